@@ -1,10 +1,12 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -34,15 +36,23 @@ type ProfileRegistryAPI interface {
 	AddValidator(ctx context.Context, key *ecdsa.PrivateKey, validator common.Address, level int8) (*types.Transaction, error)
 	RemoveValidator(ctx context.Context, key *ecdsa.PrivateKey, validator common.Address) (*types.Transaction, error)
 	GetValidator(ctx context.Context, validatorID common.Address) (*pb.Validator, error)
-	CreateCertificate(ctx context.Context, key *ecdsa.PrivateKey, owner common.Address, attributeType *big.Int, value []byte) (*types.Transaction, error)
-	RemoveCertificate(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) (*types.Transaction, error)
+	// CreateCertificate issues the certificate and waits until it is mined,
+	// returning its ID.
+	CreateCertificate(ctx context.Context, key *ecdsa.PrivateKey, owner common.Address, attributeType *big.Int, value []byte) (*big.Int, error)
+	RemoveCertificate(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error
 	GetCertificate(ctx context.Context, certificateID *big.Int) (*pb.Certificate, error)
 	GetAttributeCount(ctx context.Context, owner common.Address, attributeType *big.Int) (*big.Int, error)
 	GetAttributeValue(ctx context.Context, owner common.Address, attributeType *big.Int) ([]byte, error)
 }
 
 type EventsAPI interface {
-	GetEvents(ctx context.Context, fromBlockInitial *big.Int) (chan *Event, error)
+	// GetEvents streams events from blocks after the given one. Hashes of
+	// the blocks scanned before, if known, are used to detect the chain
+	// reorganization happened since then.
+	GetEvents(ctx context.Context, fromBlockInitial *big.Int, knownBlocks map[uint64]common.Hash) (chan *Event, error)
+	// GetEventsRange returns events from the given inclusive range of blocks,
+	// regardless of the number of their confirmations.
+	GetEventsRange(ctx context.Context, fromBlock, toBlock uint64) ([]*Event, error)
 	GetLastBlock(ctx context.Context) (uint64, error)
 }
 
@@ -51,6 +61,9 @@ type MarketAPI interface {
 	OpenDeal(ctx context.Context, key *ecdsa.PrivateKey, askID, bigID *big.Int) (*pb.Deal, error)
 	CloseDeal(ctx context.Context, key *ecdsa.PrivateKey, dealID *big.Int, blacklisted bool) error
 	GetDealInfo(ctx context.Context, dealID *big.Int) (*pb.Deal, error)
+	// GetDealClosing returns the description of the transaction, that has
+	// closed the given deal.
+	GetDealClosing(ctx context.Context, dealID *big.Int) (*DealClosing, error)
 	GetDealsAmount(ctx context.Context) (*big.Int, error)
 	PlaceOrder(ctx context.Context, key *ecdsa.PrivateKey, order *pb.Order) (*pb.Order, error)
 	CancelOrder(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error
@@ -62,6 +75,10 @@ type MarketAPI interface {
 	RemoveWorker(ctx context.Context, key *ecdsa.PrivateKey, master, slave common.Address) error
 	GetMaster(ctx context.Context, slave common.Address) (common.Address, error)
 	GetDealChangeRequestInfo(ctx context.Context, id *big.Int) (*pb.DealChangeRequest, error)
+	// GetDealChangeRequestsInfo returns change requests, that have been sent
+	// for the given deal starting from the given block, in order of their
+	// creation. Zero block means all requests since the deal has been opened.
+	GetDealChangeRequestsInfo(ctx context.Context, dealID *big.Int, fromBlock uint64) ([]*pb.DealChangeRequest, error)
 	CreateChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, request *pb.DealChangeRequest) (*big.Int, error)
 	CancelChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error
 	GetNumBenchmarks(ctx context.Context) (uint64, error)
@@ -69,7 +86,7 @@ type MarketAPI interface {
 
 type BlacklistAPI interface {
 	Check(ctx context.Context, who, whom common.Address) (bool, error)
-	Add(ctx context.Context, key *ecdsa.PrivateKey, who, whom common.Address) (*types.Transaction, error)
+	Add(ctx context.Context, key *ecdsa.PrivateKey, who, whom common.Address) error
 	Remove(ctx context.Context, key *ecdsa.PrivateKey, whom common.Address) error
 	AddMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error)
 	RemoveMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error)
@@ -91,6 +108,9 @@ type TokenAPI interface {
 	AllowanceOf(ctx context.Context, from, to common.Address) (*big.Int, error)
 	// TotalSupply - all amount of emitted token
 	TotalSupply(ctx context.Context) (*big.Int, error)
+	// WaitTransaction awaits the given transaction to be confirmed,
+	// returning an error if it has failed
+	WaitTransaction(ctx context.Context, tx *types.Transaction) (*Receipt, error)
 }
 
 type TestTokenAPI interface {
@@ -119,6 +139,9 @@ type SimpleGatekeeperAPI interface {
 	// Kill calls contract to suicide, all ether and tokens funds transfer to owner.
 	// Accessible only by owner.
 	Kill(ctx context.Context, key *ecdsa.PrivateKey) (*types.Transaction, error)
+	// WaitTransaction awaits the given transaction to be confirmed,
+	// returning an error if it has failed
+	WaitTransaction(ctx context.Context, tx *types.Transaction) (*Receipt, error)
 }
 
 type BasicAPI struct {
@@ -352,6 +375,50 @@ func (api *BasicMarketAPI) GetDealInfo(ctx context.Context, dealID *big.Int) (*p
 	}, nil
 }
 
+func (api *BasicMarketAPI) GetDealClosing(ctx context.Context, dealID *big.Int) (*DealClosing, error) {
+	deal, err := api.GetDealInfo(ctx, dealID)
+	if err != nil {
+		return nil, err
+	}
+	if deal.GetStatus() != pb.DealStatus_DEAL_CLOSED {
+		return nil, fmt.Errorf("deal %s is not closed", dealID.String())
+	}
+
+	logs, err := api.client.FilterLogs(ctx, ethereum.FilterQuery{
+		Topics:    [][]common.Hash{{DealUpdatedTopic}, {common.BigToHash(dealID)}},
+		FromBlock: big.NewInt(0),
+		Addresses: []common.Address{MarketAddr()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, fmt.Errorf("no updates found for deal %s", dealID.String())
+	}
+
+	// Closed deals are never updated again, so it's the last update, that
+	// has closed the deal.
+	tx, _, err := api.client.TransactionByHash(ctx, logs[len(logs)-1].TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		signer = types.NewEIP155Signer(tx.ChainId())
+	}
+
+	sender, err := types.Sender(signer, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DealClosing{
+		ClosedBy: sender,
+		Billed:   !bytes.HasPrefix(tx.Data(), closeDealMethodID),
+	}, nil
+}
+
 func (api *BasicMarketAPI) GetDealsAmount(ctx context.Context) (*big.Int, error) {
 	return api.marketContract.GetDealsAmount(getCallOptions(ctx))
 }
@@ -531,6 +598,54 @@ func (api *BasicMarketAPI) GetDealChangeRequestInfo(ctx context.Context, changeR
 	}, nil
 }
 
+func (api *BasicMarketAPI) GetDealChangeRequestsInfo(ctx context.Context, dealID *big.Int, fromBlock uint64) ([]*pb.DealChangeRequest, error) {
+	// Change request events are not indexed by deal, so we scan them
+	// starting from the block, where the deal has been opened.
+	if fromBlock == 0 {
+		opened, err := api.client.FilterLogs(ctx, ethereum.FilterQuery{
+			Topics:    [][]common.Hash{{DealOpenedTopic}, {common.BigToHash(dealID)}},
+			FromBlock: big.NewInt(0),
+			Addresses: []common.Address{MarketAddr()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(opened) == 0 {
+			return nil, fmt.Errorf("no deal with id = %s", dealID.String())
+		}
+
+		fromBlock = opened[0].BlockNumber
+	}
+
+	logs, err := api.client.FilterLogs(ctx, ethereum.FilterQuery{
+		Topics:    [][]common.Hash{{DealChangeRequestSentTopic}},
+		FromBlock: big.NewInt(0).SetUint64(fromBlock),
+		Addresses: []common.Address{MarketAddr()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []*pb.DealChangeRequest
+	for _, log := range logs {
+		id, err := extractBig(log.Topics, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		request, err := api.GetDealChangeRequestInfo(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if request.GetDealID().Unwrap().Cmp(dealID) == 0 {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests, nil
+}
+
 func (api *BasicMarketAPI) CreateChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, req *pb.DealChangeRequest) (*big.Int, error) {
 	duration := big.NewInt(int64(req.GetDuration()))
 	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.opts.gasPrice)
@@ -601,14 +716,33 @@ func NewProfileRegistry(address common.Address, opts *chainOpts) (ProfileRegistr
 	}, nil
 }
 
-func (api *ProfileRegistry) CreateCertificate(ctx context.Context, key *ecdsa.PrivateKey, owner common.Address, attributeType *big.Int, value []byte) (*types.Transaction, error) {
+func (api *ProfileRegistry) CreateCertificate(ctx context.Context, key *ecdsa.PrivateKey, owner common.Address, attributeType *big.Int, value []byte) (*big.Int, error) {
 	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.opts.gasPrice)
-	return api.profileRegistryContract.CreateCertificate(opts, owner, attributeType, value)
+	tx, err := api.profileRegistryContract.CreateCertificate(opts, owner, attributeType, value)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := WaitTxAndExtractLog(ctx, api.client, api.opts.blockConfirmations, api.opts.logParsePeriod, tx, CertificateCreatedTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractBig(logs.Topics, 1)
 }
 
-func (api *ProfileRegistry) RemoveCertificate(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) (*types.Transaction, error) {
+func (api *ProfileRegistry) RemoveCertificate(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error {
 	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.opts.gasPrice)
-	return api.profileRegistryContract.RemoveCertificate(opts, id)
+	tx, err := api.profileRegistryContract.RemoveCertificate(opts, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitTxAndExtractLog(ctx, api.client, api.opts.blockConfirmations, api.opts.logParsePeriod, tx, CertificateUpdatedTopic); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func (api *ProfileRegistry) AddValidator(ctx context.Context, key *ecdsa.PrivateKey, validator common.Address, level int8) (*types.Transaction, error) {
@@ -683,9 +817,18 @@ func (api *BasicBlacklistAPI) Check(ctx context.Context, who, whom common.Addres
 	return api.blacklistContract.Check(getCallOptions(ctx), who, whom)
 }
 
-func (api *BasicBlacklistAPI) Add(ctx context.Context, key *ecdsa.PrivateKey, who, whom common.Address) (*types.Transaction, error) {
+func (api *BasicBlacklistAPI) Add(ctx context.Context, key *ecdsa.PrivateKey, who, whom common.Address) error {
 	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.opts.gasPrice)
-	return api.blacklistContract.Add(opts, who, whom)
+	tx, err := api.blacklistContract.Add(opts, who, whom)
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitTxAndExtractLog(ctx, api.client, api.opts.blockConfirmations, api.opts.logParsePeriod, tx, AddedToBlacklistTopic); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func (api *BasicBlacklistAPI) Remove(ctx context.Context, key *ecdsa.PrivateKey, whom common.Address) error {
@@ -768,6 +911,10 @@ func (api *StandardTokenApi) TotalSupply(ctx context.Context) (*big.Int, error)
 	return api.tokenContract.TotalSupply(getCallOptions(ctx))
 }
 
+func (api *StandardTokenApi) WaitTransaction(ctx context.Context, tx *types.Transaction) (*Receipt, error) {
+	return waitTransaction(ctx, api.client, api.opts, tx)
+}
+
 type TestTokenApi struct {
 	client        CustomEthereumClient
 	tokenContract *marketAPI.SNMTToken
@@ -797,9 +944,14 @@ func (api *TestTokenApi) GetTokens(ctx context.Context, key *ecdsa.PrivateKey) (
 	return api.tokenContract.GetTokens(opts)
 }
 
+// maxTrackedBlocks is the number of the latest scanned blocks, whose hashes
+// are kept to find the fork point when the chain is reorganized.
+const maxTrackedBlocks = 256
+
 type BasicEventsAPI struct {
-	client CustomEthereumClient
-	logger *zap.Logger
+	client        CustomEthereumClient
+	confirmations uint64
+	logger        *zap.Logger
 }
 
 func NewEventsAPI(opts *chainOpts, logger *zap.Logger) (EventsAPI, error) {
@@ -809,8 +961,9 @@ func NewEventsAPI(opts *chainOpts, logger *zap.Logger) (EventsAPI, error) {
 	}
 
 	return &BasicEventsAPI{
-		client: client,
-		logger: logger,
+		client:        client,
+		confirmations: uint64(opts.blockConfirmations),
+		logger:        logger,
 	}, nil
 }
 
@@ -826,74 +979,96 @@ func (api *BasicEventsAPI) GetLastBlock(ctx context.Context) (uint64, error) {
 	}
 }
 
-func (api *BasicEventsAPI) GetEvents(ctx context.Context, fromBlockInitial *big.Int) (chan *Event, error) {
-	var (
-		topics     [][]common.Hash
-		eventTopic = []common.Hash{
-			DealOpenedTopic,
-			DealUpdatedTopic,
-			OrderPlacedTopic,
-			OrderUpdatedTopic,
-			DealChangeRequestSentTopic,
-			DealChangeRequestUpdatedTopic,
-			BilledTopic,
-			WorkerAnnouncedTopic,
-			WorkerConfirmedTopic,
-			WorkerConfirmedTopic,
-			WorkerRemovedTopic,
-			AddedToBlacklistTopic,
-			RemovedFromBlacklistTopic,
-			ValidatorCreatedTopic,
-			ValidatorDeletedTopic,
-			CertificateCreatedTopic,
-		}
-		out = make(chan *Event, 128)
-	)
-	topics = append(topics, eventTopic)
+// GetEvents streams events from blocks after the given one. Only blocks
+// having the configured number of confirmations are scanned. If the chain is
+// reorganized deeper than that anyway, including the known blocks scanned
+// before the call, "RevertData" event is sent and events are sent again
+// starting from the fork point.
+func (api *BasicEventsAPI) GetEvents(ctx context.Context, fromBlockInitial *big.Int, knownBlocks map[uint64]common.Hash) (chan *Event, error) {
+	out := make(chan *Event, 128)
+
+	// hashes maps the numbers of scanned blocks into their hashes.
+	hashes := map[uint64]common.Hash{}
+	for number, hash := range knownBlocks {
+		hashes[number] = hash
+	}
 
 	go func() {
 		var (
-			lastLogBlockNumber = fromBlockInitial.Uint64()
-			fromBlock          = fromBlockInitial.Uint64()
-			tk                 = time.NewTicker(time.Second)
+			fromBlock = fromBlockInitial.Uint64()
+			tk        = time.NewTicker(time.Second)
 		)
+		defer tk.Stop()
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-tk.C:
-				logs, err := api.client.FilterLogs(ctx, ethereum.FilterQuery{
-					Topics:    topics,
-					FromBlock: big.NewInt(0).SetUint64(fromBlock),
-					Addresses: []common.Address{
-						MarketAddr(),
-						BlacklistAddr(),
-						ProfileRegistryAddr(),
-					},
-				})
+				lastBlock, err := api.GetLastBlock(ctx)
+				if err != nil {
+					out <- &Event{
+						Data:        &ErrorData{Err: errors.Wrap(err, "failed to GetLastBlock")},
+						BlockNumber: fromBlock,
+					}
+					continue
+				}
 
+				forkBlock, reorganized, err := api.findFork(ctx, hashes)
+				if err != nil {
+					out <- &Event{
+						Data:        &ErrorData{Err: errors.Wrap(err, "failed to check chain reorganization")},
+						BlockNumber: fromBlock,
+					}
+					continue
+				}
+				if reorganized {
+					api.logger.Warn("chain is reorganized, reverting events", zap.Uint64("fromBlock", fromBlock),
+						zap.Uint64("forkBlock", forkBlock))
+					for number := range hashes {
+						if number > forkBlock {
+							delete(hashes, number)
+						}
+					}
+					if forkBlock < fromBlock {
+						fromBlock = forkBlock
+					}
+					out <- &Event{Data: &RevertData{BlockNumber: forkBlock}, BlockNumber: forkBlock, BlockHash: hashes[forkBlock]}
+				}
+
+				if lastBlock < fromBlock+api.confirmations+1 {
+					continue
+				}
+				toBlock := lastBlock - api.confirmations
+
+				logs, err := api.client.FilterLogs(ctx, filterQuery(fromBlock+1, toBlock))
 				if err != nil {
 					out <- &Event{
 						Data:        &ErrorData{Err: errors.Wrap(err, "failed to FilterLogs")},
 						BlockNumber: fromBlock,
 					}
+					continue
 				}
 
-				numLogs := len(logs)
-				if numLogs < 1 {
-					api.logger.Info("no logs, skipping")
+				header, err := api.client.HeaderByNumber(ctx, big.NewInt(0).SetUint64(toBlock))
+				if err != nil {
+					out <- &Event{
+						Data:        &ErrorData{Err: errors.Wrap(err, "failed to get block header")},
+						BlockNumber: fromBlock,
+					}
 					continue
 				}
+				hashes[toBlock] = header.Hash()
 
-				var eventTS uint64
+				var (
+					eventTS            uint64
+					lastLogBlockNumber uint64
+				)
 				for _, log := range logs {
-					// Skip logs from the last seen block.
-					if log.BlockNumber == fromBlock {
-						continue
-					}
 					// Update eventTS if we've got a new block.
 					if lastLogBlockNumber != log.BlockNumber {
 						lastLogBlockNumber = log.BlockNumber
+						hashes[log.BlockNumber] = log.BlockHash
 						block, err := api.client.BlockByNumber(ctx, big.NewInt(0).SetUint64(lastLogBlockNumber))
 						if err != nil {
 							api.logger.Warn("failed to get event timestamp", zap.Error(err),
@@ -905,7 +1080,12 @@ func (api *BasicEventsAPI) GetEvents(ctx context.Context, fromBlockInitial *big.
 					api.processLog(log, eventTS, out)
 				}
 
-				fromBlock = logs[numLogs-1].BlockNumber
+				fromBlock = toBlock
+				for number := range hashes {
+					if number+maxTrackedBlocks < toBlock {
+						delete(hashes, number)
+					}
+				}
 			}
 		}
 	}()
@@ -913,6 +1093,104 @@ func (api *BasicEventsAPI) GetEvents(ctx context.Context, fromBlockInitial *big.
 	return out, nil
 }
 
+// GetEventsRange returns events from the given inclusive range of blocks.
+func (api *BasicEventsAPI) GetEventsRange(ctx context.Context, fromBlock, toBlock uint64) ([]*Event, error) {
+	logs, err := api.client.FilterLogs(ctx, filterQuery(fromBlock, toBlock))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to FilterLogs")
+	}
+
+	var (
+		out                = make(chan *Event, len(logs))
+		eventTS            uint64
+		lastLogBlockNumber uint64
+	)
+	for _, log := range logs {
+		if lastLogBlockNumber != log.BlockNumber {
+			lastLogBlockNumber = log.BlockNumber
+			block, err := api.client.BlockByNumber(ctx, big.NewInt(0).SetUint64(lastLogBlockNumber))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get block %d", lastLogBlockNumber)
+			}
+			eventTS = block.Time().Uint64()
+		}
+		api.processLog(log, eventTS, out)
+	}
+	close(out)
+
+	events := make([]*Event, 0, len(logs))
+	for event := range out {
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// filterQuery returns the query for logs of market events from the given
+// inclusive range of blocks.
+func filterQuery(fromBlock, toBlock uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Topics: [][]common.Hash{{
+			DealOpenedTopic,
+			DealUpdatedTopic,
+			OrderPlacedTopic,
+			OrderUpdatedTopic,
+			DealChangeRequestSentTopic,
+			DealChangeRequestUpdatedTopic,
+			BilledTopic,
+			WorkerAnnouncedTopic,
+			WorkerConfirmedTopic,
+			WorkerConfirmedTopic,
+			WorkerRemovedTopic,
+			AddedToBlacklistTopic,
+			RemovedFromBlacklistTopic,
+			ValidatorCreatedTopic,
+			ValidatorDeletedTopic,
+			CertificateCreatedTopic,
+		}},
+		FromBlock: big.NewInt(0).SetUint64(fromBlock),
+		ToBlock:   big.NewInt(0).SetUint64(toBlock),
+		Addresses: []common.Address{
+			MarketAddr(),
+			BlacklistAddr(),
+			ProfileRegistryAddr(),
+		},
+	}
+}
+
+// findFork returns the last scanned block that is still in the chain and
+// whether any later scanned block has been removed from it.
+func (api *BasicEventsAPI) findFork(ctx context.Context, hashes map[uint64]common.Hash) (uint64, bool, error) {
+	if len(hashes) == 0 {
+		return 0, false, nil
+	}
+
+	numbers := make([]uint64, 0, len(hashes))
+	for number := range hashes {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] > numbers[j] })
+
+	for idx, number := range numbers {
+		header, err := api.client.HeaderByNumber(ctx, big.NewInt(0).SetUint64(number))
+		if err != nil {
+			return 0, false, err
+		}
+		if header.Hash() == hashes[number] {
+			return number, idx != 0, nil
+		}
+	}
+
+	// The reorganization is deeper than the tracked blocks, so the best we
+	// can do is to revert everything we know.
+	oldest := numbers[len(numbers)-1]
+	if oldest == 0 {
+		return 0, true, nil
+	}
+
+	return oldest - 1, true, nil
+}
+
 func (api *BasicEventsAPI) processLog(log types.Log, eventTS uint64, out chan *Event) {
 	// This should never happen, but it's ethereum, and things might happen.
 	if len(log.Topics) < 1 {
@@ -924,11 +1202,13 @@ func (api *BasicEventsAPI) processLog(log types.Log, eventTS uint64, out chan *E
 	}
 
 	sendErr := func(out chan *Event, err error, topic common.Hash) {
-		out <- &Event{Data: &ErrorData{Err: err, Topic: topic.String()}, BlockNumber: log.BlockNumber, TS: eventTS}
+		out <- &Event{Data: &ErrorData{Err: err, Topic: topic.String()}, BlockNumber: log.BlockNumber, TS: eventTS, TxHash: log.TxHash,
+			BlockHash: log.BlockHash}
 	}
 
 	sendData := func(data interface{}) {
-		out <- &Event{Data: data, BlockNumber: log.BlockNumber, TS: eventTS}
+		out <- &Event{Data: data, BlockNumber: log.BlockNumber, TS: eventTS, TxHash: log.TxHash,
+			LogIndex: uint64(log.Index), BlockHash: log.BlockHash}
 	}
 
 	var topic = log.Topics[0]
@@ -1148,3 +1428,7 @@ func (api *BasicSimpleGatekeeper) Kill(ctx context.Context, key *ecdsa.PrivateKe
 	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.opts.gasPrice)
 	return api.contract.Kill(opts)
 }
+
+func (api *BasicSimpleGatekeeper) WaitTransaction(ctx context.Context, tx *types.Transaction) (*Receipt, error) {
+	return waitTransaction(ctx, api.client, api.opts, tx)
+}
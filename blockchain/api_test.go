@@ -0,0 +1,75 @@
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testHeadersClient struct {
+	CustomEthereumClient
+	headers map[uint64]*types.Header
+}
+
+func (m *testHeadersClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	header, ok := m.headers[number.Uint64()]
+	if !ok {
+		return nil, errors.New("header not found")
+	}
+
+	return header, nil
+}
+
+func (m *testHeadersClient) reorganize(number uint64) {
+	m.headers[number] = &types.Header{Number: big.NewInt(int64(number)), Extra: []byte("reorganized")}
+}
+
+func TestEventsFindFork(t *testing.T) {
+	client := &testHeadersClient{headers: map[uint64]*types.Header{}}
+	hashes := map[uint64]common.Hash{}
+	for _, number := range []uint64{10, 20, 30} {
+		client.headers[number] = &types.Header{Number: big.NewInt(int64(number))}
+		hashes[number] = client.headers[number].Hash()
+	}
+
+	api := &BasicEventsAPI{client: client, logger: zap.NewNop()}
+
+	fork, reorganized, err := api.findFork(context.Background(), map[uint64]common.Hash{})
+	require.NoError(t, err)
+	assert.False(t, reorganized)
+
+	fork, reorganized, err = api.findFork(context.Background(), hashes)
+	require.NoError(t, err)
+	assert.False(t, reorganized)
+	assert.Equal(t, uint64(30), fork)
+
+	client.reorganize(30)
+	client.reorganize(20)
+	fork, reorganized, err = api.findFork(context.Background(), hashes)
+	require.NoError(t, err)
+	assert.True(t, reorganized)
+	assert.Equal(t, uint64(10), fork)
+
+	client.reorganize(10)
+	fork, reorganized, err = api.findFork(context.Background(), hashes)
+	require.NoError(t, err)
+	assert.True(t, reorganized)
+	assert.Equal(t, uint64(9), fork)
+
+	delete(client.headers, 30)
+	_, _, err = api.findFork(context.Background(), hashes)
+	assert.Error(t, err)
+
+	// The only known block, e.g. the one scanned before the restart, is
+	// reorganized.
+	_, reorganized, err = api.findFork(context.Background(), map[uint64]common.Hash{20: hashes[20]})
+	require.NoError(t, err)
+	assert.True(t, reorganized)
+}
@@ -2,6 +2,8 @@ package blockchain
 
 import (
 	"net/url"
+
+	"github.com/pkg/errors"
 )
 
 // Config represents SONM blockchain configuration structure that can act as a
@@ -9,12 +11,17 @@ import (
 type Config struct {
 	Endpoint          url.URL
 	SidechainEndpoint url.URL
+	// BlockConfirmations is the number of sidechain blocks mined after the
+	// one containing a transaction or an event before it is considered
+	// final.
+	BlockConfirmations int64
 }
 
 func (m *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var cfg struct {
-		Endpoint          string `yaml:"endpoint"`
-		SidechainEndpoint string `yaml:"sidechain_endpoint"`
+		Endpoint           string `yaml:"endpoint"`
+		SidechainEndpoint  string `yaml:"sidechain_endpoint"`
+		BlockConfirmations *int64 `yaml:"block_confirmations"`
 	}
 
 	if err := unmarshal(&cfg); err != nil {
@@ -39,8 +46,18 @@ func (m *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return err
 	}
 
+	if cfg.BlockConfirmations == nil {
+		cfg.BlockConfirmations = new(int64)
+		*cfg.BlockConfirmations = defaultBlockConfirmations
+	}
+
+	if *cfg.BlockConfirmations < 0 {
+		return errors.New("block confirmations must not be negative")
+	}
+
 	m.Endpoint = *endpoint
 	m.SidechainEndpoint = *sidechainEndpoint
+	m.BlockConfirmations = *cfg.BlockConfirmations
 
 	return nil
 }
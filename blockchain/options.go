@@ -85,6 +85,7 @@ func WithConfig(cfg *Config) Option {
 		if cfg != nil {
 			o.masterchain.endpoint = cfg.Endpoint.String()
 			o.sidechain.endpoint = cfg.SidechainEndpoint.String()
+			o.sidechain.blockConfirmations = cfg.BlockConfirmations
 		}
 	}
 }
@@ -0,0 +1,1709 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	pb "github.com/sonm-io/core/proto"
+)
+
+const (
+	// maxBenchmarkValue is the maximum benchmark value accepted by the
+	// Market, because postgres/sqlite can't work with uint64.
+	maxBenchmarkValue = uint64(1) << 63
+	// testTokenAmount is the amount of tokens sent by the test token on
+	// each "GetTokens" call, i.e. 100 SNM.
+	testTokenAmount = "100000000000000000000"
+)
+
+var (
+	errNotOwner         = errors.New("sender is not the contract owner")
+	errNotDealMember    = errors.New("sender is not a deal member")
+	errDealNotAccepted  = errors.New("deal is not in the accepted state")
+	errGatekeeperKilled = errors.New("gatekeeper has been killed")
+)
+
+// SimulatedAPI is an in-memory implementation of the API, which follows
+// the semantics of SONM smart contracts without any Ethereum node.
+//
+// Each state-changing call is immediately mined into its own block, so the
+// emitted events are available right after the call returns. The chain time
+// is virtual and is moved forward only using "AdvanceTime", which makes
+// billing fully deterministic.
+type SimulatedAPI struct {
+	mu sync.Mutex
+
+	owner       common.Address
+	now         time.Time
+	blockNumber uint64
+	txCount     uint64
+	events      []*Event
+	pending     []interface{}
+	notify      chan struct{}
+
+	numBenchmarks  uint64
+	orders         []*pb.Order
+	deals          []*pb.Deal
+	changeRequests []*pb.DealChangeRequest
+	// actualRequests maps deal ID into the pair of currently active change
+	// request IDs made by supplier (ASK) and consumer (BID) respectively.
+	actualRequests map[uint64]*[2]uint64
+	// closings maps deal ID into the description of its closing.
+	closings       map[uint64]*DealClosing
+	masterOf       map[common.Address]common.Address
+	isMaster       map[common.Address]bool
+	masterRequests map[common.Address]map[common.Address]bool
+
+	blacklisted      map[common.Address]map[common.Address]bool
+	blacklistMasters map[common.Address]bool
+	blacklistMarket  common.Address
+
+	validators      map[common.Address]int8
+	certificates    []*simulatedCertificate
+	attributeValues map[common.Address]map[uint64][]byte
+	attributeCounts map[common.Address]map[uint64]uint64
+
+	currentPrice *big.Int
+
+	liveToken       *simulatedTokenState
+	sideToken       *simulatedTokenState
+	masterchainGate *simulatedGatekeeper
+	sidechainGate   *simulatedGatekeeper
+}
+
+// NewSimulatedAPI constructs a new simulated blockchain, whose contracts are
+// owned by the given address.
+func NewSimulatedAPI(owner common.Address) *SimulatedAPI {
+	m := &SimulatedAPI{
+		owner:            owner,
+		now:              time.Now().Truncate(time.Second),
+		notify:           make(chan struct{}),
+		numBenchmarks:    pb.MinNumBenchmarks,
+		actualRequests:   map[uint64]*[2]uint64{},
+		closings:         map[uint64]*DealClosing{},
+		masterOf:         map[common.Address]common.Address{},
+		isMaster:         map[common.Address]bool{},
+		masterRequests:   map[common.Address]map[common.Address]bool{},
+		blacklisted:      map[common.Address]map[common.Address]bool{},
+		blacklistMasters: map[common.Address]bool{},
+		blacklistMarket:  MarketAddr(),
+		validators:       map[common.Address]int8{owner: -1},
+		attributeValues:  map[common.Address]map[uint64][]byte{},
+		attributeCounts:  map[common.Address]map[uint64]uint64{},
+		currentPrice:     big.NewInt(1),
+		liveToken:        newSimulatedTokenState(SNMAddr()),
+		sideToken:        newSimulatedTokenState(SNMSidechainAddr()),
+	}
+
+	m.masterchainGate = newSimulatedGatekeeper(m, GatekeeperLiveAddr(), m.liveToken)
+	m.sidechainGate = newSimulatedGatekeeper(m, GatekeeperSidechainAddr(), m.sideToken)
+
+	return m
+}
+
+func (m *SimulatedAPI) ProfileRegistry() ProfileRegistryAPI {
+	return &simulatedProfileRegistry{chain: m}
+}
+
+func (m *SimulatedAPI) Events() EventsAPI {
+	return &simulatedEvents{chain: m}
+}
+
+func (m *SimulatedAPI) Market() MarketAPI {
+	return &simulatedMarket{chain: m}
+}
+
+func (m *SimulatedAPI) Blacklist() BlacklistAPI {
+	return &simulatedBlacklist{chain: m}
+}
+
+func (m *SimulatedAPI) LiveToken() TokenAPI {
+	return &simulatedToken{chain: m, state: m.liveToken}
+}
+
+func (m *SimulatedAPI) SideToken() TokenAPI {
+	return &simulatedToken{chain: m, state: m.sideToken}
+}
+
+func (m *SimulatedAPI) TestToken() TestTokenAPI {
+	return &simulatedTestToken{chain: m}
+}
+
+func (m *SimulatedAPI) OracleUSD() OracleAPI {
+	return &simulatedOracle{chain: m}
+}
+
+func (m *SimulatedAPI) MasterchainGate() SimpleGatekeeperAPI {
+	return m.masterchainGate
+}
+
+func (m *SimulatedAPI) SidechainGate() SimpleGatekeeperAPI {
+	return m.sidechainGate
+}
+
+// Now returns the current virtual time of the chain.
+func (m *SimulatedAPI) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.now
+}
+
+// AdvanceTime moves the virtual time of the chain forward.
+func (m *SimulatedAPI) AdvanceTime(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.now = m.now.Add(d)
+}
+
+// MintLiveTokens emits the given amount of masterchain tokens to the
+// specified address.
+func (m *SimulatedAPI) MintLiveTokens(to common.Address, amount *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.liveToken.mint(to, amount)
+}
+
+// MintSideTokens emits the given amount of sidechain tokens, that are used
+// by the Market, to the specified address.
+func (m *SimulatedAPI) MintSideTokens(to common.Address, amount *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sideToken.mint(to, amount)
+}
+
+// transact executes the given function as a single transaction.
+//
+// Events emitted by the function are mined into a new block only if it
+// succeeds, i.e. the function must perform all checks before modifying the
+// state, the same way as "require" does in contracts.
+func (m *SimulatedAPI) transact(to common.Address, fn func() error) (*types.Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pending = nil
+	if err := fn(); err != nil {
+		m.pending = nil
+		return nil, err
+	}
+
+	m.blockNumber++
+	m.txCount++
+	tx := types.NewTransaction(m.txCount, to, big.NewInt(0), 0, big.NewInt(0), nil)
+	// Each transaction is mined in its own block.
+	for idx, data := range m.pending {
+		m.events = append(m.events, &Event{
+			Data:        data,
+			BlockNumber: m.blockNumber,
+			TS:          uint64(m.now.Unix()),
+			TxHash:      tx.Hash(),
+			LogIndex:    uint64(idx),
+		})
+	}
+	m.pending = nil
+
+	close(m.notify)
+	m.notify = make(chan struct{})
+
+	return tx, nil
+}
+
+// waitTransaction returns the receipt of the transaction made by transact.
+// Failed transactions are never sent, and every sent one is mined into its
+// own block immediately, so the block number matches the nonce.
+func (m *SimulatedAPI) waitTransaction(tx *types.Transaction) (*Receipt, error) {
+	return &Receipt{
+		Receipt:     &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful},
+		BlockNumber: int64(tx.Nonce()),
+	}, nil
+}
+
+func (m *SimulatedAPI) emit(data interface{}) {
+	m.pending = append(m.pending, data)
+}
+
+// calculatePayment returns the amount of tokens required to pay for the
+// given period in seconds with the given USD price per second.
+func (m *SimulatedAPI) calculatePayment(price *big.Int, period int64) *big.Int {
+	v := new(big.Int).Mul(m.currentPrice, price)
+	v.Mul(v, big.NewInt(period))
+	return v.Div(v, big.NewInt(1e18))
+}
+
+func (m *SimulatedAPI) getMaster(worker common.Address) common.Address {
+	if master, ok := m.masterOf[worker]; ok {
+		return master
+	}
+
+	return worker
+}
+
+func (m *SimulatedAPI) isBlacklisted(who, whom common.Address) bool {
+	return m.blacklisted[who][whom]
+}
+
+func (m *SimulatedAPI) addToBlacklist(who, whom common.Address) {
+	if _, ok := m.blacklisted[who]; !ok {
+		m.blacklisted[who] = map[common.Address]bool{}
+	}
+
+	m.blacklisted[who][whom] = true
+	m.emit(&AddedToBlacklistData{AdderID: who, AddeeID: whom})
+}
+
+func (m *SimulatedAPI) attributeValue(owner common.Address, attributeType uint64) []byte {
+	return m.attributeValues[owner][attributeType]
+}
+
+func (m *SimulatedAPI) checkProfileLevel(owner common.Address, level pb.IdentityLevel) bool {
+	switch {
+	case level > 4:
+		return false
+	case level == 4:
+		return len(m.attributeValue(owner, 1401)) != 0
+	case level == 3:
+		return len(m.attributeValue(owner, 1301)) != 0
+	case level == 2:
+		return len(m.attributeValue(owner, 1201)) != 0
+	default:
+		return true
+	}
+}
+
+func (m *SimulatedAPI) order(id *big.Int) (*pb.Order, bool) {
+	if !id.IsUint64() || id.Uint64() == 0 || id.Uint64() > uint64(len(m.orders)) {
+		return nil, false
+	}
+
+	return m.orders[id.Uint64()-1], true
+}
+
+func (m *SimulatedAPI) deal(id *big.Int) (*pb.Deal, bool) {
+	if !id.IsUint64() || id.Uint64() == 0 || id.Uint64() > uint64(len(m.deals)) {
+		return nil, false
+	}
+
+	return m.deals[id.Uint64()-1], true
+}
+
+func (m *SimulatedAPI) changeRequest(id uint64) (*pb.DealChangeRequest, bool) {
+	if id == 0 || id > uint64(len(m.changeRequests)) {
+		return nil, false
+	}
+
+	return m.changeRequests[id-1], true
+}
+
+func (m *SimulatedAPI) certificate(id *big.Int) *simulatedCertificate {
+	if !id.IsUint64() || id.Uint64() == 0 || id.Uint64() > uint64(len(m.certificates)) {
+		return nil
+	}
+
+	return m.certificates[id.Uint64()-1]
+}
+
+func (m *SimulatedAPI) isDealMember(deal *pb.Deal, addr common.Address) bool {
+	return addr == deal.GetSupplierID().Unwrap() ||
+		addr == deal.GetConsumerID().Unwrap() ||
+		addr == deal.GetMasterID().Unwrap()
+}
+
+// newOrder validates the given order and constructs its on-chain
+// representation without storing it.
+func (m *SimulatedAPI) newOrder(author common.Address, order *pb.Order) (*pb.Order, error) {
+	if order.GetNetflags()>>pb.NumNetflags != 0 {
+		return nil, errors.New("too many netflags")
+	}
+
+	values := order.GetBenchmarks().GetValues()
+	if uint64(len(values)) > m.numBenchmarks {
+		return nil, errors.New("too many benchmarks")
+	}
+
+	benchmarks := make([]uint64, m.numBenchmarks)
+	for id, value := range values {
+		if value >= maxBenchmarkValue {
+			return nil, errors.Errorf("benchmark %d value is too large", id)
+		}
+		benchmarks[id] = value
+	}
+
+	if len(order.GetTag()) > 32 {
+		return nil, errors.New("tag value is too long")
+	}
+
+	tag := make([]byte, 32)
+	copy(tag, order.GetTag())
+
+	frozenSum := big.NewInt(0)
+	if order.GetOrderType() == pb.OrderType_BID {
+		switch {
+		case order.GetDuration() == 0:
+			frozenSum = m.calculatePayment(order.GetPrice().Unwrap(), int64(time.Hour.Seconds()))
+		case order.GetDuration() < uint64((24 * time.Hour).Seconds()):
+			frozenSum = m.calculatePayment(order.GetPrice().Unwrap(), int64(order.GetDuration()))
+		default:
+			frozenSum = m.calculatePayment(order.GetPrice().Unwrap(), int64((24 * time.Hour).Seconds()))
+		}
+	}
+
+	return &pb.Order{
+		Id:             pb.NewBigInt(big.NewInt(int64(len(m.orders) + 1))),
+		DealID:         pb.NewBigIntFromInt(0),
+		OrderType:      order.GetOrderType(),
+		OrderStatus:    pb.OrderStatus_ORDER_ACTIVE,
+		AuthorID:       pb.NewEthAddress(author),
+		CounterpartyID: pb.NewEthAddress(order.GetCounterpartyID().Unwrap()),
+		Duration:       order.GetDuration(),
+		Price:          pb.NewBigInt(order.GetPrice().Unwrap()),
+		Netflags:       order.GetNetflags(),
+		IdentityLevel:  order.GetIdentityLevel(),
+		Blacklist:      common.HexToAddress(order.GetBlacklist()).Hex(),
+		Tag:            tag,
+		Benchmarks:     &pb.Benchmarks{Values: benchmarks},
+		FrozenSum:      pb.NewBigInt(frozenSum),
+	}, nil
+}
+
+// storeOrder freezes the order's sum and stores it.
+func (m *SimulatedAPI) storeOrder(order *pb.Order) error {
+	if err := m.sideToken.transferFrom(MarketAddr(), order.GetAuthorID().Unwrap(), MarketAddr(), order.GetFrozenSum().Unwrap()); err != nil {
+		return errors.WithMessage(err, "failed to freeze order funds")
+	}
+
+	m.orders = append(m.orders, order)
+	m.emit(&OrderPlacedData{ID: order.GetId().Unwrap()})
+	return nil
+}
+
+func (m *SimulatedAPI) checkDeal(ask, bid *pb.Order) error {
+	if ask.GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE || bid.GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE {
+		return errors.New("both orders must be active")
+	}
+	if ask.GetOrderType() != pb.OrderType_ASK {
+		return errors.New("ask order has invalid type")
+	}
+	if bid.GetOrderType() != pb.OrderType_BID {
+		return errors.New("bid order has invalid type")
+	}
+
+	askAuthor := ask.GetAuthorID().Unwrap()
+	bidAuthor := bid.GetAuthorID().Unwrap()
+	master := m.getMaster(askAuthor)
+
+	if !ask.GetCounterpartyID().IsZero() && ask.GetCounterpartyID().Unwrap() != m.getMaster(bidAuthor) {
+		return errors.New("bid author does not match ask counterparty")
+	}
+	if !bid.GetCounterpartyID().IsZero() && bid.GetCounterpartyID().Unwrap() != master {
+		return errors.New("ask author does not match bid counterparty")
+	}
+
+	askBlacklist := common.HexToAddress(ask.GetBlacklist())
+	bidBlacklist := common.HexToAddress(bid.GetBlacklist())
+	if m.isBlacklisted(bidBlacklist, master) ||
+		m.isBlacklisted(bidBlacklist, askAuthor) ||
+		m.isBlacklisted(bidAuthor, master) ||
+		m.isBlacklisted(bidAuthor, askAuthor) ||
+		m.isBlacklisted(askBlacklist, bidAuthor) ||
+		m.isBlacklisted(master, bidAuthor) ||
+		m.isBlacklisted(askAuthor, bidAuthor) {
+		return errors.New("one of the counterparties is blacklisted")
+	}
+
+	if ask.GetPrice().Cmp(bid.GetPrice()) > 0 {
+		return errors.New("ask price is greater than bid price")
+	}
+	if ask.GetDuration() < bid.GetDuration() {
+		return errors.New("ask duration is less than bid duration")
+	}
+	if !m.checkProfileLevel(bidAuthor, ask.GetIdentityLevel()) {
+		return errors.New("bid author identity level is too low")
+	}
+	if !m.checkProfileLevel(askAuthor, bid.GetIdentityLevel()) {
+		return errors.New("ask author identity level is too low")
+	}
+	if bid.GetNetflags()&^ask.GetNetflags() != 0 {
+		return errors.New("ask does not satisfy bid netflags")
+	}
+
+	askBenchmarks := ask.GetBenchmarks().GetValues()
+	for id, value := range bid.GetBenchmarks().GetValues() {
+		if id >= len(askBenchmarks) || askBenchmarks[id] < value {
+			return errors.Errorf("ask does not satisfy bid benchmark %d", id)
+		}
+	}
+
+	return nil
+}
+
+func (m *SimulatedAPI) openDeal(ask, bid *pb.Order) *pb.Deal {
+	id := pb.NewBigInt(big.NewInt(int64(len(m.deals) + 1)))
+	now := m.now.Unix()
+
+	ask.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+	bid.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+	ask.DealID = id
+	bid.DealID = id
+	m.emit(&OrderUpdatedData{ID: ask.GetId().Unwrap()})
+	m.emit(&OrderUpdatedData{ID: bid.GetId().Unwrap()})
+
+	// Zero end time means spot deal.
+	var endTime int64
+	if ask.GetDuration() != 0 {
+		endTime = now + int64(bid.GetDuration())
+	}
+
+	deal := &pb.Deal{
+		Id:             id,
+		Benchmarks:     proto.Clone(ask.GetBenchmarks()).(*pb.Benchmarks),
+		SupplierID:     ask.GetAuthorID(),
+		ConsumerID:     bid.GetAuthorID(),
+		MasterID:       pb.NewEthAddress(m.getMaster(ask.GetAuthorID().Unwrap())),
+		AskID:          ask.GetId(),
+		BidID:          bid.GetId(),
+		Duration:       bid.GetDuration(),
+		Price:          ask.GetPrice(),
+		StartTime:      &pb.Timestamp{Seconds: now},
+		EndTime:        &pb.Timestamp{Seconds: endTime},
+		Status:         pb.DealStatus_DEAL_ACCEPTED,
+		BlockedBalance: bid.GetFrozenSum(),
+		TotalPayout:    pb.NewBigIntFromInt(0),
+		LastBillTS:     &pb.Timestamp{Seconds: now},
+	}
+
+	m.deals = append(m.deals, deal)
+	m.emit(&DealOpenedData{ID: id.Unwrap()})
+	return deal
+}
+
+func (m *SimulatedAPI) internalCloseDeal(deal *pb.Deal, closing *DealClosing) {
+	if deal.GetStatus() == pb.DealStatus_DEAL_CLOSED {
+		return
+	}
+
+	m.closings[deal.GetId().Unwrap().Uint64()] = closing
+	deal.Status = pb.DealStatus_DEAL_CLOSED
+	deal.EndTime = &pb.Timestamp{Seconds: m.now.Unix()}
+	m.emit(&DealUpdatedData{ID: deal.GetId().Unwrap()})
+}
+
+// reserve tries to block the given amount of consumer's tokens for the deal.
+//
+// Unlike the contract, which reverts the transaction when the consumer has
+// enough tokens, but not enough allowance, the simulation treats both cases
+// as insufficient funds.
+func (m *SimulatedAPI) reserve(deal *pb.Deal, amount *big.Int) bool {
+	if err := m.sideToken.transferFrom(MarketAddr(), deal.GetConsumerID().Unwrap(), MarketAddr(), amount); err != nil {
+		return false
+	}
+
+	deal.BlockedBalance = pb.NewBigInt(new(big.Int).Add(deal.GetBlockedBalance().Unwrap(), amount))
+	return true
+}
+
+// payoutAndClose pays all blocked funds to the master and closes the deal,
+// which happens when the consumer is unable to pay for it anymore.
+func (m *SimulatedAPI) payoutAndClose(sender common.Address, deal *pb.Deal) error {
+	blockedBalance := deal.GetBlockedBalance().Unwrap()
+
+	// The transfer is the only thing that can fail here, and it checks the
+	// balance before moving any tokens.
+	if err := m.sideToken.transfer(MarketAddr(), deal.GetMasterID().Unwrap(), blockedBalance); err != nil {
+		return err
+	}
+
+	m.emit(&BilledData{DealID: deal.GetId().Unwrap(), PaidAmount: blockedBalance})
+	m.internalCloseDeal(deal, &DealClosing{ClosedBy: sender, Billed: true})
+	deal.LastBillTS = &pb.Timestamp{Seconds: m.now.Unix()}
+	deal.TotalPayout = pb.NewBigInt(new(big.Int).Add(deal.GetTotalPayout().Unwrap(), blockedBalance))
+	deal.BlockedBalance = pb.NewBigIntFromInt(0)
+	return nil
+}
+
+// checkBill checks that the market holds the funds blocked for the deal.
+//
+// Having them is enough for billing to succeed: the missing tokens are either
+// reserved from the consumer or the deal is paid out and closed. Callers that
+// modify the state before billing must call it first.
+func (m *SimulatedAPI) checkBill(deal *pb.Deal) error {
+	balance := m.sideToken.balanceOf(MarketAddr())
+	blockedBalance := deal.GetBlockedBalance().Unwrap()
+	if balance.Cmp(blockedBalance) < 0 {
+		return errors.Errorf("insufficient market balance: %s < %s", balance.String(), blockedBalance.String())
+	}
+
+	return nil
+}
+
+func (m *SimulatedAPI) bill(sender common.Address, deal *pb.Deal) error {
+	if err := m.checkBill(deal); err != nil {
+		return err
+	}
+
+	var (
+		now        = m.now.Unix()
+		price      = deal.GetPrice().Unwrap()
+		lastBillTS = deal.GetLastBillTS().GetSeconds()
+		endTime    = deal.GetEndTime().GetSeconds()
+		paidAmount *big.Int
+	)
+
+	switch {
+	case !deal.IsSpot() && lastBillTS >= endTime:
+		// The deal has already been billed after its end time.
+		return nil
+	case !deal.IsSpot() && now > endTime:
+		paidAmount = m.calculatePayment(price, endTime-lastBillTS)
+	default:
+		paidAmount = m.calculatePayment(price, now-lastBillTS)
+	}
+
+	blockedBalance := deal.GetBlockedBalance().Unwrap()
+	if paidAmount.Cmp(blockedBalance) > 0 {
+		if !m.reserve(deal, new(big.Int).Sub(paidAmount, blockedBalance)) {
+			return m.payoutAndClose(sender, deal)
+		}
+	}
+
+	if err := m.sideToken.transfer(MarketAddr(), deal.GetMasterID().Unwrap(), paidAmount); err != nil {
+		return err
+	}
+
+	deal.BlockedBalance = pb.NewBigInt(new(big.Int).Sub(deal.GetBlockedBalance().Unwrap(), paidAmount))
+	deal.TotalPayout = pb.NewBigInt(new(big.Int).Add(deal.GetTotalPayout().Unwrap(), paidAmount))
+	deal.LastBillTS = &pb.Timestamp{Seconds: now}
+	m.emit(&BilledData{DealID: deal.GetId().Unwrap(), PaidAmount: paidAmount})
+
+	var nextPeriod int64
+	switch {
+	case deal.IsSpot():
+		nextPeriod = int64(time.Hour.Seconds())
+	case now > endTime:
+		// Funds are not reserved for the next period after the deal ends.
+		return nil
+	case endTime-now < int64((24 * time.Hour).Seconds()):
+		nextPeriod = endTime - now
+	default:
+		nextPeriod = int64((24 * time.Hour).Seconds())
+	}
+
+	nextPeriodSum := m.calculatePayment(price, nextPeriod)
+	blockedBalance = deal.GetBlockedBalance().Unwrap()
+	if nextPeriodSum.Cmp(blockedBalance) > 0 {
+		if !m.reserve(deal, new(big.Int).Sub(nextPeriodSum, blockedBalance)) {
+			return m.payoutAndClose(sender, deal)
+		}
+	}
+
+	return nil
+}
+
+func (m *SimulatedAPI) updateChangeRequest(id uint64, status pb.ChangeRequestStatus) {
+	request, ok := m.changeRequest(id)
+	if !ok {
+		return
+	}
+
+	request.Status = status
+	m.emit(&DealChangeRequestUpdatedData{ID: big.NewInt(0).SetUint64(id)})
+}
+
+// applyChangeRequest bills the deal using its previous conditions and then
+// changes them.
+func (m *SimulatedAPI) applyChangeRequest(sender common.Address, deal *pb.Deal, price *big.Int, duration uint64) error {
+	if err := m.bill(sender, deal); err != nil {
+		return err
+	}
+
+	deal.Price = pb.NewBigInt(price)
+	deal.Duration = duration
+	if !deal.IsSpot() {
+		deal.EndTime = &pb.Timestamp{Seconds: deal.GetStartTime().GetSeconds() + int64(duration)}
+	}
+
+	return nil
+}
+
+func (m *SimulatedAPI) createChangeRequest(sender common.Address, deal *pb.Deal, price *big.Int, duration uint64) (uint64, error) {
+	if !m.isDealMember(deal, sender) {
+		return 0, errNotDealMember
+	}
+	if deal.GetStatus() != pb.DealStatus_DEAL_ACCEPTED {
+		return 0, errDealNotAccepted
+	}
+	if deal.IsSpot() && duration != 0 {
+		return 0, errors.New("duration of spot deal can not be changed")
+	}
+	// The request may be applied immediately, which bills the deal.
+	if err := m.checkBill(deal); err != nil {
+		return 0, err
+	}
+
+	requestType := pb.OrderType_ASK
+	if sender == deal.GetConsumerID().Unwrap() {
+		requestType = pb.OrderType_BID
+	}
+
+	id := uint64(len(m.changeRequests) + 1)
+	request := &pb.DealChangeRequest{
+		Id:          pb.NewBigInt(big.NewInt(0).SetUint64(id)),
+		DealID:      deal.GetId(),
+		RequestType: requestType,
+		Duration:    duration,
+		Price:       pb.NewBigInt(price),
+		Status:      pb.ChangeRequestStatus_REQUEST_CREATED,
+		CreatedTS:   &pb.Timestamp{Seconds: m.now.Unix()},
+	}
+	m.changeRequests = append(m.changeRequests, request)
+	m.emit(&DealChangeRequestSentData{ID: request.GetId().Unwrap()})
+
+	dealID := deal.GetId().Unwrap().Uint64()
+	actual, ok := m.actualRequests[dealID]
+	if !ok {
+		actual = &[2]uint64{}
+		m.actualRequests[dealID] = actual
+	}
+
+	// Supplier's requests live in the first slot, consumer's - in the second.
+	own, other := 0, 1
+	if requestType == pb.OrderType_BID {
+		own, other = 1, 0
+	}
+
+	m.updateChangeRequest(actual[own], pb.ChangeRequestStatus_REQUEST_CANCELED)
+	actual[own] = id
+
+	// Both consumer raising the price and supplier lowering the price are
+	// accepted immediately, when the duration is not changed.
+	if duration == deal.GetDuration() {
+		cmp := price.Cmp(deal.GetPrice().Unwrap())
+		if requestType == pb.OrderType_BID && cmp > 0 || requestType == pb.OrderType_ASK && cmp < 0 {
+			actual[own] = 0
+			m.updateChangeRequest(id, pb.ChangeRequestStatus_REQUEST_ACCEPTED)
+			return id, m.applyChangeRequest(sender, deal, price, deal.GetDuration())
+		}
+	}
+
+	matchingRequest, ok := m.changeRequest(actual[other])
+	if !ok || matchingRequest.GetStatus() != pb.ChangeRequestStatus_REQUEST_CREATED {
+		return id, nil
+	}
+
+	ask, bid := matchingRequest, request
+	if requestType == pb.OrderType_ASK {
+		ask, bid = request, matchingRequest
+	}
+
+	if ask.GetDuration() < bid.GetDuration() || ask.GetPrice().Cmp(bid.GetPrice()) > 0 {
+		return id, nil
+	}
+
+	actual[own], actual[other] = 0, 0
+	m.updateChangeRequest(id, pb.ChangeRequestStatus_REQUEST_ACCEPTED)
+	m.updateChangeRequest(matchingRequest.GetId().Unwrap().Uint64(), pb.ChangeRequestStatus_REQUEST_ACCEPTED)
+	return id, m.applyChangeRequest(sender, deal, ask.GetPrice().Unwrap(), bid.GetDuration())
+}
+
+func (m *SimulatedAPI) isOwner(key *ecdsa.PrivateKey) bool {
+	return crypto.PubkeyToAddress(key.PublicKey) == m.owner
+}
+
+type simulatedMarket struct {
+	chain *SimulatedAPI
+}
+
+func (m *simulatedMarket) QuickBuy(ctx context.Context, key *ecdsa.PrivateKey, askID *big.Int) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(MarketAddr(), func() error {
+		ask, ok := m.chain.order(askID)
+		if !ok {
+			return errors.Errorf("no order with id = %s", askID.String())
+		}
+		if ask.GetOrderType() != pb.OrderType_ASK {
+			return errors.New("order is not an ask")
+		}
+		if ask.GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE {
+			return errors.New("order is not active")
+		}
+		if !m.chain.checkProfileLevel(sender, ask.GetIdentityLevel()) {
+			return errors.New("identity level is too low")
+		}
+
+		master := m.chain.getMaster(ask.GetAuthorID().Unwrap())
+		if m.chain.isBlacklisted(sender, master) ||
+			m.chain.isBlacklisted(ask.GetAuthorID().Unwrap(), sender) ||
+			m.chain.isBlacklisted(common.HexToAddress(ask.GetBlacklist()), sender) {
+			return errors.New("one of the counterparties is blacklisted")
+		}
+
+		bid, err := m.chain.newOrder(sender, &pb.Order{
+			OrderType:      pb.OrderType_BID,
+			CounterpartyID: pb.NewEthAddress(master),
+			Duration:       ask.GetDuration(),
+			Price:          ask.GetPrice(),
+			Netflags:       ask.GetNetflags(),
+			IdentityLevel:  pb.IdentityLevel_ANONYMOUS,
+			Benchmarks:     ask.GetBenchmarks(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := m.chain.checkDeal(ask, bid); err != nil {
+			return err
+		}
+		if err := m.chain.storeOrder(bid); err != nil {
+			return err
+		}
+
+		m.chain.openDeal(ask, bid)
+		return nil
+	})
+}
+
+func (m *simulatedMarket) OpenDeal(ctx context.Context, key *ecdsa.PrivateKey, askID, bidID *big.Int) (*pb.Deal, error) {
+	var id *big.Int
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		ask, ok := m.chain.order(askID)
+		if !ok {
+			return errors.Errorf("no order with id = %s", askID.String())
+		}
+		bid, ok := m.chain.order(bidID)
+		if !ok {
+			return errors.Errorf("no order with id = %s", bidID.String())
+		}
+		if err := m.chain.checkDeal(ask, bid); err != nil {
+			return err
+		}
+
+		id = m.chain.openDeal(ask, bid).GetId().Unwrap()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m.GetDealInfo(ctx, id)
+}
+
+func (m *simulatedMarket) CloseDeal(ctx context.Context, key *ecdsa.PrivateKey, dealID *big.Int, blacklisted bool) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		deal, ok := m.chain.deal(dealID)
+		if !ok {
+			return errors.Errorf("no deal with id = %s", dealID.String())
+		}
+		if deal.GetStatus() != pb.DealStatus_DEAL_ACCEPTED {
+			return errDealNotAccepted
+		}
+		if !m.chain.isDealMember(deal, sender) {
+			return errNotDealMember
+		}
+
+		consumer := deal.GetConsumerID().Unwrap()
+		if m.chain.now.Unix() <= deal.GetStartTime().GetSeconds()+int64(deal.GetDuration()) && sender != consumer {
+			return errors.New("only consumer can close the deal before its end time")
+		}
+		if blacklisted && sender != consumer {
+			return errors.New("only consumer can blacklist the supplier")
+		}
+
+		if err := m.chain.bill(sender, deal); err != nil {
+			return err
+		}
+		m.chain.internalCloseDeal(deal, &DealClosing{ClosedBy: sender})
+		// The deal is closed by the "CloseDeal" call even if it has been
+		// closed while billing.
+		m.chain.closings[dealID.Uint64()] = &DealClosing{ClosedBy: sender}
+
+		blockedBalance := deal.GetBlockedBalance().Unwrap()
+		if blockedBalance.Sign() > 0 {
+			if err := m.chain.sideToken.transfer(MarketAddr(), consumer, blockedBalance); err != nil {
+				return err
+			}
+			deal.BlockedBalance = pb.NewBigIntFromInt(0)
+		}
+
+		// Blacklisting can't fail, so it is done after all fallible steps.
+		if blacklisted {
+			m.chain.addToBlacklist(consumer, deal.GetSupplierID().Unwrap())
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (m *simulatedMarket) GetDealInfo(ctx context.Context, dealID *big.Int) (*pb.Deal, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	deal, ok := m.chain.deal(dealID)
+	if !ok {
+		return nil, errors.Errorf("no deal with id = %s", dealID.String())
+	}
+
+	return proto.Clone(deal).(*pb.Deal), nil
+}
+
+func (m *simulatedMarket) GetDealClosing(ctx context.Context, dealID *big.Int) (*DealClosing, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	closing, ok := m.chain.closings[dealID.Uint64()]
+	if !ok {
+		return nil, errors.Errorf("deal %s is not closed", dealID.String())
+	}
+
+	return &DealClosing{ClosedBy: closing.ClosedBy, Billed: closing.Billed}, nil
+}
+
+func (m *simulatedMarket) GetDealsAmount(ctx context.Context) (*big.Int, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return big.NewInt(int64(len(m.chain.deals))), nil
+}
+
+func (m *simulatedMarket) PlaceOrder(ctx context.Context, key *ecdsa.PrivateKey, order *pb.Order) (*pb.Order, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	var id *big.Int
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		newOrder, err := m.chain.newOrder(sender, order)
+		if err != nil {
+			return err
+		}
+		if err := m.chain.storeOrder(newOrder); err != nil {
+			return err
+		}
+
+		id = newOrder.GetId().Unwrap()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m.GetOrderInfo(ctx, id)
+}
+
+func (m *simulatedMarket) CancelOrder(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		order, ok := m.chain.order(id)
+		if !ok {
+			return errors.Errorf("no order with id = %s", id.String())
+		}
+		if order.GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE {
+			return errors.New("order is not active")
+		}
+		if order.GetAuthorID().Unwrap() != sender {
+			return errors.New("sender is not the order author")
+		}
+		if err := m.chain.sideToken.transfer(MarketAddr(), sender, order.GetFrozenSum().Unwrap()); err != nil {
+			return err
+		}
+
+		order.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+		m.chain.emit(&OrderUpdatedData{ID: id})
+		return nil
+	})
+
+	return err
+}
+
+func (m *simulatedMarket) GetOrderInfo(ctx context.Context, orderID *big.Int) (*pb.Order, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	order, ok := m.chain.order(orderID)
+	if !ok {
+		return nil, errors.Errorf("no order with id = %s", orderID.String())
+	}
+
+	return proto.Clone(order).(*pb.Order), nil
+}
+
+func (m *simulatedMarket) GetOrdersAmount(ctx context.Context) (*big.Int, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return big.NewInt(int64(len(m.chain.orders))), nil
+}
+
+func (m *simulatedMarket) Bill(ctx context.Context, key *ecdsa.PrivateKey, dealID *big.Int) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		deal, ok := m.chain.deal(dealID)
+		if !ok {
+			return errors.Errorf("no deal with id = %s", dealID.String())
+		}
+		if deal.GetStatus() != pb.DealStatus_DEAL_ACCEPTED {
+			return errDealNotAccepted
+		}
+		if !m.chain.isDealMember(deal, sender) {
+			return errNotDealMember
+		}
+
+		return m.chain.bill(sender, deal)
+	})
+
+	return err
+}
+
+func (m *simulatedMarket) RegisterWorker(ctx context.Context, key *ecdsa.PrivateKey, master common.Address) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		if m.chain.getMaster(sender) != sender {
+			return errors.New("worker already has a master")
+		}
+		if m.chain.isMaster[sender] {
+			return errors.New("master can not be registered as a worker")
+		}
+		if m.chain.getMaster(master) != master {
+			return errors.New("worker can not be registered as a master")
+		}
+
+		if _, ok := m.chain.masterRequests[master]; !ok {
+			m.chain.masterRequests[master] = map[common.Address]bool{}
+		}
+		m.chain.masterRequests[master][sender] = true
+		m.chain.emit(&WorkerAnnouncedData{WorkerID: sender, MasterID: master})
+		return nil
+	})
+
+	return err
+}
+
+func (m *simulatedMarket) ConfirmWorker(ctx context.Context, key *ecdsa.PrivateKey, slave common.Address) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		if !m.chain.masterRequests[sender][slave] {
+			return errors.New("worker has not been announced")
+		}
+
+		m.chain.masterOf[slave] = sender
+		m.chain.isMaster[sender] = true
+		delete(m.chain.masterRequests[sender], slave)
+		m.chain.emit(&WorkerConfirmedData{WorkerID: slave, MasterID: sender})
+		return nil
+	})
+
+	return err
+}
+
+func (m *simulatedMarket) RemoveWorker(ctx context.Context, key *ecdsa.PrivateKey, master, slave common.Address) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		if m.chain.getMaster(slave) != master {
+			return errors.New("worker does not belong to the master")
+		}
+		if sender != slave && sender != master {
+			return errors.New("sender is neither the worker nor the master")
+		}
+
+		delete(m.chain.masterOf, slave)
+		m.chain.emit(&WorkerRemovedData{WorkerID: slave, MasterID: master})
+		return nil
+	})
+
+	return err
+}
+
+func (m *simulatedMarket) GetMaster(ctx context.Context, slave common.Address) (common.Address, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return m.chain.getMaster(slave), nil
+}
+
+func (m *simulatedMarket) GetDealChangeRequestInfo(ctx context.Context, id *big.Int) (*pb.DealChangeRequest, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	if !id.IsUint64() {
+		return nil, errors.Errorf("no change request with id = %s", id.String())
+	}
+
+	request, ok := m.chain.changeRequest(id.Uint64())
+	if !ok {
+		return nil, errors.Errorf("no change request with id = %s", id.String())
+	}
+
+	return proto.Clone(request).(*pb.DealChangeRequest), nil
+}
+
+func (m *simulatedMarket) GetDealChangeRequestsInfo(ctx context.Context, dealID *big.Int, fromBlock uint64) ([]*pb.DealChangeRequest, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	if _, ok := m.chain.deal(dealID); !ok {
+		return nil, errors.Errorf("no deal with id = %s", dealID.String())
+	}
+
+	var requests []*pb.DealChangeRequest
+	for _, event := range m.chain.events {
+		data, ok := event.Data.(*DealChangeRequestSentData)
+		if !ok || event.BlockNumber < fromBlock {
+			continue
+		}
+
+		request, _ := m.chain.changeRequest(data.ID.Uint64())
+		if request.GetDealID().Unwrap().Cmp(dealID) == 0 {
+			requests = append(requests, proto.Clone(request).(*pb.DealChangeRequest))
+		}
+	}
+
+	return requests, nil
+}
+
+func (m *simulatedMarket) CreateChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, request *pb.DealChangeRequest) (*big.Int, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	var id uint64
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		deal, ok := m.chain.deal(request.GetDealID().Unwrap())
+		if !ok {
+			return errors.Errorf("no deal with id = %s", request.GetDealID().Unwrap().String())
+		}
+
+		var err error
+		id, err = m.chain.createChangeRequest(sender, deal, request.GetPrice().Unwrap(), request.GetDuration())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).SetUint64(id), nil
+}
+
+func (m *simulatedMarket) CancelChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(MarketAddr(), func() error {
+		if !id.IsUint64() {
+			return errors.Errorf("no change request with id = %s", id.String())
+		}
+		request, ok := m.chain.changeRequest(id.Uint64())
+		if !ok {
+			return errors.Errorf("no change request with id = %s", id.String())
+		}
+		deal, ok := m.chain.deal(request.GetDealID().Unwrap())
+		if !ok || !m.chain.isDealMember(deal, sender) {
+			return errNotDealMember
+		}
+		if request.GetStatus() == pb.ChangeRequestStatus_REQUEST_ACCEPTED {
+			return errors.New("change request is already accepted")
+		}
+
+		// Cancelling an opponent's request means rejecting it.
+		isConsumer := sender == deal.GetConsumerID().Unwrap()
+		status := pb.ChangeRequestStatus_REQUEST_CANCELED
+		if isConsumer == (request.GetRequestType() == pb.OrderType_ASK) {
+			status = pb.ChangeRequestStatus_REQUEST_REJECTED
+		}
+
+		slot := 0
+		if request.GetRequestType() == pb.OrderType_BID {
+			slot = 1
+		}
+		if actual, ok := m.chain.actualRequests[request.GetDealID().Unwrap().Uint64()]; ok && actual[slot] == id.Uint64() {
+			actual[slot] = 0
+		}
+
+		m.chain.updateChangeRequest(id.Uint64(), status)
+		return nil
+	})
+
+	return err
+}
+
+func (m *simulatedMarket) GetNumBenchmarks(ctx context.Context) (uint64, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return m.chain.numBenchmarks, nil
+}
+
+type simulatedBlacklist struct {
+	chain *SimulatedAPI
+}
+
+func (m *simulatedBlacklist) Check(ctx context.Context, who, whom common.Address) (bool, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return m.chain.isBlacklisted(who, whom), nil
+}
+
+func (m *simulatedBlacklist) Add(ctx context.Context, key *ecdsa.PrivateKey, who, whom common.Address) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(BlacklistAddr(), func() error {
+		if m.chain.blacklistMarket == (common.Address{}) {
+			return errors.New("market address is not set")
+		}
+		if sender != m.chain.blacklistMarket && !m.chain.blacklistMasters[sender] {
+			return errors.New("sender is neither the market nor the blacklist master")
+		}
+
+		m.chain.addToBlacklist(who, whom)
+		return nil
+	})
+	return err
+}
+
+func (m *simulatedBlacklist) Remove(ctx context.Context, key *ecdsa.PrivateKey, whom common.Address) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(BlacklistAddr(), func() error {
+		if !m.chain.isBlacklisted(sender, whom) {
+			return errors.New("address is not blacklisted")
+		}
+
+		delete(m.chain.blacklisted[sender], whom)
+		m.chain.emit(&RemovedFromBlacklistData{RemoverID: sender, RemoveeID: whom})
+		return nil
+	})
+
+	return err
+}
+
+func (m *simulatedBlacklist) AddMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error) {
+	return m.chain.transact(BlacklistAddr(), func() error {
+		if !m.chain.isOwner(key) {
+			return errNotOwner
+		}
+		if m.chain.blacklistMasters[root] {
+			return errors.New("address is already a blacklist master")
+		}
+
+		m.chain.blacklistMasters[root] = true
+		return nil
+	})
+}
+
+func (m *simulatedBlacklist) RemoveMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error) {
+	return m.chain.transact(BlacklistAddr(), func() error {
+		if !m.chain.isOwner(key) {
+			return errNotOwner
+		}
+		if !m.chain.blacklistMasters[root] {
+			return errors.New("address is not a blacklist master")
+		}
+
+		delete(m.chain.blacklistMasters, root)
+		return nil
+	})
+}
+
+func (m *simulatedBlacklist) SetMarketAddress(ctx context.Context, key *ecdsa.PrivateKey, market common.Address) (*types.Transaction, error) {
+	return m.chain.transact(BlacklistAddr(), func() error {
+		if !m.chain.isOwner(key) {
+			return errNotOwner
+		}
+
+		m.chain.blacklistMarket = market
+		return nil
+	})
+}
+
+type simulatedCertificate struct {
+	from          common.Address
+	to            common.Address
+	attributeType uint64
+	value         []byte
+}
+
+type simulatedProfileRegistry struct {
+	chain *SimulatedAPI
+}
+
+func (m *simulatedProfileRegistry) AddValidator(ctx context.Context, key *ecdsa.PrivateKey, validator common.Address, level int8) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(ProfileRegistryAddr(), func() error {
+		if m.chain.validators[sender] != -1 {
+			return errNotOwner
+		}
+		if level <= 0 {
+			return errors.New("validator level must be positive")
+		}
+		if m.chain.validators[validator] != 0 {
+			return errors.New("validator already exists")
+		}
+
+		m.chain.validators[validator] = level
+		m.chain.emit(&ValidatorCreatedData{ID: validator})
+		return nil
+	})
+}
+
+func (m *simulatedProfileRegistry) RemoveValidator(ctx context.Context, key *ecdsa.PrivateKey, validator common.Address) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(ProfileRegistryAddr(), func() error {
+		if m.chain.validators[sender] != -1 {
+			return errNotOwner
+		}
+		if m.chain.validators[validator] <= 0 {
+			return errors.New("validator does not exist")
+		}
+
+		delete(m.chain.validators, validator)
+		m.chain.emit(&ValidatorDeletedData{ID: validator})
+		return nil
+	})
+}
+
+func (m *simulatedProfileRegistry) GetValidator(ctx context.Context, validatorID common.Address) (*pb.Validator, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return &pb.Validator{
+		Id:    pb.NewEthAddress(validatorID),
+		Level: uint64(m.chain.validators[validatorID]),
+	}, nil
+}
+
+func (m *simulatedProfileRegistry) CreateCertificate(ctx context.Context, key *ecdsa.PrivateKey, owner common.Address, attributeType *big.Int, value []byte) (*big.Int, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	var id *big.Int
+	_, err := m.chain.transact(ProfileRegistryAddr(), func() error {
+		if !attributeType.IsUint64() {
+			return errors.New("invalid attribute type")
+		}
+
+		ty := attributeType.Uint64()
+		if ty >= 1100 {
+			if int8(ty/100%10) > m.chain.validators[sender] {
+				return errors.New("validator level is too low for the attribute")
+			}
+		} else if owner != sender {
+			return errors.New("only owner can create self-signed certificates")
+		}
+
+		if len(value) == 0 {
+			return errors.New("certificate value is empty")
+		}
+
+		isMultiple := ty/1000 == 2
+		if !isMultiple && m.chain.attributeCounts[owner][ty] != 0 {
+			if !bytes.Equal(m.chain.attributeValue(owner, ty), value) {
+				return errors.New("attribute value differs from the existing one")
+			}
+		}
+
+		if _, ok := m.chain.attributeCounts[owner]; !ok {
+			m.chain.attributeCounts[owner] = map[uint64]uint64{}
+			m.chain.attributeValues[owner] = map[uint64][]byte{}
+		}
+		if !isMultiple && m.chain.attributeCounts[owner][ty] == 0 {
+			m.chain.attributeValues[owner][ty] = value
+		}
+		m.chain.attributeCounts[owner][ty]++
+
+		m.chain.certificates = append(m.chain.certificates, &simulatedCertificate{
+			from:          sender,
+			to:            owner,
+			attributeType: ty,
+			value:         value,
+		})
+		id = big.NewInt(int64(len(m.chain.certificates)))
+		m.chain.emit(&CertificateCreatedData{ID: id})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+func (m *simulatedProfileRegistry) RemoveCertificate(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, err := m.chain.transact(ProfileRegistryAddr(), func() error {
+		certificate := m.chain.certificate(id)
+		if certificate == nil || len(certificate.value) == 0 {
+			return errors.Errorf("no certificate with id = %s", id.String())
+		}
+		if certificate.to != sender && certificate.from != sender && m.chain.validators[sender] != -1 {
+			return errors.New("sender is not allowed to remove the certificate")
+		}
+
+		m.chain.attributeCounts[certificate.to][certificate.attributeType]--
+		if m.chain.attributeCounts[certificate.to][certificate.attributeType] == 0 {
+			delete(m.chain.attributeValues[certificate.to], certificate.attributeType)
+		}
+		certificate.value = nil
+		return nil
+	})
+	return err
+}
+
+func (m *simulatedProfileRegistry) GetCertificate(ctx context.Context, certificateID *big.Int) (*pb.Certificate, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	certificate := m.chain.certificate(certificateID)
+	if certificate == nil {
+		certificate = &simulatedCertificate{}
+	}
+
+	return &pb.Certificate{
+		ValidatorID: pb.NewEthAddress(certificate.from),
+		OwnerID:     pb.NewEthAddress(certificate.to),
+		Attribute:   certificate.attributeType,
+		Value:       certificate.value,
+	}, nil
+}
+
+func (m *simulatedProfileRegistry) GetAttributeCount(ctx context.Context, owner common.Address, attributeType *big.Int) (*big.Int, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return big.NewInt(0).SetUint64(m.chain.attributeCounts[owner][attributeType.Uint64()]), nil
+}
+
+func (m *simulatedProfileRegistry) GetAttributeValue(ctx context.Context, owner common.Address, attributeType *big.Int) ([]byte, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return m.chain.attributeValue(owner, attributeType.Uint64()), nil
+}
+
+type simulatedTokenState struct {
+	address     common.Address
+	totalSupply *big.Int
+	balances    map[common.Address]*big.Int
+	allowances  map[common.Address]map[common.Address]*big.Int
+}
+
+func newSimulatedTokenState(address common.Address) *simulatedTokenState {
+	return &simulatedTokenState{
+		address:     address,
+		totalSupply: big.NewInt(0),
+		balances:    map[common.Address]*big.Int{},
+		allowances:  map[common.Address]map[common.Address]*big.Int{},
+	}
+}
+
+func (m *simulatedTokenState) balanceOf(addr common.Address) *big.Int {
+	if balance, ok := m.balances[addr]; ok {
+		return new(big.Int).Set(balance)
+	}
+
+	return big.NewInt(0)
+}
+
+func (m *simulatedTokenState) allowanceOf(from, to common.Address) *big.Int {
+	if allowance, ok := m.allowances[from][to]; ok {
+		return new(big.Int).Set(allowance)
+	}
+
+	return big.NewInt(0)
+}
+
+func (m *simulatedTokenState) mint(to common.Address, amount *big.Int) {
+	m.totalSupply.Add(m.totalSupply, amount)
+	m.balances[to] = new(big.Int).Add(m.balanceOf(to), amount)
+}
+
+func (m *simulatedTokenState) approve(from, to common.Address, amount *big.Int) {
+	if _, ok := m.allowances[from]; !ok {
+		m.allowances[from] = map[common.Address]*big.Int{}
+	}
+
+	m.allowances[from][to] = new(big.Int).Set(amount)
+}
+
+func (m *simulatedTokenState) transfer(from, to common.Address, amount *big.Int) error {
+	if amount.Sign() < 0 {
+		return errors.New("negative amount")
+	}
+
+	balance := m.balanceOf(from)
+	if balance.Cmp(amount) < 0 {
+		return errors.Errorf("insufficient balance: %s < %s", balance.String(), amount.String())
+	}
+
+	m.balances[from] = balance.Sub(balance, amount)
+	m.balances[to] = new(big.Int).Add(m.balanceOf(to), amount)
+	return nil
+}
+
+func (m *simulatedTokenState) transferFrom(spender, from, to common.Address, amount *big.Int) error {
+	allowance := m.allowanceOf(from, spender)
+	if allowance.Cmp(amount) < 0 {
+		return errors.Errorf("insufficient allowance: %s < %s", allowance.String(), amount.String())
+	}
+
+	if err := m.transfer(from, to, amount); err != nil {
+		return err
+	}
+
+	m.approve(from, spender, allowance.Sub(allowance, amount))
+	return nil
+}
+
+type simulatedToken struct {
+	chain *SimulatedAPI
+	state *simulatedTokenState
+}
+
+func (m *simulatedToken) Approve(ctx context.Context, key *ecdsa.PrivateKey, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(m.state.address, func() error {
+		m.state.approve(sender, to, amount)
+		return nil
+	})
+}
+
+func (m *simulatedToken) Transfer(ctx context.Context, key *ecdsa.PrivateKey, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(m.state.address, func() error {
+		return m.state.transfer(sender, to, amount)
+	})
+}
+
+func (m *simulatedToken) TransferFrom(ctx context.Context, key *ecdsa.PrivateKey, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(m.state.address, func() error {
+		return m.state.transferFrom(sender, from, to, amount)
+	})
+}
+
+func (m *simulatedToken) BalanceOf(ctx context.Context, address common.Address) (*big.Int, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return m.state.balanceOf(address), nil
+}
+
+func (m *simulatedToken) AllowanceOf(ctx context.Context, from, to common.Address) (*big.Int, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return m.state.allowanceOf(from, to), nil
+}
+
+func (m *simulatedToken) TotalSupply(ctx context.Context) (*big.Int, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return new(big.Int).Set(m.state.totalSupply), nil
+}
+
+func (m *simulatedToken) WaitTransaction(ctx context.Context, tx *types.Transaction) (*Receipt, error) {
+	return m.chain.waitTransaction(tx)
+}
+
+type simulatedTestToken struct {
+	chain *SimulatedAPI
+}
+
+func (m *simulatedTestToken) GetTokens(ctx context.Context, key *ecdsa.PrivateKey) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(SNMAddr(), func() error {
+		amount, _ := new(big.Int).SetString(testTokenAmount, 10)
+		m.chain.liveToken.mint(sender, amount)
+		return nil
+	})
+}
+
+type simulatedOracle struct {
+	chain *SimulatedAPI
+}
+
+func (m *simulatedOracle) SetCurrentPrice(ctx context.Context, key *ecdsa.PrivateKey, price *big.Int) (*types.Transaction, error) {
+	return m.chain.transact(OracleUsdAddr(), func() error {
+		if !m.chain.isOwner(key) {
+			return errNotOwner
+		}
+		if price.Sign() <= 0 {
+			return errors.New("price must be positive")
+		}
+
+		m.chain.currentPrice = new(big.Int).Set(price)
+		return nil
+	})
+}
+
+func (m *simulatedOracle) GetCurrentPrice(ctx context.Context) (*big.Int, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return new(big.Int).Set(m.chain.currentPrice), nil
+}
+
+type simulatedGatekeeper struct {
+	chain             *SimulatedAPI
+	address           common.Address
+	token             *simulatedTokenState
+	transactionAmount uint64
+	paid              map[string]bool
+	killed            bool
+}
+
+func newSimulatedGatekeeper(chain *SimulatedAPI, address common.Address, token *simulatedTokenState) *simulatedGatekeeper {
+	return &simulatedGatekeeper{
+		chain:   chain,
+		address: address,
+		token:   token,
+		paid:    map[string]bool{},
+	}
+}
+
+func (m *simulatedGatekeeper) PayIn(ctx context.Context, key *ecdsa.PrivateKey, value *big.Int) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	return m.chain.transact(m.address, func() error {
+		if m.killed {
+			return errGatekeeperKilled
+		}
+		if err := m.token.transferFrom(m.address, sender, m.address, value); err != nil {
+			return err
+		}
+
+		m.transactionAmount++
+		return nil
+	})
+}
+
+func (m *simulatedGatekeeper) Payout(ctx context.Context, key *ecdsa.PrivateKey, to common.Address, value *big.Int, txNumber *big.Int) (*types.Transaction, error) {
+	return m.chain.transact(m.address, func() error {
+		if m.killed {
+			return errGatekeeperKilled
+		}
+		if !m.chain.isOwner(key) {
+			return errNotOwner
+		}
+
+		txHash := crypto.Keccak256Hash(to.Bytes(), common.BigToHash(txNumber).Bytes(), common.BigToHash(value).Bytes()).Hex()
+		if m.paid[txHash] {
+			return errors.New("payout has already been paid")
+		}
+		if err := m.token.transfer(m.address, to, value); err != nil {
+			return err
+		}
+
+		m.paid[txHash] = true
+		return nil
+	})
+}
+
+func (m *simulatedGatekeeper) Kill(ctx context.Context, key *ecdsa.PrivateKey) (*types.Transaction, error) {
+	return m.chain.transact(m.address, func() error {
+		if m.killed {
+			return errGatekeeperKilled
+		}
+		if !m.chain.isOwner(key) {
+			return errNotOwner
+		}
+		if err := m.token.transfer(m.address, m.chain.owner, m.token.balanceOf(m.address)); err != nil {
+			return err
+		}
+
+		m.killed = true
+		return nil
+	})
+}
+
+func (m *simulatedGatekeeper) WaitTransaction(ctx context.Context, tx *types.Transaction) (*Receipt, error) {
+	return m.chain.waitTransaction(tx)
+}
+
+type simulatedEvents struct {
+	chain *SimulatedAPI
+}
+
+func (m *simulatedEvents) GetLastBlock(ctx context.Context) (uint64, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	return m.chain.blockNumber, nil
+}
+
+func (m *simulatedEvents) GetEventsRange(ctx context.Context, fromBlock, toBlock uint64) ([]*Event, error) {
+	m.chain.mu.Lock()
+	defer m.chain.mu.Unlock()
+
+	var events []*Event
+	for _, event := range m.chain.events {
+		if event.BlockNumber >= fromBlock && event.BlockNumber <= toBlock {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// GetEvents streams all events mined after the given block, including those
+// that are mined after the call, until the context is canceled. The
+// simulated chain is never reorganized, so known blocks are ignored.
+func (m *simulatedEvents) GetEvents(ctx context.Context, fromBlockInitial *big.Int, knownBlocks map[uint64]common.Hash) (chan *Event, error) {
+	out := make(chan *Event, 128)
+
+	go func() {
+		var (
+			fromBlock = fromBlockInitial.Uint64()
+			cursor    = 0
+		)
+
+		for {
+			m.chain.mu.Lock()
+			events := m.chain.events[cursor:]
+			cursor = len(m.chain.events)
+			notify := m.chain.notify
+			m.chain.mu.Unlock()
+
+			for _, event := range events {
+				if event.BlockNumber <= fromBlock {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case out <- event:
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-notify:
+			}
+		}
+	}()
+
+	return out, nil
+}
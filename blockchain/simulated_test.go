@@ -0,0 +1,328 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type simulatedTestEnv struct {
+	api      *SimulatedAPI
+	owner    *ecdsa.PrivateKey
+	supplier *ecdsa.PrivateKey
+	consumer *ecdsa.PrivateKey
+}
+
+func newSimulatedTestEnv(t *testing.T) *simulatedTestEnv {
+	owner, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	supplier, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	consumer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	api := NewSimulatedAPI(crypto.PubkeyToAddress(owner.PublicKey))
+
+	// Make payments equal to "price * seconds" for the sake of simplicity.
+	_, err = api.OracleUSD().SetCurrentPrice(context.Background(), owner, big.NewInt(1e18))
+	require.NoError(t, err)
+
+	consumerAddr := crypto.PubkeyToAddress(consumer.PublicKey)
+	api.MintSideTokens(consumerAddr, big.NewInt(1e6))
+	_, err = api.SideToken().Approve(context.Background(), consumer, MarketAddr(), big.NewInt(1e6))
+	require.NoError(t, err)
+
+	return &simulatedTestEnv{
+		api:      api,
+		owner:    owner,
+		supplier: supplier,
+		consumer: consumer,
+	}
+}
+
+func (m *simulatedTestEnv) openDeal(t *testing.T, duration uint64, price int64) *pb.Deal {
+	ctx := context.Background()
+
+	ask, err := m.api.Market().PlaceOrder(ctx, m.supplier, &pb.Order{
+		OrderType:  pb.OrderType_ASK,
+		Duration:   duration,
+		Price:      pb.NewBigIntFromInt(price),
+		Netflags:   7,
+		Benchmarks: &pb.Benchmarks{Values: []uint64{100, 100, 4, 1e9, 1e9, 1e6, 1e6, 0, 0, 0, 0, 0}},
+	})
+	require.NoError(t, err)
+
+	bid, err := m.api.Market().PlaceOrder(ctx, m.consumer, &pb.Order{
+		OrderType:  pb.OrderType_BID,
+		Duration:   duration,
+		Price:      pb.NewBigIntFromInt(price),
+		Netflags:   1,
+		Benchmarks: &pb.Benchmarks{Values: []uint64{50, 50, 2, 1e8}},
+	})
+	require.NoError(t, err)
+
+	deal, err := m.api.Market().OpenDeal(ctx, m.consumer, ask.GetId().Unwrap(), bid.GetId().Unwrap())
+	require.NoError(t, err)
+
+	return deal
+}
+
+func TestSimulatedForwardDealLifecycle(t *testing.T) {
+	ctx := context.Background()
+	env := newSimulatedTestEnv(t)
+	supplierAddr := crypto.PubkeyToAddress(env.supplier.PublicKey)
+	consumerAddr := crypto.PubkeyToAddress(env.consumer.PublicKey)
+
+	deal := env.openDeal(t, 3600, 10)
+	assert.Equal(t, pb.DealStatus_DEAL_ACCEPTED, deal.GetStatus())
+	assert.Equal(t, supplierAddr, deal.GetMasterID().Unwrap())
+	assert.Equal(t, big.NewInt(36000), deal.GetBlockedBalance().Unwrap())
+
+	balance, err := env.api.SideToken().BalanceOf(ctx, consumerAddr)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e6-36000), balance)
+
+	// Supplier is not allowed to close the deal before its end.
+	require.Error(t, env.api.Market().CloseDeal(ctx, env.supplier, deal.GetId().Unwrap(), false))
+
+	env.api.AdvanceTime(600 * time.Second)
+	require.NoError(t, env.api.Market().Bill(ctx, env.supplier, deal.GetId().Unwrap()))
+
+	balance, err = env.api.SideToken().BalanceOf(ctx, supplierAddr)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(6000), balance)
+
+	env.api.AdvanceTime(time.Hour)
+	require.NoError(t, env.api.Market().CloseDeal(ctx, env.supplier, deal.GetId().Unwrap(), false))
+
+	deal, err = env.api.Market().GetDealInfo(ctx, deal.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, pb.DealStatus_DEAL_CLOSED, deal.GetStatus())
+	assert.Equal(t, big.NewInt(36000), deal.GetTotalPayout().Unwrap())
+	assert.True(t, deal.GetBlockedBalance().IsZero())
+
+	balance, err = env.api.SideToken().BalanceOf(ctx, supplierAddr)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(36000), balance)
+
+	balance, err = env.api.SideToken().BalanceOf(ctx, consumerAddr)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e6-36000), balance)
+}
+
+func TestSimulatedEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	env := newSimulatedTestEnv(t)
+	deal := env.openDeal(t, 3600, 10)
+
+	events, err := env.api.Events().GetEvents(ctx, big.NewInt(0), nil)
+	require.NoError(t, err)
+
+	expected := []interface{}{
+		&OrderPlacedData{ID: big.NewInt(1)},
+		&OrderPlacedData{ID: big.NewInt(2)},
+		&OrderUpdatedData{ID: big.NewInt(1)},
+		&OrderUpdatedData{ID: big.NewInt(2)},
+		&DealOpenedData{ID: deal.GetId().Unwrap()},
+		&BilledData{DealID: deal.GetId().Unwrap(), PaidAmount: big.NewInt(36000)},
+		&DealUpdatedData{ID: deal.GetId().Unwrap()},
+	}
+
+	// Events mined after the subscription must be delivered too.
+	env.api.AdvanceTime(2 * time.Hour)
+	require.NoError(t, env.api.Market().CloseDeal(ctx, env.consumer, deal.GetId().Unwrap(), false))
+
+	lastBlock, err := env.api.Events().GetLastBlock(ctx)
+	require.NoError(t, err)
+
+	for _, data := range expected {
+		select {
+		case event := <-events:
+			assert.Equal(t, data, event.Data)
+			assert.True(t, event.BlockNumber <= lastBlock)
+		case <-time.After(time.Second):
+			t.Fatalf("event %T has not been received", data)
+		}
+	}
+}
+
+func TestSimulatedChangeRequests(t *testing.T) {
+	ctx := context.Background()
+	env := newSimulatedTestEnv(t)
+	deal := env.openDeal(t, 3600, 10)
+
+	// Supplier lowering the price is accepted immediately.
+	id, err := env.api.Market().CreateChangeRequest(ctx, env.supplier, &pb.DealChangeRequest{
+		DealID:   deal.GetId(),
+		Duration: 3600,
+		Price:    pb.NewBigIntFromInt(8),
+	})
+	require.NoError(t, err)
+
+	request, err := env.api.Market().GetDealChangeRequestInfo(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, pb.ChangeRequestStatus_REQUEST_ACCEPTED, request.GetStatus())
+	assert.Equal(t, pb.OrderType_ASK, request.GetRequestType())
+
+	// Extending the deal requires both sides to agree.
+	askID, err := env.api.Market().CreateChangeRequest(ctx, env.supplier, &pb.DealChangeRequest{
+		DealID:   deal.GetId(),
+		Duration: 7200,
+		Price:    pb.NewBigIntFromInt(9),
+	})
+	require.NoError(t, err)
+
+	bidID, err := env.api.Market().CreateChangeRequest(ctx, env.consumer, &pb.DealChangeRequest{
+		DealID:   deal.GetId(),
+		Duration: 7200,
+		Price:    pb.NewBigIntFromInt(9),
+	})
+	require.NoError(t, err)
+
+	for _, id := range []*big.Int{askID, bidID} {
+		request, err := env.api.Market().GetDealChangeRequestInfo(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, pb.ChangeRequestStatus_REQUEST_ACCEPTED, request.GetStatus())
+	}
+
+	deal, err = env.api.Market().GetDealInfo(ctx, deal.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7200), deal.GetDuration())
+	assert.Equal(t, big.NewInt(9), deal.GetPrice().Unwrap())
+	assert.Equal(t, deal.GetStartTime().GetSeconds()+7200, deal.GetEndTime().GetSeconds())
+
+	// Consumer rejects the supplier's request.
+	id, err = env.api.Market().CreateChangeRequest(ctx, env.supplier, &pb.DealChangeRequest{
+		DealID:   deal.GetId(),
+		Duration: 7200,
+		Price:    pb.NewBigIntFromInt(12),
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.api.Market().CancelChangeRequest(ctx, env.consumer, id))
+
+	request, err = env.api.Market().GetDealChangeRequestInfo(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, pb.ChangeRequestStatus_REQUEST_REJECTED, request.GetStatus())
+
+	requests, err := env.api.Market().GetDealChangeRequestsInfo(ctx, deal.GetId().Unwrap(), 0)
+	require.NoError(t, err)
+	require.Len(t, requests, 4)
+	assert.Equal(t, id, requests[3].GetId().Unwrap())
+
+	// The last request has been sent in the block before the cancellation.
+	lastBlock, err := env.api.Events().GetLastBlock(ctx)
+	require.NoError(t, err)
+	requests, err = env.api.Market().GetDealChangeRequestsInfo(ctx, deal.GetId().Unwrap(), lastBlock-1)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, id, requests[0].GetId().Unwrap())
+}
+
+func TestSimulatedFailedTransactionKeepsState(t *testing.T) {
+	ctx := context.Background()
+	env := newSimulatedTestEnv(t)
+	deal := env.openDeal(t, 3600, 10)
+
+	lastBlock, err := env.api.Events().GetLastBlock(ctx)
+	require.NoError(t, err)
+
+	// Break the market's accounting, so that billing the deal fails.
+	env.api.sideToken.balances[MarketAddr()] = big.NewInt(0)
+	env.api.AdvanceTime(600 * time.Second)
+
+	_, err = env.api.Market().CreateChangeRequest(ctx, env.supplier, &pb.DealChangeRequest{
+		DealID:   deal.GetId(),
+		Duration: 3600,
+		Price:    pb.NewBigIntFromInt(8),
+	})
+	require.Error(t, err)
+	require.Error(t, env.api.Market().Bill(ctx, env.supplier, deal.GetId().Unwrap()))
+	require.Error(t, env.api.Market().CloseDeal(ctx, env.consumer, deal.GetId().Unwrap(), true))
+
+	blacklisted, err := env.api.Blacklist().Check(ctx, crypto.PubkeyToAddress(env.consumer.PublicKey), deal.GetSupplierID().Unwrap())
+	require.NoError(t, err)
+	assert.False(t, blacklisted)
+
+	requests, err := env.api.Market().GetDealChangeRequestsInfo(ctx, deal.GetId().Unwrap(), 0)
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+
+	actual, err := env.api.Market().GetDealInfo(ctx, deal.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, deal, actual)
+
+	block, err := env.api.Events().GetLastBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, lastBlock, block)
+}
+
+func TestSimulatedBlacklistOnClose(t *testing.T) {
+	ctx := context.Background()
+	env := newSimulatedTestEnv(t)
+	supplierAddr := crypto.PubkeyToAddress(env.supplier.PublicKey)
+	consumerAddr := crypto.PubkeyToAddress(env.consumer.PublicKey)
+
+	deal := env.openDeal(t, 0, 10)
+	assert.True(t, deal.IsSpot())
+	require.NoError(t, env.api.Market().CloseDeal(ctx, env.consumer, deal.GetId().Unwrap(), true))
+
+	blacklisted, err := env.api.Blacklist().Check(ctx, consumerAddr, supplierAddr)
+	require.NoError(t, err)
+	assert.True(t, blacklisted)
+
+	ask, err := env.api.Market().PlaceOrder(ctx, env.supplier, &pb.Order{
+		OrderType: pb.OrderType_ASK,
+		Price:     pb.NewBigIntFromInt(10),
+	})
+	require.NoError(t, err)
+
+	_, err = env.api.Market().QuickBuy(ctx, env.consumer, ask.GetId().Unwrap())
+	require.Error(t, err)
+
+	require.NoError(t, env.api.Blacklist().Remove(ctx, env.consumer, supplierAddr))
+	_, err = env.api.Market().QuickBuy(ctx, env.consumer, ask.GetId().Unwrap())
+	require.NoError(t, err)
+
+	ask, err = env.api.Market().GetOrderInfo(ctx, ask.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, pb.OrderStatus_ORDER_INACTIVE, ask.GetOrderStatus())
+	assert.Equal(t, big.NewInt(2), ask.GetDealID().Unwrap())
+
+	err = env.api.Blacklist().Add(ctx, env.supplier, supplierAddr, common.Address{})
+	assert.Error(t, err)
+}
+
+func TestSimulatedDealClosing(t *testing.T) {
+	ctx := context.Background()
+	env := newSimulatedTestEnv(t)
+	supplierAddr := crypto.PubkeyToAddress(env.supplier.PublicKey)
+	consumerAddr := crypto.PubkeyToAddress(env.consumer.PublicKey)
+
+	deal := env.openDeal(t, 0, 10)
+	_, err := env.api.Market().GetDealClosing(ctx, deal.GetId().Unwrap())
+	require.Error(t, err)
+
+	require.NoError(t, env.api.Market().CloseDeal(ctx, env.consumer, deal.GetId().Unwrap(), false))
+	closing, err := env.api.Market().GetDealClosing(ctx, deal.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, &DealClosing{ClosedBy: consumerAddr}, closing)
+
+	// The deal is closed while billing when the consumer runs out of funds.
+	deal = env.openDeal(t, 0, 10)
+	env.api.AdvanceTime(100000 * time.Second)
+	require.NoError(t, env.api.Market().Bill(ctx, env.supplier, deal.GetId().Unwrap()))
+
+	closing, err = env.api.Market().GetDealClosing(ctx, deal.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, &DealClosing{ClosedBy: supplierAddr, Billed: true}, closing)
+}
@@ -1,6 +1,12 @@
 package blockchain
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// closeDealMethodID is the selector of the market's "CloseDeal" method.
+var closeDealMethodID = crypto.Keccak256([]byte("CloseDeal(uint256,bool)"))[:4]
 
 // Market topics
 var (
@@ -10,6 +10,23 @@ type Event struct {
 	Data        interface{}
 	BlockNumber uint64
 	TS          uint64
+	// TxHash is the hash of the transaction, that emitted the event.
+	TxHash common.Hash
+	// LogIndex is the index of the event's log in the block. Along with the
+	// block number it identifies the event.
+	LogIndex uint64
+	// BlockHash is the hash of the event's block, zero if unknown.
+	BlockHash common.Hash
+}
+
+// DealClosing describes the transaction, that has closed a deal.
+type DealClosing struct {
+	// ClosedBy is the address the closing transaction has been sent from.
+	ClosedBy common.Address
+	// Billed is true if the deal has been closed by the market while
+	// billing, i.e. not by the explicit "CloseDeal" call. This happens when
+	// the consumer is unable to pay for the deal.
+	Billed bool
 }
 
 type DealOpenedData struct {
@@ -82,3 +99,10 @@ type ValidatorDeletedData struct {
 type CertificateCreatedData struct {
 	ID *big.Int
 }
+
+// RevertData is sent when the chain is reorganized deeper than the
+// confirmation depth. Events received after the given block are no longer
+// valid and are sent again according to the new chain.
+type RevertData struct {
+	BlockNumber uint64
+}
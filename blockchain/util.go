@@ -116,6 +116,19 @@ func WaitTransactionReceipt(ctx context.Context, client CustomEthereumClient, co
 	}
 }
 
+func waitTransaction(ctx context.Context, client CustomEthereumClient, opts *chainOpts, tx *types.Transaction) (*Receipt, error) {
+	receipt, err := WaitTransactionReceipt(ctx, client, opts.blockConfirmations, opts.logParsePeriod, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status == types.ReceiptStatusFailed {
+		return nil, fmt.Errorf("transaction %s has failed", tx.Hash().Hex())
+	}
+
+	return receipt, nil
+}
+
 func getTxOpts(ctx context.Context, key *ecdsa.PrivateKey, gasLimit uint64, gasPrice int64) *bind.TransactOpts {
 	opts := bind.NewKeyedTransactor(key)
 	opts.Context = ctx
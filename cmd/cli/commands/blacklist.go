@@ -12,6 +12,7 @@ import (
 func init() {
 	blacklistRootCmd.AddCommand(
 		blacklistListCmd,
+		blacklistAddCmd,
 		blacklistRemoveCmd,
 	)
 }
@@ -55,6 +56,41 @@ var blacklistListCmd = &cobra.Command{
 	},
 }
 
+var blacklistAddCmd = &cobra.Command{
+	Use:   "add <addr>",
+	Short: "Add given address to your blacklist",
+	Long: `Add given address to your blacklist.
+
+Only blacklist masters are allowed to add addresses directly. Otherwise close
+a deal with the "--blacklist" flag to blacklist its supplier.`,
+	PreRun: loadKeyStoreIfRequired,
+	Args:   cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		black, err := newBlacklistClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		addr, err := util.HexToAddress(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		_, err = black.Add(ctx, sonm.NewEthAddress(addr))
+		if err != nil {
+			showError(cmd, "Cannot add address to blacklist", err)
+			os.Exit(1)
+		}
+
+		showOk(cmd)
+	},
+}
+
 var blacklistRemoveCmd = &cobra.Command{
 	Use:    "remove <addr>",
 	Short:  "Remove given address from your blacklist",
@@ -20,6 +20,8 @@ const (
 	// log flag names
 	logTypeFlag       = "type"
 	sinceFlag         = "since"
+	untilFlag         = "until"
+	grepFlag          = "grep"
 	addTimestampsFlag = "ts"
 	followFlag        = "follow"
 	tailFlag          = "tail"
@@ -41,6 +43,8 @@ var (
 	// logging flag vars
 	logType       string
 	since         string
+	until         string
+	grep          string
 	addTimestamps bool
 	follow        bool
 	tail          string
@@ -84,7 +88,7 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&insecureFlag, "insecure", false, "Disable TLS for connection")
 	rootCmd.PersistentFlags().StringVar(&keystoreFlag, "keystore", "", "Keystore dir")
 
-	rootCmd.AddCommand(workerMgmtCmd, orderRootCmd, dealRootCmd, taskRootCmd, blacklistRootCmd)
+	rootCmd.AddCommand(workerMgmtCmd, orderRootCmd, dealRootCmd, taskRootCmd, blacklistRootCmd, profileRootCmd)
 	rootCmd.AddCommand(loginCmd, tokenRootCmd, versionCmd, autoCompleteCmd, masterRootCmd)
 }
 
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/sonm-io/core/cmd/cli/task_config"
 	pb "github.com/sonm-io/core/proto"
@@ -10,16 +11,23 @@ import (
 
 var (
 	ordersSearchLimit uint64 = 0
+	orderTaskFile     string
+	orderTaskDeadline time.Duration
+	orderKeepAlive    bool
 )
 
 func init() {
 	orderListCmd.PersistentFlags().Uint64Var(&ordersSearchLimit, "limit", 10, "Orders count to show")
+	orderCreateCmd.Flags().StringVar(&orderTaskFile, "task", "", "Task definition to start automatically when the deal is opened")
+	orderCreateCmd.Flags().DurationVar(&orderTaskDeadline, "task-deadline", 0, "Close the deal if the task can not be started in this time after opening (0 means never)")
+	orderCreateCmd.Flags().BoolVar(&orderKeepAlive, "keep-alive", false, "Migrate the task to a new deal with the same BID when the deal is lost")
 
 	orderRootCmd.AddCommand(
 		orderListCmd,
 		orderStatusCmd,
 		orderCreateCmd,
 		orderCancelCmd,
+		bidPlansRootCmd,
 	)
 }
 
@@ -102,12 +110,40 @@ var orderCreateCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		var spec *pb.TaskSpec
+		if len(orderTaskFile) > 0 {
+			spec, err = task_config.LoadConfig(orderTaskFile)
+			if err != nil {
+				showError(cmd, "Cannot load task definition", err)
+				os.Exit(1)
+			}
+		}
+
 		created, err := market.CreateOrder(ctx, bid)
 		if err != nil {
 			showError(cmd, "Cannot create order on marketplace", err)
 			os.Exit(1)
 		}
 
+		if spec != nil {
+			tasks, err := newTaskClient(ctx)
+			if err != nil {
+				showError(cmd, "Cannot create client connection", err)
+				os.Exit(1)
+			}
+
+			_, err = tasks.Deploy(ctx, &pb.TaskDeploymentRequest{
+				OrderID:   created.GetId(),
+				Spec:      spec,
+				Deadline:  &pb.Duration{Nanoseconds: orderTaskDeadline.Nanoseconds()},
+				KeepAlive: orderKeepAlive,
+			})
+			if err != nil {
+				showError(cmd, "Order is created, but cannot attach task to it", err)
+				os.Exit(1)
+			}
+		}
+
 		printID(cmd, created.GetId().Unwrap().String())
 	},
 }
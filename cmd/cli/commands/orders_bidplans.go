@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"os"
+
+	pb "github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	bidPlansRootCmd.AddCommand(
+		bidPlanListCmd,
+		bidPlanCancelCmd,
+		bidPlanReplaceCmd,
+	)
+}
+
+var bidPlansRootCmd = &cobra.Command{
+	Use:   "bid-plan",
+	Short: "Operations with bid plans being matched by the Node",
+}
+
+var bidPlanListCmd = &cobra.Command{
+	Use:    "list",
+	Short:  "Show bid plans with their matching state",
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		market, err := newMarketClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		plans, err := market.BidPlans(ctx, &pb.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot get bid plans", err)
+			os.Exit(1)
+		}
+
+		printBidPlanList(cmd, plans)
+	},
+}
+
+var bidPlanCancelCmd = &cobra.Command{
+	Use:    "cancel <bid_plan_id>",
+	Short:  "Stop matching bid plan and cancel its order",
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		market, err := newMarketClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		if _, err := market.CancelBidPlan(ctx, &pb.ID{Id: args[0]}); err != nil {
+			showError(cmd, "Cannot cancel bid plan", err)
+			os.Exit(1)
+		}
+
+		showOk(cmd)
+	},
+}
+
+var bidPlanReplaceCmd = &cobra.Command{
+	Use:     "replace <bid_plan_id> <price>",
+	Short:   "Re-place bid plan's order with the new price",
+	Example: "  sonmcli order bid-plan replace 3b3d2c6e-7a0f-4a2a-8d1c-8d5c2b0e9a4f 0.5USD/h",
+	Args:    cobra.MinimumNArgs(2),
+	PreRun:  loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		market, err := newMarketClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		price := &pb.Price{}
+		if err := price.LoadFromString(args[1]); err != nil {
+			showError(cmd, "Cannot parse price", err)
+			os.Exit(1)
+		}
+
+		plan, err := market.ReplaceBidPlan(ctx, &pb.ReplaceBidPlanRequest{ID: args[0], Price: price})
+		if err != nil {
+			showError(cmd, "Cannot replace bid plan", err)
+			os.Exit(1)
+		}
+
+		printID(cmd, plan.GetOrderID().Unwrap().String())
+	},
+}
@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,11 +20,29 @@ func printTaskStatus(cmd *cobra.Command, id string, taskStatus *pb.TaskStatusRep
 		cmd.Printf("  Image:  %s\r\n", taskStatus.GetImageName())
 		cmd.Printf("  Status: %s\r\n", taskStatus.GetStatus().String())
 		cmd.Printf("  Uptime: %s\r\n", time.Duration(taskStatus.GetUptime()).String())
+		if taskStatus.GetHealth() != pb.TaskStatusReply_NO_HEALTHCHECK {
+			cmd.Printf("  Health: %s\r\n", taskStatus.GetHealth().String())
+		}
 
 		if taskStatus.GetUsage() != nil {
+			cpu := taskStatus.GetUsage().GetCpu()
+			mem := taskStatus.GetUsage().GetMemory()
+			blockIO := taskStatus.GetUsage().GetBlockIO()
+
 			cmd.Println("  Resources:")
-			cmd.Printf("    CPU: %d\r\n", taskStatus.Usage.GetCpu().GetTotal())
-			cmd.Printf("    MEM: %s\r\n", datasize.NewByteSize(taskStatus.Usage.GetMemory().GetMaxUsage()).HumanReadable())
+			cmd.Printf("    CPU: %d\r\n", cpu.GetTotal())
+			for i, usage := range cpu.GetPerCore() {
+				cmd.Printf("      core %d: %d\r\n", i, usage)
+			}
+			cmd.Printf("      Kernel/User: %d/%d\r\n", cpu.GetKernelMode(), cpu.GetUserMode())
+			cmd.Printf("      Throttled: %d of %d periods, %s\r\n", cpu.GetThrottledPeriods(), cpu.GetPeriods(), time.Duration(cpu.GetThrottledTime()).String())
+			cmd.Printf("    MEM: %s (max %s, limit %s)\r\n",
+				datasize.NewByteSize(mem.GetUsage()).HumanReadable(),
+				datasize.NewByteSize(mem.GetMaxUsage()).HumanReadable(),
+				datasize.NewByteSize(mem.GetLimit()).HumanReadable())
+			cmd.Printf("    Block IO:\r\n")
+			cmd.Printf("      Read/Write bytes: %d/%d\r\n", blockIO.GetReadBytes(), blockIO.GetWriteBytes())
+			cmd.Printf("      Read/Write ops: %d/%d\r\n", blockIO.GetReadOps(), blockIO.GetWriteOps())
 			if taskStatus.GetUsage().GetNetwork() != nil {
 				cmd.Printf("    NET:\r\n")
 				for i, net := range taskStatus.GetUsage().GetNetwork() {
@@ -33,6 +53,28 @@ func printTaskStatus(cmd *cobra.Command, id string, taskStatus *pb.TaskStatusRep
 					cmd.Printf("        Tx/Rx dropped: %d/%d\r\n", net.TxDropped, net.RxDropped)
 				}
 			}
+			if len(taskStatus.GetUsage().GetGpu()) > 0 {
+				cmd.Printf("    GPU:\r\n")
+				for id, gpu := range taskStatus.GetUsage().GetGpu() {
+					cmd.Printf("      %s:\r\n", id)
+					cmd.Printf("        Utilization: %d%%\r\n", gpu.GetUtilization())
+					cmd.Printf("        Memory: %s/%s\r\n",
+						datasize.NewByteSize(gpu.GetMemoryUsed()).HumanReadable(),
+						datasize.NewByteSize(gpu.GetMemoryTotal()).HumanReadable())
+				}
+			}
+		}
+
+		if taskStatus.GetAllocatedResources() != nil {
+			cmd.Println("  Allocated resources:")
+			out, err := yaml.Marshal(taskStatus.GetAllocatedResources())
+			if err != nil {
+				cmd.Printf("    %s\r\n", err)
+			} else {
+				for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+					cmd.Printf("    %s\r\n", line)
+				}
+			}
 		}
 
 		if len(taskStatus.GetPortMap()) > 0 {
@@ -50,11 +92,16 @@ func printTaskStatus(cmd *cobra.Command, id string, taskStatus *pb.TaskStatusRep
 			"image":  taskStatus.GetImageName(),
 			"ports":  taskStatus.GetPortMap(),
 			"uptime": fmt.Sprintf("%d", time.Duration(taskStatus.GetUptime())),
+			"health": taskStatus.GetHealth().String(),
 		}
 		if taskStatus.GetUsage() != nil {
 			v["cpu"] = fmt.Sprintf("%d", taskStatus.GetUsage().GetCpu().GetTotal())
 			v["mem"] = fmt.Sprintf("%d", taskStatus.GetUsage().GetMemory().GetMaxUsage())
 			v["net"] = taskStatus.GetUsage().GetNetwork()
+			v["usage"] = taskStatus.GetUsage()
+		}
+		if taskStatus.GetAllocatedResources() != nil {
+			v["allocated"] = taskStatus.GetAllocatedResources()
 		}
 
 		showJSON(cmd, v)
@@ -93,6 +140,32 @@ func printWorkerStatus(cmd *cobra.Command, stat *pb.StatusReply) {
 	}
 }
 
+func printMaintenanceStatus(cmd *cobra.Command, status *pb.MaintenanceStatusReply) {
+	if isSimpleFormat() {
+		if !status.GetEnabled() {
+			cmd.Printf("Maintenance is not started\r\n")
+			return
+		}
+
+		cmd.Printf("Since:              %s\r\n", status.GetSince().Unix().Format(time.RFC3339))
+		cmd.Printf("Spot grace period:  %s\r\n", status.GetSpotGracePeriod().Unwrap().String())
+		if status.GetDrained() {
+			cmd.Printf("Status:             drained\r\n")
+			return
+		}
+
+		cmd.Printf("Status:             draining\r\n")
+		for _, id := range status.GetOrders() {
+			cmd.Printf("  order %s is waiting for cancellation\r\n", id.Unwrap().String())
+		}
+		for _, id := range status.GetDeals() {
+			cmd.Printf("  deal %s is waiting for finish\r\n", id.Unwrap().String())
+		}
+	} else {
+		showJSON(cmd, status)
+	}
+}
+
 func printBenchmarkGroup(cmd *cobra.Command, benchmarks map[uint64]*pb.Benchmark) {
 	cmd.Println("  Benchmarks:")
 	for _, bn := range benchmarks {
@@ -223,6 +296,82 @@ func printAskList(cmd *cobra.Command, slots *pb.AskPlansReply) {
 	}
 }
 
+func printBidPlanList(cmd *cobra.Command, reply *pb.BidPlansReply) {
+	if isSimpleFormat() {
+		plans := reply.GetBidPlans()
+		if len(plans) == 0 {
+			cmd.Printf("No bid plans found\r\n")
+			return
+		}
+
+		ids := make([]string, 0, len(plans))
+		for id := range plans {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			plan := plans[id]
+			cmd.Printf("ID:         %s\r\n", id)
+			cmd.Printf("  Status:   %s\r\n", plan.GetStatus().String())
+			if !plan.GetOrderID().IsZero() {
+				cmd.Printf("  Order ID: %s\r\n", plan.GetOrderID().Unwrap().String())
+			}
+			if !plan.GetDealID().IsZero() {
+				cmd.Printf("  Deal ID:  %s\r\n", plan.GetDealID().Unwrap().String())
+			}
+			cmd.Printf("  Price:    %s USD/sec\r\n", plan.GetBid().GetPrice().GetPerSecond().ToPriceString())
+			if len(plan.GetLastError()) > 0 {
+				cmd.Printf("  Error:    %s\r\n", plan.GetLastError())
+			}
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
+func printTaskDeployments(cmd *cobra.Command, reply *pb.TaskDeploymentsReply) {
+	if isSimpleFormat() {
+		deployments := reply.GetDeployments()
+		if len(deployments) == 0 {
+			cmd.Printf("No task deployments found\r\n")
+			return
+		}
+
+		ids := make([]string, 0, len(deployments))
+		for id := range deployments {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			deployment := deployments[id]
+			cmd.Printf("Bid plan:   %s\r\n", id)
+			cmd.Printf("  Status:   %s\r\n", deployment.GetStatus().String())
+			if !deployment.GetDealID().IsZero() {
+				cmd.Printf("  Deal ID:  %s\r\n", deployment.GetDealID().Unwrap().String())
+			}
+			if len(deployment.GetTaskID()) > 0 {
+				cmd.Printf("  Task ID:  %s\r\n", deployment.GetTaskID())
+			}
+			if deployment.GetAttempts() > 0 {
+				cmd.Printf("  Attempts: %d\r\n", deployment.GetAttempts())
+			}
+			if deployment.GetKeepAlive() {
+				cmd.Printf("  Keep alive, migrated %d times\r\n", deployment.GetMigrations())
+			}
+			if len(deployment.GetRestoredImage()) > 0 {
+				cmd.Printf("  Restored: %s\r\n", deployment.GetRestoredImage())
+			}
+			if len(deployment.GetLastError()) > 0 {
+				cmd.Printf("  Error:    %s\r\n", deployment.GetLastError())
+			}
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
 func printVersion(cmd *cobra.Command, v string) {
 	if isSimpleFormat() {
 		cmd.Printf("sonmcli %s (%s)\r\n", v, util.GetPlatformName())
@@ -346,6 +495,24 @@ func printID(cmd *cobra.Command, id string) {
 	}
 }
 
+func printTaskEvent(cmd *cobra.Command, event *pb.TaskEvent) {
+	if isSimpleFormat() {
+		cmd.Printf("%s  %s  %s", event.GetTimestamp().Unix().Format(time.RFC3339), event.GetId(), event.GetStatus().String())
+		if event.GetRestarted() {
+			cmd.Printf(" (restarted)")
+		}
+		if event.GetOOMKilled() {
+			cmd.Printf(" (OOM killed)")
+		}
+		if event.GetStatus() == pb.TaskStatusReply_BROKEN || event.GetRestarted() {
+			cmd.Printf(" exit code: %d", event.GetExitCode())
+		}
+		cmd.Printf("\r\n")
+	} else {
+		showJSON(cmd, event)
+	}
+}
+
 func printTaskStart(cmd *cobra.Command, start *pb.StartTaskReply) {
 	if isSimpleFormat() {
 		cmd.Printf("Task ID:    %s\r\n", start.Id)
@@ -379,6 +546,28 @@ func printBalanceInfo(cmd *cobra.Command, reply *pb.BalanceReply) {
 	}
 }
 
+func printTokenTransaction(cmd *cobra.Command, tx *pb.TokenTransaction) {
+	if isSimpleFormat() {
+		if len(tx.GetHash()) == 0 {
+			cmd.Println("Nothing to do")
+			return
+		}
+
+		cmd.Printf("Transaction: %s\n", tx.GetHash())
+		cmd.Printf("Block:       %d\n", tx.GetBlockNumber())
+	} else {
+		showJSON(cmd, tx)
+	}
+}
+
+func printMarketAllowance(cmd *cobra.Command, allowance *pb.BigInt) {
+	if isSimpleFormat() {
+		cmd.Printf("Market allowance: %s SNM\n", allowance.ToPriceString())
+	} else {
+		showJSON(cmd, map[string]string{"allowance": allowance.ToPriceString()})
+	}
+}
+
 func printBlacklist(cmd *cobra.Command, list *pb.BlacklistReply) {
 	if isSimpleFormat() {
 		if len(list.GetAddresses()) == 0 {
@@ -395,6 +584,61 @@ func printBlacklist(cmd *cobra.Command, list *pb.BlacklistReply) {
 	}
 }
 
+func printProfile(cmd *cobra.Command, reply *pb.ProfileReply) {
+	if isSimpleFormat() {
+		profile := reply.GetProfile()
+		cmd.Printf("Address:        %s\n", profile.GetUserID().Unwrap().Hex())
+		cmd.Printf("Identity level: %s\n", pb.IdentityLevel(profile.GetIdentityLevel()))
+		if profile.GetName() != "" {
+			cmd.Printf("Name:           %s\n", profile.GetName())
+		}
+		if profile.GetCountry() != "" {
+			cmd.Printf("Country:        %s\n", profile.GetCountry())
+		}
+		cmd.Printf("Active asks:    %d\n", profile.GetActiveAsks())
+		cmd.Printf("Active bids:    %d\n", profile.GetActiveBids())
+
+		if len(reply.GetCertificates()) == 0 {
+			cmd.Println("No certificates")
+			return
+		}
+
+		cmd.Println("Certificates:")
+		for _, certificate := range reply.GetCertificates() {
+			cmd.Printf("  %s = %s (validator %s)\n", certificateAttributeName(certificate.GetAttribute()),
+				string(certificate.GetValue()), certificate.GetValidatorID().Unwrap().Hex())
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
+func printProfilesList(cmd *cobra.Command, list *pb.ProfilesReply) {
+	if isSimpleFormat() {
+		if len(list.GetProfiles()) == 0 {
+			cmd.Println("No profiles found")
+			return
+		}
+
+		for _, profile := range list.GetProfiles() {
+			cmd.Printf("%s  %-12s  %-7s  %s\n", profile.GetUserID().Unwrap().Hex(),
+				pb.IdentityLevel(profile.GetIdentityLevel()), profile.GetCountry(), profile.GetName())
+		}
+	} else {
+		showJSON(cmd, list)
+	}
+}
+
+func certificateAttributeName(attribute uint64) string {
+	for name, code := range certificateAttributes {
+		if code == attribute {
+			return name
+		}
+	}
+
+	return strconv.FormatUint(attribute, 10)
+}
+
 func printWorkersList(cmd *cobra.Command, list *pb.WorkerListReply) {
 	if isSimpleFormat() {
 		if len(list.GetWorkers()) == 0 {
@@ -0,0 +1,230 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	profilesSearchRole     string
+	profilesSearchIdentity string
+	profilesSearchCountry  []string
+	profilesSearchName     string
+	profilesSearchLimit    uint64
+	profilesSearchOffset   uint64
+)
+
+// certificateAttributes maps human-readable names of the well-known
+// certificate attributes to their codes.
+var certificateAttributes = map[string]uint64{
+	"name":    1102,
+	"country": 1303,
+}
+
+func init() {
+	profileListCmd.Flags().StringVar(&profilesSearchRole, "role", "any", "Profile role: any, supplier or consumer")
+	profileListCmd.Flags().StringVar(&profilesSearchIdentity, "identity", "anonymous", "Minimal identity level: anonymous, pseudonymous or identified")
+	profileListCmd.Flags().StringSliceVar(&profilesSearchCountry, "country", nil, "Show only profiles from the given countries")
+	profileListCmd.Flags().StringVar(&profilesSearchName, "name", "", "Show only profiles with the name matching the given one")
+	profileListCmd.Flags().Uint64Var(&profilesSearchLimit, "limit", 10, "Profiles count to show")
+	profileListCmd.Flags().Uint64Var(&profilesSearchOffset, "offset", 0, "Profiles count to skip")
+
+	profileRootCmd.AddCommand(
+		profileShowCmd,
+		profileListCmd,
+		profileIssueCmd,
+		profileRevokeCmd,
+	)
+}
+
+var profileRootCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage profiles and certificates",
+}
+
+var profileShowCmd = &cobra.Command{
+	Use:    "show [addr]",
+	Short:  "Show profile with its certificates, your own by default",
+	PreRun: loadKeyStoreIfRequired,
+	Args:   cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		profiles, err := newProfilesClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		var addr *sonm.EthAddress
+		if len(args) > 0 {
+			ownerAddr, err := util.HexToAddress(args[0])
+			if err != nil {
+				showError(cmd, err.Error(), nil)
+				os.Exit(1)
+			}
+			addr = sonm.NewEthAddress(ownerAddr)
+		}
+
+		profile, err := profiles.Profile(ctx, addr)
+		if err != nil {
+			showError(cmd, "Cannot get profile", err)
+			os.Exit(1)
+		}
+
+		printProfile(cmd, profile)
+	},
+}
+
+var profileListCmd = &cobra.Command{
+	Use:    "list",
+	Short:  "Search profiles",
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		profiles, err := newProfilesClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		role, err := parseProfileRole(profilesSearchRole)
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		identity, ok := sonm.IdentityLevel_value[strings.ToUpper(profilesSearchIdentity)]
+		if !ok {
+			showError(cmd, fmt.Sprintf("Unknown identity level \"%s\"", profilesSearchIdentity), nil)
+			os.Exit(1)
+		}
+
+		list, err := profiles.List(ctx, &sonm.ProfilesRequest{
+			Role:          role,
+			IdentityLevel: sonm.IdentityLevel(identity),
+			Country:       profilesSearchCountry,
+			Name:          profilesSearchName,
+			Limit:         profilesSearchLimit,
+			Offset:        profilesSearchOffset,
+		})
+		if err != nil {
+			showError(cmd, "Cannot get profiles", err)
+			os.Exit(1)
+		}
+
+		printProfilesList(cmd, list)
+	},
+}
+
+var profileIssueCmd = &cobra.Command{
+	Use:   "issue <addr> <attribute> <value>",
+	Short: "Issue certificate for the given address",
+	Long: `Issue certificate for the given address.
+
+Attribute is either a numeric code or one of the well-known names: "name" or
+"country". Attributes below 1100 are self-signed and can be issued only for
+your own address, others require your key to be a validator of the
+attribute's level.`,
+	Args:   cobra.ExactArgs(3),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		profiles, err := newProfilesClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		owner, err := util.HexToAddress(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		attribute, err := parseCertificateAttribute(args[1])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		id, err := profiles.CreateCertificate(ctx, &sonm.CertificateRequest{
+			OwnerID:   sonm.NewEthAddress(owner),
+			Attribute: attribute,
+			Value:     []byte(args[2]),
+		})
+		if err != nil {
+			showError(cmd, "Cannot issue certificate", err)
+			os.Exit(1)
+		}
+
+		printID(cmd, id.Unwrap().String())
+	},
+}
+
+var profileRevokeCmd = &cobra.Command{
+	Use:    "revoke <cert_id>",
+	Short:  "Revoke certificate",
+	Args:   cobra.ExactArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		profiles, err := newProfilesClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		id, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, "Cannot parse certificate ID", err)
+			os.Exit(1)
+		}
+
+		if _, err := profiles.RemoveCertificate(ctx, sonm.NewBigInt(id)); err != nil {
+			showError(cmd, "Cannot revoke certificate", err)
+			os.Exit(1)
+		}
+
+		showOk(cmd)
+	},
+}
+
+func parseProfileRole(role string) (sonm.ProfileRole, error) {
+	switch strings.ToLower(role) {
+	case "any":
+		return sonm.ProfileRole_AnyRole, nil
+	case "supplier":
+		return sonm.ProfileRole_Supplier, nil
+	case "consumer":
+		return sonm.ProfileRole_Consumer, nil
+	default:
+		return sonm.ProfileRole_AnyRole, fmt.Errorf("unknown profile role \"%s\"", role)
+	}
+}
+
+func parseCertificateAttribute(attribute string) (uint64, error) {
+	if code, ok := certificateAttributes[strings.ToLower(attribute)]; ok {
+		return code, nil
+	}
+
+	code, err := strconv.ParseUint(attribute, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unknown certificate attribute \"%s\"", attribute)
+	}
+
+	return code, nil
+}
@@ -25,6 +25,8 @@ import (
 func init() {
 	taskLogsCmd.Flags().StringVar(&logType, logTypeFlag, "both", "\"stdout\" or \"stderr\" or \"both\"")
 	taskLogsCmd.Flags().StringVar(&since, sinceFlag, "", "Show logs since timestamp (e.g. 2013-01-02T13:23:37) or relative (e.g. 42m for 42 minutes)")
+	taskLogsCmd.Flags().StringVar(&until, untilFlag, "", "Show logs before timestamp (e.g. 2013-01-02T13:23:37) or relative (e.g. 42m for 42 minutes)")
+	taskLogsCmd.Flags().StringVar(&grep, grepFlag, "", "Show only log lines matching the regular expression")
 	taskLogsCmd.Flags().BoolVar(&addTimestamps, addTimestampsFlag, true, "Show timestamp for each log line")
 	taskLogsCmd.Flags().BoolVar(&follow, followFlag, false, "Stream logs continuously")
 	taskLogsCmd.Flags().StringVar(&tail, tailFlag, "50", "Number of lines to show from the end of the logs")
@@ -38,10 +40,12 @@ func init() {
 		taskStartCmd,
 		taskStatusCmd,
 		taskLogsCmd,
+		taskEventsCmd,
 		taskStopCmd,
 		taskPullCmd,
 		taskPushCmd,
 		taskJoinNetworkCmd,
+		taskDeploymentsCmd,
 	)
 }
 
@@ -316,6 +320,8 @@ var taskLogsCmd = &cobra.Command{
 			Id:            args[1],
 			DealID:        pb.NewBigInt(dealID),
 			Since:         since,
+			Until:         until,
+			Grep:          grep,
 			AddTimestamps: addTimestamps,
 			Follow:        follow,
 			Tail:          tail,
@@ -343,6 +349,48 @@ var taskLogsCmd = &cobra.Command{
 	},
 }
 
+var taskEventsCmd = &cobra.Command{
+	Use:    "events <deal_id>",
+	Short:  "Stream lifecycle events of tasks within the deal",
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot connect to Node", err)
+			os.Exit(1)
+		}
+
+		dealID, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		events, err := node.Events(ctx, &pb.TaskEventsRequest{DealID: pb.NewBigInt(dealID)})
+		if err != nil {
+			showError(cmd, "Cannot subscribe to task events", err)
+			os.Exit(1)
+		}
+
+		for {
+			event, err := events.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				showError(cmd, "Failed to receive task event", err)
+				os.Exit(1)
+			}
+
+			printTaskEvent(cmd, event)
+		}
+	},
+}
+
 var taskStopCmd = &cobra.Command{
 	Use:    "stop <deal_id> <task_id>",
 	Short:  "Stop task",
@@ -595,3 +643,27 @@ var taskPushCmd = &cobra.Command{
 		}
 	},
 }
+
+var taskDeploymentsCmd = &cobra.Command{
+	Use:    "deployments",
+	Short:  "Show tasks to be started automatically when deals are opened",
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot connect to Node", err)
+			os.Exit(1)
+		}
+
+		reply, err := node.Deployments(ctx, &pb.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot get task deployments", err)
+			os.Exit(1)
+		}
+
+		printTaskDeployments(cmd, reply)
+	},
+}
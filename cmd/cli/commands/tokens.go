@@ -4,13 +4,23 @@ import (
 	"os"
 
 	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
 	"github.com/spf13/cobra"
 )
 
+var tokenTransferSidechain bool
+
 func init() {
+	tokenTransferCmd.Flags().BoolVar(&tokenTransferSidechain, "sidechain", false, "Transfer tokens on SONM sidechain instead of Ethereum")
+
 	tokenRootCmd.AddCommand(
 		// tokenGetCmd,
 		tokenBalanceCmd,
+		tokenTransferCmd,
+		tokenAllowanceCmd,
+		tokenApproveCmd,
+		tokenDepositCmd,
+		tokenWithdrawCmd,
 	)
 }
 
@@ -65,3 +75,161 @@ var tokenBalanceCmd = &cobra.Command{
 		printBalanceInfo(cmd, balance)
 	},
 }
+
+var tokenTransferCmd = &cobra.Command{
+	Use:    "transfer <addr> <amount>",
+	Short:  "Transfer SNM to the given address and wait for confirmation",
+	Args:   cobra.ExactArgs(2),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		to, err := util.HexToAddress(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		amount, err := util.StringToEtherPrice(args[1])
+		if err != nil {
+			showError(cmd, "Cannot parse amount", err)
+			os.Exit(1)
+		}
+
+		tx, err := token.Transfer(ctx, &sonm.TokenTransferRequest{
+			To:        sonm.NewEthAddress(to),
+			Amount:    sonm.NewBigInt(amount),
+			Sidechain: tokenTransferSidechain,
+		})
+		if err != nil {
+			showError(cmd, "Cannot transfer tokens", err)
+			os.Exit(1)
+		}
+
+		printTokenTransaction(cmd, tx)
+	},
+}
+
+var tokenAllowanceCmd = &cobra.Command{
+	Use:    "allowance",
+	Short:  "Show the amount of SNM the marketplace is allowed to spend",
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		allowance, err := token.MarketAllowance(ctx, &sonm.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot get market allowance", err)
+			os.Exit(1)
+		}
+
+		printMarketAllowance(cmd, allowance)
+	},
+}
+
+var tokenApproveCmd = &cobra.Command{
+	Use:    "approve <amount>",
+	Short:  "Allow the marketplace to spend the given amount of SNM",
+	Args:   cobra.ExactArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		amount, err := util.StringToEtherPrice(args[0])
+		if err != nil {
+			showError(cmd, "Cannot parse amount", err)
+			os.Exit(1)
+		}
+
+		tx, err := token.ApproveMarket(ctx, sonm.NewBigInt(amount))
+		if err != nil {
+			showError(cmd, "Cannot approve market allowance", err)
+			os.Exit(1)
+		}
+
+		printTokenTransaction(cmd, tx)
+	},
+}
+
+var tokenDepositCmd = &cobra.Command{
+	Use:    "deposit <amount>",
+	Short:  "Move SNM from Ethereum to SONM sidechain",
+	Args:   cobra.ExactArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		amount, err := util.StringToEtherPrice(args[0])
+		if err != nil {
+			showError(cmd, "Cannot parse amount", err)
+			os.Exit(1)
+		}
+
+		tx, err := token.Deposit(ctx, sonm.NewBigInt(amount))
+		if err != nil {
+			showError(cmd, "Cannot deposit tokens", err)
+			os.Exit(1)
+		}
+
+		printTokenTransaction(cmd, tx)
+	},
+}
+
+var tokenWithdrawCmd = &cobra.Command{
+	Use:    "withdraw <amount>",
+	Short:  "Move SNM from SONM sidechain to Ethereum",
+	Args:   cobra.ExactArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		amount, err := util.StringToEtherPrice(args[0])
+		if err != nil {
+			showError(cmd, "Cannot parse amount", err)
+			os.Exit(1)
+		}
+
+		tx, err := token.Withdraw(ctx, sonm.NewBigInt(amount))
+		if err != nil {
+			showError(cmd, "Cannot withdraw tokens", err)
+			os.Exit(1)
+		}
+
+		printTokenTransaction(cmd, tx)
+	},
+}
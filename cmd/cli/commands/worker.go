@@ -49,6 +49,7 @@ func init() {
 	workerMgmtCmd.AddCommand(
 		workerStatusCmd,
 		askPlansRootCmd,
+		maintenanceRootCmd,
 		workerTasksCmd,
 		workerDevicesCmd,
 		workerFreeDevicesCmd,
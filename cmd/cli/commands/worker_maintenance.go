@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"os"
+	"time"
+
+	pb "github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+)
+
+var maintenanceSpotGracePeriod time.Duration
+
+func init() {
+	maintenanceStartCmd.Flags().DurationVar(&maintenanceSpotGracePeriod, "spot-grace", time.Hour, "Time spot deals are allowed to run before closing")
+
+	maintenanceRootCmd.AddCommand(
+		maintenanceStartCmd,
+		maintenanceStatusCmd,
+		maintenanceStopCmd,
+	)
+}
+
+var maintenanceRootCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Take worker out of service keeping its ask plans",
+}
+
+var maintenanceStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start draining worker",
+	Long: `Start draining worker.
+
+No new orders are placed for ask plans and unmatched orders are cancelled.
+Forward deals are allowed to reach their end, while spot deals are closed
+after the grace period. Use "maintenance status" to watch the progress.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		request := &pb.MaintenanceRequest{
+			SpotGracePeriod: &pb.Duration{Nanoseconds: int64(maintenanceSpotGracePeriod)},
+		}
+		if _, err := worker.StartMaintenance(workerCtx, request); err != nil {
+			showError(cmd, "Cannot start maintenance", err)
+			os.Exit(1)
+		}
+
+		showOk(cmd)
+	},
+}
+
+var maintenanceStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show drain progress",
+	Run: func(cmd *cobra.Command, _ []string) {
+		status, err := worker.MaintenanceStatus(workerCtx, &pb.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot get maintenance status", err)
+			os.Exit(1)
+		}
+
+		printMaintenanceStatus(cmd, status)
+	},
+}
+
+var maintenanceStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop maintenance and resume placing orders for ask plans",
+	Run: func(cmd *cobra.Command, _ []string) {
+		if _, err := worker.StopMaintenance(workerCtx, &pb.Empty{}); err != nil {
+			showError(cmd, "Cannot stop maintenance", err)
+			os.Exit(1)
+		}
+
+		showOk(cmd)
+	},
+}
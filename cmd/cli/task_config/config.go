@@ -1,6 +1,7 @@
 package task_config
 
 import (
+	"github.com/anmitsu/go-shlex"
 	"github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util/config"
 )
@@ -9,7 +10,7 @@ func LoadConfig(path string) (*sonm.TaskSpec, error) {
 	// Manual renaming from snake_case to lowercase fields here to be able to
 	// load them directly in the protobuf.
 	cfg := &sonm.TaskSpec{}
-	if err := config.LoadWith(cfg, path, config.SnakeToLower); err != nil {
+	if err := config.LoadWith(cfg, path, normalizeTaskConfig); err != nil {
 		return nil, err
 	}
 
@@ -19,3 +20,29 @@ func LoadConfig(path string) (*sonm.TaskSpec, error) {
 
 	return cfg, nil
 }
+
+func normalizeTaskConfig(m map[interface{}]interface{}) {
+	config.SnakeToLower(m)
+
+	container, ok := m["container"].(map[interface{}]interface{})
+	if !ok {
+		return
+	}
+
+	// Allow to specify command and entrypoint as a single string, which is
+	// split into arguments the same way as shell does.
+	for _, key := range []string{"command", "entrypoint"} {
+		value, ok := container[key].(string)
+		if !ok {
+			continue
+		}
+
+		args, err := shlex.Split(value, true)
+		if err != nil {
+			// Leave as is, making the following unmarshalling fail.
+			continue
+		}
+
+		container[key] = args
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/sonm-io/core/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -89,6 +91,97 @@ container:
 	require.NotNil(t, cfg)
 }
 
+func TestTaskCommand(t *testing.T) {
+	createTestConfigFile(`
+container:
+  image: user/image:v1
+  command: /myapp -param=1 "quoted arg"
+  entrypoint:
+    - /bin/sh
+    - -c
+  working_dir: /opt/app
+  user: nobody:nogroup
+  hostname: worker-1
+  labels:
+    com.example.role: backend
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, []string{"/myapp", "-param=1", "quoted arg"}, cfg.Container.Command)
+	assert.Equal(t, []string{"/bin/sh", "-c"}, cfg.Container.Entrypoint)
+	assert.Equal(t, "/opt/app", cfg.Container.WorkingDir)
+	assert.Equal(t, "nobody:nogroup", cfg.Container.User)
+	assert.Equal(t, "worker-1", cfg.Container.Hostname)
+	assert.Equal(t, map[string]string{"com.example.role": "backend"}, cfg.Container.Labels)
+}
+
+func TestTaskContainerInvalid(t *testing.T) {
+	cases := map[string]string{
+		"absolute path":              "working_dir: opt/app",
+		"user must be in":            "user: \"no body\"",
+		"invalid container hostname": "hostname: -worker",
+		"reserved":                   "labels:\n    sonm.dealid: 42",
+	}
+
+	for expected, body := range cases {
+		createTestConfigFile("container:\n  image: user/image:v1\n  " + body + "\n")
+
+		cfg, err := LoadConfig(testCfgPath)
+		assert.Nil(t, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), expected)
+	}
+	deleteTestConfigFile()
+}
+
+func TestTaskHealthcheck(t *testing.T) {
+	createTestConfigFile(`
+container:
+  image: user/image:v1
+  healthcheck:
+    http_port: 8080
+    http_path: /health
+    interval: 10s
+    timeout: 2s
+    retries: 5
+    on_unhealthy: restart
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	healthcheck := cfg.Container.Healthcheck
+	require.NotNil(t, healthcheck)
+	assert.Equal(t, uint32(8080), healthcheck.HttpPort)
+	assert.Equal(t, "/health", healthcheck.HttpPath)
+	assert.Equal(t, 10*time.Second, healthcheck.Interval.Unwrap())
+	assert.Equal(t, 2*time.Second, healthcheck.Timeout.Unwrap())
+	assert.Equal(t, uint32(5), healthcheck.Retries)
+	assert.Equal(t, sonm.ContainerHealthcheck_RESTART, healthcheck.OnUnhealthy)
+}
+
+func TestTaskHealthcheckInvalid(t *testing.T) {
+	createTestConfigFile(`
+container:
+  image: user/image:v1
+  healthcheck:
+    command: ["/bin/check"]
+    tcp_port: 8080
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	assert.Nil(t, cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exactly one healthcheck probe")
+}
+
 func TestTaskMinimal(t *testing.T) {
 	createTestConfigFile(`
 container:
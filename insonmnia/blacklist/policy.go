@@ -0,0 +1,160 @@
+// Package blacklist implements the policy of automatic blacklisting of
+// suppliers that misbehave repeatedly.
+//
+// The policy is only applicable to the consumer side, i.e. the Node. The
+// market allows only the consumer to blacklist the supplier and only while
+// closing the deal, while adding to the blacklist directly is restricted to
+// the market itself and blacklist masters granted by the contract owner.
+package blacklist
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+)
+
+// Event is a supplier misbehavior reported to the policy.
+type Event int
+
+const (
+	// TaskFailed means that a task running on the deal has failed.
+	TaskFailed Event = iota
+)
+
+func (m Event) String() string {
+	switch m {
+	case TaskFailed:
+		return "task failed"
+	default:
+		return fmt.Sprintf("unknown event %d", int(m))
+	}
+}
+
+// Config configures automatic blacklisting. Thresholds set to zero disable
+// blacklisting on the corresponding events.
+type Config struct {
+	Enabled      bool          `yaml:"enabled"`
+	TaskFailures uint64        `yaml:"task_failures" default:"3"`
+	Period       time.Duration `yaml:"period" default:"24h"`
+}
+
+func (m *Config) threshold(event Event) uint64 {
+	switch event {
+	case TaskFailed:
+		return m.TaskFailures
+	default:
+		return 0
+	}
+}
+
+type record struct {
+	event     Event
+	timestamp time.Time
+}
+
+// Policy counts events reported for deal suppliers and blacklists a supplier
+// when the number of its events of the same kind within the configured
+// period reaches the threshold.
+//
+// Only deals the policy's key is the consumer of are taken into account.
+// A nil policy ignores all events.
+type Policy struct {
+	cfg Config
+	eth blockchain.API
+	key *ecdsa.PrivateKey
+	log *zap.SugaredLogger
+
+	mu      sync.Mutex
+	records map[common.Address][]record
+}
+
+func NewPolicy(cfg Config, eth blockchain.API, key *ecdsa.PrivateKey, log *zap.SugaredLogger) *Policy {
+	return &Policy{
+		cfg:     cfg,
+		eth:     eth,
+		key:     key,
+		log:     log,
+		records: map[common.Address][]record{},
+	}
+}
+
+// Report records the event that happened with the deal, blacklisting the
+// supplier if required. Returns true if the deal has been closed while
+// blacklisting.
+func (m *Policy) Report(ctx context.Context, deal *sonm.Deal, event Event) (bool, error) {
+	if m == nil || !m.cfg.Enabled {
+		return false, nil
+	}
+
+	threshold := m.cfg.threshold(event)
+	if threshold == 0 {
+		return false, nil
+	}
+
+	consumer := crypto.PubkeyToAddress(m.key.PublicKey)
+	if deal.GetConsumerID().Unwrap() != consumer {
+		return false, nil
+	}
+
+	supplier := deal.GetSupplierID().Unwrap()
+	if !m.record(supplier, event, threshold) {
+		return false, nil
+	}
+
+	m.log.Infof("blacklisting %s: %s %d times", supplier.Hex(), event, threshold)
+	if err := m.blacklist(ctx, deal); err != nil {
+		return false, fmt.Errorf("could not blacklist %s: %s", supplier.Hex(), err)
+	}
+
+	return true, nil
+}
+
+// record saves the event, returning true if the threshold is reached. The
+// counter is reset in this case.
+func (m *Policy) record(whom common.Address, event Event, threshold uint64) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	count := uint64(1)
+	var records []record
+	for _, r := range m.records[whom] {
+		if now.Sub(r.timestamp) > m.cfg.Period {
+			continue
+		}
+		if r.event == event {
+			count++
+		}
+		records = append(records, r)
+	}
+
+	if count >= threshold {
+		delete(m.records, whom)
+		return true
+	}
+
+	m.records[whom] = append(records, record{event: event, timestamp: now})
+	return false
+}
+
+// blacklist closes the deal with blacklisting its supplier, which is the
+// only way the market allows the consumer to blacklist.
+func (m *Policy) blacklist(ctx context.Context, deal *sonm.Deal) error {
+	current, err := m.eth.Market().GetDealInfo(ctx, deal.GetId().Unwrap())
+	if err != nil {
+		return err
+	}
+	if current.GetStatus() != sonm.DealStatus_DEAL_ACCEPTED {
+		return fmt.Errorf("deal %s is already closed", deal.GetId().Unwrap().String())
+	}
+
+	return m.eth.Market().CloseDeal(ctx, m.key, deal.GetId().Unwrap(), true)
+}
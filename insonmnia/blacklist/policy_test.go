@@ -0,0 +1,118 @@
+package blacklist
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var (
+	testMaster   = common.HexToAddress("0x1")
+	testSupplier = common.HexToAddress("0x2")
+)
+
+func newTestDeal(id int64, status sonm.DealStatus, consumer *ecdsa.PrivateKey) *sonm.Deal {
+	return &sonm.Deal{
+		Id:         sonm.NewBigIntFromInt(id),
+		SupplierID: sonm.NewEthAddress(testSupplier),
+		ConsumerID: sonm.NewEthAddress(crypto.PubkeyToAddress(consumer.PublicKey)),
+		MasterID:   sonm.NewEthAddress(testMaster),
+		Status:     status,
+	}
+}
+
+func TestPolicyClosesDealAfterThreshold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	deal := newTestDeal(2, sonm.DealStatus_DEAL_ACCEPTED, key)
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	market.EXPECT().GetDealInfo(gomock.Any(), deal.GetId().Unwrap()).Return(deal, nil)
+	market.EXPECT().CloseDeal(gomock.Any(), key, deal.GetId().Unwrap(), true).Return(nil)
+
+	cfg := Config{Enabled: true, TaskFailures: 2, Period: time.Hour}
+	policy := NewPolicy(cfg, eth, key, zap.NewNop().Sugar())
+	ctx := context.Background()
+
+	closed, err := policy.Report(ctx, newTestDeal(1, sonm.DealStatus_DEAL_ACCEPTED, key), TaskFailed)
+	require.NoError(t, err)
+	assert.False(t, closed)
+
+	closed, err = policy.Report(ctx, deal, TaskFailed)
+	require.NoError(t, err)
+	assert.True(t, closed)
+	assert.Empty(t, policy.records)
+}
+
+func TestPolicyFailsOnClosedDeal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	deal := newTestDeal(1, sonm.DealStatus_DEAL_CLOSED, key)
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	market.EXPECT().GetDealInfo(gomock.Any(), deal.GetId().Unwrap()).Return(deal, nil)
+
+	cfg := Config{Enabled: true, TaskFailures: 1, Period: time.Hour}
+	policy := NewPolicy(cfg, eth, key, zap.NewNop().Sugar())
+
+	// The market doesn't allow the consumer to blacklist after the deal is
+	// closed.
+	closed, err := policy.Report(context.Background(), deal, TaskFailed)
+	require.Error(t, err)
+	assert.False(t, closed)
+}
+
+func TestPolicyIgnoresSupplierSide(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	consumer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	cfg := Config{Enabled: true, TaskFailures: 1, Period: time.Hour}
+	policy := NewPolicy(cfg, blockchain.NewMockAPI(ctrl), key, zap.NewNop().Sugar())
+
+	closed, err := policy.Report(context.Background(), newTestDeal(1, sonm.DealStatus_DEAL_ACCEPTED, consumer), TaskFailed)
+	require.NoError(t, err)
+	assert.False(t, closed)
+	assert.Empty(t, policy.records)
+}
+
+func TestPolicyDisabled(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var policy *Policy
+	closed, err := policy.Report(context.Background(), newTestDeal(1, sonm.DealStatus_DEAL_ACCEPTED, key), TaskFailed)
+	require.NoError(t, err)
+	assert.False(t, closed)
+
+	policy = NewPolicy(Config{TaskFailures: 1}, nil, key, zap.NewNop().Sugar())
+	closed, err = policy.Report(context.Background(), newTestDeal(1, sonm.DealStatus_DEAL_ACCEPTED, key), TaskFailed)
+	require.NoError(t, err)
+	assert.False(t, closed)
+}
@@ -0,0 +1,596 @@
+package dwh
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net"
+	"reflect"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/insonmnia/auth"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"github.com/sonm-io/core/util/rest"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	adminAPIPrefix = "/sonm.DWHAdmin/"
+	// maxResyncBlocks is the maximum number of blocks resynced at once.
+	maxResyncBlocks = 100000
+)
+
+var (
+	adminMethods = []string{
+		adminAPIPrefix + "FailedEvents",
+		adminAPIPrefix + "ReplayFailedEvents",
+		adminAPIPrefix + "Resync",
+	}
+
+	// eventDataTypes maps names of event data types, stored along with failed
+	// events, into the types themselves.
+	eventDataTypes = map[string]reflect.Type{}
+)
+
+func init() {
+	for _, data := range []interface{}{
+		&blockchain.DealOpenedData{},
+		&blockchain.DealUpdatedData{},
+		&blockchain.DealChangeRequestSentData{},
+		&blockchain.DealChangeRequestUpdatedData{},
+		&blockchain.OrderPlacedData{},
+		&blockchain.OrderUpdatedData{},
+		&blockchain.BilledData{},
+		&blockchain.WorkerAnnouncedData{},
+		&blockchain.WorkerConfirmedData{},
+		&blockchain.WorkerRemovedData{},
+		&blockchain.AddedToBlacklistData{},
+		&blockchain.RemovedFromBlacklistData{},
+		&blockchain.ValidatorCreatedData{},
+		&blockchain.ValidatorDeletedData{},
+		&blockchain.CertificateCreatedData{},
+	} {
+		eventDataTypes[reflect.TypeOf(data).String()] = reflect.TypeOf(data).Elem()
+	}
+}
+
+// newAuthorization restricts the admin service to the configured admin or,
+// if there is none, to the DWH key owner. The rest of methods are public.
+func (m *DWH) newAuthorization() *auth.AuthRouter {
+	admin := crypto.PubkeyToAddress(m.key.PublicKey)
+	if m.cfg.Admin != nil {
+		admin = *m.cfg.Admin
+	}
+
+	return auth.NewEventAuthorization(m.ctx,
+		auth.WithLog(m.logger),
+		auth.Allow(adminMethods...).With(auth.NewTransportAuthorization(admin)),
+	)
+}
+
+// serveAdminHTTP serves the admin service via REST. There is no way to
+// authenticate REST requests, so it must be listening on loopback only.
+func (m *DWH) serveAdminHTTP() error {
+	m.mu.Lock()
+	lis, err := net.Listen("tcp", m.cfg.AdminHTTPListenAddr)
+	if err != nil {
+		m.mu.Unlock()
+		return errors.WithMessage(err, "failed to create admin http listener")
+	}
+
+	srv, err := rest.NewServer(rest.WithContext(m.ctx), rest.WithListener(lis))
+	if err != nil {
+		m.mu.Unlock()
+		return errors.WithMessage(err, "failed to create admin rest server")
+	}
+
+	err = srv.RegisterService((*pb.DWHAdminServer)(nil), m)
+	if err != nil {
+		m.mu.Unlock()
+		return errors.WithMessage(err, "failed to RegisterService")
+	}
+
+	m.adminHTTP = srv
+	m.mu.Unlock()
+	return srv.Serve()
+}
+
+func (m *DWH) FailedEvents(ctx context.Context, request *pb.FailedEventsRequest) (*pb.FailedEventsReply, error) {
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	events, count, err := m.storage.GetFailedEvents(conn, request)
+	if err != nil {
+		m.logger.Warn("failed to GetFailedEvents", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.NotFound, "failed to GetFailedEvents")
+	}
+
+	return &pb.FailedEventsReply{Events: events, Count: count}, nil
+}
+
+func (m *DWH) ReplayFailedEvents(ctx context.Context, request *pb.ReplayFailedEventsRequest) (*pb.ReplayFailedEventsReply, error) {
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	var events []*pb.FailedEvent
+	if len(request.Ids) == 0 {
+		var err error
+		events, _, err = m.storage.GetFailedEvents(conn, &pb.FailedEventsRequest{})
+		if err != nil {
+			m.logger.Warn("failed to GetFailedEvents", util.LaconicError(err))
+			return nil, status.Error(codes.NotFound, "failed to GetFailedEvents")
+		}
+	}
+	for _, id := range request.Ids {
+		event, err := m.storage.GetFailedEventByID(conn, id)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "failed event %d not found", id)
+		}
+		events = append(events, event)
+	}
+
+	reply := &pb.ReplayFailedEventsReply{}
+	for _, event := range events {
+		if err := m.replayFailedEvent(event); err != nil {
+			m.logger.Warn("failed to replay event", util.LaconicError(err), zap.Uint64("id", event.Id))
+			event.Error = err.Error()
+			event.FailedAt = &pb.Timestamp{Seconds: time.Now().Unix()}
+			if err := m.storage.UpdateFailedEvent(conn, event.Id, event.Error, uint64(event.FailedAt.Seconds)); err != nil {
+				m.logger.Warn("failed to UpdateFailedEvent", util.LaconicError(err), zap.Uint64("id", event.Id))
+			}
+			reply.Failed = append(reply.Failed, event)
+			continue
+		}
+
+		if err := m.storage.DeleteFailedEvent(conn, event.Id); err != nil {
+			m.logger.Warn("failed to DeleteFailedEvent", util.LaconicError(err), zap.Uint64("id", event.Id))
+			return nil, status.Errorf(codes.Internal, "failed to remove replayed event %d", event.Id)
+		}
+		reply.Replayed++
+	}
+
+	return reply, nil
+}
+
+func (m *DWH) replayFailedEvent(event *pb.FailedEvent) error {
+	dataType, ok := eventDataTypes[event.Type]
+	if !ok {
+		return errors.Errorf("unknown event type %s", event.Type)
+	}
+
+	data := reflect.New(dataType).Interface()
+	if err := json.Unmarshal([]byte(event.Data), data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal event data")
+	}
+
+	return m.processEvent(&blockchain.Event{
+		Data:        data,
+		BlockNumber: event.BlockNumber,
+		TS:          event.EventTS,
+		TxHash:      common.HexToHash(event.TxHash),
+		LogIndex:    event.LogIndex,
+	})
+}
+
+// storeFailedEvent saves the event, that can't be processed, so it can be
+// inspected and replayed later.
+func (m *DWH) storeFailedEvent(event *blockchain.Event, reason error) {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		m.logger.Warn("failed to marshal failed event", util.LaconicError(err))
+		return
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	err = m.storage.InsertFailedEvent(conn, &pb.FailedEvent{
+		BlockNumber: event.BlockNumber,
+		EventTS:     event.TS,
+		Type:        reflect.TypeOf(event.Data).String(),
+		Data:        string(data),
+		Error:       reason.Error(),
+		FailedAt:    &pb.Timestamp{Seconds: time.Now().Unix()},
+		TxHash:      event.TxHash.Hex(),
+		LogIndex:    event.LogIndex,
+	})
+	if err != nil {
+		m.logger.Warn("failed to InsertFailedEvent", util.LaconicError(err))
+	}
+}
+
+func (m *DWH) Resync(ctx context.Context, request *pb.ResyncRequest) (*pb.ResyncReply, error) {
+	var (
+		err   error
+		reply = &pb.ResyncReply{}
+		kinds = 0
+	)
+	for _, set := range []bool{
+		!request.GetOrderID().IsZero(),
+		!request.GetDealID().IsZero(),
+		!request.GetProfileID().IsZero(),
+		request.GetToBlock() != 0,
+	} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of order, deal, profile or block range must be specified")
+	}
+
+	if request.FromBlock > request.ToBlock {
+		return nil, status.Error(codes.InvalidArgument, "invalid block range")
+	}
+	if request.ToBlock-request.FromBlock >= maxResyncBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d blocks can be resynced at once", maxResyncBlocks)
+	}
+
+	// Resynced entities are logged as changes, which must not interleave
+	// with the ones of events being processed.
+	m.writeChanges(func() {
+		switch {
+		case !request.GetOrderID().IsZero():
+			err = m.resyncOrder(request.GetOrderID().Unwrap())
+			reply.Orders = 1
+		case !request.GetDealID().IsZero():
+			err = m.resyncDeal(request.GetDealID().Unwrap())
+			reply.Deals = 1
+		case !request.GetProfileID().IsZero():
+			err = m.resyncProfile(request.GetProfileID().Unwrap())
+			reply.Profiles = 1
+		default:
+			reply, err = m.resyncBlocks(request.FromBlock, request.ToBlock)
+		}
+	})
+
+	if err != nil {
+		m.logger.Warn("failed to Resync", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Errorf(codes.Internal, "failed to resync: %v", err)
+	}
+
+	return reply, nil
+}
+
+// resyncOrder replaces the stored order with the one from the market.
+func (m *DWH) resyncOrder(orderID *big.Int) error {
+	order, err := m.blockchain.Market().GetOrderInfo(m.ctx, orderID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetOrderInfo")
+	}
+
+	conn, err := newTxConn(m.db, m.logger)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer conn.Finish()
+
+	var (
+		eventTS = uint64(time.Now().Unix())
+		masters = map[common.Address]bool{}
+	)
+	snapshot, err := m.storage.GetOrderByID(conn, orderID)
+	if err == nil {
+		eventTS = uint64(snapshot.GetCreatedTS().GetSeconds())
+		masters[snapshot.GetMasterID().Unwrap()] = true
+	}
+
+	if err := m.storage.DeleteOrder(conn, orderID); err != nil {
+		return errors.Wrap(err, "failed to DeleteOrder")
+	}
+	if err := m.storage.RemoveStaleID(conn, orderID, "Order"); err != nil {
+		return errors.Wrap(err, "failed to RemoveStaleID")
+	}
+
+	if order.OrderStatus != pb.OrderStatus_ORDER_INACTIVE || !order.DealID.IsZero() {
+		if err := m.insertOrder(conn, eventTS, order); err != nil {
+			return errors.Wrap(err, "failed to insertOrder")
+		}
+		if dwhOrder, err := m.storage.GetOrderByID(conn, orderID); err == nil {
+			masters[dwhOrder.GetMasterID().Unwrap()] = true
+		}
+	}
+
+	for masterID := range masters {
+		if err := m.recountProfileStats(conn, masterID); err != nil {
+			return errors.Wrapf(err, "failed to recount profile stats (%s)", masterID.Hex())
+		}
+	}
+
+	return m.logOrderChange(conn, m.currentBlock(), pb.DWHOrderEvent_UPDATED, orderID, snapshot)
+}
+
+// resyncDeal replaces the stored deal with the one from the market, removing
+// it if the deal is closed.
+func (m *DWH) resyncDeal(dealID *big.Int) error {
+	deal, err := m.blockchain.Market().GetDealInfo(m.ctx, dealID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetDealInfo")
+	}
+
+	conn, err := newTxConn(m.db, m.logger)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer conn.Finish()
+
+	if err := m.storage.RemoveStaleID(conn, dealID, "Deal"); err != nil {
+		return errors.Wrap(err, "failed to RemoveStaleID")
+	}
+
+	snapshot, err := m.storage.GetDealByID(conn, dealID)
+	exists := err == nil
+
+	switch {
+	case deal.Status == pb.DealStatus_DEAL_CLOSED:
+		if err := m.storage.DeleteDeal(conn, dealID); err != nil {
+			return errors.Wrap(err, "failed to DeleteDeal")
+		}
+		if err := m.storage.DeleteOrder(conn, deal.GetAskID().Unwrap()); err != nil {
+			return errors.Wrap(err, "failed to DeleteOrder")
+		}
+		if err := m.storage.DeleteOrder(conn, deal.GetBidID().Unwrap()); err != nil {
+			return errors.Wrap(err, "failed to DeleteOrder")
+		}
+	case exists:
+		if err := m.storage.UpdateDeal(conn, deal); err != nil {
+			return errors.Wrap(err, "failed to UpdateDeal")
+		}
+	default:
+		if err := m.insertDeal(conn, deal); err != nil {
+			return errors.Wrap(err, "failed to insertDeal")
+		}
+	}
+
+	return m.logDealChange(conn, m.currentBlock(), pb.DWHDealEvent_UPDATED, dealID, snapshot, nil)
+}
+
+// resyncChangeRequest replaces the stored deal change request with the one
+// from the market, removing it if the request is no longer pending.
+func (m *DWH) resyncChangeRequest(eventTS uint64, changeRequestID *big.Int) error {
+	changeRequest, err := m.blockchain.Market().GetDealChangeRequestInfo(m.ctx, changeRequestID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetDealChangeRequestInfo")
+	}
+
+	conn, err := newTxConn(m.db, m.logger)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer conn.Finish()
+
+	if err := m.storage.DeleteDealChangeRequest(conn, changeRequestID); err != nil {
+		return errors.Wrap(err, "failed to DeleteDealChangeRequest")
+	}
+
+	if changeRequest.Status != pb.ChangeRequestStatus_REQUEST_CREATED {
+		return nil
+	}
+	if _, err := m.storage.GetDealByID(conn, changeRequest.GetDealID().Unwrap()); err != nil {
+		return nil
+	}
+
+	changeRequest.CreatedTS = &pb.Timestamp{Seconds: int64(eventTS)}
+	if err := m.storage.InsertDealChangeRequest(conn, changeRequest); err != nil {
+		return errors.Wrap(err, "failed to InsertDealChangeRequest")
+	}
+
+	return nil
+}
+
+// resyncProfile updates the profile attributes from the profile registry and
+// recounts the profile stats.
+func (m *DWH) resyncProfile(userID common.Address) error {
+	attributes := map[uint64][]byte{}
+	for _, attribute := range []uint64{CertificateName, CertificateCountry} {
+		value, err := m.blockchain.ProfileRegistry().GetAttributeValue(m.ctx, userID, big.NewInt(0).SetUint64(attribute))
+		if err != nil {
+			return errors.Wrapf(err, "failed to GetAttributeValue (%s)", attributeToString[attribute])
+		}
+		attributes[attribute] = value
+	}
+
+	conn, err := newTxConn(m.db, m.logger)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer conn.Finish()
+
+	if _, err := m.storage.GetProfileByID(conn, userID); err != nil {
+		certificates, _ := json.Marshal([]*pb.Certificate{})
+		err := m.storage.InsertProfileUserID(conn, &pb.Profile{
+			UserID:       pb.NewEthAddress(userID),
+			Certificates: string(certificates),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to InsertProfileUserID")
+		}
+	}
+
+	for attribute, value := range attributes {
+		if err := m.storage.UpdateProfile(conn, userID, attributeToString[attribute], string(value)); err != nil {
+			return errors.Wrapf(err, "failed to UpdateProfile (%s)", attributeToString[attribute])
+		}
+	}
+
+	if err := m.updateProfileCertificates(conn, userID); err != nil {
+		return errors.Wrap(err, "failed to updateProfileCertificates")
+	}
+
+	if err := m.recountProfileStats(conn, userID); err != nil {
+		return errors.Wrap(err, "failed to recountProfileStats")
+	}
+
+	if err := m.updateEntitiesByProfile(conn, userID); err != nil {
+		return errors.Wrap(err, "failed to updateEntitiesByProfile")
+	}
+
+	return nil
+}
+
+// resyncCertificate stores the certificate if it is missing, returning its
+// owner.
+func (m *DWH) resyncCertificate(certificateID *big.Int) (common.Address, error) {
+	certificate, err := m.blockchain.ProfileRegistry().GetCertificate(m.ctx, certificateID)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to GetCertificate")
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	ownerID := certificate.GetOwnerID().Unwrap()
+	certificates, err := m.storage.GetCertificates(conn, ownerID)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to GetCertificates")
+	}
+
+	// Stored certificates have no IDs, so they are compared by contents.
+	for _, stored := range certificates {
+		if stored.Attribute == certificate.Attribute && bytes.Equal(stored.Value, certificate.Value) &&
+			stored.GetValidatorID().Unwrap() == certificate.GetValidatorID().Unwrap() {
+			return ownerID, nil
+		}
+	}
+
+	if err := m.storage.InsertCertificate(conn, certificate); err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to InsertCertificate")
+	}
+
+	return ownerID, nil
+}
+
+// resyncBlacklistEntry stores or removes the blacklist entry depending on
+// the blacklist state.
+func (m *DWH) resyncBlacklistEntry(who, whom common.Address) error {
+	ok, err := m.blockchain.Blacklist().Check(m.ctx, who, whom)
+	if err != nil {
+		return errors.Wrap(err, "failed to Check blacklist")
+	}
+
+	conn, err := newTxConn(m.db, m.logger)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer conn.Finish()
+
+	if err := m.storage.DeleteBlacklistEntry(conn, who, whom); err != nil {
+		return errors.Wrap(err, "failed to DeleteBlacklistEntry")
+	}
+
+	if ok {
+		if err := m.storage.InsertBlacklistEntry(conn, who, whom); err != nil {
+			return errors.Wrap(err, "failed to InsertBlacklistEntry")
+		}
+	}
+
+	return nil
+}
+
+// resyncBlocks resyncs entities referred by events from the given inclusive
+// range of blocks. Worker events are processed again in order, because the
+// current state of workers can not be fully read from the market.
+func (m *DWH) resyncBlocks(fromBlock, toBlock uint64) (*pb.ResyncReply, error) {
+	events, err := m.blockchain.Events().GetEventsRange(m.ctx, fromBlock, toBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetEventsRange")
+	}
+
+	var (
+		orders     = map[string]*big.Int{}
+		deals      = map[string]*big.Int{}
+		validators = map[common.Address]bool{}
+		blacklist  = map[[2]common.Address]bool{}
+		profiles   = map[common.Address]bool{}
+	)
+	for _, event := range events {
+		switch value := event.Data.(type) {
+		case *blockchain.OrderPlacedData:
+			orders[value.ID.String()] = value.ID
+		case *blockchain.OrderUpdatedData:
+			orders[value.ID.String()] = value.ID
+		case *blockchain.DealOpenedData:
+			deals[value.ID.String()] = value.ID
+		case *blockchain.DealUpdatedData:
+			deals[value.ID.String()] = value.ID
+		case *blockchain.BilledData:
+			deals[value.DealID.String()] = value.DealID
+		case *blockchain.DealChangeRequestSentData:
+			if err := m.resyncChangeRequest(event.TS, value.ID); err != nil {
+				return nil, errors.Wrapf(err, "failed to resync change request %s", value.ID.String())
+			}
+		case *blockchain.DealChangeRequestUpdatedData:
+			if err := m.resyncChangeRequest(event.TS, value.ID); err != nil {
+				return nil, errors.Wrapf(err, "failed to resync change request %s", value.ID.String())
+			}
+		case *blockchain.WorkerAnnouncedData, *blockchain.WorkerConfirmedData, *blockchain.WorkerRemovedData:
+			err := withTx(m.db, m.logger, func(conn queryConn) error {
+				return m.applyEvent(conn, event)
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to process worker event")
+			}
+		case *blockchain.ValidatorCreatedData:
+			validators[value.ID] = true
+		case *blockchain.ValidatorDeletedData:
+			validators[value.ID] = true
+		case *blockchain.AddedToBlacklistData:
+			blacklist[[2]common.Address{value.AdderID, value.AddeeID}] = true
+		case *blockchain.RemovedFromBlacklistData:
+			blacklist[[2]common.Address{value.RemoverID, value.RemoveeID}] = true
+		case *blockchain.CertificateCreatedData:
+			ownerID, err := m.resyncCertificate(value.ID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resync certificate %s", value.ID.String())
+			}
+			profiles[ownerID] = true
+		}
+	}
+
+	for validatorID := range validators {
+		err := withTx(m.db, m.logger, func(conn queryConn) error {
+			return m.onValidatorCreated(conn, validatorID)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resync validator %s", validatorID.Hex())
+		}
+	}
+	for entry := range blacklist {
+		if err := m.resyncBlacklistEntry(entry[0], entry[1]); err != nil {
+			return nil, errors.Wrapf(err, "failed to resync blacklist entry %s", entry[1].Hex())
+		}
+	}
+	for _, orderID := range orders {
+		if err := m.resyncOrder(orderID); err != nil {
+			return nil, errors.Wrapf(err, "failed to resync order %s", orderID.String())
+		}
+	}
+	// Deals are resynced after orders, because closing a deal removes them.
+	for _, dealID := range deals {
+		if err := m.resyncDeal(dealID); err != nil {
+			return nil, errors.Wrapf(err, "failed to resync deal %s", dealID.String())
+		}
+	}
+	for userID := range profiles {
+		if err := m.resyncProfile(userID); err != nil {
+			return nil, errors.Wrapf(err, "failed to resync profile %s", userID.Hex())
+		}
+	}
+
+	m.logger.Info("resynced blocks", zap.Uint64("from_block", fromBlock), zap.Uint64("to_block", toBlock),
+		zap.Int("orders", len(orders)), zap.Int("deals", len(deals)), zap.Int("profiles", len(profiles)))
+
+	return &pb.ResyncReply{
+		Orders:   uint64(len(orders)),
+		Deals:    uint64(len(deals)),
+		Profiles: uint64(len(profiles)),
+	}, nil
+}
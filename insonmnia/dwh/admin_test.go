@@ -0,0 +1,143 @@
+package dwh
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	bch "github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAdminDBPath = "test_admin_dwh.db"
+
+func newTestAdminOrder(t *testing.T, id int64) *pb.Order {
+	benchmarks, err := pb.NewBenchmarks([]uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11})
+	require.NoError(t, err)
+
+	return &pb.Order{
+		Id:             pb.NewBigIntFromInt(id),
+		DealID:         pb.NewBigIntFromInt(0),
+		OrderType:      pb.OrderType_BID,
+		OrderStatus:    pb.OrderStatus_ORDER_ACTIVE,
+		AuthorID:       pb.NewEthAddress(common.HexToAddress("0xE1")),
+		CounterpartyID: pb.NewEthAddress(common.Address{}),
+		Duration:       3600,
+		Price:          pb.NewBigIntFromInt(100),
+		Tag:            []byte{0, 1},
+		FrozenSum:      pb.NewBigIntFromInt(0),
+		Benchmarks:     benchmarks,
+	}
+}
+
+func TestDWH_ReplayFailedEvents(t *testing.T) {
+	w, err := getTestDWH(testAdminDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testAdminDBPath)
+	defer w.db.Close()
+
+	var (
+		controller = gomock.NewController(t)
+		mockBlock  = bch.NewMockAPI(controller)
+		mockMarket = bch.NewMockMarketAPI(controller)
+		order      = newTestAdminOrder(t, 91001)
+		conn       = newSimpleConn(w.db)
+	)
+	defer controller.Finish()
+	mockMarket.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().Return(order, nil)
+	mockBlock.EXPECT().Market().AnyTimes().Return(mockMarket)
+	w.blockchain = mockBlock
+
+	w.storeFailedEvent(&bch.Event{
+		Data:        &bch.OrderPlacedData{ID: order.GetId().Unwrap()},
+		BlockNumber: 10,
+		TS:          7,
+	}, assert.AnError)
+	require.NoError(t, w.storage.InsertFailedEvent(conn, &pb.FailedEvent{
+		BlockNumber: 11,
+		Type:        "*blockchain.UnknownData",
+		Data:        "{}",
+		Error:       assert.AnError.Error(),
+	}))
+
+	failed, err := w.FailedEvents(w.ctx, &pb.FailedEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, failed.GetEvents(), 2)
+	assert.Equal(t, uint64(2), failed.GetCount())
+	assert.Equal(t, "*blockchain.OrderPlacedData", failed.GetEvents()[0].GetType())
+	assert.Equal(t, uint64(7), failed.GetEvents()[0].GetEventTS())
+	assert.Equal(t, assert.AnError.Error(), failed.GetEvents()[0].GetError())
+
+	reply, err := w.ReplayFailedEvents(w.ctx, &pb.ReplayFailedEventsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), reply.GetReplayed())
+	require.Len(t, reply.GetFailed(), 1)
+	assert.Equal(t, uint64(11), reply.GetFailed()[0].GetBlockNumber())
+
+	stored, err := w.storage.GetOrderByID(conn, order.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), stored.GetCreatedTS().GetSeconds())
+
+	failed, err = w.FailedEvents(w.ctx, &pb.FailedEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, failed.GetEvents(), 1)
+	assert.Contains(t, failed.GetEvents()[0].GetError(), "unknown event type")
+
+	_, err = w.ReplayFailedEvents(w.ctx, &pb.ReplayFailedEventsRequest{Ids: []uint64{100}})
+	assert.Error(t, err)
+}
+
+func TestDWH_Resync(t *testing.T) {
+	w, err := getTestDWH(testAdminDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testAdminDBPath)
+	defer w.db.Close()
+
+	var (
+		controller = gomock.NewController(t)
+		mockBlock  = bch.NewMockAPI(controller)
+		mockMarket = bch.NewMockMarketAPI(controller)
+		order      = newTestAdminOrder(t, 92001)
+		conn       = newSimpleConn(w.db)
+	)
+	defer controller.Finish()
+	mockBlock.EXPECT().Market().AnyTimes().Return(mockMarket)
+	w.blockchain = mockBlock
+
+	require.NoError(t, w.storage.InsertOrder(conn, &pb.DWHOrder{
+		CreatedTS: &pb.Timestamp{Seconds: 5},
+		MasterID:  order.GetAuthorID(),
+		Order:     newTestAdminOrder(t, 92001),
+	}))
+
+	// The market has a different price, e.g. because of a lost event.
+	order.Price = pb.NewBigIntFromInt(200)
+	mockMarket.EXPECT().GetOrderInfo(gomock.Any(), order.GetId().Unwrap()).Return(order, nil)
+
+	reply, err := w.Resync(w.ctx, &pb.ResyncRequest{OrderID: order.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), reply.GetOrders())
+
+	stored, err := w.storage.GetOrderByID(conn, order.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), stored.GetOrder().GetPrice().Unwrap().Int64())
+	assert.Equal(t, int64(5), stored.GetCreatedTS().GetSeconds())
+
+	// Cancelled orders are removed.
+	order.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+	mockMarket.EXPECT().GetOrderInfo(gomock.Any(), order.GetId().Unwrap()).Return(order, nil)
+
+	_, err = w.Resync(w.ctx, &pb.ResyncRequest{OrderID: order.GetId()})
+	require.NoError(t, err)
+	_, err = w.storage.GetOrderByID(conn, order.GetId().Unwrap())
+	assert.Error(t, err)
+
+	_, err = w.Resync(w.ctx, &pb.ResyncRequest{OrderID: order.GetId(), DealID: pb.NewBigInt(big.NewInt(1))})
+	assert.Error(t, err)
+	_, err = w.Resync(w.ctx, &pb.ResyncRequest{FromBlock: 10, ToBlock: 5})
+	assert.Error(t, err)
+}
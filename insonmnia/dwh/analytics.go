@@ -0,0 +1,805 @@
+package dwh
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// rollupPeriod is the duration of rollup buckets in seconds, analytics
+	// are calculated with this precision.
+	rollupPeriod = 3600
+	// priceBinsPerOctave is the number of price histogram bins per each
+	// doubling of the price. It bounds the error of price percentiles.
+	priceBinsPerOctave = 32
+	// zeroPriceBin is the price histogram bin of free orders.
+	zeroPriceBin = math.MinInt32
+	// maxAnalyticsBuckets is the maximum number of buckets returned at once.
+	maxAnalyticsBuckets = 1000
+	// defaultAnalyticsRange is the duration of the period in seconds used if
+	// its start is not specified.
+	defaultAnalyticsRange = 24 * 3600
+)
+
+// orderRollup counts orders of the same device class placed and removed from
+// the market within the rollup bucket.
+type orderRollup struct {
+	BucketTS      uint64
+	Type          pb.OrderType
+	IdentityLevel uint64
+	GPUMem        uint64
+	CPUCores      uint64
+	Netflags      uint64
+	Placed        uint64
+	Matched       uint64
+	Cancelled     uint64
+	// MatchTime is the total number of seconds matched orders spent in the
+	// market.
+	MatchTime     uint64
+	PlacedVolume  *big.Int
+	RemovedVolume *big.Int
+}
+
+func newOrderRollup(key *orderRollup) *orderRollup {
+	return &orderRollup{
+		BucketTS:      key.BucketTS,
+		Type:          key.Type,
+		IdentityLevel: key.IdentityLevel,
+		GPUMem:        key.GPUMem,
+		CPUCores:      key.CPUCores,
+		Netflags:      key.Netflags,
+		PlacedVolume:  big.NewInt(0),
+		RemovedVolume: big.NewInt(0),
+	}
+}
+
+func newOrderRollupKey(ts uint64, order *pb.DWHOrder) *orderRollup {
+	benchmarks := order.GetOrder().GetBenchmarks()
+
+	return &orderRollup{
+		BucketTS:      rollupBucket(ts),
+		Type:          order.GetOrder().GetOrderType(),
+		IdentityLevel: order.GetCreatorIdentityLevel(),
+		GPUMem:        gpuMemClass(benchmarks.GPUMem()),
+		CPUCores:      benchmarks.CPUCores(),
+		Netflags:      order.GetOrder().GetNetflags(),
+	}
+}
+
+func (m *orderRollup) entityID() string {
+	return fmt.Sprintf("%d_%d_%d_%d_%d_%d", m.BucketTS, m.Type, m.IdentityLevel, m.GPUMem, m.CPUCores, m.Netflags)
+}
+
+// priceRollup is a bin of the histogram of order prices per benchmark unit
+// within the rollup bucket. Prices are in wei per second.
+type priceRollup struct {
+	BucketTS      uint64
+	Type          pb.OrderType
+	IdentityLevel uint64
+	BenchmarkID   uint64
+	Bin           int64
+	Count         uint64
+	MinPrice      float64
+	MaxPrice      float64
+}
+
+func newPriceRollup(key *priceRollup) *priceRollup {
+	return &priceRollup{
+		BucketTS:      key.BucketTS,
+		Type:          key.Type,
+		IdentityLevel: key.IdentityLevel,
+		BenchmarkID:   key.BenchmarkID,
+		Bin:           key.Bin,
+	}
+}
+
+func (m *priceRollup) entityID() string {
+	return fmt.Sprintf("%d_%d_%d_%d_%d", m.BucketTS, m.Type, m.IdentityLevel, m.BenchmarkID, m.Bin)
+}
+
+func (m *priceRollup) add(price float64) {
+	if m.Count == 0 || price < m.MinPrice {
+		m.MinPrice = price
+	}
+	if m.Count == 0 || price > m.MaxPrice {
+		m.MaxPrice = price
+	}
+	m.Count++
+}
+
+// payoutRollup sums payments made to suppliers of the same identity level
+// within the rollup bucket.
+type payoutRollup struct {
+	BucketTS      uint64
+	IdentityLevel uint64
+	Bills         uint64
+	Payout        *big.Int
+}
+
+func newPayoutRollup(key *payoutRollup) *payoutRollup {
+	return &payoutRollup{
+		BucketTS:      key.BucketTS,
+		IdentityLevel: key.IdentityLevel,
+		Payout:        big.NewInt(0),
+	}
+}
+
+func (m *payoutRollup) entityID() string {
+	return fmt.Sprintf("%d_%d", m.BucketTS, m.IdentityLevel)
+}
+
+// rolledUpOrder records the identity level an active order has been counted
+// in rollups with when placed, so its removal is counted with the same one
+// even if the creator's level has changed since. Orders placed before
+// rollups have been introduced have no records and are never counted.
+type rolledUpOrder struct {
+	ID            string
+	IdentityLevel uint64
+	// Active is false if the order is not counted in rollups as active.
+	Active bool
+}
+
+// rollupFilter selects rollups from the [From, To) range. Zero type and
+// empty identity levels match any.
+type rollupFilter struct {
+	From           uint64
+	To             uint64
+	Type           pb.OrderType
+	IdentityLevels []pb.IdentityLevel
+}
+
+func rollupBucket(ts uint64) uint64 {
+	return ts - ts%rollupPeriod
+}
+
+// gpuMemClass rounds the GPU memory down to a power of two, so similar
+// devices fall into the same class.
+func gpuMemClass(value uint64) uint64 {
+	if value == 0 {
+		return 0
+	}
+
+	return 1 << uint(bits.Len64(value)-1)
+}
+
+func pricePerUnit(price *big.Int, value uint64) float64 {
+	result, _ := new(big.Float).Quo(new(big.Float).SetInt(price), new(big.Float).SetUint64(value)).Float64()
+	return result
+}
+
+func priceBin(price float64) int64 {
+	if price <= 0 {
+		return zeroPriceBin
+	}
+
+	return int64(math.Floor(math.Log2(price) * priceBinsPerOctave))
+}
+
+// isRollupEvent reports whether the event updates analytics rollups.
+func isRollupEvent(event *blockchain.Event) bool {
+	switch event.Data.(type) {
+	case *blockchain.OrderPlacedData, *blockchain.OrderUpdatedData, *blockchain.BilledData:
+		return true
+	default:
+		return false
+	}
+}
+
+// updateRollups updates analytics rollups with the changes made by the
+// event. It must be called in the same transaction the event is applied in
+// and with the rollups mutex held.
+func (m *DWH) updateRollups(conn queryConn, event *blockchain.Event, snapshot interface{}) error {
+	switch value := event.Data.(type) {
+	case *blockchain.OrderPlacedData:
+		return m.rollupOrderPlaced(conn, event, value.ID)
+	case *blockchain.OrderUpdatedData:
+		order, _ := snapshot.(*pb.DWHOrder)
+		return m.rollupOrderRemoved(conn, event, order)
+	case *blockchain.BilledData:
+		return m.rollupBill(conn, event, value.DealID, value.PaidAmount)
+	}
+
+	return nil
+}
+
+func (m *DWH) rollupOrderPlaced(conn queryConn, event *blockchain.Event, orderID *big.Int) error {
+	order, err := m.storage.GetOrderByID(conn, orderID)
+	if err != nil || order.GetOrder().GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE {
+		// Stale orders are not stored.
+		return nil
+	}
+
+	rollup, err := m.getOrderRollup(conn, event.BlockNumber, newOrderRollupKey(event.TS, order))
+	if err != nil {
+		return err
+	}
+
+	record, err := m.getRolledUpOrder(conn, event.BlockNumber, orderID)
+	if err != nil {
+		return err
+	}
+
+	record.IdentityLevel = order.GetCreatorIdentityLevel()
+	record.Active = true
+	if err := m.storage.SaveRolledUpOrder(conn, record); err != nil {
+		return errors.Wrap(err, "failed to SaveRolledUpOrder")
+	}
+
+	price := order.GetOrder().GetPrice().Unwrap()
+	rollup.Placed++
+	rollup.PlacedVolume.Add(rollup.PlacedVolume, price)
+	if err := m.storage.SaveOrderRollup(conn, rollup); err != nil {
+		return errors.Wrap(err, "failed to SaveOrderRollup")
+	}
+
+	for benchID, value := range order.GetOrder().GetBenchmarks().GetValues() {
+		if value == 0 {
+			continue
+		}
+
+		pricePerUnit := pricePerUnit(price, value)
+		rollup, err := m.getPriceRollup(conn, event.BlockNumber, &priceRollup{
+			BucketTS:      rollupBucket(event.TS),
+			Type:          order.GetOrder().GetOrderType(),
+			IdentityLevel: order.GetCreatorIdentityLevel(),
+			BenchmarkID:   uint64(benchID),
+			Bin:           priceBin(pricePerUnit),
+		})
+		if err != nil {
+			return err
+		}
+
+		rollup.add(pricePerUnit)
+		if err := m.storage.SavePriceRollup(conn, rollup); err != nil {
+			return errors.Wrap(err, "failed to SavePriceRollup")
+		}
+	}
+
+	return nil
+}
+
+// rollupOrderRemoved counts the order as matched or cancelled if the event
+// removed it from the market. The snapshot is the state of the order before
+// the event.
+func (m *DWH) rollupOrderRemoved(conn queryConn, event *blockchain.Event, snapshot *pb.DWHOrder) error {
+	if snapshot == nil || snapshot.GetOrder().GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE {
+		return nil
+	}
+
+	// Matched orders are kept along with their deals, while cancelled ones
+	// are deleted.
+	orderID := snapshot.GetOrder().GetId().Unwrap()
+	order, err := m.storage.GetOrderByID(conn, orderID)
+	if err == nil && order.GetOrder().GetOrderStatus() == pb.OrderStatus_ORDER_ACTIVE {
+		return nil
+	}
+	matched := err == nil && !order.GetOrder().GetDealID().IsZero()
+
+	record, err := m.getRolledUpOrder(conn, event.BlockNumber, orderID)
+	if err != nil {
+		return err
+	}
+	if !record.Active {
+		return nil
+	}
+
+	key := newOrderRollupKey(event.TS, snapshot)
+	key.IdentityLevel = record.IdentityLevel
+	rollup, err := m.getOrderRollup(conn, event.BlockNumber, key)
+	if err != nil {
+		return err
+	}
+
+	if matched {
+		rollup.Matched++
+		if createdTS := uint64(snapshot.GetCreatedTS().GetSeconds()); event.TS > createdTS {
+			rollup.MatchTime += event.TS - createdTS
+		}
+	} else {
+		rollup.Cancelled++
+	}
+	rollup.RemovedVolume.Add(rollup.RemovedVolume, snapshot.GetOrder().GetPrice().Unwrap())
+
+	if err := m.storage.SaveOrderRollup(conn, rollup); err != nil {
+		return errors.Wrap(err, "failed to SaveOrderRollup")
+	}
+
+	record.Active = false
+	if err := m.storage.SaveRolledUpOrder(conn, record); err != nil {
+		return errors.Wrap(err, "failed to SaveRolledUpOrder")
+	}
+
+	return nil
+}
+
+func (m *DWH) rollupBill(conn queryConn, event *blockchain.Event, dealID, paidAmount *big.Int) error {
+	var masterID common.Address
+	if deal, err := m.storage.GetDealByID(conn, dealID); err == nil {
+		masterID = deal.GetDeal().GetMasterID().Unwrap()
+	} else {
+		// Stale deals are not stored, while their bills are still paid.
+		deal, err := m.blockchain.Market().GetDealInfo(m.ctx, dealID)
+		if err != nil {
+			return errors.Wrap(err, "failed to GetDealInfo")
+		}
+		masterID = deal.GetMasterID().Unwrap()
+	}
+
+	var identityLevel uint64
+	if profile, err := m.storage.GetProfileByID(conn, masterID); err == nil {
+		identityLevel = profile.GetIdentityLevel()
+	}
+
+	rollup, err := m.getPayoutRollup(conn, event.BlockNumber, &payoutRollup{
+		BucketTS:      rollupBucket(event.TS),
+		IdentityLevel: identityLevel,
+	})
+	if err != nil {
+		return err
+	}
+
+	rollup.Bills++
+	rollup.Payout.Add(rollup.Payout, paidAmount)
+	if err := m.storage.SavePayoutRollup(conn, rollup); err != nil {
+		return errors.Wrap(err, "failed to SavePayoutRollup")
+	}
+
+	return nil
+}
+
+// getOrderRollup returns the stored state of the rollup, journaling it, so
+// it can be restored on chain reorganization. The same applies to the other
+// rollup getters.
+func (m *DWH) getOrderRollup(conn queryConn, blockNumber uint64, key *orderRollup) (*orderRollup, error) {
+	rollup, err := m.storage.GetOrderRollup(conn, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetOrderRollup")
+	}
+
+	if err := m.journalRollup(conn, blockNumber, journalEntityOrderRollup, rollup.entityID(), rollup); err != nil {
+		return nil, err
+	}
+
+	return rollup, nil
+}
+
+func (m *DWH) getPriceRollup(conn queryConn, blockNumber uint64, key *priceRollup) (*priceRollup, error) {
+	rollup, err := m.storage.GetPriceRollup(conn, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetPriceRollup")
+	}
+
+	if err := m.journalRollup(conn, blockNumber, journalEntityPriceRollup, rollup.entityID(), rollup); err != nil {
+		return nil, err
+	}
+
+	return rollup, nil
+}
+
+func (m *DWH) getPayoutRollup(conn queryConn, blockNumber uint64, key *payoutRollup) (*payoutRollup, error) {
+	rollup, err := m.storage.GetPayoutRollup(conn, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetPayoutRollup")
+	}
+
+	if err := m.journalRollup(conn, blockNumber, journalEntityPayoutRollup, rollup.entityID(), rollup); err != nil {
+		return nil, err
+	}
+
+	return rollup, nil
+}
+
+func (m *DWH) getRolledUpOrder(conn queryConn, blockNumber uint64, orderID *big.Int) (*rolledUpOrder, error) {
+	record, err := m.storage.GetRolledUpOrder(conn, orderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetRolledUpOrder")
+	}
+
+	if err := m.journalRollup(conn, blockNumber, journalEntityRolledUpOrder, record.ID, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (m *DWH) GetPriceHistory(ctx context.Context, request *pb.PriceHistoryRequest) (*pb.PriceHistoryReply, error) {
+	period, err := newAnalyticsPeriod(request.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+	if request.GetType() == pb.OrderType_ANY {
+		return nil, status.Error(codes.InvalidArgument, "order type must be specified")
+	}
+	if request.GetBenchmarkID() >= m.numBenchmarks {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown benchmark %d", request.GetBenchmarkID())
+	}
+	for _, percentile := range request.GetPercentiles() {
+		if percentile < 0 || percentile > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid percentile %v", percentile)
+		}
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	filter := period.filter(request.GetType(), request.GetIdentityLevel())
+	rollups, err := m.storage.GetPriceRollups(conn, filter, request.GetBenchmarkID())
+	if err != nil {
+		m.logger.Warn("failed to GetPriceRollups", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.Internal, "failed to GetPriceHistory")
+	}
+
+	histograms := make([]priceHistogram, period.numBuckets)
+	for idx := range histograms {
+		histograms[idx] = priceHistogram{}
+	}
+	for _, rollup := range rollups {
+		histograms[period.bucket(rollup.BucketTS)].add(rollup)
+	}
+
+	reply := &pb.PriceHistoryReply{}
+	for idx, histogram := range histograms {
+		stats := histogram.stats(request.GetPercentiles())
+		stats.Ts = period.timestamp(uint64(idx))
+		reply.Buckets = append(reply.Buckets, stats)
+	}
+
+	return reply, nil
+}
+
+// deviceClassKey identifies orders of the same type and device class.
+type deviceClassKey struct {
+	orderType pb.OrderType
+	gpuMem    uint64
+	cpuCores  uint64
+	netflags  uint64
+}
+
+func (m deviceClassKey) less(other deviceClassKey) bool {
+	if m.orderType != other.orderType {
+		return m.orderType < other.orderType
+	}
+	if m.gpuMem != other.gpuMem {
+		return m.gpuMem < other.gpuMem
+	}
+	if m.cpuCores != other.cpuCores {
+		return m.cpuCores < other.cpuCores
+	}
+
+	return m.netflags < other.netflags
+}
+
+// supplyDemandSeries holds the changes of active orders of a device class
+// per bucket, which are accumulated afterwards.
+type supplyDemandSeries struct {
+	active []int64
+	volume []*big.Int
+	placed []uint64
+}
+
+func newSupplyDemandSeries(numBuckets uint64) *supplyDemandSeries {
+	series := &supplyDemandSeries{
+		active: make([]int64, numBuckets),
+		volume: make([]*big.Int, numBuckets),
+		placed: make([]uint64, numBuckets),
+	}
+	for idx := range series.volume {
+		series.volume[idx] = big.NewInt(0)
+	}
+
+	return series
+}
+
+func (m *DWH) GetSupplyDemand(ctx context.Context, request *pb.SupplyDemandRequest) (*pb.SupplyDemandReply, error) {
+	period, err := newAnalyticsPeriod(request.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	// Active orders are counted from the very beginning. Removals are only
+	// counted for orders placed since then, so the counts never go below
+	// zero for databases created before rollups.
+	filter := period.filter(request.GetType(), request.GetIdentityLevel())
+	filter.From = 0
+	rollups, err := m.storage.GetOrderRollups(conn, filter)
+	if err != nil {
+		m.logger.Warn("failed to GetOrderRollups", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.Internal, "failed to GetSupplyDemand")
+	}
+
+	groups := map[deviceClassKey]*supplyDemandSeries{}
+	for _, rollup := range rollups {
+		key := deviceClassKey{
+			orderType: rollup.Type,
+			gpuMem:    rollup.GPUMem,
+			cpuCores:  rollup.CPUCores,
+			netflags:  rollup.Netflags,
+		}
+		series, ok := groups[key]
+		if !ok {
+			series = newSupplyDemandSeries(period.numBuckets)
+			groups[key] = series
+		}
+
+		// Changes made before the period are accounted in the first bucket.
+		var idx uint64
+		if rollup.BucketTS >= period.from {
+			idx = period.bucket(rollup.BucketTS)
+			series.placed[idx] += rollup.Placed
+		}
+		series.active[idx] += int64(rollup.Placed) - int64(rollup.Matched) - int64(rollup.Cancelled)
+		series.volume[idx].Add(series.volume[idx], rollup.PlacedVolume)
+		series.volume[idx].Sub(series.volume[idx], rollup.RemovedVolume)
+	}
+
+	keys := make([]deviceClassKey, 0, len(groups))
+	for key, series := range groups {
+		keys = append(keys, key)
+		for idx := 1; idx < len(series.active); idx++ {
+			series.active[idx] += series.active[idx-1]
+			series.volume[idx].Add(series.volume[idx], series.volume[idx-1])
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].less(keys[j])
+	})
+
+	reply := &pb.SupplyDemandReply{}
+	for idx := uint64(0); idx < period.numBuckets; idx++ {
+		for _, key := range keys {
+			series := groups[key]
+			if series.active[idx] <= 0 && series.placed[idx] == 0 {
+				continue
+			}
+
+			stats := &pb.SupplyDemandStats{
+				Ts:   period.timestamp(idx),
+				Type: key.orderType,
+				DeviceClass: &pb.DeviceClass{
+					GPUMem:   key.gpuMem,
+					CPUCores: key.cpuCores,
+					Netflags: key.netflags,
+				},
+				ActiveVolume: pb.NewBigIntFromInt(0),
+				Placed:       series.placed[idx],
+			}
+			if series.active[idx] > 0 {
+				stats.Active = uint64(series.active[idx])
+			}
+			if series.volume[idx].Sign() > 0 {
+				stats.ActiveVolume = pb.NewBigInt(series.volume[idx])
+			}
+			reply.Stats = append(reply.Stats, stats)
+		}
+	}
+
+	return reply, nil
+}
+
+func (m *DWH) GetMatchStats(ctx context.Context, request *pb.MatchStatsRequest) (*pb.MatchStatsReply, error) {
+	period, err := newAnalyticsPeriod(request.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	rollups, err := m.storage.GetOrderRollups(conn, period.filter(request.GetType(), request.GetIdentityLevel()))
+	if err != nil {
+		m.logger.Warn("failed to GetOrderRollups", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.Internal, "failed to GetMatchStats")
+	}
+
+	reply := &pb.MatchStatsReply{}
+	matchTimes := make([]uint64, period.numBuckets)
+	for idx := uint64(0); idx < period.numBuckets; idx++ {
+		reply.Buckets = append(reply.Buckets, &pb.MatchStats{Ts: period.timestamp(idx)})
+	}
+	for _, rollup := range rollups {
+		idx := period.bucket(rollup.BucketTS)
+		reply.Buckets[idx].Placed += rollup.Placed
+		reply.Buckets[idx].Matched += rollup.Matched
+		reply.Buckets[idx].Cancelled += rollup.Cancelled
+		matchTimes[idx] += rollup.MatchTime
+	}
+
+	for idx, stats := range reply.Buckets {
+		if removed := stats.Matched + stats.Cancelled; removed > 0 {
+			stats.FillRate = float64(stats.Matched) / float64(removed)
+		}
+		if stats.Matched > 0 {
+			stats.TimeToMatch = matchTimes[idx] / stats.Matched
+		}
+	}
+
+	return reply, nil
+}
+
+func (m *DWH) GetPayoutStats(ctx context.Context, request *pb.PayoutStatsRequest) (*pb.PayoutStatsReply, error) {
+	period, err := newAnalyticsPeriod(request.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	rollups, err := m.storage.GetPayoutRollups(conn, period.filter(pb.OrderType_ANY, request.GetIdentityLevel()))
+	if err != nil {
+		m.logger.Warn("failed to GetPayoutRollups", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.Internal, "failed to GetPayoutStats")
+	}
+
+	var (
+		payouts = make([]*big.Int, period.numBuckets)
+		bills   = make([]uint64, period.numBuckets)
+		total   = big.NewInt(0)
+	)
+	for idx := range payouts {
+		payouts[idx] = big.NewInt(0)
+	}
+	for _, rollup := range rollups {
+		idx := period.bucket(rollup.BucketTS)
+		bills[idx] += rollup.Bills
+		payouts[idx].Add(payouts[idx], rollup.Payout)
+		total.Add(total, rollup.Payout)
+	}
+
+	reply := &pb.PayoutStatsReply{Total: pb.NewBigInt(total)}
+	for idx := range payouts {
+		reply.Buckets = append(reply.Buckets, &pb.PayoutStats{
+			Ts:     period.timestamp(uint64(idx)),
+			Bills:  bills[idx],
+			Payout: pb.NewBigInt(payouts[idx]),
+		})
+	}
+
+	return reply, nil
+}
+
+// analyticsPeriod is a validated AnalyticsPeriod, aligned to rollup buckets.
+type analyticsPeriod struct {
+	from       uint64
+	bucketSize uint64
+	numBuckets uint64
+}
+
+func newAnalyticsPeriod(period *pb.AnalyticsPeriod) (*analyticsPeriod, error) {
+	var from, to uint64
+	if period.GetFrom().GetSeconds() > 0 {
+		from = uint64(period.GetFrom().GetSeconds())
+	}
+	if period.GetTo().GetSeconds() > 0 {
+		to = uint64(period.GetTo().GetSeconds())
+	} else {
+		to = uint64(time.Now().Unix())
+	}
+	if from == 0 && to > defaultAnalyticsRange {
+		from = to - defaultAnalyticsRange
+	}
+
+	bucketSize := period.GetBucketSize()
+	if bucketSize == 0 {
+		bucketSize = rollupPeriod
+	}
+	if bucketSize%rollupPeriod != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "bucket size must be a multiple of %d seconds", rollupPeriod)
+	}
+	if from >= to {
+		return nil, status.Error(codes.InvalidArgument, "period must end after it starts")
+	}
+
+	from = rollupBucket(from)
+	numBuckets := (to - from + bucketSize - 1) / bucketSize
+	if numBuckets > maxAnalyticsBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many buckets, at most %d are allowed", maxAnalyticsBuckets)
+	}
+
+	return &analyticsPeriod{from: from, bucketSize: bucketSize, numBuckets: numBuckets}, nil
+}
+
+func (m *analyticsPeriod) filter(orderType pb.OrderType, identityLevels []pb.IdentityLevel) *rollupFilter {
+	return &rollupFilter{
+		From:           m.from,
+		To:             m.from + m.numBuckets*m.bucketSize,
+		Type:           orderType,
+		IdentityLevels: identityLevels,
+	}
+}
+
+func (m *analyticsPeriod) bucket(ts uint64) uint64 {
+	return (ts - m.from) / m.bucketSize
+}
+
+func (m *analyticsPeriod) timestamp(bucket uint64) *pb.Timestamp {
+	return &pb.Timestamp{Seconds: int64(m.from + bucket*m.bucketSize)}
+}
+
+// priceHistogram merges price rollups into bins.
+type priceHistogram map[int64]*priceRollup
+
+func (m priceHistogram) add(rollup *priceRollup) {
+	if rollup.Count == 0 {
+		return
+	}
+
+	bin, ok := m[rollup.Bin]
+	if !ok {
+		m[rollup.Bin] = &priceRollup{
+			Bin:      rollup.Bin,
+			Count:    rollup.Count,
+			MinPrice: rollup.MinPrice,
+			MaxPrice: rollup.MaxPrice,
+		}
+		return
+	}
+
+	bin.Count += rollup.Count
+	bin.MinPrice = math.Min(bin.MinPrice, rollup.MinPrice)
+	bin.MaxPrice = math.Max(bin.MaxPrice, rollup.MaxPrice)
+}
+
+func (m priceHistogram) stats(percentiles []float64) *pb.PriceStats {
+	stats := &pb.PriceStats{Percentiles: make([]float64, len(percentiles))}
+
+	bins := make([]*priceRollup, 0, len(m))
+	for _, bin := range m {
+		bins = append(bins, bin)
+		stats.Count += bin.Count
+	}
+	if stats.Count == 0 {
+		return stats
+	}
+	sort.Slice(bins, func(i, j int) bool {
+		return bins[i].Bin < bins[j].Bin
+	})
+
+	stats.Min = bins[0].MinPrice
+	stats.Max = bins[len(bins)-1].MaxPrice
+	stats.Median = percentile(bins, stats.Count, 50)
+	for idx, value := range percentiles {
+		stats.Percentiles[idx] = percentile(bins, stats.Count, value)
+	}
+
+	return stats
+}
+
+// percentile finds the bin containing the requested rank and interpolates
+// the price between the bin's bounds, assuming prices are spread evenly.
+func percentile(bins []*priceRollup, count uint64, value float64) float64 {
+	rank := value / 100 * float64(count-1)
+
+	var seen uint64
+	for _, bin := range bins {
+		if rank >= float64(seen+bin.Count) {
+			seen += bin.Count
+			continue
+		}
+		if bin.Count == 1 {
+			return bin.MinPrice
+		}
+
+		position := math.Min((rank-float64(seen))/float64(bin.Count-1), 1)
+		return bin.MinPrice + (bin.MaxPrice-bin.MinPrice)*position
+	}
+
+	return bins[len(bins)-1].MaxPrice
+}
@@ -0,0 +1,276 @@
+package dwh
+
+import (
+	"math"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	bch "github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAnalyticsDBPath = "test_analytics_dwh.db"
+	testReplayDBPath    = "test_replay_dwh.db"
+)
+
+func TestDWH_Rollups(t *testing.T) {
+	w, err := getTestDWH(testAnalyticsDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testAnalyticsDBPath)
+	defer w.db.Close()
+
+	var (
+		conn   = newSimpleConn(w.db)
+		base   = uint64(10 * rollupPeriod)
+		period = &pb.AnalyticsPeriod{
+			From:       &pb.Timestamp{Seconds: int64(base)},
+			To:         &pb.Timestamp{Seconds: int64(base + 2*rollupPeriod)},
+			BucketSize: rollupPeriod,
+		}
+	)
+
+	place := func(id, price int64, blockNumber, ts uint64) {
+		order := newTestAdminOrder(t, id)
+		order.OrderType = pb.OrderType_ASK
+		order.Price = pb.NewBigIntFromInt(price)
+		require.NoError(t, w.storage.InsertOrder(conn, &pb.DWHOrder{
+			CreatedTS: &pb.Timestamp{Seconds: int64(ts)},
+			MasterID:  order.GetAuthorID(),
+			Order:     order,
+		}))
+		require.NoError(t, w.updateRollups(conn, &bch.Event{Data: &bch.OrderPlacedData{ID: big.NewInt(id)}, BlockNumber: blockNumber, TS: ts}, nil))
+	}
+	remove := func(id, dealID int64, blockNumber, ts uint64) {
+		snapshot, err := w.storage.GetOrderByID(conn, big.NewInt(id))
+		require.NoError(t, err)
+		require.NoError(t, w.storage.DeleteOrder(conn, big.NewInt(id)))
+		if dealID != 0 {
+			order := *snapshot.GetOrder()
+			order.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+			order.DealID = pb.NewBigIntFromInt(dealID)
+			require.NoError(t, w.storage.InsertOrder(conn, &pb.DWHOrder{
+				CreatedTS: snapshot.GetCreatedTS(),
+				MasterID:  snapshot.GetMasterID(),
+				Order:     &order,
+			}))
+		}
+		require.NoError(t, w.updateRollups(conn, &bch.Event{Data: &bch.OrderUpdatedData{ID: big.NewInt(id)}, BlockNumber: blockNumber, TS: ts}, snapshot))
+	}
+
+	place(94001, 100, 10, base+10)
+	place(94002, 200, 11, base+20)
+	place(94003, 400, 12, base+rollupPeriod+10)
+	remove(94001, 0, 13, base+rollupPeriod+20)
+	remove(94002, 94005, 14, base+rollupPeriod+30)
+
+	bid := newTestAdminOrder(t, 94004)
+	require.NoError(t, w.storage.InsertOrder(conn, &pb.DWHOrder{
+		CreatedTS: &pb.Timestamp{Seconds: int64(base)},
+		MasterID:  bid.GetAuthorID(),
+		Order:     bid,
+	}))
+	require.NoError(t, w.storage.InsertDeal(conn, &pb.Deal{
+		Id:             pb.NewBigIntFromInt(94005),
+		Benchmarks:     bid.GetBenchmarks(),
+		SupplierID:     pb.NewEthAddress(common.HexToAddress("0xE1")),
+		ConsumerID:     pb.NewEthAddress(common.HexToAddress("0xE2")),
+		MasterID:       pb.NewEthAddress(common.HexToAddress("0xE1")),
+		AskID:          pb.NewBigIntFromInt(94002),
+		BidID:          bid.GetId(),
+		Duration:       3600,
+		Price:          pb.NewBigIntFromInt(200),
+		StartTime:      &pb.Timestamp{Seconds: int64(base)},
+		EndTime:        &pb.Timestamp{},
+		Status:         pb.DealStatus_DEAL_ACCEPTED,
+		BlockedBalance: pb.NewBigIntFromInt(0),
+		TotalPayout:    pb.NewBigIntFromInt(0),
+		LastBillTS:     &pb.Timestamp{},
+	}))
+	require.NoError(t, w.updateRollups(conn, &bch.Event{
+		Data:        &bch.BilledData{DealID: big.NewInt(94005), PaidAmount: big.NewInt(1000)},
+		BlockNumber: 15,
+		TS:          base + rollupPeriod + 40,
+	}, nil))
+
+	// Benchmark 2 has the value of 2, so prices per unit are halved.
+	prices, err := w.GetPriceHistory(w.ctx, &pb.PriceHistoryRequest{
+		Period:      period,
+		Type:        pb.OrderType_ASK,
+		BenchmarkID: 2,
+		Percentiles: []float64{0, 100},
+	})
+	require.NoError(t, err)
+	require.Len(t, prices.GetBuckets(), 2)
+	assert.Equal(t, uint64(2), prices.GetBuckets()[0].GetCount())
+	assert.Equal(t, 50.0, prices.GetBuckets()[0].GetMin())
+	assert.Equal(t, 100.0, prices.GetBuckets()[0].GetMax())
+	assert.Equal(t, []float64{50, 100}, prices.GetBuckets()[0].GetPercentiles())
+	assert.Equal(t, 200.0, prices.GetBuckets()[1].GetMedian())
+
+	prices, err = w.GetPriceHistory(w.ctx, &pb.PriceHistoryRequest{
+		Period:        period,
+		Type:          pb.OrderType_ASK,
+		BenchmarkID:   2,
+		IdentityLevel: []pb.IdentityLevel{pb.IdentityLevel_IDENTIFIED},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, prices.GetBuckets()[0].GetCount())
+
+	supply, err := w.GetSupplyDemand(w.ctx, &pb.SupplyDemandRequest{Period: period})
+	require.NoError(t, err)
+	require.Len(t, supply.GetStats(), 2)
+	assert.Equal(t, &pb.DeviceClass{GPUMem: 8, CPUCores: 2}, supply.GetStats()[0].GetDeviceClass())
+	assert.Equal(t, uint64(2), supply.GetStats()[0].GetActive())
+	assert.Equal(t, uint64(2), supply.GetStats()[0].GetPlaced())
+	assert.Equal(t, int64(300), supply.GetStats()[0].GetActiveVolume().Unwrap().Int64())
+	assert.Equal(t, uint64(1), supply.GetStats()[1].GetActive())
+	assert.Equal(t, int64(400), supply.GetStats()[1].GetActiveVolume().Unwrap().Int64())
+
+	matches, err := w.GetMatchStats(w.ctx, &pb.MatchStatsRequest{
+		Period: &pb.AnalyticsPeriod{From: period.From, To: period.To, BucketSize: 2 * rollupPeriod},
+	})
+	require.NoError(t, err)
+	require.Len(t, matches.GetBuckets(), 1)
+	assert.Equal(t, uint64(3), matches.GetBuckets()[0].GetPlaced())
+	assert.Equal(t, uint64(1), matches.GetBuckets()[0].GetMatched())
+	assert.Equal(t, uint64(1), matches.GetBuckets()[0].GetCancelled())
+	assert.Equal(t, 0.5, matches.GetBuckets()[0].GetFillRate())
+	assert.Equal(t, uint64(rollupPeriod+10), matches.GetBuckets()[0].GetTimeToMatch())
+
+	payouts, err := w.GetPayoutStats(w.ctx, &pb.PayoutStatsRequest{Period: period})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), payouts.GetTotal().Unwrap().Int64())
+	assert.Equal(t, uint64(1), payouts.GetBuckets()[1].GetBills())
+
+	// Rollups are restored on chain reorganization.
+	require.NoError(t, w.revertBlocks(12))
+
+	matches, err = w.GetMatchStats(w.ctx, &pb.MatchStatsRequest{Period: period})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), matches.GetBuckets()[1].GetPlaced())
+	assert.Zero(t, matches.GetBuckets()[1].GetMatched())
+	assert.Zero(t, matches.GetBuckets()[1].GetCancelled())
+
+	payouts, err = w.GetPayoutStats(w.ctx, &pb.PayoutStatsRequest{Period: period})
+	require.NoError(t, err)
+	assert.Zero(t, payouts.GetTotal().Unwrap().Sign())
+
+	// Removals are counted with the identity level orders have been placed
+	// with, while orders placed before rollups are not counted at all.
+	place(94007, 100, 13, base+rollupPeriod+10)
+	require.NoError(t, w.storage.UpdateOrders(conn, &pb.Profile{
+		UserID:        newTestAdminOrder(t, 94007).GetAuthorID(),
+		IdentityLevel: uint64(pb.IdentityLevel_IDENTIFIED),
+	}))
+	remove(94007, 0, 14, base+rollupPeriod+20)
+
+	legacy := newTestAdminOrder(t, 94006)
+	require.NoError(t, w.storage.InsertOrder(conn, &pb.DWHOrder{
+		CreatedTS: &pb.Timestamp{Seconds: int64(base)},
+		MasterID:  legacy.GetAuthorID(),
+		Order:     legacy,
+	}))
+	remove(94006, 0, 15, base+rollupPeriod+30)
+
+	for level, cancelled := range map[pb.IdentityLevel]uint64{pb.IdentityLevel_ANONYMOUS: 1, pb.IdentityLevel_IDENTIFIED: 0} {
+		matches, err = w.GetMatchStats(w.ctx, &pb.MatchStatsRequest{Period: period, IdentityLevel: []pb.IdentityLevel{level}})
+		require.NoError(t, err)
+		assert.Equal(t, cancelled, matches.GetBuckets()[1].GetCancelled(), level.String())
+	}
+
+	_, err = w.GetMatchStats(w.ctx, &pb.MatchStatsRequest{
+		Period: &pb.AnalyticsPeriod{From: period.From, To: period.To, BucketSize: 10},
+	})
+	assert.Error(t, err)
+}
+
+func TestDWH_ReplayedEventsAreSkipped(t *testing.T) {
+	w, err := getTestDWH(testReplayDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testReplayDBPath)
+	defer w.db.Close()
+
+	var (
+		controller = gomock.NewController(t)
+		mockBlock  = bch.NewMockAPI(controller)
+		mockMarket = bch.NewMockMarketAPI(controller)
+		base       = uint64(10 * rollupPeriod)
+		order      = newTestAdminOrder(t, 96001)
+		deal       = &pb.Deal{
+			Id:         pb.NewBigIntFromInt(96002),
+			SupplierID: pb.NewEthAddress(common.HexToAddress("0xE1")),
+			ConsumerID: pb.NewEthAddress(common.HexToAddress("0xE2")),
+			MasterID:   pb.NewEthAddress(common.HexToAddress("0xE1")),
+			Status:     pb.DealStatus_DEAL_CLOSED,
+		}
+		period = &pb.AnalyticsPeriod{
+			From:       &pb.Timestamp{Seconds: int64(base)},
+			To:         &pb.Timestamp{Seconds: int64(base + rollupPeriod)},
+			BucketSize: rollupPeriod,
+		}
+	)
+	defer controller.Finish()
+	order.OrderType = pb.OrderType_ASK
+	mockMarket.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().Return(order, nil)
+	mockMarket.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().Return(deal, nil)
+	mockBlock.EXPECT().Market().AnyTimes().Return(mockMarket)
+	w.blockchain = mockBlock
+
+	require.NoError(t, w.storage.StoreStaleID(newSimpleConn(w.db), deal.GetId().Unwrap(), "Deal"))
+
+	events := []*bch.Event{
+		{Data: &bch.OrderPlacedData{ID: order.GetId().Unwrap()}, BlockNumber: 10, TS: base + 10, LogIndex: 0},
+		{Data: &bch.BilledData{DealID: deal.GetId().Unwrap(), PaidAmount: big.NewInt(1000)}, BlockNumber: 10,
+			TS: base + 10, LogIndex: 1},
+	}
+	// The last known block is replayed on restart.
+	for idx := 0; idx < 2; idx++ {
+		for _, event := range events {
+			require.NoError(t, w.processEvent(event))
+		}
+	}
+
+	matches, err := w.GetMatchStats(w.ctx, &pb.MatchStatsRequest{Period: period})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), matches.GetBuckets()[0].GetPlaced())
+
+	// Changes are logged along with the event, so they are not duplicated.
+	entries, err := w.storage.GetChangeLog(newSimpleConn(w.db), journalEntityOrder, 0, math.MaxInt64, changeLogBatch)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Bills of stale deals are counted, so payouts match the payments.
+	payouts, err := w.GetPayoutStats(w.ctx, &pb.PayoutStatsRequest{Period: period})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), payouts.GetTotal().Unwrap().Int64())
+	assert.Equal(t, uint64(1), payouts.GetBuckets()[0].GetBills())
+}
+
+func TestPriceHistogram(t *testing.T) {
+	histogram := priceHistogram{}
+	for _, price := range []float64{10, 10.1, 20, 40, 80} {
+		rollup := &priceRollup{Bin: priceBin(price)}
+		rollup.add(price)
+		histogram.add(rollup)
+	}
+	histogram.add(&priceRollup{Bin: priceBin(0)})
+
+	stats := histogram.stats([]float64{0, 25, 100})
+	assert.Equal(t, uint64(5), stats.GetCount())
+	assert.Equal(t, 10.0, stats.GetMin())
+	assert.Equal(t, 80.0, stats.GetMax())
+	assert.Equal(t, 20.0, stats.GetMedian())
+	assert.Equal(t, []float64{10, 10.1, 80}, stats.GetPercentiles())
+}
+
+func TestGPUMemClass(t *testing.T) {
+	assert.Equal(t, uint64(0), gpuMemClass(0))
+	assert.Equal(t, uint64(4096), gpuMemClass(4096))
+	assert.Equal(t, uint64(4096), gpuMemClass(8191))
+}
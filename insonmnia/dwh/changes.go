@@ -0,0 +1,332 @@
+package dwh
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// changeLogDepth is the number of the latest blocks, whose changes can be
+	// resent to resumed subscriptions.
+	changeLogDepth = 50000
+	// changeLogBatch is the maximum number of changes read at once.
+	changeLogBatch = 100
+)
+
+// changeLogEntry is a stored change of an order or a deal. Event is the
+// JSON-encoded DWHOrderEvent or DWHDealEvent.
+type changeLogEntry struct {
+	Id          uint64
+	BlockNumber uint64
+	Entity      string
+	Event       []byte
+}
+
+// changeNotifier wakes up subscriptions when new changes are committed. The
+// zero value is ready to use.
+//
+// Changes are identified by auto-incremented IDs, which are assigned on
+// insertion, so concurrent transactions may commit them out of order. The
+// watermark is the ID of the last change, below which all changes are known
+// to be committed, only such changes are streamed.
+type changeNotifier struct {
+	mu        sync.Mutex
+	notify    chan struct{}
+	watermark uint64
+}
+
+// Wait returns the current watermark and the channel, that is closed on the
+// next notification.
+func (m *changeNotifier) Wait() (uint64, <-chan struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.notify == nil {
+		m.notify = make(chan struct{})
+	}
+
+	return m.watermark, m.notify
+}
+
+// Notify advances the watermark to the given change ID, waking up
+// subscriptions.
+func (m *changeNotifier) Notify(watermark uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if watermark > m.watermark {
+		m.watermark = watermark
+	}
+	if m.notify != nil {
+		close(m.notify)
+		m.notify = nil
+	}
+}
+
+// writeChanges runs the function, which stores changes, possibly in several
+// concurrent transactions, waiting for all of them to finish, and then makes
+// the stored changes visible to subscriptions. Writers are serialized, so
+// all changes below the watermark are committed.
+func (m *DWH) writeChanges(fn func()) {
+	m.changeLogMu.Lock()
+	defer m.changeLogMu.Unlock()
+
+	fn()
+	m.advanceChangeLog()
+}
+
+// advanceChangeLog advances the watermark to the last stored change. Must be
+// called when no transactions storing changes are in progress.
+func (m *DWH) advanceChangeLog() {
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	id, err := m.storage.GetLastChangeLogID(conn)
+	if err != nil {
+		// The watermark is advanced on the next write.
+		m.logger.Warn("failed to GetLastChangeLogID", util.LaconicError(err))
+		return
+	}
+
+	m.changes.Notify(id)
+}
+
+// snapshotEntity returns the state of the order or the deal the event is
+// going to remove, so the removal can be still reported to subscriptions.
+func (m *DWH) snapshotEntity(conn queryConn, event *blockchain.Event) interface{} {
+	switch value := event.Data.(type) {
+	case *blockchain.OrderUpdatedData:
+		if order, err := m.storage.GetOrderByID(conn, value.ID); err == nil {
+			return order
+		}
+	case *blockchain.DealUpdatedData:
+		if deal, err := m.storage.GetDealByID(conn, value.ID); err == nil {
+			return deal
+		}
+	}
+
+	return nil
+}
+
+// isChangeLogEvent reports whether the event changes orders or deals, which
+// are sent to subscriptions.
+func isChangeLogEvent(event *blockchain.Event) bool {
+	switch event.Data.(type) {
+	case *blockchain.OrderPlacedData, *blockchain.OrderUpdatedData, *blockchain.DealOpenedData,
+		*blockchain.DealUpdatedData, *blockchain.BilledData, *blockchain.DealChangeRequestSentData,
+		*blockchain.DealChangeRequestUpdatedData:
+		return true
+	default:
+		return false
+	}
+}
+
+// logChange stores the change made by the event. It must be called in the
+// same transaction the event is applied in, subscriptions are notified after
+// all transactions of the events batch are committed.
+func (m *DWH) logChange(conn queryConn, event *blockchain.Event, snapshot interface{}) error {
+	switch value := event.Data.(type) {
+	case *blockchain.OrderPlacedData:
+		return m.logOrderChange(conn, event.BlockNumber, pb.DWHOrderEvent_PLACED, value.ID, nil)
+	case *blockchain.OrderUpdatedData:
+		order, _ := snapshot.(*pb.DWHOrder)
+		return m.logOrderChange(conn, event.BlockNumber, pb.DWHOrderEvent_UPDATED, value.ID, order)
+	case *blockchain.DealOpenedData:
+		return m.logDealChange(conn, event.BlockNumber, pb.DWHDealEvent_OPENED, value.ID, nil, nil)
+	case *blockchain.DealUpdatedData:
+		deal, _ := snapshot.(*pb.DWHDeal)
+		return m.logDealChange(conn, event.BlockNumber, pb.DWHDealEvent_UPDATED, value.ID, deal, nil)
+	case *blockchain.BilledData:
+		return m.logDealChange(conn, event.BlockNumber, pb.DWHDealEvent_BILLED, value.DealID, nil, nil)
+	case *blockchain.DealChangeRequestSentData:
+		return m.logChangeRequestChange(conn, event.BlockNumber, pb.DWHDealEvent_CHANGE_REQUEST_SENT, value.ID)
+	case *blockchain.DealChangeRequestUpdatedData:
+		return m.logChangeRequestChange(conn, event.BlockNumber, pb.DWHDealEvent_CHANGE_REQUEST_UPDATED, value.ID)
+	}
+
+	return nil
+}
+
+// logOrderChange stores the current state of the order. If the order is
+// removed, the given snapshot is stored as inactive instead. Nothing is
+// stored if there is neither, unless the change is a revert.
+func (m *DWH) logOrderChange(conn queryConn, blockNumber uint64, eventType pb.DWHOrderEvent_Type, orderID *big.Int, snapshot *pb.DWHOrder) error {
+	order, err := m.storage.GetOrderByID(conn, orderID)
+	if err != nil && eventType != pb.DWHOrderEvent_REVERTED {
+		if snapshot == nil {
+			return nil
+		}
+		order = snapshot
+		order.GetOrder().OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+	}
+
+	data, err := json.Marshal(&pb.DWHOrderEvent{
+		BlockNumber: blockNumber,
+		Type:        eventType,
+		OrderID:     pb.NewBigInt(orderID),
+		Order:       order,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal order event")
+	}
+
+	return m.storage.InsertChangeLogEntry(conn, &changeLogEntry{
+		BlockNumber: blockNumber,
+		Entity:      journalEntityOrder,
+		Event:       data,
+	})
+}
+
+// logDealChange stores the current state of the deal the same way as
+// logOrderChange does, except that removed deals are stored as closed.
+func (m *DWH) logDealChange(conn queryConn, blockNumber uint64, eventType pb.DWHDealEvent_Type, dealID *big.Int,
+	snapshot *pb.DWHDeal, changeRequest *pb.DealChangeRequest) error {
+	deal, err := m.storage.GetDealByID(conn, dealID)
+	if err != nil && eventType != pb.DWHDealEvent_REVERTED {
+		if snapshot == nil {
+			return nil
+		}
+		deal = snapshot
+		deal.GetDeal().Status = pb.DealStatus_DEAL_CLOSED
+	}
+
+	data, err := json.Marshal(&pb.DWHDealEvent{
+		BlockNumber:   blockNumber,
+		Type:          eventType,
+		DealID:        pb.NewBigInt(dealID),
+		Deal:          deal,
+		ChangeRequest: changeRequest,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal deal event")
+	}
+
+	return m.storage.InsertChangeLogEntry(conn, &changeLogEntry{
+		BlockNumber: blockNumber,
+		Entity:      journalEntityDeal,
+		Event:       data,
+	})
+}
+
+func (m *DWH) logChangeRequestChange(conn queryConn, blockNumber uint64, eventType pb.DWHDealEvent_Type, changeRequestID *big.Int) error {
+	changeRequest, err := m.blockchain.Market().GetDealChangeRequestInfo(m.ctx, changeRequestID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetDealChangeRequestInfo")
+	}
+
+	return m.logDealChange(conn, blockNumber, eventType, changeRequest.GetDealID().Unwrap(), nil, changeRequest)
+}
+
+func (m *DWH) pruneChangeLog(blockNumber uint64) {
+	if blockNumber <= changeLogDepth {
+		return
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	if err := m.storage.PruneChangeLog(conn, blockNumber-changeLogDepth); err != nil {
+		m.logger.Warn("failed to PruneChangeLog", util.LaconicError(err))
+	}
+}
+
+func (m *DWH) SubscribeOrders(request *pb.OrdersSubscribeRequest, stream pb.DWHChanges_SubscribeOrdersServer) error {
+	filter := request.GetFilter()
+	if filter == nil {
+		filter = &pb.OrdersRequest{}
+	}
+
+	return m.tailChangeLog(stream.Context(), journalEntityOrder, request.GetFromBlock(), func(entry *changeLogEntry) error {
+		event := &pb.DWHOrderEvent{}
+		if err := json.Unmarshal(entry.Event, event); err != nil {
+			return status.Errorf(codes.Internal, "failed to unmarshal order event %d", entry.Id)
+		}
+		event.Id = entry.Id
+
+		if event.GetOrder() != nil && !matchOrder(filter, event.GetOrder()) {
+			return nil
+		}
+
+		return stream.Send(event)
+	})
+}
+
+func (m *DWH) SubscribeDeals(request *pb.DealsSubscribeRequest, stream pb.DWHChanges_SubscribeDealsServer) error {
+	filter := request.GetFilter()
+	if filter == nil {
+		filter = &pb.DealsRequest{}
+	}
+
+	return m.tailChangeLog(stream.Context(), journalEntityDeal, request.GetFromBlock(), func(entry *changeLogEntry) error {
+		event := &pb.DWHDealEvent{}
+		if err := json.Unmarshal(entry.Event, event); err != nil {
+			return status.Errorf(codes.Internal, "failed to unmarshal deal event %d", entry.Id)
+		}
+		event.Id = entry.Id
+
+		if event.GetDeal() != nil && !matchDeal(filter, event.GetDeal()) {
+			return nil
+		}
+
+		return stream.Send(event)
+	})
+}
+
+// tailChangeLog passes stored changes of the given entity to the callback,
+// starting from the given block or from the new ones if it is zero, until
+// the context is done.
+func (m *DWH) tailChangeLog(ctx context.Context, entity string, fromBlock uint64, fn func(entry *changeLogEntry) error) error {
+	if lastKnownBlock := m.currentBlock(); fromBlock != 0 && fromBlock+changeLogDepth <= lastKnownBlock {
+		return status.Errorf(codes.OutOfRange, "changes before block %d are not available", lastKnownBlock-changeLogDepth+1)
+	}
+
+	conn := newSimpleConn(m.db)
+	cursor, err := m.storage.GetChangeLogCursor(conn, fromBlock)
+	conn.Finish()
+	if err != nil {
+		m.logger.Warn("failed to GetChangeLogCursor", util.LaconicError(err))
+		return status.Error(codes.Internal, "failed to GetChangeLogCursor")
+	}
+
+	for {
+		// Wait channel must be obtained before reading, otherwise changes
+		// committed in between are noticed on the next notification only.
+		watermark, wait := m.changes.Wait()
+
+		conn := newSimpleConn(m.db)
+		entries, err := m.storage.GetChangeLog(conn, entity, cursor, watermark, changeLogBatch)
+		conn.Finish()
+		if err != nil {
+			m.logger.Warn("failed to GetChangeLog", util.LaconicError(err))
+			return status.Error(codes.Internal, "failed to GetChangeLog")
+		}
+
+		for _, entry := range entries {
+			if err := fn(entry); err != nil {
+				return err
+			}
+			cursor = entry.Id
+		}
+
+		if len(entries) == changeLogBatch {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-m.ctx.Done():
+			return status.Error(codes.Unavailable, "DWH is shutting down")
+		case <-wait:
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package dwh
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const testChangesDBPath = "test_changes_dwh.db"
+
+type testOrdersStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.DWHOrderEvent
+}
+
+func (m *testOrdersStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *testOrdersStream) Send(event *pb.DWHOrderEvent) error {
+	m.events <- event
+	return nil
+}
+
+func TestDWH_SubscribeOrders(t *testing.T) {
+	w, err := getTestDWH(testChangesDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testChangesDBPath)
+	defer w.db.Close()
+
+	conn := newSimpleConn(w.db)
+	insertOrder := func(id int64, orderType pb.OrderType, blockNumber uint64) {
+		order := newTestAdminOrder(t, id)
+		order.OrderType = orderType
+		require.NoError(t, w.storage.InsertOrder(conn, &pb.DWHOrder{
+			CreatedTS: &pb.Timestamp{Seconds: 5},
+			MasterID:  order.GetAuthorID(),
+			Order:     order,
+		}))
+		require.NoError(t, w.logOrderChange(conn, blockNumber, pb.DWHOrderEvent_PLACED, order.GetId().Unwrap(), nil))
+		w.advanceChangeLog()
+	}
+
+	insertOrder(93001, pb.OrderType_BID, 10)
+	insertOrder(93002, pb.OrderType_ASK, 11)
+	insertOrder(93003, pb.OrderType_BID, 12)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &testOrdersStream{ctx: ctx, events: make(chan *pb.DWHOrderEvent, 16)}
+	go w.SubscribeOrders(&pb.OrdersSubscribeRequest{
+		Filter:    &pb.OrdersRequest{Type: pb.OrderType_BID},
+		FromBlock: 11,
+	}, stream)
+
+	receive := func() *pb.DWHOrderEvent {
+		select {
+		case event := <-stream.events:
+			return event
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no event received")
+			return nil
+		}
+	}
+
+	// Changes from the earlier blocks and not matching the filter are skipped.
+	event := receive()
+	assert.Equal(t, uint64(12), event.GetBlockNumber())
+	assert.Equal(t, int64(93003), event.GetOrderID().Unwrap().Int64())
+	assert.Equal(t, pb.DWHOrderEvent_PLACED, event.GetType())
+
+	// Removed orders are sent in their last state.
+	snapshot, err := w.storage.GetOrderByID(conn, newTestAdminOrder(t, 93003).GetId().Unwrap())
+	require.NoError(t, err)
+	require.NoError(t, w.storage.DeleteOrder(conn, snapshot.GetOrder().GetId().Unwrap()))
+	require.NoError(t, w.logOrderChange(conn, 13, pb.DWHOrderEvent_UPDATED, snapshot.GetOrder().GetId().Unwrap(), snapshot))
+	w.advanceChangeLog()
+
+	next := receive()
+	assert.True(t, next.GetId() > event.GetId())
+	assert.Equal(t, pb.DWHOrderEvent_UPDATED, next.GetType())
+	assert.Equal(t, pb.OrderStatus_ORDER_INACTIVE, next.GetOrder().GetOrder().GetOrderStatus())
+
+	insertOrder(93004, pb.OrderType_BID, 14)
+	assert.Equal(t, int64(93004), receive().GetOrderID().Unwrap().Int64())
+
+	// Changes above the watermark may be not committed yet and are not sent
+	// until it is advanced.
+	order := newTestAdminOrder(t, 93005)
+	order.OrderType = pb.OrderType_BID
+	require.NoError(t, w.logOrderChange(conn, 15, pb.DWHOrderEvent_PLACED, order.GetId().Unwrap(), &pb.DWHOrder{Order: order}))
+	w.changes.Notify(0)
+	select {
+	case event := <-stream.events:
+		require.FailNow(t, "uncommitted change sent", "%v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+	w.advanceChangeLog()
+	assert.Equal(t, int64(93005), receive().GetOrderID().Unwrap().Int64())
+
+	w.setCurrentBlock(changeLogDepth+20, common.Hash{})
+	err = w.SubscribeOrders(&pb.OrdersSubscribeRequest{FromBlock: 10}, stream)
+	assert.Error(t, err)
+}
+
+func TestMatchOrder(t *testing.T) {
+	order := &pb.DWHOrder{
+		CreatedTS:            &pb.Timestamp{Seconds: 100},
+		CreatorIdentityLevel: 2,
+		MasterID:             pb.NewEthAddress(common.HexToAddress("0xE1")),
+		Order: &pb.Order{
+			Id:             pb.NewBigIntFromInt(1),
+			DealID:         pb.NewBigIntFromInt(0),
+			OrderType:      pb.OrderType_ASK,
+			AuthorID:       pb.NewEthAddress(common.HexToAddress("0xE2")),
+			CounterpartyID: pb.NewEthAddress(common.Address{}),
+			Duration:       3600,
+			Price:          pb.NewBigIntFromInt(100),
+			Netflags:       3,
+			Benchmarks:     &pb.Benchmarks{Values: []uint64{10, 20}},
+		},
+	}
+
+	tests := []struct {
+		filter *pb.OrdersRequest
+		match  bool
+	}{
+		{&pb.OrdersRequest{}, true},
+		{&pb.OrdersRequest{Type: pb.OrderType_BID}, false},
+		{&pb.OrdersRequest{MasterID: pb.NewEthAddress(common.HexToAddress("0xE1"))}, true},
+		{&pb.OrdersRequest{AuthorID: pb.NewEthAddress(common.HexToAddress("0xE1"))}, false},
+		{&pb.OrdersRequest{CounterpartyID: []*pb.EthAddress{pb.NewEthAddress(common.Address{})}}, true},
+		{&pb.OrdersRequest{Duration: &pb.MaxMinUint64{Min: 3601}}, false},
+		{&pb.OrdersRequest{Price: &pb.MaxMinBig{Max: pb.NewBigIntFromInt(99)}}, false},
+		{&pb.OrdersRequest{Price: &pb.MaxMinBig{Min: pb.NewBigIntFromInt(100)}}, true},
+		{&pb.OrdersRequest{Netflags: &pb.CmpUint64{Value: 1, Operator: pb.CmpOp_GTE}}, true},
+		{&pb.OrdersRequest{Netflags: &pb.CmpUint64{Value: 1, Operator: pb.CmpOp_LTE}}, false},
+		{&pb.OrdersRequest{CreatorIdentityLevel: []pb.IdentityLevel{pb.IdentityLevel_ANONYMOUS}}, false},
+		{&pb.OrdersRequest{CreatedTS: &pb.MaxMinTimestamp{Min: &pb.Timestamp{Seconds: 101}}}, false},
+		{&pb.OrdersRequest{Benchmarks: map[uint64]*pb.MaxMinUint64{1: {Min: 20, Max: 30}}}, true},
+		{&pb.OrdersRequest{Benchmarks: map[uint64]*pb.MaxMinUint64{0: {Min: 11}}}, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.match, matchOrder(test.filter, order), "%v", test.filter)
+	}
+}
+
+func TestMatchDeal(t *testing.T) {
+	deal := &pb.DWHDeal{
+		Netflags:         1,
+		AskIdentityLevel: 1,
+		BidIdentityLevel: 3,
+		Deal: &pb.Deal{
+			Status:     pb.DealStatus_DEAL_ACCEPTED,
+			SupplierID: pb.NewEthAddress(common.HexToAddress("0xE1")),
+			ConsumerID: pb.NewEthAddress(common.HexToAddress("0xE2")),
+			AskID:      pb.NewBigIntFromInt(1),
+			BidID:      pb.NewBigIntFromInt(2),
+			Duration:   3600,
+			Price:      pb.NewBigIntFromInt(100),
+			Benchmarks: &pb.Benchmarks{Values: []uint64{10}},
+		},
+	}
+
+	tests := []struct {
+		filter *pb.DealsRequest
+		match  bool
+	}{
+		{&pb.DealsRequest{}, true},
+		{&pb.DealsRequest{Status: pb.DealStatus_DEAL_CLOSED}, false},
+		{&pb.DealsRequest{ConsumerID: pb.NewEthAddress(common.HexToAddress("0xE2"))}, true},
+		{&pb.DealsRequest{SupplierID: pb.NewEthAddress(common.HexToAddress("0xE2"))}, false},
+		{&pb.DealsRequest{BidID: pb.NewBigIntFromInt(2)}, true},
+		{&pb.DealsRequest{AskIdentityLevel: pb.IdentityLevel_IDENTIFIED}, false},
+		{&pb.DealsRequest{BidIdentityLevel: pb.IdentityLevel_IDENTIFIED}, true},
+		{&pb.DealsRequest{Duration: &pb.MaxMinUint64{Max: 3599}}, false},
+		{&pb.DealsRequest{Benchmarks: map[uint64]*pb.MaxMinUint64{5: {Min: 1}}}, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.match, matchDeal(test.filter, deal), "%v", test.filter)
+	}
+}
@@ -1,6 +1,7 @@
 package dwh
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jinzhu/configor"
 	"github.com/pkg/errors"
 	"github.com/sonm-io/core/accounts"
@@ -9,15 +10,17 @@ import (
 )
 
 type Config struct {
-	Logging           LoggingConfig      `yaml:"logging"`
-	GRPCListenAddr    string             `yaml:"grpc_address" default:"127.0.0.1:15021"`
-	HTTPListenAddr    string             `yaml:"http_address" default:"127.0.0.1:15022"`
-	Eth               accounts.EthConfig `yaml:"ethereum" required:"true"`
-	Storage           *storageConfig     `yaml:"storage" required:"true"`
-	Blockchain        *blockchain.Config `yaml:"blockchain" required:"true"`
-	MetricsListenAddr string             `yaml:"metrics_listen_addr" default:"127.0.0.1:14004"`
-	ColdStart         bool               `yaml:"cold_start"`
-	NumWorkers        int                `yaml:"num_workers" default:"64"`
+	Logging             LoggingConfig      `yaml:"logging"`
+	GRPCListenAddr      string             `yaml:"grpc_address" default:"127.0.0.1:15021"`
+	HTTPListenAddr      string             `yaml:"http_address" default:"127.0.0.1:15022"`
+	AdminHTTPListenAddr string             `yaml:"admin_http_address"`
+	Admin               *common.Address    `yaml:"admin"`
+	Eth                 accounts.EthConfig `yaml:"ethereum" required:"true"`
+	Storage             *storageConfig     `yaml:"storage" required:"true"`
+	Blockchain          *blockchain.Config `yaml:"blockchain" required:"true"`
+	MetricsListenAddr   string             `yaml:"metrics_listen_addr" default:"127.0.0.1:14004"`
+	ColdStart           bool               `yaml:"cold_start"`
+	NumWorkers          int                `yaml:"num_workers" default:"64"`
 }
 
 type storageConfig struct {
@@ -0,0 +1,172 @@
+package dwh
+
+import (
+	pb "github.com/sonm-io/core/proto"
+)
+
+// matchOrder reports whether the order satisfies the filter the same way as
+// GetOrders does, except that the order status is not checked.
+func matchOrder(r *pb.OrdersRequest, order *pb.DWHOrder) bool {
+	o := order.GetOrder()
+
+	if !r.DealID.IsZero() && r.DealID.Unwrap().Cmp(o.GetDealID().Unwrap()) != 0 {
+		return false
+	}
+	if r.Type > 0 && r.Type != o.GetOrderType() {
+		return false
+	}
+	if !r.AuthorID.IsZero() && r.AuthorID.Unwrap() != o.GetAuthorID().Unwrap() {
+		return false
+	}
+	if !r.MasterID.IsZero() && r.MasterID.Unwrap() != order.GetMasterID().Unwrap() {
+		return false
+	}
+	if len(r.CounterpartyID) > 0 {
+		found := false
+		for _, id := range r.CounterpartyID {
+			if id.Unwrap() == o.GetCounterpartyID().Unwrap() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !matchDuration(r.Duration, o.GetDuration()) {
+		return false
+	}
+	if !matchPrice(r.Price, o.GetPrice()) {
+		return false
+	}
+	if !matchNetflags(r.Netflags, o.GetNetflags()) {
+		return false
+	}
+	if len(r.CreatorIdentityLevel) > 0 {
+		found := false
+		for _, level := range r.CreatorIdentityLevel {
+			if uint64(level) == order.GetCreatorIdentityLevel() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if r.CreatedTS != nil {
+		createdTS := order.GetCreatedTS().GetSeconds()
+		if r.CreatedTS.GetMax().GetSeconds() > 0 && createdTS > r.CreatedTS.GetMax().GetSeconds() {
+			return false
+		}
+		if r.CreatedTS.GetMin().GetSeconds() > 0 && createdTS < r.CreatedTS.GetMin().GetSeconds() {
+			return false
+		}
+	}
+
+	return matchBenchmarks(r.Benchmarks, o.GetBenchmarks())
+}
+
+// matchDeal reports whether the deal satisfies the filter the same way as
+// GetDeals does.
+func matchDeal(r *pb.DealsRequest, deal *pb.DWHDeal) bool {
+	d := deal.GetDeal()
+
+	if r.Status > 0 && r.Status != d.GetStatus() {
+		return false
+	}
+	if !r.SupplierID.IsZero() && r.SupplierID.Unwrap() != d.GetSupplierID().Unwrap() {
+		return false
+	}
+	if !r.ConsumerID.IsZero() && r.ConsumerID.Unwrap() != d.GetConsumerID().Unwrap() {
+		return false
+	}
+	if !r.MasterID.IsZero() && r.MasterID.Unwrap() != d.GetMasterID().Unwrap() {
+		return false
+	}
+	if !r.AskID.IsZero() && r.AskID.Unwrap().Cmp(d.GetAskID().Unwrap()) != 0 {
+		return false
+	}
+	if !r.BidID.IsZero() && r.BidID.Unwrap().Cmp(d.GetBidID().Unwrap()) != 0 {
+		return false
+	}
+	if !matchDuration(r.Duration, d.GetDuration()) {
+		return false
+	}
+	if !matchPrice(r.Price, d.GetPrice()) {
+		return false
+	}
+	if !matchNetflags(r.Netflags, deal.GetNetflags()) {
+		return false
+	}
+	if r.AskIdentityLevel > 0 && deal.GetAskIdentityLevel() < uint64(r.AskIdentityLevel) {
+		return false
+	}
+	if r.BidIdentityLevel > 0 && deal.GetBidIdentityLevel() < uint64(r.BidIdentityLevel) {
+		return false
+	}
+
+	return matchBenchmarks(r.Benchmarks, d.GetBenchmarks())
+}
+
+func matchDuration(condition *pb.MaxMinUint64, duration uint64) bool {
+	if condition == nil {
+		return true
+	}
+	if condition.Max > 0 && duration > condition.Max {
+		return false
+	}
+
+	return duration >= condition.Min
+}
+
+func matchPrice(condition *pb.MaxMinBig, price *pb.BigInt) bool {
+	if condition == nil {
+		return true
+	}
+	if condition.Max != nil && price.Unwrap().Cmp(condition.Max.Unwrap()) > 0 {
+		return false
+	}
+	if condition.Min != nil && price.Unwrap().Cmp(condition.Min.Unwrap()) < 0 {
+		return false
+	}
+
+	return true
+}
+
+// matchNetflags mirrors newNetflagsWhere: GTE requires all the flags from
+// the condition to be set, LTE requires no flags except the ones from the
+// condition to be set.
+func matchNetflags(condition *pb.CmpUint64, netflags uint64) bool {
+	if condition == nil || condition.Value == 0 {
+		return true
+	}
+
+	switch condition.Operator {
+	case pb.CmpOp_GTE:
+		return netflags&condition.Value == condition.Value
+	case pb.CmpOp_LTE:
+		return netflags&condition.Value == netflags
+	default:
+		return netflags == condition.Value
+	}
+}
+
+func matchBenchmarks(conditions map[uint64]*pb.MaxMinUint64, benchmarks *pb.Benchmarks) bool {
+	values := benchmarks.GetValues()
+	for benchID, condition := range conditions {
+		var value uint64
+		if benchID < uint64(len(values)) {
+			value = values[benchID]
+		}
+
+		if condition.Max > 0 && value > condition.Max {
+			return false
+		}
+		if condition.Min > 0 && value < condition.Min {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,743 @@
+package dwh
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// blockJournalDepth is the number of the latest blocks, whose changes
+	// can be reverted on chain reorganization.
+	blockJournalDepth = 1024
+
+	journalEntityOrder         = "Order"
+	journalEntityDeal          = "Deal"
+	journalEntityOrderRollup   = "OrderRollup"
+	journalEntityPriceRollup   = "PriceRollup"
+	journalEntityPayoutRollup  = "PayoutRollup"
+	journalEntityRolledUpOrder = "RolledUpOrder"
+	journalEntityWorker        = "Worker"
+	journalEntityBlacklist     = "Blacklist"
+	journalEntityValidator     = "Validator"
+	journalEntityProfile       = "Profile"
+)
+
+// blockJournalEntry is the state of a market entity before it was modified
+// by an event from the given block. Empty snapshot means that the entity did
+// not exist.
+type blockJournalEntry struct {
+	BlockNumber uint64
+	Entity      string
+	EntityID    string
+	Snapshot    []byte
+}
+
+// dealSnapshot is the state of a deal with the rows depending on it.
+type dealSnapshot struct {
+	Deal           *pb.Deal                `json:"deal"`
+	Conditions     []*pb.DealCondition     `json:"conditions"`
+	ChangeRequests []*pb.DealChangeRequest `json:"changeRequests"`
+}
+
+// profileSnapshot is the state of a profile with the certificates it is
+// built from.
+type profileSnapshot struct {
+	Profile      *pb.Profile       `json:"profile"`
+	Certificates []*pb.Certificate `json:"certificates"`
+}
+
+// journalEvent saves the state of market entities the event is going to
+// modify, so the changes can be reverted if the event's block is removed
+// from the chain.
+func (m *DWH) journalEvent(conn queryConn, event *blockchain.Event) error {
+	switch value := event.Data.(type) {
+	case *blockchain.OrderPlacedData:
+		return m.journalOrder(conn, event.BlockNumber, value.ID)
+	case *blockchain.OrderUpdatedData:
+		return m.journalOrder(conn, event.BlockNumber, value.ID)
+	case *blockchain.DealOpenedData:
+		return m.journalDeal(conn, event.BlockNumber, value.ID)
+	case *blockchain.DealUpdatedData:
+		// Closing the deal also removes its orders.
+		if deal, err := m.storage.GetDealByID(conn, value.ID); err == nil {
+			if err := m.journalOrder(conn, event.BlockNumber, deal.GetDeal().GetAskID().Unwrap()); err != nil {
+				return err
+			}
+			if err := m.journalOrder(conn, event.BlockNumber, deal.GetDeal().GetBidID().Unwrap()); err != nil {
+				return err
+			}
+		}
+		return m.journalDeal(conn, event.BlockNumber, value.ID)
+	case *blockchain.BilledData:
+		return m.journalDeal(conn, event.BlockNumber, value.DealID)
+	case *blockchain.DealChangeRequestSentData:
+		return m.journalChangeRequest(conn, event.BlockNumber, value.ID)
+	case *blockchain.DealChangeRequestUpdatedData:
+		return m.journalChangeRequest(conn, event.BlockNumber, value.ID)
+	case *blockchain.WorkerAnnouncedData:
+		return m.journalWorker(conn, event.BlockNumber, value.MasterID, value.WorkerID)
+	case *blockchain.WorkerConfirmedData:
+		return m.journalWorker(conn, event.BlockNumber, value.MasterID, value.WorkerID)
+	case *blockchain.WorkerRemovedData:
+		return m.journalWorker(conn, event.BlockNumber, value.MasterID, value.WorkerID)
+	case *blockchain.AddedToBlacklistData:
+		return m.journalBlacklistEntry(conn, event.BlockNumber, value.AdderID, value.AddeeID)
+	case *blockchain.RemovedFromBlacklistData:
+		return m.journalBlacklistEntry(conn, event.BlockNumber, value.RemoverID, value.RemoveeID)
+	case *blockchain.ValidatorCreatedData:
+		return m.journalValidator(conn, event.BlockNumber, value.ID)
+	case *blockchain.ValidatorDeletedData:
+		return m.journalValidator(conn, event.BlockNumber, value.ID)
+	case *blockchain.CertificateCreatedData:
+		return m.journalCertificate(conn, event.BlockNumber, value.ID)
+	}
+
+	return nil
+}
+
+func (m *DWH) journalOrder(conn queryConn, blockNumber uint64, orderID *big.Int) error {
+	if ok, err := m.storage.CheckBlockJournalEntry(conn, blockNumber, journalEntityOrder, orderID.String()); err != nil || ok {
+		return err
+	}
+
+	entry := &blockJournalEntry{BlockNumber: blockNumber, Entity: journalEntityOrder, EntityID: orderID.String()}
+	if order, err := m.storage.GetOrderByID(conn, orderID); err == nil {
+		if entry.Snapshot, err = json.Marshal(order); err != nil {
+			return errors.Wrap(err, "failed to marshal order")
+		}
+	}
+
+	return m.storage.InsertBlockJournalEntry(conn, entry)
+}
+
+func (m *DWH) journalDeal(conn queryConn, blockNumber uint64, dealID *big.Int) error {
+	if ok, err := m.storage.CheckBlockJournalEntry(conn, blockNumber, journalEntityDeal, dealID.String()); err != nil || ok {
+		return err
+	}
+
+	entry := &blockJournalEntry{BlockNumber: blockNumber, Entity: journalEntityDeal, EntityID: dealID.String()}
+	if deal, err := m.storage.GetDealByID(conn, dealID); err == nil {
+		conditions, _, err := m.storage.GetDealConditions(conn, &pb.DealConditionsRequest{DealID: pb.NewBigInt(dealID)})
+		if err != nil {
+			return errors.Wrap(err, "failed to GetDealConditions")
+		}
+		changeRequests, err := m.storage.GetDealChangeRequestsByDealID(conn, dealID)
+		if err != nil {
+			return errors.Wrap(err, "failed to GetDealChangeRequestsByDealID")
+		}
+
+		entry.Snapshot, err = json.Marshal(&dealSnapshot{
+			Deal:           deal.GetDeal(),
+			Conditions:     conditions,
+			ChangeRequests: changeRequests,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal deal")
+		}
+	}
+
+	return m.storage.InsertBlockJournalEntry(conn, entry)
+}
+
+func (m *DWH) journalChangeRequest(conn queryConn, blockNumber uint64, changeRequestID *big.Int) error {
+	changeRequest, err := m.blockchain.Market().GetDealChangeRequestInfo(m.ctx, changeRequestID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetDealChangeRequestInfo")
+	}
+
+	return m.journalDeal(conn, blockNumber, changeRequest.GetDealID().Unwrap())
+}
+
+func (m *DWH) journalWorker(conn queryConn, blockNumber uint64, masterID, workerID common.Address) error {
+	entityID := pairEntityID(masterID, workerID)
+	if ok, err := m.storage.CheckBlockJournalEntry(conn, blockNumber, journalEntityWorker, entityID); err != nil || ok {
+		return err
+	}
+
+	workers, _, err := m.storage.GetWorkers(conn, &pb.WorkersRequest{MasterID: pb.NewEthAddress(masterID)})
+	if err != nil {
+		return errors.Wrap(err, "failed to GetWorkers")
+	}
+
+	var snapshot interface{}
+	for _, worker := range workers {
+		if worker.GetSlaveID().Unwrap() == workerID {
+			snapshot = worker
+		}
+	}
+
+	return m.journalSnapshot(conn, blockNumber, journalEntityWorker, entityID, snapshot)
+}
+
+func (m *DWH) journalBlacklistEntry(conn queryConn, blockNumber uint64, adderID, addeeID common.Address) error {
+	entityID := pairEntityID(adderID, addeeID)
+	if ok, err := m.storage.CheckBlockJournalEntry(conn, blockNumber, journalEntityBlacklist, entityID); err != nil || ok {
+		return err
+	}
+
+	blacklist, err := m.storage.GetBlacklist(conn, &pb.BlacklistRequest{OwnerID: pb.NewEthAddress(adderID)})
+	if err != nil {
+		return errors.Wrap(err, "failed to GetBlacklist")
+	}
+
+	// Blacklist entries have no state besides their existence.
+	var snapshot interface{}
+	for _, address := range blacklist.GetAddresses() {
+		if address == addeeID.Hex() {
+			snapshot = true
+		}
+	}
+
+	return m.journalSnapshot(conn, blockNumber, journalEntityBlacklist, entityID, snapshot)
+}
+
+func (m *DWH) journalValidator(conn queryConn, blockNumber uint64, validatorID common.Address) error {
+	if ok, err := m.storage.CheckBlockJournalEntry(conn, blockNumber, journalEntityValidator, validatorID.Hex()); err != nil || ok {
+		return err
+	}
+
+	var snapshot interface{}
+	if validator, err := m.storage.GetValidator(conn, validatorID); err == nil {
+		snapshot = validator
+	}
+
+	return m.journalSnapshot(conn, blockNumber, journalEntityValidator, validatorID.Hex(), snapshot)
+}
+
+// journalCertificate saves the profile of the certificate owner, because
+// the certificate is never modified by itself.
+func (m *DWH) journalCertificate(conn queryConn, blockNumber uint64, certificateID *big.Int) error {
+	certificate, err := m.blockchain.ProfileRegistry().GetCertificate(m.ctx, certificateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetCertificate")
+	}
+
+	return m.journalProfile(conn, blockNumber, certificate.GetOwnerID().Unwrap())
+}
+
+func (m *DWH) journalProfile(conn queryConn, blockNumber uint64, userID common.Address) error {
+	if ok, err := m.storage.CheckBlockJournalEntry(conn, blockNumber, journalEntityProfile, userID.Hex()); err != nil || ok {
+		return err
+	}
+
+	certificates, err := m.storage.GetCertificates(conn, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetCertificates")
+	}
+
+	var snapshot interface{}
+	if profile, err := m.storage.GetProfileByID(conn, userID); err == nil {
+		snapshot = &profileSnapshot{Profile: profile, Certificates: certificates}
+	} else if len(certificates) != 0 {
+		snapshot = &profileSnapshot{Certificates: certificates}
+	}
+
+	return m.journalSnapshot(conn, blockNumber, journalEntityProfile, userID.Hex(), snapshot)
+}
+
+// journalSnapshot saves the given state of the entity, nil state means that
+// the entity does not exist.
+func (m *DWH) journalSnapshot(conn queryConn, blockNumber uint64, entity, entityID string, state interface{}) error {
+	entry := &blockJournalEntry{BlockNumber: blockNumber, Entity: entity, EntityID: entityID}
+	if state != nil {
+		var err error
+		if entry.Snapshot, err = json.Marshal(state); err != nil {
+			return errors.Wrapf(err, "failed to marshal %s", entity)
+		}
+	}
+
+	return m.storage.InsertBlockJournalEntry(conn, entry)
+}
+
+// pairEntityID returns the journal ID of an entity identified by a pair of
+// addresses, like a worker or a blacklist entry.
+func pairEntityID(first, second common.Address) string {
+	return first.Hex() + "_" + second.Hex()
+}
+
+func parsePairEntityID(entityID string) (common.Address, common.Address, error) {
+	parts := strings.Split(entityID, "_")
+	if len(parts) != 2 {
+		return common.Address{}, common.Address{}, errors.Errorf("invalid entity ID %s", entityID)
+	}
+
+	first, err := util.HexToAddress(parts[0])
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+	second, err := util.HexToAddress(parts[1])
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+
+	return first, second, nil
+}
+
+// journalRollup saves the state of the rollup before it is modified by an
+// event from the given block.
+func (m *DWH) journalRollup(conn queryConn, blockNumber uint64, entity, entityID string, rollup interface{}) error {
+	if ok, err := m.storage.CheckBlockJournalEntry(conn, blockNumber, entity, entityID); err != nil || ok {
+		return err
+	}
+
+	snapshot, err := json.Marshal(rollup)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal rollup")
+	}
+
+	return m.storage.InsertBlockJournalEntry(conn, &blockJournalEntry{
+		BlockNumber: blockNumber,
+		Entity:      entity,
+		EntityID:    entityID,
+		Snapshot:    snapshot,
+	})
+}
+
+// revertBlocks restores market entities modified after the given block to
+// the state they had at that block.
+func (m *DWH) revertBlocks(blockNumber uint64) error {
+	conn, err := newTxConn(m.db, m.logger)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer conn.Finish()
+
+	entries, err := m.storage.GetBlockJournal(conn, blockNumber)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetBlockJournal")
+	}
+
+	// The earliest journaled state of an entity is the one it had at the
+	// given block, events are applied in the order of their blocks. Validators are restored first, because certificates refer
+	// to them, orders go before deals for the same reason. Profiles are
+	// restored after orders and deals, which copy profile attributes.
+	var (
+		validators []*blockJournalEntry
+		others     []*blockJournalEntry
+		orders     []*blockJournalEntry
+		deals      []*blockJournalEntry
+		profiles   []*blockJournalEntry
+		rollups    []*blockJournalEntry
+		seen       = map[string]bool{}
+		masters    = map[common.Address]bool{}
+	)
+	for _, entry := range entries {
+		key := entry.Entity + "_" + entry.EntityID
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		switch entry.Entity {
+		case journalEntityOrder:
+			orders = append(orders, entry)
+		case journalEntityDeal:
+			deals = append(deals, entry)
+		case journalEntityOrderRollup, journalEntityPriceRollup, journalEntityPayoutRollup, journalEntityRolledUpOrder:
+			rollups = append(rollups, entry)
+		case journalEntityValidator:
+			validators = append(validators, entry)
+		case journalEntityWorker, journalEntityBlacklist:
+			others = append(others, entry)
+		case journalEntityProfile:
+			profiles = append(profiles, entry)
+		}
+	}
+
+	for _, entry := range validators {
+		if err := m.restoreValidator(conn, entry); err != nil {
+			return errors.Wrapf(err, "failed to restore validator %s", entry.EntityID)
+		}
+	}
+
+	for _, entry := range others {
+		var err error
+		switch entry.Entity {
+		case journalEntityWorker:
+			err = m.restoreWorker(conn, entry)
+		case journalEntityBlacklist:
+			err = m.restoreBlacklistEntry(conn, entry)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to restore %s %s", entry.Entity, entry.EntityID)
+		}
+	}
+
+	for _, entry := range orders {
+		if err := m.restoreOrder(conn, entry, masters); err != nil {
+			return errors.Wrapf(err, "failed to restore order %s", entry.EntityID)
+		}
+	}
+
+	for _, entry := range deals {
+		if err := m.restoreDeal(conn, entry); err != nil {
+			return errors.Wrapf(err, "failed to restore deal %s", entry.EntityID)
+		}
+	}
+
+	for _, entry := range profiles {
+		if err := m.restoreProfile(conn, entry, masters); err != nil {
+			return errors.Wrapf(err, "failed to restore profile %s", entry.EntityID)
+		}
+	}
+
+	for _, entry := range rollups {
+		if err := m.restoreRollup(conn, entry); err != nil {
+			return errors.Wrapf(err, "failed to restore %s %s", entry.Entity, entry.EntityID)
+		}
+	}
+
+	for masterID := range masters {
+		if err := m.recountProfileStats(conn, masterID); err != nil {
+			return errors.Wrapf(err, "failed to recount profile stats (%s)", masterID.Hex())
+		}
+	}
+
+	if err := m.storage.DeleteBlockJournal(conn, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to DeleteBlockJournal")
+	}
+	if err := m.storage.DeleteAppliedEvents(conn, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to DeleteAppliedEvents")
+	}
+	// Failed events from the removed blocks are no longer valid.
+	if err := m.storage.DeleteFailedEvents(conn, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to DeleteFailedEvents")
+	}
+
+	// Payments are recorded as is, so the ones from the removed blocks are
+	// simply dropped.
+	if err := m.storage.DeletePayments(conn, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to DeletePayments")
+	}
+
+	// Subscriptions are notified about the restored state, while changes from
+	// the removed blocks are not resent to the resumed ones.
+	if err := m.storage.DeleteChangeLog(conn, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to DeleteChangeLog")
+	}
+	for _, entry := range orders {
+		orderID, _ := new(big.Int).SetString(entry.EntityID, 10)
+		if err := m.logOrderChange(conn, blockNumber, pb.DWHOrderEvent_REVERTED, orderID, nil); err != nil {
+			return errors.Wrap(err, "failed to log order change")
+		}
+	}
+	for _, entry := range deals {
+		dealID, _ := new(big.Int).SetString(entry.EntityID, 10)
+		if err := m.logDealChange(conn, blockNumber, pb.DWHDealEvent_REVERTED, dealID, nil, nil); err != nil {
+			return errors.Wrap(err, "failed to log deal change")
+		}
+	}
+
+	m.logger.Info("reverted blocks", zap.Uint64("block_number", blockNumber),
+		zap.Int("orders", len(orders)), zap.Int("deals", len(deals)), zap.Int("rollups", len(rollups)),
+		zap.Int("profiles", len(profiles)), zap.Int("validators", len(validators)), zap.Int("others", len(others)))
+
+	return nil
+}
+
+func (m *DWH) restoreOrder(conn queryConn, entry *blockJournalEntry, masters map[common.Address]bool) error {
+	orderID, ok := new(big.Int).SetString(entry.EntityID, 10)
+	if !ok {
+		return errors.New("invalid order ID")
+	}
+
+	if order, err := m.storage.GetOrderByID(conn, orderID); err == nil {
+		masters[order.GetMasterID().Unwrap()] = true
+	}
+
+	if err := m.storage.DeleteOrder(conn, orderID); err != nil {
+		return errors.Wrap(err, "failed to DeleteOrder")
+	}
+
+	if len(entry.Snapshot) == 0 {
+		return nil
+	}
+
+	order := &pb.DWHOrder{}
+	if err := json.Unmarshal(entry.Snapshot, order); err != nil {
+		return errors.Wrap(err, "failed to unmarshal order")
+	}
+	masters[order.GetMasterID().Unwrap()] = true
+
+	return m.storage.InsertOrder(conn, order)
+}
+
+func (m *DWH) restoreDeal(conn queryConn, entry *blockJournalEntry) error {
+	dealID, ok := new(big.Int).SetString(entry.EntityID, 10)
+	if !ok {
+		return errors.New("invalid deal ID")
+	}
+
+	changeRequests, err := m.storage.GetDealChangeRequestsByDealID(conn, dealID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetDealChangeRequestsByDealID")
+	}
+	for _, changeRequest := range changeRequests {
+		if err := m.storage.DeleteDealChangeRequest(conn, changeRequest.GetId().Unwrap()); err != nil {
+			return errors.Wrap(err, "failed to DeleteDealChangeRequest")
+		}
+	}
+	if err := m.storage.DeleteDealConditions(conn, dealID); err != nil {
+		return errors.Wrap(err, "failed to DeleteDealConditions")
+	}
+	if err := m.storage.DeleteDeal(conn, dealID); err != nil {
+		return errors.Wrap(err, "failed to DeleteDeal")
+	}
+
+	if len(entry.Snapshot) == 0 {
+		return nil
+	}
+
+	snapshot := &dealSnapshot{}
+	if err := json.Unmarshal(entry.Snapshot, snapshot); err != nil {
+		return errors.Wrap(err, "failed to unmarshal deal")
+	}
+
+	if err := m.storage.InsertDeal(conn, snapshot.Deal); err != nil {
+		return errors.Wrap(err, "failed to InsertDeal")
+	}
+	// Conditions are sorted from the newest to the oldest one.
+	for idx := len(snapshot.Conditions) - 1; idx >= 0; idx-- {
+		if err := m.storage.InsertDealCondition(conn, snapshot.Conditions[idx]); err != nil {
+			return errors.Wrap(err, "failed to InsertDealCondition")
+		}
+	}
+	for _, changeRequest := range snapshot.ChangeRequests {
+		if err := m.storage.InsertDealChangeRequest(conn, changeRequest); err != nil {
+			return errors.Wrap(err, "failed to InsertDealChangeRequest")
+		}
+	}
+
+	return nil
+}
+
+func (m *DWH) restoreWorker(conn queryConn, entry *blockJournalEntry) error {
+	masterID, workerID, err := parsePairEntityID(entry.EntityID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.storage.DeleteWorker(conn, masterID, workerID); err != nil {
+		return errors.Wrap(err, "failed to DeleteWorker")
+	}
+
+	if len(entry.Snapshot) == 0 {
+		return nil
+	}
+
+	worker := &pb.DWHWorker{}
+	if err := json.Unmarshal(entry.Snapshot, worker); err != nil {
+		return errors.Wrap(err, "failed to unmarshal worker")
+	}
+
+	if err := m.storage.InsertWorker(conn, masterID, workerID); err != nil {
+		return errors.Wrap(err, "failed to InsertWorker")
+	}
+	if worker.GetConfirmed() {
+		if err := m.storage.UpdateWorker(conn, masterID, workerID); err != nil {
+			return errors.Wrap(err, "failed to UpdateWorker")
+		}
+	}
+
+	return nil
+}
+
+func (m *DWH) restoreBlacklistEntry(conn queryConn, entry *blockJournalEntry) error {
+	adderID, addeeID, err := parsePairEntityID(entry.EntityID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.storage.DeleteBlacklistEntry(conn, adderID, addeeID); err != nil {
+		return errors.Wrap(err, "failed to DeleteBlacklistEntry")
+	}
+
+	if len(entry.Snapshot) == 0 {
+		return nil
+	}
+
+	return m.storage.InsertBlacklistEntry(conn, adderID, addeeID)
+}
+
+func (m *DWH) restoreValidator(conn queryConn, entry *blockJournalEntry) error {
+	validatorID, err := util.HexToAddress(entry.EntityID)
+	if err != nil {
+		return err
+	}
+
+	// Deleting a validator also deletes its certificates, so the existing
+	// ones are only updated. Certificates of the new validators are created
+	// later and are restored with their owners' profiles.
+	if len(entry.Snapshot) == 0 {
+		return m.storage.DeleteValidator(conn, validatorID)
+	}
+
+	validator := &pb.Validator{}
+	if err := json.Unmarshal(entry.Snapshot, validator); err != nil {
+		return errors.Wrap(err, "failed to unmarshal validator")
+	}
+
+	return m.storage.InsertOrUpdateValidator(conn, validator)
+}
+
+func (m *DWH) restoreProfile(conn queryConn, entry *blockJournalEntry, masters map[common.Address]bool) error {
+	userID, err := util.HexToAddress(entry.EntityID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.storage.DeleteCertificates(conn, userID); err != nil {
+		return errors.Wrap(err, "failed to DeleteCertificates")
+	}
+	if err := m.storage.DeleteProfile(conn, userID); err != nil {
+		return errors.Wrap(err, "failed to DeleteProfile")
+	}
+
+	snapshot := &profileSnapshot{}
+	if len(entry.Snapshot) != 0 {
+		if err := json.Unmarshal(entry.Snapshot, snapshot); err != nil {
+			return errors.Wrap(err, "failed to unmarshal profile")
+		}
+	}
+
+	for _, certificate := range snapshot.Certificates {
+		if err := m.storage.InsertCertificate(conn, certificate); err != nil {
+			return errors.Wrap(err, "failed to InsertCertificate")
+		}
+	}
+
+	profile := snapshot.Profile
+	if profile == nil {
+		certificates, _ := json.Marshal([]*pb.Certificate{})
+		profile = &pb.Profile{UserID: pb.NewEthAddress(userID), Certificates: string(certificates)}
+	} else {
+		if err := m.storage.InsertProfileUserID(conn, profile); err != nil {
+			return errors.Wrap(err, "failed to InsertProfileUserID")
+		}
+		for field, value := range map[string]interface{}{
+			"IdentityLevel":  profile.GetIdentityLevel(),
+			"Name":           profile.GetName(),
+			"Country":        profile.GetCountry(),
+			"IsCorporation":  profile.GetIsCorporation(),
+			"IsProfessional": profile.GetIsProfessional(),
+		} {
+			if err := m.storage.UpdateProfile(conn, userID, field, value); err != nil {
+				return errors.Wrapf(err, "failed to UpdateProfile (%s)", field)
+			}
+		}
+		masters[userID] = true
+	}
+
+	// Orders and deals keep a copy of their authors' profile attributes.
+	if err := m.storage.UpdateOrders(conn, profile); err != nil {
+		return errors.Wrap(err, "failed to UpdateOrders")
+	}
+	if err := m.storage.UpdateDealsSupplier(conn, profile); err != nil {
+		return errors.Wrap(err, "failed to UpdateDealsSupplier")
+	}
+	if err := m.storage.UpdateDealsConsumer(conn, profile); err != nil {
+		return errors.Wrap(err, "failed to UpdateDealsConsumer")
+	}
+
+	return nil
+}
+
+// restoreRollup saves the journaled rollup state. Rollups are always
+// journaled with their full state, which is zero for the new ones.
+func (m *DWH) restoreRollup(conn queryConn, entry *blockJournalEntry) error {
+	switch entry.Entity {
+	case journalEntityOrderRollup:
+		rollup := &orderRollup{}
+		if err := json.Unmarshal(entry.Snapshot, rollup); err != nil {
+			return errors.Wrap(err, "failed to unmarshal order rollup")
+		}
+		return m.storage.SaveOrderRollup(conn, rollup)
+	case journalEntityPriceRollup:
+		rollup := &priceRollup{}
+		if err := json.Unmarshal(entry.Snapshot, rollup); err != nil {
+			return errors.Wrap(err, "failed to unmarshal price rollup")
+		}
+		return m.storage.SavePriceRollup(conn, rollup)
+	case journalEntityPayoutRollup:
+		rollup := &payoutRollup{}
+		if err := json.Unmarshal(entry.Snapshot, rollup); err != nil {
+			return errors.Wrap(err, "failed to unmarshal payout rollup")
+		}
+		return m.storage.SavePayoutRollup(conn, rollup)
+	case journalEntityRolledUpOrder:
+		record := &rolledUpOrder{}
+		if err := json.Unmarshal(entry.Snapshot, record); err != nil {
+			return errors.Wrap(err, "failed to unmarshal rolled up order")
+		}
+		return m.storage.SaveRolledUpOrder(conn, record)
+	default:
+		return errors.Errorf("unknown rollup %s", entry.Entity)
+	}
+}
+
+// recountProfileStats updates the number of active orders of the profile
+// from scratch.
+func (m *DWH) recountProfileStats(conn queryConn, masterID common.Address) error {
+	for orderType, field := range map[pb.OrderType]string{pb.OrderType_ASK: "ActiveAsks", pb.OrderType_BID: "ActiveBids"} {
+		_, count, err := m.storage.GetOrders(conn, &pb.OrdersRequest{
+			Type:      orderType,
+			MasterID:  pb.NewEthAddress(masterID),
+			WithCount: true,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get %s count", field)
+		}
+
+		if err := m.storage.UpdateProfile(conn, masterID, field, count); err != nil {
+			return errors.Wrapf(err, "failed to UpdateProfile (%s)", field)
+		}
+	}
+
+	return nil
+}
+
+// processRevert reverts changes made after the fork block, retrying until it
+// succeeds, because the following events depend on it.
+func (m *DWH) processRevert(data *blockchain.RevertData) {
+	m.logger.Warn("chain is reorganized, reverting blocks", zap.Uint64("block_number", data.BlockNumber))
+	for {
+		err := m.revertBlocks(data.BlockNumber)
+		if err == nil {
+			return
+		}
+
+		m.logger.Warn("failed to revertBlocks, retrying", util.LaconicError(err))
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (m *DWH) pruneBlockJournal(blockNumber uint64) {
+	if blockNumber <= blockJournalDepth {
+		return
+	}
+
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	if err := m.storage.PruneBlockJournal(conn, blockNumber-blockJournalDepth); err != nil {
+		m.logger.Warn("failed to PruneBlockJournal", util.LaconicError(err))
+	}
+	// Applied events are only needed to skip the replayed ones, so they are
+	// pruned along with the journal.
+	if err := m.storage.PruneAppliedEvents(conn, blockNumber-blockJournalDepth); err != nil {
+		m.logger.Warn("failed to PruneAppliedEvents", util.LaconicError(err))
+	}
+}
@@ -0,0 +1,236 @@
+package dwh
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJournalDBPath = "test_journal_dwh.db"
+
+func TestDWH_revertBlocks(t *testing.T) {
+	w, err := getTestDWH(testJournalDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testJournalDBPath)
+	defer w.db.Close()
+
+	benchmarks, err := pb.NewBenchmarks([]uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11})
+	require.NoError(t, err)
+
+	newOrder := func(id int64, orderType pb.OrderType) *pb.DWHOrder {
+		return &pb.DWHOrder{
+			CreatedTS: &pb.Timestamp{Seconds: 5},
+			MasterID:  pb.NewEthAddress(common.HexToAddress("0xE1")),
+			Order: &pb.Order{
+				Id:             pb.NewBigIntFromInt(id),
+				DealID:         pb.NewBigIntFromInt(0),
+				OrderType:      orderType,
+				OrderStatus:    pb.OrderStatus_ORDER_ACTIVE,
+				AuthorID:       pb.NewEthAddress(common.HexToAddress("0xE1")),
+				CounterpartyID: pb.NewEthAddress(common.Address{}),
+				Duration:       3600,
+				Price:          pb.NewBigIntFromInt(100),
+				Tag:            []byte{0, 1},
+				FrozenSum:      pb.NewBigIntFromInt(0),
+				Benchmarks:     benchmarks,
+			},
+		}
+	}
+
+	var (
+		conn   = newSimpleConn(w.db)
+		ask    = newOrder(90001, pb.OrderType_ASK)
+		bid    = newOrder(90002, pb.OrderType_BID)
+		dealID = big.NewInt(90003)
+		deal   = &pb.Deal{
+			Id:             pb.NewBigInt(dealID),
+			Benchmarks:     benchmarks,
+			SupplierID:     pb.NewEthAddress(common.HexToAddress("0xE1")),
+			ConsumerID:     pb.NewEthAddress(common.HexToAddress("0xE2")),
+			MasterID:       pb.NewEthAddress(common.HexToAddress("0xE1")),
+			AskID:          ask.GetOrder().GetId(),
+			BidID:          bid.GetOrder().GetId(),
+			Duration:       3600,
+			Price:          pb.NewBigIntFromInt(100),
+			StartTime:      &pb.Timestamp{Seconds: 10},
+			EndTime:        &pb.Timestamp{},
+			Status:         pb.DealStatus_DEAL_ACCEPTED,
+			BlockedBalance: pb.NewBigIntFromInt(360000),
+			TotalPayout:    pb.NewBigIntFromInt(0),
+			LastBillTS:     &pb.Timestamp{Seconds: 10},
+		}
+	)
+
+	// Block 1: orders are placed.
+	for _, order := range []*pb.DWHOrder{ask, bid} {
+		require.NoError(t, w.journalOrder(conn, 1, order.GetOrder().GetId().Unwrap()))
+		require.NoError(t, w.storage.InsertOrder(conn, order))
+	}
+
+	// Block 2: orders are matched into a deal.
+	for _, order := range []*pb.DWHOrder{ask, bid} {
+		require.NoError(t, w.journalOrder(conn, 2, order.GetOrder().GetId().Unwrap()))
+		require.NoError(t, w.storage.UpdateOrderStatus(conn, order.GetOrder().GetId().Unwrap(), pb.OrderStatus_ORDER_INACTIVE))
+	}
+	require.NoError(t, w.journalDeal(conn, 2, dealID))
+	require.NoError(t, w.storage.InsertDeal(conn, deal))
+	require.NoError(t, w.storage.InsertDealCondition(conn, &pb.DealCondition{
+		SupplierID:  deal.SupplierID,
+		ConsumerID:  deal.ConsumerID,
+		MasterID:    deal.MasterID,
+		Duration:    deal.Duration,
+		Price:       deal.Price,
+		StartTime:   deal.StartTime,
+		EndTime:     &pb.Timestamp{},
+		TotalPayout: deal.TotalPayout,
+		DealID:      deal.Id,
+	}))
+
+	// Block 3: the deal is billed twice, only the first state is journaled.
+	require.NoError(t, w.journalDeal(conn, 3, dealID))
+	require.NoError(t, w.storage.UpdateDealPayout(conn, dealID, big.NewInt(500), 15))
+	require.NoError(t, w.journalDeal(conn, 3, dealID))
+	require.NoError(t, w.storage.UpdateDealPayout(conn, dealID, big.NewInt(1000), 20))
+
+	require.NoError(t, w.revertBlocks(2))
+
+	restoredDeal, err := w.storage.GetDealByID(conn, dealID)
+	require.NoError(t, err)
+	assert.Zero(t, restoredDeal.GetDeal().GetTotalPayout().Unwrap().Sign())
+	assert.Equal(t, int64(10), restoredDeal.GetDeal().GetLastBillTS().GetSeconds())
+	conditions, _, err := w.storage.GetDealConditions(conn, &pb.DealConditionsRequest{DealID: pb.NewBigInt(dealID)})
+	require.NoError(t, err)
+	assert.Len(t, conditions, 1)
+
+	require.NoError(t, w.revertBlocks(1))
+
+	_, err = w.storage.GetDealByID(conn, dealID)
+	assert.Error(t, err)
+	conditions, _, err = w.storage.GetDealConditions(conn, &pb.DealConditionsRequest{DealID: pb.NewBigInt(dealID)})
+	require.NoError(t, err)
+	assert.Len(t, conditions, 0)
+	restoredAsk, err := w.storage.GetOrderByID(conn, ask.GetOrder().GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, pb.OrderStatus_ORDER_ACTIVE, restoredAsk.GetOrder().GetOrderStatus())
+	assert.Equal(t, ask.GetOrder().GetTag(), restoredAsk.GetOrder().GetTag())
+
+	require.NoError(t, w.revertBlocks(0))
+
+	_, err = w.storage.GetOrderByID(conn, ask.GetOrder().GetId().Unwrap())
+	assert.Error(t, err)
+	_, err = w.storage.GetOrderByID(conn, bid.GetOrder().GetId().Unwrap())
+	assert.Error(t, err)
+	entries, err := w.storage.GetBlockJournal(conn, 0)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
+
+func TestDWH_revertBlocksProfiles(t *testing.T) {
+	w, err := getTestDWH(testJournalDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testJournalDBPath)
+	defer w.db.Close()
+
+	var (
+		conn        = newSimpleConn(w.db)
+		masterID    = common.HexToAddress("0xF1")
+		workerID    = common.HexToAddress("0xF2")
+		validatorID = common.HexToAddress("0xF3")
+		userID      = common.HexToAddress("0xF4")
+	)
+
+	newCertificate := func(name string) *pb.Certificate {
+		return &pb.Certificate{
+			OwnerID:     pb.NewEthAddress(userID),
+			ValidatorID: pb.NewEthAddress(validatorID),
+			Attribute:   CertificateName,
+			Value:       []byte(name),
+		}
+	}
+
+	// Block 1: the worker is announced, the blacklist entry is added, the
+	// validator issues the first certificate.
+	require.NoError(t, w.journalWorker(conn, 1, masterID, workerID))
+	require.NoError(t, w.storage.InsertWorker(conn, masterID, workerID))
+	require.NoError(t, w.journalBlacklistEntry(conn, 1, masterID, userID))
+	require.NoError(t, w.storage.InsertBlacklistEntry(conn, masterID, userID))
+	require.NoError(t, w.journalValidator(conn, 1, validatorID))
+	require.NoError(t, w.storage.InsertOrUpdateValidator(conn, &pb.Validator{Id: pb.NewEthAddress(validatorID), Level: 1}))
+	require.NoError(t, w.journalProfile(conn, 1, userID))
+	require.NoError(t, w.storage.InsertCertificate(conn, newCertificate("old")))
+	require.NoError(t, w.updateProfile(conn, newCertificate("old")))
+
+	// Block 2: the worker is confirmed, the blacklist entry is removed, the
+	// validator is deleted after issuing the second certificate.
+	require.NoError(t, w.journalWorker(conn, 2, masterID, workerID))
+	require.NoError(t, w.storage.UpdateWorker(conn, masterID, workerID))
+	require.NoError(t, w.journalBlacklistEntry(conn, 2, masterID, userID))
+	require.NoError(t, w.storage.DeleteBlacklistEntry(conn, masterID, userID))
+	require.NoError(t, w.journalProfile(conn, 2, userID))
+	require.NoError(t, w.storage.InsertCertificate(conn, newCertificate("new")))
+	require.NoError(t, w.updateProfile(conn, newCertificate("new")))
+	require.NoError(t, w.journalValidator(conn, 2, validatorID))
+	require.NoError(t, w.storage.UpdateValidator(conn, &pb.Validator{Id: pb.NewEthAddress(validatorID), Level: 0}))
+
+	require.NoError(t, w.revertBlocks(1))
+
+	workers, _, err := w.storage.GetWorkers(conn, &pb.WorkersRequest{MasterID: pb.NewEthAddress(masterID)})
+	require.NoError(t, err)
+	require.Len(t, workers, 1)
+	assert.False(t, workers[0].GetConfirmed())
+	blacklist, err := w.storage.GetBlacklist(conn, &pb.BlacklistRequest{OwnerID: pb.NewEthAddress(masterID)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{userID.Hex()}, blacklist.GetAddresses())
+	validator, err := w.storage.GetValidator(conn, validatorID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), validator.GetLevel())
+	profile, err := w.storage.GetProfileByID(conn, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "old", profile.GetName())
+	certificates, err := w.storage.GetCertificates(conn, userID)
+	require.NoError(t, err)
+	assert.Len(t, certificates, 1)
+
+	require.NoError(t, w.revertBlocks(0))
+
+	workers, _, err = w.storage.GetWorkers(conn, &pb.WorkersRequest{MasterID: pb.NewEthAddress(masterID)})
+	require.NoError(t, err)
+	assert.Len(t, workers, 0)
+	blacklist, err = w.storage.GetBlacklist(conn, &pb.BlacklistRequest{OwnerID: pb.NewEthAddress(masterID)})
+	require.NoError(t, err)
+	assert.Len(t, blacklist.GetAddresses(), 0)
+	_, err = w.storage.GetValidator(conn, validatorID)
+	assert.Error(t, err)
+	_, err = w.storage.GetProfileByID(conn, userID)
+	assert.Error(t, err)
+	certificates, err = w.storage.GetCertificates(conn, userID)
+	require.NoError(t, err)
+	assert.Len(t, certificates, 0)
+}
+
+func TestDWH_GetBlockJournalOrder(t *testing.T) {
+	w, err := getTestDWH(testJournalDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testJournalDBPath)
+	defer w.db.Close()
+
+	conn := newSimpleConn(w.db)
+	for _, blockNumber := range []uint64{3, 2} {
+		require.NoError(t, w.storage.InsertBlockJournalEntry(conn, &blockJournalEntry{
+			BlockNumber: blockNumber,
+			Entity:      journalEntityOrder,
+			EntityID:    "90001",
+		}))
+	}
+
+	// The earliest block's entry goes first even if it is journaled later.
+	entries, err := w.storage.GetBlockJournal(conn, 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(2), entries[0].BlockNumber)
+}
@@ -0,0 +1,148 @@
+package dwh
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	paymentsCSVPath  = "/payments.csv"
+	paymentsJSONPath = "/payments.json"
+)
+
+var paymentsCSVHeader = []string{"id", "dealID", "payerID", "payeeID", "amount", "blockNumber", "ts", "txHash", "logIndex"}
+
+func (m *DWH) GetPayments(ctx context.Context, request *pb.PaymentsRequest) (*pb.PaymentsReply, error) {
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	payments, count, err := m.storage.GetPayments(conn, request)
+	if err != nil {
+		m.logger.Warn("failed to GetPayments", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.NotFound, "failed to GetPayments")
+	}
+
+	return &pb.PaymentsReply{Payments: payments, Count: count}, nil
+}
+
+// exportPayments serves all the payments matching the query parameters as a
+// single document, so that they can be reconciled with the external books.
+// Supported parameters are `address`, `payerID`, `payeeID`, `dealID`, `from`
+// and `to`, the latter two being unix timestamps.
+func (m *DWH) exportPayments(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	request, err := paymentsRequestFromQuery(r.URL.Query())
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(err.Error()))
+		return
+	}
+
+	reply, err := m.GetPayments(r.Context(), request)
+	if err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		rw.Write([]byte(err.Error()))
+		return
+	}
+
+	switch r.URL.Path {
+	case paymentsCSVPath:
+		rw.Header().Set("Content-Type", "text/csv")
+		err = writePaymentsCSV(rw, reply.GetPayments())
+	default:
+		rw.Header().Set("Content-Type", "application/json")
+		payments := reply.GetPayments()
+		if payments == nil {
+			payments = []*pb.Payment{}
+		}
+		err = json.NewEncoder(rw).Encode(payments)
+	}
+	if err != nil {
+		m.logger.Warn("failed to export payments", util.LaconicError(err))
+	}
+}
+
+func paymentsRequestFromQuery(query url.Values) (*pb.PaymentsRequest, error) {
+	request := &pb.PaymentsRequest{}
+	addresses := map[string]**pb.EthAddress{
+		"address": &request.Address,
+		"payerID": &request.PayerID,
+		"payeeID": &request.PayeeID,
+	}
+	for name, target := range addresses {
+		if value := query.Get(name); len(value) > 0 {
+			address := &pb.EthAddress{}
+			if err := address.UnmarshalText([]byte(value)); err != nil {
+				return nil, errors.Wrapf(err, "invalid %s", name)
+			}
+			*target = address
+		}
+	}
+
+	if value := query.Get("dealID"); len(value) > 0 {
+		dealID, err := pb.NewBigIntFromString(value)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid dealID")
+		}
+		request.DealID = dealID
+	}
+
+	request.Ts = &pb.MaxMinTimestamp{}
+	timestamps := map[string]**pb.Timestamp{
+		"from": &request.Ts.Min,
+		"to":   &request.Ts.Max,
+	}
+	for name, target := range timestamps {
+		if value := query.Get(name); len(value) > 0 {
+			seconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid %s", name)
+			}
+			*target = &pb.Timestamp{Seconds: seconds}
+		}
+	}
+
+	return request, nil
+}
+
+func writePaymentsCSV(rw http.ResponseWriter, payments []*pb.Payment) error {
+	writer := csv.NewWriter(rw)
+	if err := writer.Write(paymentsCSVHeader); err != nil {
+		return err
+	}
+
+	for _, payment := range payments {
+		err := writer.Write([]string{
+			strconv.FormatUint(payment.GetId(), 10),
+			payment.GetDealID().Unwrap().String(),
+			payment.GetPayerID().Unwrap().Hex(),
+			payment.GetPayeeID().Unwrap().Hex(),
+			payment.GetAmount().Unwrap().String(),
+			strconv.FormatUint(payment.GetBlockNumber(), 10),
+			strconv.FormatInt(payment.GetTs().GetSeconds(), 10),
+			payment.GetTxHash(),
+			strconv.FormatUint(payment.GetLogIndex(), 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,121 @@
+package dwh
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	bch "github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPaymentsDBPath = "test_payments_dwh.db"
+
+func TestDWH_Payments(t *testing.T) {
+	w, err := getTestDWH(testPaymentsDBPath)
+	require.NoError(t, err)
+	defer os.Remove(testPaymentsDBPath)
+	defer w.db.Close()
+
+	var (
+		controller = gomock.NewController(t)
+		mockBlock  = bch.NewMockAPI(controller)
+		mockMarket = bch.NewMockMarketAPI(controller)
+		conn       = newSimpleConn(w.db)
+		supplier   = common.HexToAddress("0xE1")
+		consumer   = common.HexToAddress("0xE2")
+		deal       = &pb.Deal{
+			Id:         pb.NewBigIntFromInt(95001),
+			SupplierID: pb.NewEthAddress(supplier),
+			ConsumerID: pb.NewEthAddress(consumer),
+			MasterID:   pb.NewEthAddress(supplier),
+			Status:     pb.DealStatus_DEAL_CLOSED,
+		}
+	)
+	defer controller.Finish()
+	mockMarket.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().Return(deal, nil)
+	mockBlock.EXPECT().Market().AnyTimes().Return(mockMarket)
+	w.blockchain = mockBlock
+
+	// Payments of deals, that are already closed, are recorded as well.
+	require.NoError(t, w.storage.StoreStaleID(conn, deal.GetId().Unwrap(), "Deal"))
+	require.NoError(t, w.onBilled(conn, 100, 10, common.HexToHash("0xA1"), 0, deal.GetId().Unwrap(), big.NewInt(1000)))
+	require.NoError(t, w.onBilled(conn, 200, 11, common.HexToHash("0xA2"), 0, deal.GetId().Unwrap(), big.NewInt(500)))
+	// Replayed bills are not duplicated.
+	require.NoError(t, w.onBilled(conn, 200, 11, common.HexToHash("0xA2"), 0, deal.GetId().Unwrap(), big.NewInt(500)))
+	// The deal can be billed twice in a single transaction, when the consumer
+	// is unable to pay for the next period.
+	require.NoError(t, w.onBilled(conn, 200, 11, common.HexToHash("0xA2"), 2, deal.GetId().Unwrap(), big.NewInt(50)))
+	require.NoError(t, w.storage.InsertPayment(conn, &pb.Payment{
+		DealID:      pb.NewBigIntFromInt(95002),
+		PayerID:     pb.NewEthAddress(common.HexToAddress("0xE3")),
+		PayeeID:     pb.NewEthAddress(supplier),
+		Amount:      pb.NewBigIntFromInt(300),
+		BlockNumber: 12,
+		Ts:          &pb.Timestamp{Seconds: 300},
+		TxHash:      common.HexToHash("0xA3").Hex(),
+	}))
+
+	reply, err := w.GetPayments(w.ctx, &pb.PaymentsRequest{Address: pb.NewEthAddress(consumer)})
+	require.NoError(t, err)
+	require.Len(t, reply.GetPayments(), 3)
+	assert.Equal(t, consumer, reply.GetPayments()[0].GetPayerID().Unwrap())
+	assert.Equal(t, supplier, reply.GetPayments()[0].GetPayeeID().Unwrap())
+	assert.Equal(t, int64(1000), reply.GetPayments()[0].GetAmount().Unwrap().Int64())
+	assert.Equal(t, uint64(10), reply.GetPayments()[0].GetBlockNumber())
+	assert.Equal(t, common.HexToHash("0xA1").Hex(), reply.GetPayments()[0].GetTxHash())
+
+	reply, err = w.GetPayments(w.ctx, &pb.PaymentsRequest{PayeeID: pb.NewEthAddress(supplier), WithCount: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), reply.GetCount())
+
+	reply, err = w.GetPayments(w.ctx, &pb.PaymentsRequest{
+		Address: pb.NewEthAddress(supplier),
+		Ts:      &pb.MaxMinTimestamp{Min: &pb.Timestamp{Seconds: 150}, Max: &pb.Timestamp{Seconds: 250}},
+	})
+	require.NoError(t, err)
+	require.Len(t, reply.GetPayments(), 2)
+	assert.Equal(t, int64(500), reply.GetPayments()[0].GetAmount().Unwrap().Int64())
+	assert.Equal(t, int64(50), reply.GetPayments()[1].GetAmount().Unwrap().Int64())
+	assert.Equal(t, uint64(2), reply.GetPayments()[1].GetLogIndex())
+
+	reply, err = w.GetPayments(w.ctx, &pb.PaymentsRequest{DealID: pb.NewBigIntFromInt(95002)})
+	require.NoError(t, err)
+	require.Len(t, reply.GetPayments(), 1)
+
+	rec := httptest.NewRecorder()
+	w.exportPayments(rec, httptest.NewRequest(http.MethodGet, paymentsCSVPath+"?address="+consumer.Hex(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, paymentsCSVHeader, records[0])
+	assert.Equal(t, []string{"2", "95001", consumer.Hex(), supplier.Hex(), "500", "11", "200",
+		common.HexToHash("0xA2").Hex(), "0"}, records[2])
+
+	rec = httptest.NewRecorder()
+	w.exportPayments(rec, httptest.NewRequest(http.MethodGet, paymentsJSONPath+"?payerID="+consumer.Hex()+"&to=150", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var payments []*pb.Payment
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&payments))
+	require.Len(t, payments, 1)
+	assert.Equal(t, int64(1000), payments[0].GetAmount().Unwrap().Int64())
+
+	rec = httptest.NewRecorder()
+	w.exportPayments(rec, httptest.NewRequest(http.MethodGet, paymentsCSVPath+"?from=yesterday", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Payments from the removed blocks are dropped on chain reorganization.
+	require.NoError(t, w.revertBlocks(10))
+	reply, err = w.GetPayments(w.ctx, &pb.PaymentsRequest{WithCount: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), reply.GetCount())
+}
@@ -43,12 +43,19 @@ func newPostgresStorage(numBenchmarks uint64) *sqlStorage {
 		TotalPayout					TEXT NOT NULL,
 		DealID						TEXT NOT NULL REFERENCES Deals(Id) ON DELETE CASCADE
 	)`,
-			createTableDealPayments: `
-	CREATE TABLE IF NOT EXISTS DealPayments (
-		BillTS						INTEGER NOT NULL,
-		PaidAmount					TEXT NOT NULL,
-		DealID						TEXT NOT NULL REFERENCES Deals(Id) ON DELETE CASCADE,
-		UNIQUE						(BillTS, PaidAmount, DealID)
+			// Payments outlive their deals, so there is no foreign key.
+			createTablePayments: `
+	CREATE TABLE IF NOT EXISTS Payments (
+		Id							BIGSERIAL PRIMARY KEY,
+		DealID						TEXT NOT NULL,
+		PayerID						TEXT NOT NULL,
+		PayeeID						TEXT NOT NULL,
+		Amount						TEXT NOT NULL,
+		BlockNumber					BIGINT NOT NULL,
+		TS							BIGINT NOT NULL,
+		TxHash						TEXT NOT NULL,
+		LogIndex					BIGINT NOT NULL,
+		UNIQUE						(TxHash, LogIndex)
 	)`,
 			createTableChangeRequests: `
 	CREATE TABLE IF NOT EXISTS DealChangeRequests (
@@ -123,11 +130,88 @@ func newPostgresStorage(numBenchmarks uint64) *sqlStorage {
 			createTableMisc: `
 	CREATE TABLE IF NOT EXISTS Misc (
 		Id							BIGSERIAL PRIMARY KEY,
-		LastKnownBlock				INTEGER NOT NULL
+		LastKnownBlock				INTEGER NOT NULL,
+		LastKnownBlockHash			TEXT NOT NULL DEFAULT ''
 	)`,
 			createTableStaleIDs: `
 	CREATE TABLE IF NOT EXISTS StaleIDs (
 		Id 							TEXT NOT NULL
+	)`,
+			createTableBlockJournal: `
+	CREATE TABLE IF NOT EXISTS BlockJournal (
+		Id							BIGSERIAL PRIMARY KEY,
+		BlockNumber					BIGINT NOT NULL,
+		Entity						TEXT NOT NULL,
+		EntityID					TEXT NOT NULL,
+		Snapshot					BYTEA NOT NULL,
+		UNIQUE						(BlockNumber, Entity, EntityID)
+	)`,
+			createTableFailedEvents: `
+	CREATE TABLE IF NOT EXISTS FailedEvents (
+		Id							BIGSERIAL PRIMARY KEY,
+		BlockNumber					BIGINT NOT NULL,
+		EventTS						BIGINT NOT NULL,
+		Type						TEXT NOT NULL,
+		Data						TEXT NOT NULL,
+		Error						TEXT NOT NULL,
+		FailedAt					BIGINT NOT NULL,
+		TxHash						TEXT NOT NULL,
+		LogIndex					BIGINT NOT NULL
+	)`,
+			createTableAppliedEvents: `
+	CREATE TABLE IF NOT EXISTS AppliedEvents (
+		BlockNumber					BIGINT NOT NULL,
+		TxHash						TEXT NOT NULL,
+		LogIndex					BIGINT NOT NULL,
+		UNIQUE						(BlockNumber, TxHash, LogIndex)
+	)`,
+			createTableChangeLog: `
+	CREATE TABLE IF NOT EXISTS ChangeLog (
+		Id							BIGSERIAL PRIMARY KEY,
+		BlockNumber					BIGINT NOT NULL,
+		Entity						TEXT NOT NULL,
+		Event						BYTEA NOT NULL
+	)`,
+			createTableOrderRollups: `
+	CREATE TABLE IF NOT EXISTS OrderRollups (
+		BucketTS					BIGINT NOT NULL,
+		Type						INTEGER NOT NULL,
+		IdentityLevel				INTEGER NOT NULL,
+		GPUMem						BIGINT NOT NULL,
+		CPUCores					BIGINT NOT NULL,
+		Netflags					INTEGER NOT NULL,
+		Placed						BIGINT NOT NULL,
+		Matched						BIGINT NOT NULL,
+		Cancelled					BIGINT NOT NULL,
+		MatchTime					BIGINT NOT NULL,
+		PlacedVolume				TEXT NOT NULL,
+		RemovedVolume				TEXT NOT NULL,
+		UNIQUE						(BucketTS, Type, IdentityLevel, GPUMem, CPUCores, Netflags)
+	)`,
+			createTablePriceRollups: `
+	CREATE TABLE IF NOT EXISTS PriceRollups (
+		BucketTS					BIGINT NOT NULL,
+		Type						INTEGER NOT NULL,
+		IdentityLevel				INTEGER NOT NULL,
+		BenchmarkID					INTEGER NOT NULL,
+		Bin							INTEGER NOT NULL,
+		Count						BIGINT NOT NULL,
+		MinPrice					DOUBLE PRECISION NOT NULL,
+		MaxPrice					DOUBLE PRECISION NOT NULL,
+		UNIQUE						(BucketTS, Type, IdentityLevel, BenchmarkID, Bin)
+	)`,
+			createTablePayoutRollups: `
+	CREATE TABLE IF NOT EXISTS PayoutRollups (
+		BucketTS					BIGINT NOT NULL,
+		IdentityLevel				INTEGER NOT NULL,
+		Bills						BIGINT NOT NULL,
+		Payout						TEXT NOT NULL,
+		UNIQUE						(BucketTS, IdentityLevel)
+	)`,
+			createTableRolledUpOrders: `
+	CREATE TABLE IF NOT EXISTS RolledUpOrders (
+		Id							TEXT UNIQUE NOT NULL,
+		IdentityLevel				INTEGER NOT NULL
 	)`,
 			createIndexCmd: `CREATE INDEX IF NOT EXISTS %s_%s ON %s (%s)`,
 			tablesInfo:     tInfo,
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"math/big"
 	"net"
+	"net/http"
 	"reflect"
 	"sync"
 	"time"
@@ -30,21 +31,29 @@ import (
 )
 
 type DWH struct {
-	mu             sync.RWMutex
-	ctx            context.Context
-	cfg            *Config
-	key            *ecdsa.PrivateKey
-	cancel         context.CancelFunc
-	grpc           *grpc.Server
-	http           *rest.Server
-	logger         *zap.Logger
-	db             *sql.DB
-	creds          credentials.TransportCredentials
-	certRotator    util.HitlessCertRotator
-	blockchain     blockchain.API
-	storage        storage
-	numBenchmarks  uint64
-	lastKnownBlock uint64
+	mu            sync.RWMutex
+	ctx           context.Context
+	cfg           *Config
+	key           *ecdsa.PrivateKey
+	cancel        context.CancelFunc
+	grpc          *grpc.Server
+	http          *rest.Server
+	adminHTTP     *rest.Server
+	logger        *zap.Logger
+	db            *sql.DB
+	creds         credentials.TransportCredentials
+	certRotator   util.HitlessCertRotator
+	blockchain    blockchain.API
+	storage       storage
+	numBenchmarks uint64
+	changes       changeNotifier
+	changeLogMu   sync.Mutex
+	rollupsMu     sync.Mutex
+	// lastKnownBlock is written by the events loop, while RPC handlers read
+	// it too, so it is accessed under its own mutex.
+	lastKnownBlockMu   sync.RWMutex
+	lastKnownBlock     uint64
+	lastKnownBlockHash common.Hash
 }
 
 func NewDWH(ctx context.Context, cfg *Config, key *ecdsa.PrivateKey) (*DWH, error) {
@@ -92,6 +101,9 @@ func (m *DWH) Serve() error {
 	wg := errgroup.Group{}
 	wg.Go(m.serveGRPC)
 	wg.Go(m.serveHTTP)
+	if len(m.cfg.AdminHTTPListenAddr) != 0 {
+		wg.Go(m.serveAdminHTTP)
+	}
 
 	return wg.Wait()
 }
@@ -116,6 +128,9 @@ func (m *DWH) stop() {
 	if m.http != nil {
 		m.http.Close()
 	}
+	if m.adminHTTP != nil {
+		m.adminHTTP.Close()
+	}
 }
 
 func (m *DWH) setupDBts() error {
@@ -165,8 +180,14 @@ func (m *DWH) serveGRPC() error {
 
 	m.certRotator = certRotator
 	m.creds = util.NewTLS(TLSConfig)
-	m.grpc = xgrpc.NewServer(m.logger, xgrpc.Credentials(m.creds), xgrpc.DefaultTraceInterceptor())
+	m.grpc = xgrpc.NewServer(m.logger,
+		xgrpc.Credentials(m.creds),
+		xgrpc.DefaultTraceInterceptor(),
+		xgrpc.AuthorizationInterceptor(m.newAuthorization()),
+	)
 	pb.RegisterDWHServer(m.grpc, m)
+	pb.RegisterDWHAdminServer(m.grpc, m)
+	pb.RegisterDWHChangesServer(m.grpc, m)
 	grpc_prometheus.Register(m.grpc)
 
 	lis, err := net.Listen("tcp", m.cfg.GRPCListenAddr)
@@ -188,7 +209,11 @@ func (m *DWH) serveHTTP() error {
 		return errors.WithMessage(err, "failed to create http listener")
 	}
 
-	options = append(options, rest.WithListener(lis))
+	options = append(options,
+		rest.WithListener(lis),
+		rest.WithHandler(paymentsCSVPath, http.HandlerFunc(m.exportPayments)),
+		rest.WithHandler(paymentsJSONPath, http.HandlerFunc(m.exportPayments)),
+	)
 	srv, err := rest.NewServer(options...)
 	if err != nil {
 		m.mu.Unlock()
@@ -383,17 +408,35 @@ func (m *DWH) monitorBlockchain() error {
 }
 
 func (m *DWH) watchMarketEvents() error {
-	var err error
-	m.lastKnownBlock, err = m.getLastKnownBlock()
+	lastKnownBlock, err := m.getLastKnownBlock()
 	if err != nil {
 		if err := m.insertLastKnownBlock(0); err != nil {
 			return err
 		}
-		m.lastKnownBlock = 0
+		lastKnownBlock = 0
 	}
+	// The hash is unknown for the blocks saved by the older versions.
+	lastKnownBlockHash, err := m.getLastKnownBlockHash()
+	if err != nil {
+		return err
+	}
+	m.setCurrentBlock(lastKnownBlock, lastKnownBlockHash)
+	// Changes stored before the restart are committed.
+	m.writeChanges(func() {})
 
-	m.logger.Info("starting from block", zap.Uint64("block_number", m.lastKnownBlock))
-	events, err := m.blockchain.Events().GetEvents(m.ctx, big.NewInt(0).SetUint64(m.lastKnownBlock))
+	m.logger.Info("starting from block", zap.Uint64("block_number", lastKnownBlock))
+	// The last known block may be processed partially, so it is replayed,
+	// while its already applied events are skipped.
+	fromBlock := lastKnownBlock
+	if fromBlock > 0 {
+		fromBlock--
+	}
+	// The chain may be reorganized while the DWH is down.
+	knownBlocks := map[uint64]common.Hash{}
+	if lastKnownBlockHash != (common.Hash{}) {
+		knownBlocks[lastKnownBlock] = lastKnownBlockHash
+	}
+	events, err := m.blockchain.Events().GetEvents(m.ctx, big.NewInt(0).SetUint64(fromBlock), knownBlocks)
 	if err != nil {
 		return err
 	}
@@ -405,26 +448,49 @@ func (m *DWH) watchMarketEvents() error {
 	)
 	defer tk.Stop()
 
+	// The last known block is saved only when all the events received so far
+	// are processed, otherwise the ones still accumulated are lost on restart.
+	flush := func() {
+		m.writeChanges(func() { m.processEvents(dispatcher) })
+		m.saveLastKnownBlock()
+		eventsCount, dispatcher = 0, newEventDispatcher(m.logger)
+	}
+
 	// Store events by their type, run events of each type in parallel after a timeout
-	// or after a certain number of events is accumulated.
+	// or after a certain number of events is accumulated. Events are reordered
+	// by their type, so they are never accumulated across blocks, otherwise
+	// an event could be applied before the ones from the earlier blocks, which
+	// breaks the block journal.
 	for {
 		select {
 		case <-m.ctx.Done():
 			m.logger.Info("context cancelled (watchMarketEvents)")
 			return nil
 		case <-tk.C:
-			m.processEvents(dispatcher)
-			eventsCount, dispatcher = 0, newEventDispatcher(m.logger)
+			if eventsCount > 0 {
+				flush()
+			}
 		case event, ok := <-events:
 			if !ok {
 				return errors.New("events channel closed")
 			}
+			if data, ok := event.Data.(*blockchain.RevertData); ok {
+				// Events accumulated so far are journaled by their blocks, so
+				// they must be processed before reverting.
+				flush()
+				m.writeChanges(func() { m.processRevert(data) })
+				m.processBlockBoundary(event)
+				m.saveLastKnownBlock()
+				continue
+			}
+			if eventsCount > 0 && event.BlockNumber != m.currentBlock() {
+				flush()
+			}
 			m.processBlockBoundary(event)
 			dispatcher.Add(event)
 			eventsCount++
 			if eventsCount >= m.cfg.NumWorkers {
-				m.processEvents(dispatcher)
-				eventsCount, dispatcher = 0, newEventDispatcher(m.logger)
+				flush()
 			}
 		}
 	}
@@ -478,73 +544,127 @@ func (m *DWH) processEventsGroup(events []*blockchain.Event) {
 				zap.Uint64("block_number", event.BlockNumber),
 				zap.String("event_type", reflect.TypeOf(event.Data).String()),
 				zap.Any("event_data", event.Data))
+			m.storeFailedEvent(event, err)
 		}(wg, event)
 	}
 	wg.Wait()
 }
 
+// processEvent applies the event along with its journal entries and rollup
+// updates in a single transaction.
 func (m *DWH) processEvent(event *blockchain.Event) error {
+	if isRollupEvent(event) {
+		// Events of the same group are processed concurrently, while rollups
+		// are read, modified and written back.
+		m.rollupsMu.Lock()
+		defer m.rollupsMu.Unlock()
+	}
+
+	return withTx(m.db, m.logger, func(conn queryConn) error {
+		_, err := m.applyNewEvent(conn, event)
+		return err
+	})
+}
+
+// applyNewEvent applies the event unless it is already applied, which is the
+// case for events of the last known block replayed after a restart. The
+// change made by the event is logged in the same transaction, so it is
+// neither lost nor duplicated.
+func (m *DWH) applyNewEvent(conn queryConn, event *blockchain.Event) (bool, error) {
+	if ok, err := m.storage.CheckAppliedEvent(conn, event); err != nil {
+		return false, errors.Wrap(err, "failed to CheckAppliedEvent")
+	} else {
+		if ok {
+			m.logger.Debug("skipping already applied event", zap.Uint64("block_number", event.BlockNumber),
+				zap.Uint64("log_index", event.LogIndex))
+			return false, nil
+		}
+	}
+
+	if err := m.journalEvent(conn, event); err != nil {
+		return false, errors.Wrap(err, "failed to journalEvent")
+	}
+
+	snapshot := m.snapshotEntity(conn, event)
+	if err := m.applyEvent(conn, event); err != nil {
+		return false, err
+	}
+
+	if err := m.updateRollups(conn, event, snapshot); err != nil {
+		return false, errors.Wrap(err, "failed to updateRollups")
+	}
+
+	if err := m.logChange(conn, event, snapshot); err != nil {
+		return false, errors.Wrap(err, "failed to logChange")
+	}
+
+	if err := m.storage.InsertAppliedEvent(conn, event); err != nil {
+		return false, errors.Wrap(err, "failed to InsertAppliedEvent")
+	}
+
+	return true, nil
+}
+
+func (m *DWH) applyEvent(conn queryConn, event *blockchain.Event) error {
 	switch value := event.Data.(type) {
 	case *blockchain.DealOpenedData:
-		return m.onDealOpened(value.ID)
+		return m.onDealOpened(conn, value.ID)
 	case *blockchain.DealUpdatedData:
-		return m.onDealUpdated(value.ID)
+		return m.onDealUpdated(conn, value.ID)
 	case *blockchain.OrderPlacedData:
-		return m.onOrderPlaced(event.TS, value.ID)
+		return m.onOrderPlaced(conn, event.TS, value.ID)
 	case *blockchain.OrderUpdatedData:
-		return m.onOrderUpdated(value.ID)
+		return m.onOrderUpdated(conn, value.ID)
 	case *blockchain.DealChangeRequestSentData:
-		return m.onDealChangeRequestSent(event.TS, value.ID)
+		return m.onDealChangeRequestSent(conn, event.TS, value.ID)
 	case *blockchain.DealChangeRequestUpdatedData:
-		return m.onDealChangeRequestUpdated(event.TS, value.ID)
+		return m.onDealChangeRequestUpdated(conn, event.TS, value.ID)
 	case *blockchain.BilledData:
-		return m.onBilled(event.TS, value.DealID, value.PaidAmount)
+		return m.onBilled(conn, event.TS, event.BlockNumber, event.TxHash, event.LogIndex, value.DealID, value.PaidAmount)
 	case *blockchain.WorkerAnnouncedData:
-		return m.onWorkerAnnounced(value.MasterID, value.WorkerID)
+		return m.onWorkerAnnounced(conn, value.MasterID, value.WorkerID)
 	case *blockchain.WorkerConfirmedData:
-		return m.onWorkerConfirmed(value.MasterID, value.WorkerID)
+		return m.onWorkerConfirmed(conn, value.MasterID, value.WorkerID)
 	case *blockchain.WorkerRemovedData:
-		return m.onWorkerRemoved(value.MasterID, value.WorkerID)
+		return m.onWorkerRemoved(conn, value.MasterID, value.WorkerID)
 	case *blockchain.AddedToBlacklistData:
-		return m.onAddedToBlacklist(value.AdderID, value.AddeeID)
+		return m.onAddedToBlacklist(conn, value.AdderID, value.AddeeID)
 	case *blockchain.RemovedFromBlacklistData:
-		m.onRemovedFromBlacklist(value.RemoverID, value.RemoveeID)
+		return m.onRemovedFromBlacklist(conn, value.RemoverID, value.RemoveeID)
 	case *blockchain.ValidatorCreatedData:
-		return m.onValidatorCreated(value.ID)
+		return m.onValidatorCreated(conn, value.ID)
 	case *blockchain.ValidatorDeletedData:
-		return m.onValidatorDeleted(value.ID)
+		return m.onValidatorDeleted(conn, value.ID)
 	case *blockchain.CertificateCreatedData:
-		return m.onCertificateCreated(value.ID)
+		return m.onCertificateCreated(conn, value.ID)
 	}
 
 	return nil
 }
 
-func (m *DWH) onDealOpened(dealID *big.Int) error {
+func (m *DWH) onDealOpened(conn queryConn, dealID *big.Int) error {
 	deal, err := m.blockchain.Market().GetDealInfo(m.ctx, dealID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to GetDealInfo")
 	}
 
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	if deal.Status == pb.DealStatus_DEAL_CLOSED {
-		if err := m.storage.StoreStaleID(newSimpleConn(m.db), dealID, "Deal"); err != nil {
+		if err := m.storage.StoreStaleID(conn, dealID, "Deal"); err != nil {
 			return errors.Wrap(err, "failed to StoreStaleID")
 		}
 		m.logger.Debug("skipping inactive deal", zap.String("deal_id", dealID.String()))
 		return nil
 	}
 
+	return m.insertDeal(conn, deal)
+}
+
+func (m *DWH) insertDeal(conn queryConn, deal *pb.Deal) error {
 	if err := m.checkBenchmarks(deal.Benchmarks); err != nil {
 		return err
 	}
 
-	err = m.storage.InsertDeal(conn, deal)
+	err := m.storage.InsertDeal(conn, deal)
 	if err != nil {
 		return errors.Wrapf(err, "failed to insertDeal")
 	}
@@ -569,25 +689,18 @@ func (m *DWH) onDealOpened(dealID *big.Int) error {
 	return nil
 }
 
-func (m *DWH) onDealUpdated(dealID *big.Int) error {
+func (m *DWH) onDealUpdated(conn queryConn, dealID *big.Int) error {
 	deal, err := m.blockchain.Market().GetDealInfo(m.ctx, dealID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to GetDealInfo")
 	}
 
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If deal is known to be stale:
 	if ok, err := m.storage.CheckStaleID(conn, dealID, "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
 	} else {
 		if ok {
-			m.removeStaleEntityID(dealID, "Deal")
-			return nil
+			return m.removeStaleEntityID(conn, dealID, "Deal")
 		}
 	}
 
@@ -614,18 +727,12 @@ func (m *DWH) onDealUpdated(dealID *big.Int) error {
 	return nil
 }
 
-func (m *DWH) onDealChangeRequestSent(eventTS uint64, changeRequestID *big.Int) error {
+func (m *DWH) onDealChangeRequestSent(conn queryConn, eventTS uint64, changeRequestID *big.Int) error {
 	changeRequest, err := m.blockchain.Market().GetDealChangeRequestInfo(m.ctx, changeRequestID)
 	if err != nil {
 		return err
 	}
 
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If deal is known to be stale, skip.
 	if ok, err := m.storage.CheckStaleID(conn, changeRequest.DealID.Unwrap(), "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
@@ -666,18 +773,12 @@ func (m *DWH) onDealChangeRequestSent(eventTS uint64, changeRequestID *big.Int)
 	return err
 }
 
-func (m *DWH) onDealChangeRequestUpdated(eventTS uint64, changeRequestID *big.Int) error {
+func (m *DWH) onDealChangeRequestUpdated(conn queryConn, eventTS uint64, changeRequestID *big.Int) error {
 	changeRequest, err := m.blockchain.Market().GetDealChangeRequestInfo(m.ctx, changeRequestID)
 	if err != nil {
 		return err
 	}
 
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If deal is known to be stale, skip.
 	if ok, err := m.storage.CheckStaleID(conn, changeRequest.DealID.Unwrap(), "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
@@ -734,20 +835,19 @@ func (m *DWH) onDealChangeRequestUpdated(eventTS uint64, changeRequestID *big.In
 	return nil
 }
 
-func (m *DWH) onBilled(eventTS uint64, dealID, payedAmount *big.Int) error {
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
-	// If deal is known to be stale, skip.
+func (m *DWH) onBilled(conn queryConn, eventTS, blockNumber uint64, txHash common.Hash, logIndex uint64, dealID, payedAmount *big.Int) error {
+	// If deal is known to be stale, only the payment is recorded.
 	if ok, err := m.storage.CheckStaleID(conn, dealID, "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
 	} else {
 		if ok {
-			m.logger.Debug("skipping Billed event for inactive deal")
-			return nil
+			m.logger.Debug("recording payment only for inactive deal", zap.String("deal_id", dealID.String()))
+			deal, err := m.blockchain.Market().GetDealInfo(m.ctx, dealID)
+			if err != nil {
+				return errors.Wrap(err, "failed to GetDealInfo")
+			}
+
+			return m.insertPayment(conn, deal, payedAmount, eventTS, blockNumber, txHash, logIndex)
 		}
 	}
 
@@ -770,8 +870,29 @@ func (m *DWH) onBilled(eventTS uint64, dealID, payedAmount *big.Int) error {
 		return errors.Wrap(err, "failed to UpdateDealConditionPayout")
 	}
 
+	deal, err := m.storage.GetDealByID(conn, dealID)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetDealByID")
+	}
+
+	return m.insertPayment(conn, deal.GetDeal(), payedAmount, eventTS, blockNumber, txHash, logIndex)
+}
+
+// insertPayment records a single bill of the deal. Bills are paid by the
+// consumer to the master of the supplier.
+func (m *DWH) insertPayment(conn queryConn, deal *pb.Deal, amount *big.Int, eventTS, blockNumber uint64, txHash common.Hash, logIndex uint64) error {
+	err := m.storage.InsertPayment(conn, &pb.Payment{
+		DealID:      deal.GetId(),
+		PayerID:     deal.GetConsumerID(),
+		PayeeID:     deal.GetMasterID(),
+		Amount:      pb.NewBigInt(amount),
+		BlockNumber: blockNumber,
+		Ts:          &pb.Timestamp{Seconds: int64(eventTS)},
+		TxHash:      txHash.Hex(),
+		LogIndex:    logIndex,
+	})
 	if err != nil {
-		return errors.Wrap(err, "insertDealPayment failed")
+		return errors.Wrap(err, "failed to InsertPayment")
 	}
 
 	return nil
@@ -792,18 +913,12 @@ func (m *DWH) updateDealPayout(conn queryConn, dealID, payedAmount *big.Int, bil
 	return nil
 }
 
-func (m *DWH) onOrderPlaced(eventTS uint64, orderID *big.Int) error {
+func (m *DWH) onOrderPlaced(conn queryConn, eventTS uint64, orderID *big.Int) error {
 	order, err := m.blockchain.Market().GetOrderInfo(m.ctx, orderID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to GetOrderInfo")
 	}
 
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	if order.OrderStatus == pb.OrderStatus_ORDER_INACTIVE && order.DealID.IsZero() {
 		if err := m.storage.StoreStaleID(conn, orderID, "Order"); err != nil {
 			return errors.Wrap(err, "failed to StoreStaleID")
@@ -812,7 +927,14 @@ func (m *DWH) onOrderPlaced(eventTS uint64, orderID *big.Int) error {
 		return nil
 	}
 
-	var userID common.Address
+	return m.insertOrder(conn, eventTS, order)
+}
+
+func (m *DWH) insertOrder(conn queryConn, eventTS uint64, order *pb.Order) error {
+	var (
+		userID common.Address
+		err    error
+	)
 	if order.OrderType == pb.OrderType_ASK {
 		// For Ask orders, try to get this Author's masterID, use AuthorID if not found.
 		userID, err = m.storage.GetMasterByWorker(conn, order.GetAuthorID().Unwrap())
@@ -876,26 +998,19 @@ func (m *DWH) onOrderPlaced(eventTS uint64, orderID *big.Int) error {
 	return nil
 }
 
-func (m *DWH) onOrderUpdated(orderID *big.Int) error {
+func (m *DWH) onOrderUpdated(conn queryConn, orderID *big.Int) error {
 	marketOrder, err := m.blockchain.Market().GetOrderInfo(m.ctx, orderID)
 	if err != nil {
 		return errors.Wrap(err, "failed to GetOrderInfo")
 	}
 
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If the order was known to be inactive, delete it from the list of inactive entities
 	// and skip.
 	if ok, err := m.storage.CheckStaleID(conn, orderID, "Order"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
 	} else {
 		if ok {
-			m.removeStaleEntityID(orderID, "Order")
-			return nil
+			return m.removeStaleEntityID(conn, orderID, "Order")
 		}
 	}
 
@@ -937,13 +1052,10 @@ func (m *DWH) onOrderUpdated(orderID *big.Int) error {
 	return nil
 }
 
-func (m *DWH) onWorkerAnnounced(masterID, slaveID common.Address) error {
+func (m *DWH) onWorkerAnnounced(conn queryConn, masterID, slaveID common.Address) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	conn := newSimpleConn(m.db)
-	defer conn.Finish()
-
 	if ok, err := m.storage.CheckWorkerExists(conn, masterID, slaveID); err != nil {
 		return errors.Wrap(err, "failed to CheckWorker")
 	} else {
@@ -960,10 +1072,7 @@ func (m *DWH) onWorkerAnnounced(masterID, slaveID common.Address) error {
 	return nil
 }
 
-func (m *DWH) onWorkerConfirmed(masterID, slaveID common.Address) error {
-	conn := newSimpleConn(m.db)
-	defer conn.Finish()
-
+func (m *DWH) onWorkerConfirmed(conn queryConn, masterID, slaveID common.Address) error {
 	if err := m.storage.UpdateWorker(conn, masterID, slaveID); err != nil {
 		return errors.Wrap(err, "onWorkerConfirmed failed")
 	}
@@ -971,10 +1080,7 @@ func (m *DWH) onWorkerConfirmed(masterID, slaveID common.Address) error {
 	return nil
 }
 
-func (m *DWH) onWorkerRemoved(masterID, slaveID common.Address) error {
-	conn := newSimpleConn(m.db)
-	defer conn.Finish()
-
+func (m *DWH) onWorkerRemoved(conn queryConn, masterID, slaveID common.Address) error {
 	if err := m.storage.DeleteWorker(conn, masterID, slaveID); err != nil {
 		return errors.Wrap(err, "onWorkerRemoved failed")
 	}
@@ -982,10 +1088,7 @@ func (m *DWH) onWorkerRemoved(masterID, slaveID common.Address) error {
 	return nil
 }
 
-func (m *DWH) onAddedToBlacklist(adderID, addeeID common.Address) error {
-	conn := newSimpleConn(m.db)
-	defer conn.Finish()
-
+func (m *DWH) onAddedToBlacklist(conn queryConn, adderID, addeeID common.Address) error {
 	if err := m.storage.InsertBlacklistEntry(conn, adderID, addeeID); err != nil {
 		return errors.Wrap(err, "onAddedToBlacklist failed")
 	}
@@ -993,10 +1096,7 @@ func (m *DWH) onAddedToBlacklist(adderID, addeeID common.Address) error {
 	return nil
 }
 
-func (m *DWH) onRemovedFromBlacklist(removerID, removeeID common.Address) error {
-	conn := newSimpleConn(m.db)
-	defer conn.Finish()
-
+func (m *DWH) onRemovedFromBlacklist(conn queryConn, removerID, removeeID common.Address) error {
 	if err := m.storage.DeleteBlacklistEntry(conn, removerID, removeeID); err != nil {
 		return errors.Wrap(err, "onRemovedFromBlacklist failed")
 	}
@@ -1004,15 +1104,12 @@ func (m *DWH) onRemovedFromBlacklist(removerID, removeeID common.Address) error
 	return nil
 }
 
-func (m *DWH) onValidatorCreated(validatorID common.Address) error {
+func (m *DWH) onValidatorCreated(conn queryConn, validatorID common.Address) error {
 	validator, err := m.blockchain.ProfileRegistry().GetValidator(m.ctx, validatorID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get validator `%s`", validatorID.String())
 	}
 
-	conn := newSimpleConn(m.db)
-	defer conn.Finish()
-
 	if err := m.storage.InsertOrUpdateValidator(conn, validator); err != nil {
 		return errors.Wrap(err, "failed to insertValidator")
 	}
@@ -1020,15 +1117,12 @@ func (m *DWH) onValidatorCreated(validatorID common.Address) error {
 	return nil
 }
 
-func (m *DWH) onValidatorDeleted(validatorID common.Address) error {
+func (m *DWH) onValidatorDeleted(conn queryConn, validatorID common.Address) error {
 	validator, err := m.blockchain.ProfileRegistry().GetValidator(m.ctx, validatorID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get validator `%s`", validatorID.String())
 	}
 
-	conn := newSimpleConn(m.db)
-	defer conn.Finish()
-
 	if err := m.storage.UpdateValidator(conn, validator); err != nil {
 		return errors.Wrap(err, "failed to InsertOrUpdateValidator")
 	}
@@ -1036,7 +1130,7 @@ func (m *DWH) onValidatorDeleted(validatorID common.Address) error {
 	return nil
 }
 
-func (m *DWH) onCertificateCreated(certificateID *big.Int) error {
+func (m *DWH) onCertificateCreated(conn queryConn, certificateID *big.Int) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -1045,12 +1139,6 @@ func (m *DWH) onCertificateCreated(certificateID *big.Int) error {
 		return errors.Wrap(err, "failed to GetCertificate")
 	}
 
-	conn, err := newTxConn(m.db, m.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	if err = m.storage.InsertCertificate(conn, certificate); err != nil {
 		return errors.Wrap(err, "failed to insertCertificate")
 	}
@@ -1059,7 +1147,7 @@ func (m *DWH) onCertificateCreated(certificateID *big.Int) error {
 		return errors.Wrap(err, "failed to updateProfile")
 	}
 
-	if err := m.updateEntitiesByProfile(conn, certificate); err != nil {
+	if err := m.updateEntitiesByProfile(conn, certificate.OwnerID.Unwrap()); err != nil {
 		return errors.Wrap(err, "failed to updateEntitiesByProfile")
 	}
 
@@ -1104,8 +1192,13 @@ func (m *DWH) updateProfile(conn queryConn, certificate *pb.Certificate) error {
 		}
 	}
 
-	// Update certificates blob.
-	certificates, err := m.storage.GetCertificates(conn, certificate.OwnerID.Unwrap())
+	return m.updateProfileCertificates(conn, certificate.OwnerID.Unwrap())
+}
+
+// updateProfileCertificates updates the certificates blob and the identity
+// level of the profile using the stored certificates.
+func (m *DWH) updateProfileCertificates(conn queryConn, userID common.Address) error {
+	certificates, err := m.storage.GetCertificates(conn, userID)
 	if err != nil {
 		return errors.Wrap(err, "failed to GetCertificates")
 	}
@@ -1122,12 +1215,12 @@ func (m *DWH) updateProfile(conn queryConn, certificate *pb.Certificate) error {
 		}
 	}
 
-	err = m.storage.UpdateProfile(conn, certificate.OwnerID.Unwrap(), "Certificates", certificateAttrsBytes)
+	err = m.storage.UpdateProfile(conn, userID, "Certificates", certificateAttrsBytes)
 	if err != nil {
 		return errors.Wrap(err, "failed to updateProfileCertificates (Certificates)")
 	}
 
-	err = m.storage.UpdateProfile(conn, certificate.OwnerID.Unwrap(), "IdentityLevel", maxIdentityLevel)
+	err = m.storage.UpdateProfile(conn, userID, "IdentityLevel", maxIdentityLevel)
 	if err != nil {
 		return errors.Wrap(err, "failed to updateProfileCertificates (Level)")
 	}
@@ -1135,8 +1228,8 @@ func (m *DWH) updateProfile(conn queryConn, certificate *pb.Certificate) error {
 	return nil
 }
 
-func (m *DWH) updateEntitiesByProfile(conn queryConn, certificate *pb.Certificate) error {
-	profile, err := m.storage.GetProfileByID(conn, certificate.OwnerID.Unwrap())
+func (m *DWH) updateEntitiesByProfile(conn queryConn, userID common.Address) error {
+	profile, err := m.storage.GetProfileByID(conn, userID)
 	if err != nil {
 		return errors.Wrap(err, "failed to getProfileInfo")
 	}
@@ -1229,11 +1322,18 @@ func (m *DWH) getLastKnownBlock() (uint64, error) {
 	return m.storage.GetLastKnownBlock(conn)
 }
 
-func (m *DWH) updateLastKnownBlock(blockNumber int64) error {
+func (m *DWH) getLastKnownBlockHash() (common.Hash, error) {
+	conn := newSimpleConn(m.db)
+	defer conn.Finish()
+
+	return m.storage.GetLastKnownBlockHash(conn)
+}
+
+func (m *DWH) updateLastKnownBlock(blockNumber int64, blockHash common.Hash) error {
 	conn := newSimpleConn(m.db)
 	defer conn.Finish()
 
-	if err := m.storage.UpdateLastKnownBlock(conn, blockNumber); err != nil {
+	if err := m.storage.UpdateLastKnownBlock(conn, blockNumber, blockHash); err != nil {
 		return errors.Wrap(err, "failed to updateLastKnownBlock")
 	}
 
@@ -1279,9 +1379,9 @@ func (m *DWH) checkBenchmarks(benches *pb.Benchmarks) error {
 	return nil
 }
 
-func (m *DWH) removeStaleEntityID(id *big.Int, entity string) error {
+func (m *DWH) removeStaleEntityID(conn queryConn, id *big.Int, entity string) error {
 	m.logger.Debug("removing stale entity from cache", zap.String("entity", entity), zap.String("id", id.String()))
-	if err := m.storage.RemoveStaleID(newSimpleConn(m.db), id, entity); err != nil {
+	if err := m.storage.RemoveStaleID(conn, id, entity); err != nil {
 		return errors.Wrapf(err, "failed to RemoveStaleID (%s %s)", entity, id.String())
 	}
 
@@ -1289,18 +1389,51 @@ func (m *DWH) removeStaleEntityID(id *big.Int, entity string) error {
 }
 
 func (m *DWH) processBlockBoundary(event *blockchain.Event) {
-	if m.lastKnownBlock != event.BlockNumber {
-		m.lastKnownBlock = event.BlockNumber
-		for {
-			if err := m.updateLastKnownBlock(int64(event.BlockNumber)); err != nil {
-				m.logger.Warn("failed to updateLastKnownBlock", util.LaconicError(err))
-			} else {
-				return
-			}
+	if m.currentBlock() != event.BlockNumber {
+		m.setCurrentBlock(event.BlockNumber, event.BlockHash)
+		m.pruneBlockJournal(event.BlockNumber)
+		m.pruneChangeLog(event.BlockNumber)
+	}
+}
+
+// saveLastKnownBlock persists the last known block with its hash, retrying
+// until it succeeds.
+func (m *DWH) saveLastKnownBlock() {
+	for {
+		m.lastKnownBlockMu.RLock()
+		blockNumber, blockHash := m.lastKnownBlock, m.lastKnownBlockHash
+		m.lastKnownBlockMu.RUnlock()
+
+		err := m.updateLastKnownBlock(int64(blockNumber), blockHash)
+		if err == nil {
+			return
+		}
+
+		m.logger.Warn("failed to updateLastKnownBlock", util.LaconicError(err))
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-time.After(time.Second):
 		}
 	}
 }
 
+// currentBlock returns the last known block.
+func (m *DWH) currentBlock() uint64 {
+	m.lastKnownBlockMu.RLock()
+	defer m.lastKnownBlockMu.RUnlock()
+
+	return m.lastKnownBlock
+}
+
+func (m *DWH) setCurrentBlock(blockNumber uint64, blockHash common.Hash) {
+	m.lastKnownBlockMu.Lock()
+	defer m.lastKnownBlockMu.Unlock()
+
+	m.lastKnownBlock = blockNumber
+	m.lastKnownBlockHash = blockHash
+}
+
 type eventsDispatcher struct {
 	logger                    *zap.Logger
 	ValidatorsCreated         []*blockchain.Event
@@ -1359,6 +1492,8 @@ func (m *eventsDispatcher) Add(event *blockchain.Event) {
 		m.WorkersRemoved = append(m.WorkersRemoved, event)
 	case *blockchain.ErrorData:
 		m.logger.Warn("received error from events channel", zap.Error(data.Err), zap.String("topic", data.Topic))
+	case *blockchain.RevertData:
+		// Reverts are processed as soon as they are received.
 	default:
 		m.Other = append(m.Other, event)
 	}
@@ -649,7 +649,7 @@ func TestDWH_monitor(t *testing.T) {
 }
 
 func testOrderPlaced(commonEventTS uint64, commonID *big.Int) error {
-	if err := monitorDWH.onOrderPlaced(commonEventTS, commonID); err != nil {
+	if err := monitorDWH.onOrderPlaced(newSimpleConn(monitorDWH.db), commonEventTS, commonID); err != nil {
 		return errors.Wrap(err, "onOrderPlaced failed")
 	}
 	if order, err := monitorDWH.storage.GetOrderByID(newSimpleConn(monitorDWH.db), commonID); err != nil {
@@ -663,7 +663,7 @@ func testOrderPlaced(commonEventTS uint64, commonID *big.Int) error {
 }
 
 func testDealOpened(deal *pb.Deal, commonID *big.Int) error {
-	if err := monitorDWH.onDealOpened(commonID); err != nil {
+	if err := monitorDWH.onDealOpened(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onDealOpened failed")
 	}
 	// Firstly, check that a deal was created.
@@ -688,7 +688,7 @@ func testDealOpened(deal *pb.Deal, commonID *big.Int) error {
 
 func testValidatorCreatedUpdated(validator *pb.Validator) error {
 	// Check that a Validator entry is added after ValidatorCreated event.
-	if err := monitorDWH.onValidatorCreated(common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
+	if err := monitorDWH.onValidatorCreated(newSimpleConn(monitorDWH.db), common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
 		return errors.Wrap(err, "onValidatorCreated failed")
 	}
 	if validators, _, err := monitorDWH.storage.GetValidators(newSimpleConn(monitorDWH.db), &pb.ValidatorsRequest{}); err != nil {
@@ -704,7 +704,7 @@ func testValidatorCreatedUpdated(validator *pb.Validator) error {
 	}
 	validator.Level = 0
 	// Check that a Validator entry is updated after ValidatorDeleted event.
-	if err := monitorDWH.onValidatorDeleted(common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
+	if err := monitorDWH.onValidatorDeleted(newSimpleConn(monitorDWH.db), common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
 		return errors.Wrap(err, "onValidatorDeleted failed")
 	}
 	if validators, _, err := monitorDWH.storage.GetValidators(newSimpleConn(monitorDWH.db), &pb.ValidatorsRequest{}); err != nil {
@@ -724,7 +724,7 @@ func testValidatorCreatedUpdated(validator *pb.Validator) error {
 func testCertificateUpdated(certificate *pb.Certificate, commonID *big.Int) error {
 	// Check that a Certificate entry is created after CertificateCreated event. We create a special certificate,
 	// `Name`, that will be recorded directly into profile. There's two such certificate types: `Name` and `Country`.
-	if err := monitorDWH.onCertificateCreated(commonID); err != nil {
+	if err := monitorDWH.onCertificateCreated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onCertificateCreated failed")
 	}
 	if certificateAttrs, err := getCertificates(monitorDWH); err != nil {
@@ -760,7 +760,7 @@ func testCertificateUpdated(certificate *pb.Certificate, commonID *big.Int) erro
 	certificate.Attribute = CertificateCountry
 	certificate.Value = []byte("Country")
 	// Check that a  Profile entry is updated after CertificateCreated event.
-	if err := monitorDWH.onCertificateCreated(commonID); err != nil {
+	if err := monitorDWH.onCertificateCreated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onCertificateCreated failed")
 	}
 	if profiles, _, err := monitorDWH.storage.GetProfiles(newSimpleConn(monitorDWH.db), &pb.ProfilesRequest{}); err != nil {
@@ -814,7 +814,7 @@ func testOrderUpdated(order *pb.Order, commonID *big.Int) error {
 	// Check that if order is updated, it is deleted. Order should be deleted because its DealID is not set
 	// (this means that is has become inactive due to a cancellation and not a match).
 	order.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
-	if err := monitorDWH.onOrderUpdated(commonID); err != nil {
+	if err := monitorDWH.onOrderUpdated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onOrderUpdated failed")
 	}
 	if _, err := monitorDWH.storage.GetOrderByID(newSimpleConn(monitorDWH.db), commonID); err == nil {
@@ -826,7 +826,7 @@ func testOrderUpdated(order *pb.Order, commonID *big.Int) error {
 func testDealUpdated(deal *pb.Deal, commonID *big.Int) error {
 	deal.Duration += 1
 	// Test onDealUpdated event handling.
-	if err := monitorDWH.onDealUpdated(commonID); err != nil {
+	if err := monitorDWH.onDealUpdated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onDealUpdated failed")
 	}
 	if deal, err := monitorDWH.storage.GetDealByID(newSimpleConn(monitorDWH.db), commonID); err != nil {
@@ -841,7 +841,7 @@ func testDealUpdated(deal *pb.Deal, commonID *big.Int) error {
 
 func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, commonEventTS uint64, commonID *big.Int) error {
 	// Test creating an ASK DealChangeRequest.
-	if err := monitorDWH.onDealChangeRequestSent(commonEventTS, big.NewInt(0)); err != nil {
+	if err := monitorDWH.onDealChangeRequestSent(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(0)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestSent failed")
 	}
 	if changeRequest, err := getDealChangeRequest(monitorDWH, changeRequest.Id); err != nil {
@@ -854,7 +854,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	// Check that after a second ASK DealChangeRequest was created, the new one was kept and the old one was deleted.
 	changeRequest.Id = pb.NewBigIntFromInt(1)
 	changeRequest.Duration = 10021
-	if err := monitorDWH.onDealChangeRequestSent(commonEventTS, big.NewInt(1)); err != nil {
+	if err := monitorDWH.onDealChangeRequestSent(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(1)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestSent (2) failed")
 	}
 	if changeRequest, err := getDealChangeRequest(monitorDWH, changeRequest.Id); err != nil {
@@ -871,7 +871,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	changeRequest.Id = pb.NewBigIntFromInt(2)
 	changeRequest.Duration = 10022
 	changeRequest.RequestType = pb.OrderType_BID
-	if err := monitorDWH.onDealChangeRequestSent(commonEventTS, big.NewInt(2)); err != nil {
+	if err := monitorDWH.onDealChangeRequestSent(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(2)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestSent (3) failed")
 	}
 	if changeRequest, err := getDealChangeRequest(monitorDWH, changeRequest.Id); err != nil {
@@ -887,7 +887,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	// Check that when a DealChangeRequest is updated to any status but REJECTED, it is deleted.
 	changeRequest.Id = pb.NewBigIntFromInt(1)
 	changeRequest.Status = pb.ChangeRequestStatus_REQUEST_ACCEPTED
-	if err := monitorDWH.onDealChangeRequestUpdated(commonEventTS, big.NewInt(1)); err != nil {
+	if err := monitorDWH.onDealChangeRequestUpdated(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(1)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestUpdated failed")
 	}
 	if _, err := getDealChangeRequest(monitorDWH, pb.NewBigIntFromInt(1)); err == nil {
@@ -896,7 +896,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	// Check that when a DealChangeRequest is updated to REJECTED, it is kept.
 	changeRequest.Id = pb.NewBigIntFromInt(2)
 	changeRequest.Status = pb.ChangeRequestStatus_REQUEST_REJECTED
-	if err := monitorDWH.onDealChangeRequestUpdated(commonEventTS, big.NewInt(2)); err != nil {
+	if err := monitorDWH.onDealChangeRequestUpdated(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(2)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestUpdated (4) failed")
 	}
 	if _, err := getDealChangeRequest(monitorDWH, pb.NewBigIntFromInt(2)); err != nil {
@@ -933,7 +933,7 @@ func testBilled(commonEventTS uint64, commonID *big.Int) error {
 
 	// Check that after a Billed event last DealCondition.Payout is updated.
 	newBillTS := commonEventTS + 1
-	if err := monitorDWH.onBilled(newBillTS, commonID, big.NewInt(10)); err != nil {
+	if err := monitorDWH.onBilled(newSimpleConn(monitorDWH.db), newBillTS, 1, common.Hash{}, 0, commonID, big.NewInt(10)); err != nil {
 		return errors.Wrap(err, "onBilled failed")
 	}
 	if dealConditions, _, err := monitorDWH.storage.GetDealConditions(
@@ -959,6 +959,21 @@ func testBilled(commonEventTS uint64, commonID *big.Int) error {
 			newBillTS, updatedDeal.Deal.LastBillTS.Seconds)
 	}
 
+	// Check that the bill is recorded as a payment from the consumer to the master.
+	payments, _, err := monitorDWH.storage.GetPayments(newSimpleConn(monitorDWH.db),
+		&pb.PaymentsRequest{DealID: pb.NewBigInt(commonID)})
+	if err != nil {
+		return errors.Wrap(err, "GetPayments failed")
+	}
+	if len(payments) != 1 {
+		return errors.Errorf("(Billed) Expected 1 Payment, got %d", len(payments))
+	}
+	if payments[0].PayerID.Unwrap() != updatedDeal.Deal.ConsumerID.Unwrap() ||
+		payments[0].PayeeID.Unwrap() != updatedDeal.Deal.MasterID.Unwrap() {
+		return errors.Errorf("(Billed) unexpected Payment parties (%s -> %s)",
+			payments[0].PayerID.Unwrap().Hex(), payments[0].PayeeID.Unwrap().Hex())
+	}
+
 	return nil
 }
 
@@ -966,7 +981,7 @@ func testDealClosed(deal *pb.Deal, commonID *big.Int) error {
 	// Check that when a Deal's status is updated to CLOSED, Deal and its DealConditions are deleted.
 	deal.Status = pb.DealStatus_DEAL_CLOSED
 	// Test onDealUpdated event handling.
-	if err := monitorDWH.onDealUpdated(commonID); err != nil {
+	if err := monitorDWH.onDealUpdated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onDealUpdated")
 	}
 	if _, err := monitorDWH.storage.GetDealByID(newSimpleConn(monitorDWH.db), commonID); err == nil {
@@ -985,7 +1000,7 @@ func testDealClosed(deal *pb.Deal, commonID *big.Int) error {
 
 func testWorkerAnnouncedConfirmedRemoved() error {
 	// Check that a worker is added after a WorkerAnnounced event.
-	if err := monitorDWH.onWorkerAnnounced(common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
+	if err := monitorDWH.onWorkerAnnounced(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
 		return errors.Wrap(err, "onWorkerAnnounced failed")
 	}
 	if workers, _, err := monitorDWH.storage.GetWorkers(newSimpleConn(monitorDWH.db), &pb.WorkersRequest{}); err != nil {
@@ -1000,7 +1015,7 @@ func testWorkerAnnouncedConfirmedRemoved() error {
 		}
 	}
 	// Check that a worker is confirmed after a WorkerConfirmed event.
-	if err := monitorDWH.onWorkerConfirmed(common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
+	if err := monitorDWH.onWorkerConfirmed(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
 		return errors.Wrap(err, "onWorkerConfirmed failed")
 	}
 	if workers, _, err := monitorDWH.storage.GetWorkers(newSimpleConn(monitorDWH.db), &pb.WorkersRequest{}); err != nil {
@@ -1015,7 +1030,7 @@ func testWorkerAnnouncedConfirmedRemoved() error {
 		}
 	}
 	// Check that a worker is deleted after a WorkerRemoved event.
-	if err := monitorDWH.onWorkerRemoved(common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
+	if err := monitorDWH.onWorkerRemoved(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
 		return errors.Wrap(err, "onWorkerRemoved failed")
 	}
 	if workers, _, err := monitorDWH.storage.GetWorkers(newSimpleConn(monitorDWH.db), &pb.WorkersRequest{}); err != nil {
@@ -1030,7 +1045,7 @@ func testWorkerAnnouncedConfirmedRemoved() error {
 
 func testBlacklistAddedRemoved() error {
 	// Check that a Blacklist entry is added after AddedToBlacklist event.
-	if err := monitorDWH.onAddedToBlacklist(common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
+	if err := monitorDWH.onAddedToBlacklist(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
 		return errors.Wrap(err, "onAddedToBlacklist failed")
 	}
 	if blacklistReply, err := monitorDWH.storage.GetBlacklist(
@@ -1043,7 +1058,7 @@ func testBlacklistAddedRemoved() error {
 		}
 	}
 	// Check that a Blacklist entry is deleted after RemovedFromBlacklist event.
-	if err := monitorDWH.onRemovedFromBlacklist(common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
+	if err := monitorDWH.onRemovedFromBlacklist(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC"), common.HexToAddress("0xD")); err != nil {
 		return errors.Wrap(err, "onRemovedFromBlacklist failed")
 	}
 	if repl, err := monitorDWH.storage.GetBlacklist(
@@ -11,6 +11,7 @@ import (
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
 	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"google.golang.org/grpc/codes"
@@ -31,6 +32,37 @@ var (
 		pb.CmpOp_LTE: lte,
 		pb.CmpOp_EQ:  eq,
 	}
+
+	orderRollupColumns = []string{
+		"BucketTS",
+		"Type",
+		"IdentityLevel",
+		"GPUMem",
+		"CPUCores",
+		"Netflags",
+		"Placed",
+		"Matched",
+		"Cancelled",
+		"MatchTime",
+		"PlacedVolume",
+		"RemovedVolume",
+	}
+	priceRollupColumns = []string{
+		"BucketTS",
+		"Type",
+		"IdentityLevel",
+		"BenchmarkID",
+		"Bin",
+		"Count",
+		"MinPrice",
+		"MaxPrice",
+	}
+	payoutRollupColumns = []string{
+		"BucketTS",
+		"IdentityLevel",
+		"Bills",
+		"Payout",
+	}
 )
 
 type sqlStorage struct {
@@ -796,7 +828,8 @@ func (m *sqlStorage) GetBlacklist(conn queryConn, r *pb.BlacklistRequest) (*pb.B
 }
 
 func (m *sqlStorage) InsertOrUpdateValidator(conn queryConn, validator *pb.Validator) error {
-	// Validators are never deleted, so it's O.K. to check in a non-atomic way.
+	// Validators are only deleted when reverting blocks in the same transaction,
+	// so it's O.K. to check in a non-atomic way.
 	query, args, _ := m.builder().Select("*").From("Validators").Where("Id = ?", validator.GetId().Unwrap().Hex()).
 		ToSql()
 	rows, err := conn.Query(query, args...)
@@ -824,6 +857,27 @@ func (m *sqlStorage) UpdateValidator(conn queryConn, validator *pb.Validator) er
 	return err
 }
 
+func (m *sqlStorage) DeleteValidator(conn queryConn, validatorID common.Address) error {
+	query, args, _ := m.builder().Delete("Validators").Where("Id = ?", validatorID.Hex()).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) GetValidator(conn queryConn, validatorID common.Address) (*pb.Validator, error) {
+	query, args, _ := m.builder().Select("*").From("Validators").Where("Id = ?", validatorID.Hex()).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectValidator")
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, errors.New("no rows returned")
+	}
+
+	return m.decodeValidator(rows)
+}
+
 func (m *sqlStorage) InsertCertificate(conn queryConn, certificate *pb.Certificate) error {
 	query, args, _ := m.builder().Insert("Certificates").Values(
 		certificate.OwnerID.Unwrap().Hex(),
@@ -862,6 +916,12 @@ func (m *sqlStorage) GetCertificates(conn queryConn, ownerID common.Address) ([]
 	return certificates, nil
 }
 
+func (m *sqlStorage) DeleteCertificates(conn queryConn, ownerID common.Address) error {
+	query, args, _ := m.builder().Delete("Certificates").Where("OwnerID = ?", ownerID.Hex()).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
 func (m *sqlStorage) InsertProfileUserID(conn queryConn, profile *pb.Profile) error {
 	query, args, _ := m.builder().Select("Id").From("Profiles").Where("UserID = ?", profile.UserID.Unwrap().Hex()).ToSql()
 	rows, err := conn.Query(query, args...)
@@ -900,6 +960,12 @@ func (m *sqlStorage) GetProfileByID(conn queryConn, userID common.Address) (*pb.
 	return m.decodeProfile(rows)
 }
 
+func (m *sqlStorage) DeleteProfile(conn queryConn, userID common.Address) error {
+	query, args, _ := m.builder().Delete("Profiles").Where("UserID = ?", userID.Hex()).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
 func (m *sqlStorage) GetValidators(conn queryConn, r *pb.ValidatorsRequest) ([]*pb.Validator, uint64, error) {
 	builder := m.builder().Select("*").From("Validators")
 	if r.ValidatorLevel != nil {
@@ -993,14 +1059,39 @@ func (m *sqlStorage) GetLastKnownBlock(conn queryConn) (uint64, error) {
 	return lastKnownBlock, nil
 }
 
+// GetLastKnownBlockHash returns the hash of the last known block, zero if it
+// is unknown.
+func (m *sqlStorage) GetLastKnownBlockHash(conn queryConn) (common.Hash, error) {
+	query, _, _ := m.builder().Select("LastKnownBlockHash").From("Misc").Where("Id = 1").ToSql()
+	rows, err := conn.Query(query)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to selectLastKnownBlockHash")
+	}
+	defer rows.Close()
+
+	if ok := rows.Next(); !ok {
+		return common.Hash{}, errors.New("selectLastKnownBlockHash: no entries")
+	}
+
+	var blockHash string
+	if err := rows.Scan(&blockHash); err != nil {
+		return common.Hash{}, errors.Wrapf(err, "failed to parse last known block hash")
+	}
+
+	return common.HexToHash(blockHash), nil
+}
+
 func (m *sqlStorage) InsertLastKnownBlock(conn queryConn, blockNumber int64) error {
 	query, args, _ := m.builder().Insert("Misc").Columns("LastKnownBlock").Values(blockNumber).ToSql()
 	_, err := conn.Exec(query, args...)
 	return err
 }
 
-func (m *sqlStorage) UpdateLastKnownBlock(conn queryConn, blockNumber int64) error {
-	query, args, _ := m.builder().Update("Misc").Set("LastKnownBlock", blockNumber).Where("Id = 1").ToSql()
+func (m *sqlStorage) UpdateLastKnownBlock(conn queryConn, blockNumber int64, blockHash common.Hash) error {
+	query, args, _ := m.builder().Update("Misc").
+		Set("LastKnownBlock", blockNumber).
+		Set("LastKnownBlockHash", blockHash.Hex()).
+		Where("Id = 1").ToSql()
 	_, err := conn.Exec(query, args...)
 	return err
 }
@@ -1033,6 +1124,583 @@ func (m *sqlStorage) CheckStaleID(conn queryConn, id *big.Int, entity string) (b
 	return true, nil
 }
 
+func (m *sqlStorage) DeleteDealConditions(conn queryConn, dealID *big.Int) error {
+	query, args, _ := m.builder().Delete("DealConditions").Where("DealID = ?", dealID.String()).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) InsertBlockJournalEntry(conn queryConn, entry *blockJournalEntry) error {
+	query, args, _ := m.builder().Insert("BlockJournal").
+		Columns("BlockNumber", "Entity", "EntityID", "Snapshot").
+		Values(entry.BlockNumber, entry.Entity, entry.EntityID, entry.Snapshot).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) CheckBlockJournalEntry(conn queryConn, blockNumber uint64, entity, entityID string) (bool, error) {
+	query, args, _ := m.builder().Select("Id").From("BlockJournal").
+		Where("BlockNumber = ?", blockNumber).
+		Where("Entity = ?", entity).
+		Where("EntityID = ?", entityID).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	return rows.Next(), nil
+}
+
+func (m *sqlStorage) GetBlockJournal(conn queryConn, afterBlock uint64) ([]*blockJournalEntry, error) {
+	query, args, _ := m.builder().Select("BlockNumber", "Entity", "EntityID", "Snapshot").From("BlockJournal").
+		Where("BlockNumber > ?", afterBlock).OrderBy("BlockNumber", "Id").ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectBlockJournal")
+	}
+	defer rows.Close()
+
+	var out []*blockJournalEntry
+	for rows.Next() {
+		entry := &blockJournalEntry{}
+		if err := rows.Scan(&entry.BlockNumber, &entry.Entity, &entry.EntityID, &entry.Snapshot); err != nil {
+			return nil, errors.Wrap(err, "failed to scan BlockJournal row")
+		}
+		out = append(out, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (m *sqlStorage) DeleteBlockJournal(conn queryConn, afterBlock uint64) error {
+	query, args, _ := m.builder().Delete("BlockJournal").Where("BlockNumber > ?", afterBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) PruneBlockJournal(conn queryConn, beforeBlock uint64) error {
+	query, args, _ := m.builder().Delete("BlockJournal").Where("BlockNumber < ?", beforeBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) InsertAppliedEvent(conn queryConn, event *blockchain.Event) error {
+	query, args, _ := m.builder().Insert("AppliedEvents").
+		Columns("BlockNumber", "TxHash", "LogIndex").
+		Values(event.BlockNumber, event.TxHash.Hex(), event.LogIndex).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) CheckAppliedEvent(conn queryConn, event *blockchain.Event) (bool, error) {
+	query, args, _ := m.builder().Select("BlockNumber").From("AppliedEvents").
+		Where("BlockNumber = ?", event.BlockNumber).
+		Where("TxHash = ?", event.TxHash.Hex()).
+		Where("LogIndex = ?", event.LogIndex).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	return rows.Next(), nil
+}
+
+func (m *sqlStorage) DeleteAppliedEvents(conn queryConn, afterBlock uint64) error {
+	query, args, _ := m.builder().Delete("AppliedEvents").Where("BlockNumber > ?", afterBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) PruneAppliedEvents(conn queryConn, beforeBlock uint64) error {
+	query, args, _ := m.builder().Delete("AppliedEvents").Where("BlockNumber < ?", beforeBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) InsertFailedEvent(conn queryConn, event *pb.FailedEvent) error {
+	query, args, _ := m.builder().Insert("FailedEvents").
+		Columns("BlockNumber", "EventTS", "Type", "Data", "Error", "FailedAt", "TxHash", "LogIndex").
+		Values(event.BlockNumber, event.EventTS, event.Type, event.Data, event.Error,
+			event.GetFailedAt().GetSeconds(), event.TxHash, event.LogIndex).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) GetFailedEvents(conn queryConn, r *pb.FailedEventsRequest) ([]*pb.FailedEvent, uint64, error) {
+	builder := m.builder().Select("*").From("FailedEvents").OrderBy("Id")
+	query, args, _ := m.builderWithOffsetLimit(builder, r.Limit, r.Offset).ToSql()
+	rows, count, err := m.runQuery(conn, "*", true, query, args...)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to run query")
+	}
+	defer rows.Close()
+
+	var out []*pb.FailedEvent
+	for rows.Next() {
+		event, err := m.decodeFailedEvent(rows)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to decodeFailedEvent")
+		}
+		out = append(out, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.Wrap(err, "rows error")
+	}
+
+	return out, count, nil
+}
+
+func (m *sqlStorage) GetFailedEventByID(conn queryConn, id uint64) (*pb.FailedEvent, error) {
+	query, args, _ := m.builder().Select("*").From("FailedEvents").Where("Id = ?", id).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectFailedEvent")
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, errors.New("no rows returned")
+	}
+
+	return m.decodeFailedEvent(rows)
+}
+
+func (m *sqlStorage) UpdateFailedEvent(conn queryConn, id uint64, reason string, failedAt uint64) error {
+	query, args, _ := m.builder().Update("FailedEvents").
+		Set("Error", reason).
+		Set("FailedAt", failedAt).
+		Where("Id = ?", id).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) DeleteFailedEvent(conn queryConn, id uint64) error {
+	query, args, _ := m.builder().Delete("FailedEvents").Where("Id = ?", id).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) DeleteFailedEvents(conn queryConn, afterBlock uint64) error {
+	query, args, _ := m.builder().Delete("FailedEvents").Where("BlockNumber > ?", afterBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+// InsertPayment stores the payment unless the one with the same transaction
+// and log index is already stored, e.g. when its event is replayed. Note that
+// a single transaction can pay for the same deal several times.
+func (m *sqlStorage) InsertPayment(conn queryConn, payment *pb.Payment) error {
+	query, args, _ := m.builder().Select("Id").From("Payments").
+		Where("TxHash = ?", payment.TxHash).
+		Where("LogIndex = ?", payment.LogIndex).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to selectPayment")
+	}
+	exists := rows.Next()
+	rows.Close()
+	if exists {
+		return nil
+	}
+
+	query, args, _ = m.builder().Insert("Payments").
+		Columns("DealID", "PayerID", "PayeeID", "Amount", "BlockNumber", "TS", "TxHash", "LogIndex").
+		Values(
+			payment.DealID.Unwrap().String(),
+			payment.PayerID.Unwrap().Hex(),
+			payment.PayeeID.Unwrap().Hex(),
+			payment.Amount.PaddedString(),
+			payment.BlockNumber,
+			payment.Ts.Seconds,
+			payment.TxHash,
+			payment.LogIndex,
+		).ToSql()
+	_, err = conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) GetPayments(conn queryConn, r *pb.PaymentsRequest) ([]*pb.Payment, uint64, error) {
+	builder := m.builder().Select("*").From("Payments")
+	if !r.Address.IsZero() {
+		address := r.Address.Unwrap().Hex()
+		builder = builder.Where(squirrel.Or{squirrel.Eq{"PayerID": address}, squirrel.Eq{"PayeeID": address}})
+	}
+	if !r.PayerID.IsZero() {
+		builder = builder.Where("PayerID = ?", r.PayerID.Unwrap().Hex())
+	}
+	if !r.PayeeID.IsZero() {
+		builder = builder.Where("PayeeID = ?", r.PayeeID.Unwrap().Hex())
+	}
+	if !r.DealID.IsZero() {
+		builder = builder.Where("DealID = ?", r.DealID.Unwrap().String())
+	}
+	if r.Ts != nil {
+		if r.Ts.Max != nil && r.Ts.Max.Seconds > 0 {
+			builder = builder.Where("TS <= ?", r.Ts.Max.Seconds)
+		}
+		if r.Ts.Min != nil && r.Ts.Min.Seconds > 0 {
+			builder = builder.Where("TS >= ?", r.Ts.Min.Seconds)
+		}
+	}
+	builder = builder.OrderBy("Id")
+	query, args, _ := m.builderWithOffsetLimit(builder, r.Limit, r.Offset).ToSql()
+	rows, count, err := m.runQuery(conn, "*", r.WithCount, query, args...)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to run query")
+	}
+	defer rows.Close()
+
+	var out []*pb.Payment
+	for rows.Next() {
+		payment, err := m.decodePayment(rows)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to decodePayment")
+		}
+		out = append(out, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.Wrap(err, "rows error")
+	}
+
+	return out, count, nil
+}
+
+func (m *sqlStorage) DeletePayments(conn queryConn, afterBlock uint64) error {
+	query, args, _ := m.builder().Delete("Payments").Where("BlockNumber > ?", afterBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) InsertChangeLogEntry(conn queryConn, entry *changeLogEntry) error {
+	query, args, _ := m.builder().Insert("ChangeLog").
+		Columns("BlockNumber", "Entity", "Event").
+		Values(entry.BlockNumber, entry.Entity, entry.Event).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+// GetChangeLog returns entries of the given entity with IDs in the
+// (afterID, toID] range.
+func (m *sqlStorage) GetChangeLog(conn queryConn, entity string, afterID, toID, limit uint64) ([]*changeLogEntry, error) {
+	query, args, _ := m.builder().Select("Id", "BlockNumber", "Entity", "Event").From("ChangeLog").
+		Where("Entity = ?", entity).
+		Where("Id > ?", afterID).
+		Where("Id <= ?", toID).
+		OrderBy("Id").Limit(limit).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectChangeLog")
+	}
+	defer rows.Close()
+
+	var out []*changeLogEntry
+	for rows.Next() {
+		entry := &changeLogEntry{}
+		if err := rows.Scan(&entry.Id, &entry.BlockNumber, &entry.Entity, &entry.Event); err != nil {
+			return nil, errors.Wrap(err, "failed to scan ChangeLog row")
+		}
+		out = append(out, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// GetChangeLogCursor returns the ID of the last entry stored before the
+// entries of the given block. The ID of the last entry is returned if there
+// are no entries of the given and later blocks.
+func (m *sqlStorage) GetChangeLogCursor(conn queryConn, fromBlock uint64) (uint64, error) {
+	query, args, _ := m.builder().Select("Id").From("ChangeLog").
+		Where("BlockNumber >= ?", fromBlock).OrderBy("Id").Limit(1).ToSql()
+	id, ok, err := m.selectID(conn, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return id - 1, nil
+	}
+
+	return m.GetLastChangeLogID(conn)
+}
+
+// GetLastChangeLogID returns the ID of the last stored entry or zero if
+// there are no entries.
+func (m *sqlStorage) GetLastChangeLogID(conn queryConn) (uint64, error) {
+	query, args, _ := m.builder().Select("Id").From("ChangeLog").OrderBy("Id DESC").Limit(1).ToSql()
+	id, _, err := m.selectID(conn, query, args...)
+	return id, err
+}
+
+func (m *sqlStorage) selectID(conn queryConn, query string, args ...interface{}) (uint64, bool, error) {
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return 0, false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return 0, false, rows.Err()
+	}
+
+	var id uint64
+	if err := rows.Scan(&id); err != nil {
+		return 0, false, err
+	}
+
+	return id, true, nil
+}
+
+func (m *sqlStorage) DeleteChangeLog(conn queryConn, afterBlock uint64) error {
+	query, args, _ := m.builder().Delete("ChangeLog").Where("BlockNumber > ?", afterBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) PruneChangeLog(conn queryConn, beforeBlock uint64) error {
+	query, args, _ := m.builder().Delete("ChangeLog").Where("BlockNumber < ?", beforeBlock).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) GetOrderRollup(conn queryConn, key *orderRollup) (*orderRollup, error) {
+	query, args, _ := m.builder().Select(orderRollupColumns...).From("OrderRollups").
+		Where(orderRollupKey(key)).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectOrderRollup")
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return newOrderRollup(key), rows.Err()
+	}
+
+	return m.decodeOrderRollup(rows)
+}
+
+func (m *sqlStorage) SaveOrderRollup(conn queryConn, rollup *orderRollup) error {
+	query, args, _ := m.builder().Delete("OrderRollups").Where(orderRollupKey(rollup)).ToSql()
+	if _, err := conn.Exec(query, args...); err != nil {
+		return err
+	}
+
+	query, args, _ = m.builder().Insert("OrderRollups").
+		Columns(orderRollupColumns...).
+		Values(
+			rollup.BucketTS,
+			uint64(rollup.Type),
+			rollup.IdentityLevel,
+			rollup.GPUMem,
+			rollup.CPUCores,
+			rollup.Netflags,
+			rollup.Placed,
+			rollup.Matched,
+			rollup.Cancelled,
+			rollup.MatchTime,
+			util.BigIntToPaddedString(rollup.PlacedVolume),
+			util.BigIntToPaddedString(rollup.RemovedVolume),
+		).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) GetOrderRollups(conn queryConn, filter *rollupFilter) ([]*orderRollup, error) {
+	builder := m.builder().Select(orderRollupColumns...).From("OrderRollups")
+	query, args, _ := m.builderWithRollupFilter(builder, filter).OrderBy("BucketTS").ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectOrderRollups")
+	}
+	defer rows.Close()
+
+	var out []*orderRollup
+	for rows.Next() {
+		rollup, err := m.decodeOrderRollup(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rollup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (m *sqlStorage) GetPriceRollup(conn queryConn, key *priceRollup) (*priceRollup, error) {
+	query, args, _ := m.builder().Select(priceRollupColumns...).From("PriceRollups").
+		Where(priceRollupKey(key)).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectPriceRollup")
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return newPriceRollup(key), rows.Err()
+	}
+
+	return m.decodePriceRollup(rows)
+}
+
+func (m *sqlStorage) SavePriceRollup(conn queryConn, rollup *priceRollup) error {
+	query, args, _ := m.builder().Delete("PriceRollups").Where(priceRollupKey(rollup)).ToSql()
+	if _, err := conn.Exec(query, args...); err != nil {
+		return err
+	}
+
+	query, args, _ = m.builder().Insert("PriceRollups").
+		Columns(priceRollupColumns...).
+		Values(
+			rollup.BucketTS,
+			uint64(rollup.Type),
+			rollup.IdentityLevel,
+			rollup.BenchmarkID,
+			rollup.Bin,
+			rollup.Count,
+			rollup.MinPrice,
+			rollup.MaxPrice,
+		).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) GetPriceRollups(conn queryConn, filter *rollupFilter, benchmarkID uint64) ([]*priceRollup, error) {
+	builder := m.builder().Select(priceRollupColumns...).From("PriceRollups").
+		Where("BenchmarkID = ?", benchmarkID)
+	query, args, _ := m.builderWithRollupFilter(builder, filter).OrderBy("BucketTS").ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectPriceRollups")
+	}
+	defer rows.Close()
+
+	var out []*priceRollup
+	for rows.Next() {
+		rollup, err := m.decodePriceRollup(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rollup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (m *sqlStorage) GetPayoutRollup(conn queryConn, key *payoutRollup) (*payoutRollup, error) {
+	query, args, _ := m.builder().Select(payoutRollupColumns...).From("PayoutRollups").
+		Where(payoutRollupKey(key)).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectPayoutRollup")
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return newPayoutRollup(key), rows.Err()
+	}
+
+	return m.decodePayoutRollup(rows)
+}
+
+func (m *sqlStorage) SavePayoutRollup(conn queryConn, rollup *payoutRollup) error {
+	query, args, _ := m.builder().Delete("PayoutRollups").Where(payoutRollupKey(rollup)).ToSql()
+	if _, err := conn.Exec(query, args...); err != nil {
+		return err
+	}
+
+	query, args, _ = m.builder().Insert("PayoutRollups").
+		Columns(payoutRollupColumns...).
+		Values(
+			rollup.BucketTS,
+			rollup.IdentityLevel,
+			rollup.Bills,
+			util.BigIntToPaddedString(rollup.Payout),
+		).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
+func (m *sqlStorage) GetPayoutRollups(conn queryConn, filter *rollupFilter) ([]*payoutRollup, error) {
+	builder := m.builder().Select(payoutRollupColumns...).From("PayoutRollups")
+	query, args, _ := m.builderWithRollupFilter(builder, filter).OrderBy("BucketTS").ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectPayoutRollups")
+	}
+	defer rows.Close()
+
+	var out []*payoutRollup
+	for rows.Next() {
+		rollup, err := m.decodePayoutRollup(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rollup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (m *sqlStorage) GetRolledUpOrder(conn queryConn, orderID *big.Int) (*rolledUpOrder, error) {
+	query, args, _ := m.builder().Select("IdentityLevel").From("RolledUpOrders").
+		Where("Id = ?", orderID.String()).ToSql()
+	rows, err := conn.Query(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectRolledUpOrder")
+	}
+	defer rows.Close()
+
+	order := &rolledUpOrder{ID: orderID.String()}
+	if !rows.Next() {
+		return order, rows.Err()
+	}
+
+	if err := rows.Scan(&order.IdentityLevel); err != nil {
+		return nil, errors.Wrap(err, "failed to scan RolledUpOrder row")
+	}
+	order.Active = true
+
+	return order, nil
+}
+
+func (m *sqlStorage) SaveRolledUpOrder(conn queryConn, order *rolledUpOrder) error {
+	query, args, _ := m.builder().Delete("RolledUpOrders").Where("Id = ?", order.ID).ToSql()
+	if _, err := conn.Exec(query, args...); err != nil {
+		return err
+	}
+	if !order.Active {
+		return nil
+	}
+
+	query, args, _ = m.builder().Insert("RolledUpOrders").
+		Columns("Id", "IdentityLevel").
+		Values(order.ID, order.IdentityLevel).ToSql()
+	_, err := conn.Exec(query, args...)
+	return err
+}
+
 func (m *sqlStorage) addBenchmarksConditionsWhere(builder squirrel.SelectBuilder, benches map[uint64]*pb.MaxMinUint64) squirrel.SelectBuilder {
 	for benchID, condition := range benches {
 		if condition.Max > 0 {
@@ -1441,6 +2109,106 @@ func (m *sqlStorage) decodeWorker(rows *sql.Rows) (*pb.DWHWorker, error) {
 	}, nil
 }
 
+func (m *sqlStorage) decodeFailedEvent(rows *sql.Rows) (*pb.FailedEvent, error) {
+	var (
+		event    = &pb.FailedEvent{}
+		failedAt int64
+	)
+	err := rows.Scan(&event.Id, &event.BlockNumber, &event.EventTS, &event.Type, &event.Data, &event.Error, &failedAt,
+		&event.TxHash, &event.LogIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan FailedEvent row")
+	}
+	event.FailedAt = &pb.Timestamp{Seconds: failedAt}
+
+	return event, nil
+}
+
+func (m *sqlStorage) decodePayment(rows *sql.Rows) (*pb.Payment, error) {
+	var (
+		payment = &pb.Payment{}
+		dealID  string
+		payerID string
+		payeeID string
+		amount  string
+		ts      int64
+	)
+	err := rows.Scan(&payment.Id, &dealID, &payerID, &payeeID, &amount, &payment.BlockNumber, &ts, &payment.TxHash, &payment.LogIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan Payment row")
+	}
+
+	if payment.DealID, err = pb.NewBigIntFromString(dealID); err != nil {
+		return nil, errors.Wrap(err, "failed to NewBigIntFromString (DealID)")
+	}
+	bigAmount, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, errors.New("failed to parse Payment amount")
+	}
+	payment.PayerID = pb.NewEthAddress(common.HexToAddress(payerID))
+	payment.PayeeID = pb.NewEthAddress(common.HexToAddress(payeeID))
+	payment.Amount = pb.NewBigInt(bigAmount)
+	payment.Ts = &pb.Timestamp{Seconds: ts}
+
+	return payment, nil
+}
+
+func (m *sqlStorage) decodeOrderRollup(rows *sql.Rows) (*orderRollup, error) {
+	var (
+		rollup        = &orderRollup{}
+		orderType     uint64
+		placedVolume  string
+		removedVolume string
+	)
+	err := rows.Scan(&rollup.BucketTS, &orderType, &rollup.IdentityLevel, &rollup.GPUMem, &rollup.CPUCores,
+		&rollup.Netflags, &rollup.Placed, &rollup.Matched, &rollup.Cancelled, &rollup.MatchTime,
+		&placedVolume, &removedVolume)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan OrderRollup row")
+	}
+
+	rollup.Type = pb.OrderType(orderType)
+	rollup.PlacedVolume, _ = new(big.Int).SetString(placedVolume, 10)
+	rollup.RemovedVolume, _ = new(big.Int).SetString(removedVolume, 10)
+	if rollup.PlacedVolume == nil || rollup.RemovedVolume == nil {
+		return nil, errors.New("failed to parse OrderRollup volume")
+	}
+
+	return rollup, nil
+}
+
+func (m *sqlStorage) decodePriceRollup(rows *sql.Rows) (*priceRollup, error) {
+	var (
+		rollup    = &priceRollup{}
+		orderType uint64
+	)
+	err := rows.Scan(&rollup.BucketTS, &orderType, &rollup.IdentityLevel, &rollup.BenchmarkID, &rollup.Bin,
+		&rollup.Count, &rollup.MinPrice, &rollup.MaxPrice)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan PriceRollup row")
+	}
+	rollup.Type = pb.OrderType(orderType)
+
+	return rollup, nil
+}
+
+func (m *sqlStorage) decodePayoutRollup(rows *sql.Rows) (*payoutRollup, error) {
+	var (
+		rollup = &payoutRollup{}
+		payout string
+	)
+	if err := rows.Scan(&rollup.BucketTS, &rollup.IdentityLevel, &rollup.Bills, &payout); err != nil {
+		return nil, errors.Wrap(err, "failed to scan PayoutRollup row")
+	}
+
+	var ok bool
+	if rollup.Payout, ok = new(big.Int).SetString(payout, 10); !ok {
+		return nil, errors.New("failed to parse PayoutRollup payout")
+	}
+
+	return rollup, nil
+}
+
 func (m *sqlStorage) filterSortings(sortings []*pb.SortingOption, columns map[string]bool) (out []*pb.SortingOption) {
 	for _, sorting := range sortings {
 		if columns[sorting.Field] {
@@ -1454,7 +2222,7 @@ func (m *sqlStorage) filterSortings(sortings []*pb.SortingOption, columns map[st
 type sqlSetupCommands struct {
 	createTableDeals          string
 	createTableDealConditions string
-	createTableDealPayments   string
+	createTablePayments       string
 	createTableChangeRequests string
 	createTableOrders         string
 	createTableWorkers        string
@@ -1464,6 +2232,14 @@ type sqlSetupCommands struct {
 	createTableProfiles       string
 	createTableMisc           string
 	createTableStaleIDs       string
+	createTableBlockJournal   string
+	createTableFailedEvents   string
+	createTableAppliedEvents  string
+	createTableChangeLog      string
+	createTableOrderRollups   string
+	createTablePriceRollups   string
+	createTablePayoutRollups  string
+	createTableRolledUpOrders string
 	createIndexCmd            string
 	tablesInfo                *tablesInfo
 }
@@ -1479,9 +2255,9 @@ func (c *sqlSetupCommands) setupTables(db *sql.DB) error {
 		return errors.Wrapf(err, "failed to %s", c.createTableDealConditions)
 	}
 
-	_, err = db.Exec(c.createTableDealPayments)
+	_, err = db.Exec(c.createTablePayments)
 	if err != nil {
-		return errors.Wrapf(err, "failed to %s", c.createTableDealPayments)
+		return errors.Wrapf(err, "failed to %s", c.createTablePayments)
 	}
 
 	_, err = db.Exec(c.createTableChangeRequests)
@@ -1529,6 +2305,46 @@ func (c *sqlSetupCommands) setupTables(db *sql.DB) error {
 		return errors.Wrapf(err, "failed to %s", c.createTableMisc)
 	}
 
+	_, err = db.Exec(c.createTableBlockJournal)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableBlockJournal)
+	}
+
+	_, err = db.Exec(c.createTableFailedEvents)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableFailedEvents)
+	}
+
+	_, err = db.Exec(c.createTableAppliedEvents)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableAppliedEvents)
+	}
+
+	_, err = db.Exec(c.createTableChangeLog)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableChangeLog)
+	}
+
+	_, err = db.Exec(c.createTableOrderRollups)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableOrderRollups)
+	}
+
+	_, err = db.Exec(c.createTablePriceRollups)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTablePriceRollups)
+	}
+
+	_, err = db.Exec(c.createTablePayoutRollups)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTablePayoutRollups)
+	}
+
+	_, err = db.Exec(c.createTableRolledUpOrders)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableRolledUpOrders)
+	}
+
 	return nil
 }
 
@@ -1578,6 +2394,25 @@ func (c *sqlSetupCommands) createIndices(db *sql.DB) error {
 	if err = c.createIndex(db, c.createIndexCmd, "StaleIDs", "Id"); err != nil {
 		return err
 	}
+	if err = c.createIndex(db, c.createIndexCmd, "BlockJournal", "BlockNumber"); err != nil {
+		return err
+	}
+	if err = c.createIndex(db, c.createIndexCmd, "ChangeLog", "BlockNumber"); err != nil {
+		return err
+	}
+	if err = c.createIndex(db, c.createIndexCmd, "ChangeLog", "Entity"); err != nil {
+		return err
+	}
+	for _, column := range []string{"DealID", "PayerID", "PayeeID", "BlockNumber", "TS"} {
+		if err = c.createIndex(db, c.createIndexCmd, "Payments", column); err != nil {
+			return err
+		}
+	}
+	for _, table := range []string{"OrderRollups", "PriceRollups", "PayoutRollups"} {
+		if err = c.createIndex(db, c.createIndexCmd, table, "BucketTS"); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -1624,6 +2459,46 @@ func (m *sqlStorage) newNetflagsWhere(builder squirrel.SelectBuilder, operator p
 	}
 }
 
+func orderRollupKey(rollup *orderRollup) squirrel.Eq {
+	return squirrel.Eq{
+		"BucketTS":      rollup.BucketTS,
+		"Type":          uint64(rollup.Type),
+		"IdentityLevel": rollup.IdentityLevel,
+		"GPUMem":        rollup.GPUMem,
+		"CPUCores":      rollup.CPUCores,
+		"Netflags":      rollup.Netflags,
+	}
+}
+
+func priceRollupKey(rollup *priceRollup) squirrel.Eq {
+	return squirrel.Eq{
+		"BucketTS":      rollup.BucketTS,
+		"Type":          uint64(rollup.Type),
+		"IdentityLevel": rollup.IdentityLevel,
+		"BenchmarkID":   rollup.BenchmarkID,
+		"Bin":           rollup.Bin,
+	}
+}
+
+func payoutRollupKey(rollup *payoutRollup) squirrel.Eq {
+	return squirrel.Eq{
+		"BucketTS":      rollup.BucketTS,
+		"IdentityLevel": rollup.IdentityLevel,
+	}
+}
+
+func (m *sqlStorage) builderWithRollupFilter(builder squirrel.SelectBuilder, filter *rollupFilter) squirrel.SelectBuilder {
+	builder = builder.Where("BucketTS >= ?", filter.From).Where("BucketTS < ?", filter.To)
+	if filter.Type > 0 {
+		builder = builder.Where("Type = ?", uint64(filter.Type))
+	}
+	if len(filter.IdentityLevels) > 0 {
+		builder = builder.Where(squirrel.Eq{"IdentityLevel": filter.IdentityLevels})
+	}
+
+	return builder
+}
+
 func (m *sqlStorage) runQuery(conn queryConn, columns string, withCount bool, query string, args ...interface{}) (*sql.Rows, uint64, error) {
 	dataQuery := strings.Replace(query, "*", columns, 1)
 	var count uint64
@@ -45,13 +45,19 @@ func newSQLiteStorage(numBenchmarks uint64) *sqlStorage {
 		DealID						TEXT NOT NULL,
 		FOREIGN KEY (DealID)		REFERENCES Deals(Id) ON DELETE CASCADE
 	)`,
-			createTableDealPayments: `
-	CREATE TABLE IF NOT EXISTS DealPayments (
-		BillTS						INTEGER NOT NULL,
-		PaidAmount					TEXT NOT NULL,
+			// Payments outlive their deals, so there is no foreign key.
+			createTablePayments: `
+	CREATE TABLE IF NOT EXISTS Payments (
+		Id							INTEGER PRIMARY KEY AUTOINCREMENT,
 		DealID						TEXT NOT NULL,
-		UNIQUE						(BillTS, PaidAmount, DealID),
-		FOREIGN KEY (DealID) 		REFERENCES Deals(Id) ON DELETE CASCADE
+		PayerID						TEXT NOT NULL,
+		PayeeID						TEXT NOT NULL,
+		Amount						TEXT NOT NULL,
+		BlockNumber					INTEGER NOT NULL,
+		TS							INTEGER NOT NULL,
+		TxHash						TEXT NOT NULL,
+		LogIndex					INTEGER NOT NULL,
+		UNIQUE						(TxHash, LogIndex)
 	)`,
 			createTableChangeRequests: `
 	CREATE TABLE IF NOT EXISTS DealChangeRequests (
@@ -129,11 +135,88 @@ func newSQLiteStorage(numBenchmarks uint64) *sqlStorage {
 			createTableStaleIDs: `
 	CREATE TABLE IF NOT EXISTS StaleIDs (
 		Id 							TEXT NOT NULL
+	)`,
+			createTableBlockJournal: `
+	CREATE TABLE IF NOT EXISTS BlockJournal (
+		Id							INTEGER PRIMARY KEY AUTOINCREMENT,
+		BlockNumber					INTEGER NOT NULL,
+		Entity						TEXT NOT NULL,
+		EntityID					TEXT NOT NULL,
+		Snapshot					BLOB NOT NULL,
+		UNIQUE						(BlockNumber, Entity, EntityID)
+	)`,
+			createTableFailedEvents: `
+	CREATE TABLE IF NOT EXISTS FailedEvents (
+		Id							INTEGER PRIMARY KEY AUTOINCREMENT,
+		BlockNumber					INTEGER NOT NULL,
+		EventTS						INTEGER NOT NULL,
+		Type						TEXT NOT NULL,
+		Data						TEXT NOT NULL,
+		Error						TEXT NOT NULL,
+		FailedAt					INTEGER NOT NULL,
+		TxHash						TEXT NOT NULL,
+		LogIndex					INTEGER NOT NULL
+	)`,
+			createTableAppliedEvents: `
+	CREATE TABLE IF NOT EXISTS AppliedEvents (
+		BlockNumber					INTEGER NOT NULL,
+		TxHash						TEXT NOT NULL,
+		LogIndex					INTEGER NOT NULL,
+		UNIQUE						(BlockNumber, TxHash, LogIndex)
+	)`,
+			createTableChangeLog: `
+	CREATE TABLE IF NOT EXISTS ChangeLog (
+		Id							INTEGER PRIMARY KEY AUTOINCREMENT,
+		BlockNumber					INTEGER NOT NULL,
+		Entity						TEXT NOT NULL,
+		Event						BLOB NOT NULL
+	)`,
+			createTableOrderRollups: `
+	CREATE TABLE IF NOT EXISTS OrderRollups (
+		BucketTS					INTEGER NOT NULL,
+		Type						INTEGER NOT NULL,
+		IdentityLevel				INTEGER NOT NULL,
+		GPUMem						INTEGER NOT NULL,
+		CPUCores					INTEGER NOT NULL,
+		Netflags					INTEGER NOT NULL,
+		Placed						INTEGER NOT NULL,
+		Matched						INTEGER NOT NULL,
+		Cancelled					INTEGER NOT NULL,
+		MatchTime					INTEGER NOT NULL,
+		PlacedVolume				TEXT NOT NULL,
+		RemovedVolume				TEXT NOT NULL,
+		UNIQUE						(BucketTS, Type, IdentityLevel, GPUMem, CPUCores, Netflags)
+	)`,
+			createTablePriceRollups: `
+	CREATE TABLE IF NOT EXISTS PriceRollups (
+		BucketTS					INTEGER NOT NULL,
+		Type						INTEGER NOT NULL,
+		IdentityLevel				INTEGER NOT NULL,
+		BenchmarkID					INTEGER NOT NULL,
+		Bin							INTEGER NOT NULL,
+		Count						INTEGER NOT NULL,
+		MinPrice					REAL NOT NULL,
+		MaxPrice					REAL NOT NULL,
+		UNIQUE						(BucketTS, Type, IdentityLevel, BenchmarkID, Bin)
+	)`,
+			createTablePayoutRollups: `
+	CREATE TABLE IF NOT EXISTS PayoutRollups (
+		BucketTS					INTEGER NOT NULL,
+		IdentityLevel				INTEGER NOT NULL,
+		Bills						INTEGER NOT NULL,
+		Payout						TEXT NOT NULL,
+		UNIQUE						(BucketTS, IdentityLevel)
+	)`,
+			createTableRolledUpOrders: `
+	CREATE TABLE IF NOT EXISTS RolledUpOrders (
+		Id							TEXT UNIQUE NOT NULL,
+		IdentityLevel				INTEGER NOT NULL
 	)`,
 			createTableMisc: `
 	CREATE TABLE IF NOT EXISTS Misc (
 		Id							INTEGER PRIMARY KEY AUTOINCREMENT,
-		LastKnownBlock				INTEGER NOT NULL
+		LastKnownBlock				INTEGER NOT NULL,
+		LastKnownBlockHash			TEXT NOT NULL DEFAULT ''
 	)`,
 			createIndexCmd: `CREATE INDEX IF NOT EXISTS %s_%s ON %s (%s)`,
 			tablesInfo:     tInfo,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
 	pb "github.com/sonm-io/core/proto"
 	"go.uber.org/zap"
 )
@@ -47,20 +48,61 @@ type storage interface {
 	GetBlacklist(conn queryConn, request *pb.BlacklistRequest) (*pb.BlacklistReply, error)
 	InsertOrUpdateValidator(conn queryConn, validator *pb.Validator) error
 	UpdateValidator(conn queryConn, validator *pb.Validator) error
+	DeleteValidator(conn queryConn, validatorID common.Address) error
+	GetValidator(conn queryConn, validatorID common.Address) (*pb.Validator, error)
 	InsertCertificate(conn queryConn, certificate *pb.Certificate) error
 	GetCertificates(conn queryConn, ownerID common.Address) ([]*pb.Certificate, error)
+	DeleteCertificates(conn queryConn, ownerID common.Address) error
 	InsertProfileUserID(conn queryConn, profile *pb.Profile) error
 	GetProfileByID(conn queryConn, userID common.Address) (*pb.Profile, error)
+	DeleteProfile(conn queryConn, userID common.Address) error
 	GetValidators(conn queryConn, request *pb.ValidatorsRequest) ([]*pb.Validator, uint64, error)
 	GetWorkers(conn queryConn, request *pb.WorkersRequest) ([]*pb.DWHWorker, uint64, error)
 	UpdateProfile(conn queryConn, userID common.Address, field string, value interface{}) error
 	UpdateProfileStats(conn queryConn, userID common.Address, field string, value int) error
 	GetLastKnownBlock(conn queryConn) (uint64, error)
 	InsertLastKnownBlock(conn queryConn, blockNumber int64) error
-	UpdateLastKnownBlock(conn queryConn, blockNumber int64) error
+	UpdateLastKnownBlock(conn queryConn, blockNumber int64, blockHash common.Hash) error
+	GetLastKnownBlockHash(conn queryConn) (common.Hash, error)
 	StoreStaleID(conn queryConn, id *big.Int, entity string) error
 	RemoveStaleID(conn queryConn, id *big.Int, entity string) error
 	CheckStaleID(conn queryConn, id *big.Int, entity string) (bool, error)
+	DeleteDealConditions(conn queryConn, dealID *big.Int) error
+	InsertBlockJournalEntry(conn queryConn, entry *blockJournalEntry) error
+	CheckBlockJournalEntry(conn queryConn, blockNumber uint64, entity, entityID string) (bool, error)
+	GetBlockJournal(conn queryConn, afterBlock uint64) ([]*blockJournalEntry, error)
+	DeleteBlockJournal(conn queryConn, afterBlock uint64) error
+	PruneBlockJournal(conn queryConn, beforeBlock uint64) error
+	InsertAppliedEvent(conn queryConn, event *blockchain.Event) error
+	CheckAppliedEvent(conn queryConn, event *blockchain.Event) (bool, error)
+	DeleteAppliedEvents(conn queryConn, afterBlock uint64) error
+	PruneAppliedEvents(conn queryConn, beforeBlock uint64) error
+	InsertFailedEvent(conn queryConn, event *pb.FailedEvent) error
+	GetFailedEvents(conn queryConn, request *pb.FailedEventsRequest) ([]*pb.FailedEvent, uint64, error)
+	GetFailedEventByID(conn queryConn, id uint64) (*pb.FailedEvent, error)
+	UpdateFailedEvent(conn queryConn, id uint64, reason string, failedAt uint64) error
+	DeleteFailedEvent(conn queryConn, id uint64) error
+	DeleteFailedEvents(conn queryConn, afterBlock uint64) error
+	InsertPayment(conn queryConn, payment *pb.Payment) error
+	GetPayments(conn queryConn, request *pb.PaymentsRequest) ([]*pb.Payment, uint64, error)
+	DeletePayments(conn queryConn, afterBlock uint64) error
+	InsertChangeLogEntry(conn queryConn, entry *changeLogEntry) error
+	GetChangeLog(conn queryConn, entity string, afterID, toID, limit uint64) ([]*changeLogEntry, error)
+	GetChangeLogCursor(conn queryConn, fromBlock uint64) (uint64, error)
+	GetLastChangeLogID(conn queryConn) (uint64, error)
+	DeleteChangeLog(conn queryConn, afterBlock uint64) error
+	PruneChangeLog(conn queryConn, beforeBlock uint64) error
+	GetOrderRollup(conn queryConn, key *orderRollup) (*orderRollup, error)
+	SaveOrderRollup(conn queryConn, rollup *orderRollup) error
+	GetOrderRollups(conn queryConn, filter *rollupFilter) ([]*orderRollup, error)
+	GetPriceRollup(conn queryConn, key *priceRollup) (*priceRollup, error)
+	SavePriceRollup(conn queryConn, rollup *priceRollup) error
+	GetPriceRollups(conn queryConn, filter *rollupFilter, benchmarkID uint64) ([]*priceRollup, error)
+	GetPayoutRollup(conn queryConn, key *payoutRollup) (*payoutRollup, error)
+	SavePayoutRollup(conn queryConn, rollup *payoutRollup) error
+	GetPayoutRollups(conn queryConn, filter *rollupFilter) ([]*payoutRollup, error)
+	GetRolledUpOrder(conn queryConn, orderID *big.Int) (*rolledUpOrder, error)
+	SaveRolledUpOrder(conn queryConn, order *rolledUpOrder) error
 }
 
 type queryConn interface {
@@ -138,3 +180,30 @@ func (t *txConn) Finish() error {
 	}
 	return nil
 }
+
+// withTx runs the function in a transaction, which is committed only if the
+// function succeeds and none of its queries has failed.
+func withTx(db *sql.DB, logger *zap.Logger, fn func(conn queryConn) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	conn := &txConn{tx: tx, logger: logger}
+	err = fn(conn)
+	if err == nil && conn.hasErrors {
+		err = errors.New("transaction has failed queries")
+	}
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			logger.Warn("transaction rollback failed", zap.Error(err))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package node
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/sonm-io/core/proto"
 )
@@ -24,6 +25,19 @@ func (b *blacklistAPI) List(ctx context.Context, addr *sonm.EthAddress) (*sonm.B
 	return b.remotes.dwh.GetBlacklist(ctx, req)
 }
 
+func (b *blacklistAPI) Add(ctx context.Context, addr *sonm.EthAddress) (*sonm.Empty, error) {
+	if addr.IsZero() {
+		return nil, errors.New("cannot add empty address to blacklist")
+	}
+
+	owner := crypto.PubkeyToAddress(b.remotes.key.PublicKey)
+	if err := b.remotes.eth.Blacklist().Add(ctx, b.remotes.key, owner, addr.Unwrap()); err != nil {
+		return nil, errors.WithMessage(err, "cannot add address to blacklist")
+	}
+
+	return &sonm.Empty{}, nil
+}
+
 func (b *blacklistAPI) Remove(ctx context.Context, addr *sonm.EthAddress) (*sonm.Empty, error) {
 	if err := b.remotes.eth.Blacklist().Remove(ctx, b.remotes.key, addr.Unwrap()); err != nil {
 		return nil, errors.WithMessage(err, "cannot remove address from blacklist")
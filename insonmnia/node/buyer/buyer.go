@@ -0,0 +1,504 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mohae/deepcopy"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+)
+
+type YAMLConfig struct {
+	MatcherRetryInterval time.Duration `yaml:"matcher_retry_interval" default:"10s"`
+	// History describes how long finished bid plans are kept to be listed.
+	History time.Duration `yaml:"history" default:"168h"`
+	// Task deployment is retried with exponential backoff between these
+	// intervals.
+	DeployRetryInterval    time.Duration `yaml:"deploy_retry_interval" default:"10s"`
+	DeployMaxRetryInterval time.Duration `yaml:"deploy_max_retry_interval" default:"5m"`
+	// Workers running kept alive tasks are pinged with this interval. The
+	// task is migrated to a new deal if the Worker stays unreachable for
+	// the timeout.
+	KeepAliveInterval time.Duration `yaml:"keep_alive_interval" default:"30s"`
+	KeepAliveTimeout  time.Duration `yaml:"keep_alive_timeout" default:"5m"`
+	// ImageDir is a directory where committed images of migrating tasks
+	// are kept until pushed to the new Worker.
+	ImageDir string `yaml:"image_dir" default:"/var/lib/sonm/node/images"`
+}
+
+// Buyer is a consumer-side counterpart of the Worker's Salesman.
+//
+// It keeps track of BID orders placed by the Node as bid plans, trying to
+// open a deal for each of them until succeeded. Bid plans are persisted, so
+// matching continues after the Node restart.
+type Buyer struct {
+	*options
+	bidPlanStorage *state.KeyedStorage
+
+	bidPlans map[string]*sonm.BidPlan
+	// Cancellation functions of running matchers by bid plan ID.
+	matching map[string]context.CancelFunc
+
+	deploymentStorage *state.KeyedStorage
+	// Tasks to be started when deals are opened by bid plan ID.
+	deployments map[string]*sonm.TaskDeployment
+	deploying   map[string]bool
+	// Kept alive tasks being watched or migrated by bid plan ID.
+	watching map[string]bool
+
+	ctx context.Context
+	mu  sync.Mutex
+}
+
+func NewBuyer(opts ...Option) (*Buyer, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+
+	b := &Buyer{
+		options:        o,
+		bidPlanStorage: state.NewKeyedStorage("bid_plans", o.storage),
+		matching:       map[string]context.CancelFunc{},
+
+		deploymentStorage: state.NewKeyedStorage("task_deployments", o.storage),
+		deploying:         map[string]bool{},
+		watching:          map[string]bool{},
+	}
+
+	if err := b.restoreState(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Run starts matching of all active bid plans and deploying tasks for
+// opened deals. Bid plans created after are matched until the given context
+// is cancelled.
+func (m *Buyer) Run(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.ctx = ctx
+	for _, plan := range m.bidPlans {
+		if plan.GetStatus() == sonm.BidPlan_MATCHING && !plan.GetOrderID().IsZero() {
+			m.startMatching(plan)
+		}
+	}
+	for _, deployment := range m.deployments {
+		m.startDeploying(deployment)
+		m.startWatching(deployment)
+	}
+}
+
+func (m *Buyer) BidPlan(planID string) (*sonm.BidPlan, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	plan, ok := m.bidPlans[planID]
+	if !ok {
+		return nil, errors.New("specified bid-plan does not exist")
+	}
+	return deepcopy.Copy(plan).(*sonm.BidPlan), nil
+}
+
+func (m *Buyer) BidPlans() map[string]*sonm.BidPlan {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return deepcopy.Copy(m.bidPlans).(map[string]*sonm.BidPlan)
+}
+
+// CreateBidPlan places a BID order on the blockchain and starts looking for
+// a deal for it.
+func (m *Buyer) CreateBidPlan(ctx context.Context, bid *sonm.BidOrder) (*sonm.Order, error) {
+	return m.createBidPlan(ctx, bid, "")
+}
+
+// createBidPlan places a BID order and starts matching it. If the bid plan
+// the task migrates from is specified, its deployment is moved to the new bid
+// plan before matching begins, so the deal can not be opened without it.
+func (m *Buyer) createBidPlan(ctx context.Context, bid *sonm.BidOrder, migratingFrom string) (*sonm.Order, error) {
+	order, err := m.placeOrder(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := sonm.NewTimestamp(time.Now())
+	plan := &sonm.BidPlan{
+		ID:        uuid.New(),
+		OrderID:   order.GetId(),
+		Bid:       bid,
+		Status:    sonm.BidPlan_MATCHING,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.bidPlans[plan.ID] = plan
+	if err := m.bidPlanStorage.Save(m.bidPlans); err != nil {
+		delete(m.bidPlans, plan.ID)
+		return nil, fmt.Errorf("order %s is placed, but could not save bid plan: %s", order.GetId().Unwrap().String(), err)
+	}
+
+	m.log.Infof("created bid plan %s for order %s", plan.ID, order.GetId().Unwrap().String())
+
+	if migratingFrom != "" {
+		if err := m.moveDeployment(migratingFrom, plan.ID); err != nil {
+			m.log.Warnf("could not move task to bid plan %s: %s", plan.ID, err)
+		}
+	}
+
+	m.startMatching(plan)
+	return order, nil
+}
+
+// CancelBidPlan stops matching the given bid plan and cancels its order.
+func (m *Buyer) CancelBidPlan(ctx context.Context, planID string) error {
+	plan, err := m.BidPlan(planID)
+	if err != nil {
+		return err
+	}
+	if !isActive(plan) {
+		return fmt.Errorf("bid plan %s is already %s", planID, plan.GetStatus())
+	}
+
+	orderID := plan.GetOrderID()
+	if !orderID.IsZero() {
+		if err := m.eth.Market().CancelOrder(ctx, m.ethkey, orderID.Unwrap()); err != nil {
+			return fmt.Errorf("could not cancel order %s: %s", orderID.Unwrap().String(), err)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stopMatching(planID)
+	if err := m.updatePlan(planID, nil, func(plan *sonm.BidPlan) {
+		plan.Status = sonm.BidPlan_CANCELLED
+	}); err != nil {
+		return err
+	}
+
+	m.log.Infof("cancelled bid plan %s", planID)
+	return nil
+}
+
+// ReplaceBidPlan cancels the current order of the given bid plan and places
+// a new one with the specified price.
+func (m *Buyer) ReplaceBidPlan(ctx context.Context, planID string, price *sonm.Price) (*sonm.BidPlan, error) {
+	if price.GetPerSecond().IsZero() {
+		return nil, errors.New("price is required")
+	}
+
+	plan, err := m.BidPlan(planID)
+	if err != nil {
+		return nil, err
+	}
+	if !isActive(plan) {
+		return nil, fmt.Errorf("bid plan %s is already %s", planID, plan.GetStatus())
+	}
+
+	var orderID *big.Int
+	if !plan.GetOrderID().IsZero() {
+		orderID = plan.GetOrderID().Unwrap()
+		if err := m.eth.Market().CancelOrder(ctx, m.ethkey, orderID); err != nil {
+			return nil, fmt.Errorf("could not cancel order %s: %s", orderID.String(), err)
+		}
+	}
+
+	bid := plan.GetBid()
+	bid.Price = price
+
+	m.mu.Lock()
+	m.stopMatching(planID)
+	err = m.updatePlan(planID, orderID, func(plan *sonm.BidPlan) {
+		plan.OrderID = nil
+		plan.Bid = bid
+	})
+	m.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	order, placeErr := m.placeOrder(ctx, bid)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	err = m.updatePlan(planID, nil, func(plan *sonm.BidPlan) {
+		if placeErr != nil {
+			plan.Status = sonm.BidPlan_FAILED
+			plan.LastError = placeErr.Error()
+		} else {
+			plan.OrderID = order.GetId()
+			plan.Status = sonm.BidPlan_MATCHING
+			plan.LastError = ""
+		}
+	})
+
+	switch {
+	case placeErr != nil:
+		return nil, placeErr
+	case err != nil:
+		return nil, err
+	}
+
+	m.log.Infof("placed order %s instead of %s for bid plan %s", order.GetId().Unwrap().String(), plan.GetOrderID().Unwrap().String(), planID)
+
+	plan = m.bidPlans[planID]
+	m.startMatching(plan)
+	return deepcopy.Copy(plan).(*sonm.BidPlan), nil
+}
+
+func (m *Buyer) restoreState() error {
+	m.bidPlans = map[string]*sonm.BidPlan{}
+	if err := m.bidPlanStorage.Load(&m.bidPlans); err != nil {
+		return fmt.Errorf("could not restore buyer state: %s", err)
+	}
+
+	m.deployments = map[string]*sonm.TaskDeployment{}
+	if err := m.deploymentStorage.Load(&m.deployments); err != nil {
+		return fmt.Errorf("could not restore task deployments: %s", err)
+	}
+
+	dropped := false
+	for id, plan := range m.bidPlans {
+		if plan.GetStatus() != sonm.BidPlan_MATCHING && time.Since(plan.GetUpdatedAt().Unix()) > m.config.History && !isAlive(m.deployments[id]) {
+			m.log.Debugf("dropping finished bid plan %s", id)
+			delete(m.bidPlans, id)
+			dropped = true
+		}
+	}
+
+	if dropped {
+		if err := m.bidPlanStorage.Save(m.bidPlans); err != nil {
+			return err
+		}
+	}
+
+	return m.restoreDeployments()
+}
+
+// startMatching starts looking for a deal for the given bid plan in
+// background, replacing the matcher already running for it.
+//
+// Must be called with the lock held.
+func (m *Buyer) startMatching(plan *sonm.BidPlan) {
+	if m.ctx == nil {
+		// Will be started on Run.
+		return
+	}
+
+	m.stopMatching(plan.ID)
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.matching[plan.ID] = cancel
+	go m.waitForDeal(ctx, plan.ID, plan.GetOrderID().Unwrap())
+}
+
+// Must be called with the lock held.
+func (m *Buyer) stopMatching(planID string) {
+	if cancel, ok := m.matching[planID]; ok {
+		cancel()
+		delete(m.matching, planID)
+	}
+}
+
+func (m *Buyer) waitForDeal(ctx context.Context, planID string, orderID *big.Int) {
+	m.log.Infof("waiting for deal for order %s of bid plan %s", orderID.String(), planID)
+	ticker := util.NewImmediateTicker(m.config.MatcherRetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			order, err := m.eth.Market().GetOrderInfo(ctx, orderID)
+			if err != nil {
+				m.log.Warnf("could not get order info for order %s: %s", orderID.String(), err)
+				m.setLastError(planID, orderID, err)
+				continue
+			}
+
+			if done := m.checkOrder(planID, order); done {
+				return
+			}
+
+			deal, err := m.matcher.CreateDealByOrder(ctx, order)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				m.log.Warnf("could not wait for deal on order %s: %s", orderID.String(), err)
+				m.setLastError(planID, orderID, err)
+				continue
+			}
+
+			m.assignDeal(planID, orderID, deal.GetId())
+			return
+		}
+	}
+}
+
+// checkOrder updates the bid plan according to the state of its order on
+// the blockchain, returning true if there is nothing to match anymore.
+func (m *Buyer) checkOrder(planID string, order *sonm.Order) bool {
+	if !order.GetDealID().IsZero() {
+		m.assignDeal(planID, order.GetId().Unwrap(), order.GetDealID())
+		return true
+	}
+
+	if order.GetOrderStatus() != sonm.OrderStatus_ORDER_ACTIVE {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		err := m.updatePlan(planID, order.GetId().Unwrap(), func(plan *sonm.BidPlan) {
+			plan.Status = sonm.BidPlan_CANCELLED
+		})
+		if err != nil {
+			m.log.Warnf("could not mark bid plan %s as cancelled: %s", planID, err)
+			return true
+		}
+
+		m.stopMatching(planID)
+		m.log.Infof("order %s of bid plan %s has become inactive", order.GetId().Unwrap().String(), planID)
+		return true
+	}
+
+	return false
+}
+
+func (m *Buyer) assignDeal(planID string, orderID *big.Int, dealID *sonm.BigInt) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	err := m.updatePlan(planID, orderID, func(plan *sonm.BidPlan) {
+		plan.DealID = dealID
+		plan.Status = sonm.BidPlan_DEAL_OPENED
+		plan.LastError = ""
+	})
+	if err != nil {
+		m.log.Warnf("could not assign deal %s to bid plan %s: %s", dealID.Unwrap().String(), planID, err)
+		return
+	}
+
+	m.stopMatching(planID)
+
+	m.log.Infof("assigned deal %s to bid plan %s", dealID.Unwrap().String(), planID)
+
+	if err := m.assignDeploymentDeal(planID, dealID); err != nil {
+		m.log.Warnf("could not assign deal %s to task deployment of bid plan %s: %s", dealID.Unwrap().String(), planID, err)
+	}
+}
+
+func (m *Buyer) setLastError(planID string, orderID *big.Int, lastErr error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	err := m.updatePlan(planID, orderID, func(plan *sonm.BidPlan) {
+		plan.LastError = lastErr.Error()
+	})
+	if err != nil {
+		m.log.Warnf("could not update bid plan %s: %s", planID, err)
+	}
+}
+
+// updatePlan applies the given function to the bid plan and saves the state.
+// If the order ID is specified the bid plan is updated only if it still
+// refers to that order, preventing outdated matchers from overwriting it.
+//
+// Must be called with the lock held.
+func (m *Buyer) updatePlan(planID string, orderID *big.Int, fn func(plan *sonm.BidPlan)) error {
+	plan, ok := m.bidPlans[planID]
+	if !ok {
+		return fmt.Errorf("no such plan %s", planID)
+	}
+
+	if orderID != nil && (plan.GetOrderID().IsZero() || plan.GetOrderID().Unwrap().Cmp(orderID) != 0) {
+		return fmt.Errorf("order %s is no longer bound to the plan", orderID.String())
+	}
+
+	fn(plan)
+	plan.UpdatedAt = sonm.NewTimestamp(time.Now())
+
+	return m.bidPlanStorage.Save(m.bidPlans)
+}
+
+func (m *Buyer) placeOrder(ctx context.Context, bid *sonm.BidOrder) (*sonm.Order, error) {
+	order, err := m.newOrder(bid)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = m.eth.Market().PlaceOrder(ctx, m.ethkey, order)
+	if err != nil {
+		return nil, fmt.Errorf("could not place order on blockchain: %s", err)
+	}
+
+	m.log.Infof("placed order %s on blockchain", order.GetId().Unwrap().String())
+	return order, nil
+}
+
+func (m *Buyer) newOrder(bid *sonm.BidOrder) (*sonm.Order, error) {
+	knownBenchmarks := m.benchmarks.MapByCode()
+	givenBenchmarks := bid.GetResources().GetBenchmarks()
+
+	if len(givenBenchmarks) > len(knownBenchmarks) {
+		return nil, fmt.Errorf("benchmark list too large")
+	}
+
+	benchmarksValues := make([]uint64, len(knownBenchmarks))
+	for code, value := range givenBenchmarks {
+		bench, ok := knownBenchmarks[code]
+		if !ok {
+			return nil, fmt.Errorf("unknown benchmark code \"%s\"", code)
+		}
+
+		benchmarksValues[bench.GetID()] = value
+	}
+
+	benchStruct, err := sonm.NewBenchmarks(benchmarksValues)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse benchmark values: %s", err)
+	}
+
+	var blacklist string
+	if bid.GetBlacklist() != nil {
+		blacklist = bid.GetBlacklist().Unwrap().Hex()
+	}
+
+	return &sonm.Order{
+		OrderType:      sonm.OrderType_BID,
+		OrderStatus:    sonm.OrderStatus_ORDER_ACTIVE,
+		AuthorID:       sonm.NewEthAddress(crypto.PubkeyToAddress(m.ethkey.PublicKey)),
+		CounterpartyID: bid.GetCounterparty(),
+		Duration:       uint64(bid.GetDuration().Unwrap().Seconds()),
+		Price:          bid.GetPrice().GetPerSecond(),
+		Netflags: sonm.NetflagsToUint([3]bool{
+			bid.GetResources().GetNetwork().GetOverlay(),
+			bid.GetResources().GetNetwork().GetOutbound(),
+			bid.GetResources().GetNetwork().GetIncoming(),
+		}),
+		IdentityLevel: bid.GetIdentity(),
+		Blacklist:     blacklist,
+		Tag:           []byte(bid.GetTag()),
+		Benchmarks:    benchStruct,
+	}, nil
+}
+
+func isActive(plan *sonm.BidPlan) bool {
+	return plan.GetStatus() == sonm.BidPlan_MATCHING || plan.GetStatus() == sonm.BidPlan_FAILED
+}
@@ -0,0 +1,419 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testBenchList struct{}
+
+func (testBenchList) ByID() []*sonm.Benchmark {
+	return nil
+}
+
+func (testBenchList) MapByDeviceType() map[sonm.DeviceType][]*sonm.Benchmark {
+	return nil
+}
+
+func (testBenchList) MapByCode() map[string]*sonm.Benchmark {
+	benchmarks := map[string]*sonm.Benchmark{}
+	for id := 0; id < sonm.MinNumBenchmarks; id++ {
+		benchmarks[fmt.Sprintf("bench-%d", id)] = &sonm.Benchmark{ID: uint64(id)}
+	}
+	return benchmarks
+}
+
+type testMatcher struct {
+	fn func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error)
+}
+
+func (m *testMatcher) CreateDealByOrder(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+	return m.fn(ctx, order)
+}
+
+func blockingMatcher() *testMatcher {
+	return &testMatcher{fn: func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}}
+}
+
+func newTestStorage(t *testing.T) (*state.Storage, func()) {
+	dir, err := ioutil.TempDir("", "sonm-buyer-test")
+	require.NoError(t, err)
+
+	storage, err := state.NewState(context.Background(), &state.StorageConfig{
+		Endpoint: filepath.Join(dir, "node.boltdb"),
+		Bucket:   "sonm",
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+	}
+	require.NoError(t, err)
+
+	return storage, func() { os.RemoveAll(dir) }
+}
+
+// testTasks fakes the Workers' tasks. Unless the status is overridden, the
+// tasks successfully started are reported as running.
+type testTasks struct {
+	start  func(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error)
+	status func(ctx context.Context, dealID *sonm.BigInt, taskID string) (*sonm.TaskStatusReply, error)
+	pull   func(ctx context.Context, dealID *sonm.BigInt, taskID string, wr io.Writer) error
+	push   func(ctx context.Context, dealID *sonm.BigInt, rd io.Reader, size int64) error
+
+	mu      sync.Mutex
+	started map[string]bool
+}
+
+func (m *testTasks) StartTask(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+	if m.start == nil {
+		return nil, fmt.Errorf("unexpected task start")
+	}
+
+	reply, err := m.start(ctx, request)
+	if err == nil {
+		m.markStarted(reply.GetId())
+	}
+	return reply, err
+}
+
+func (m *testTasks) markStarted(taskID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.started == nil {
+		m.started = map[string]bool{}
+	}
+	m.started[taskID] = true
+}
+
+func (m *testTasks) TaskStatus(ctx context.Context, dealID *sonm.BigInt, taskID string) (*sonm.TaskStatusReply, error) {
+	if m.status != nil {
+		return m.status(ctx, dealID, taskID)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.started[taskID] {
+		return nil, fmt.Errorf("no task with id %s", taskID)
+	}
+	return &sonm.TaskStatusReply{Status: sonm.TaskStatusReply_RUNNING}, nil
+}
+
+func (m *testTasks) PullTask(ctx context.Context, dealID *sonm.BigInt, taskID string, wr io.Writer) error {
+	if m.pull == nil {
+		return fmt.Errorf("unexpected task pull")
+	}
+	return m.pull(ctx, dealID, taskID, wr)
+}
+
+func (m *testTasks) PushTask(ctx context.Context, dealID *sonm.BigInt, rd io.Reader, size int64) error {
+	if m.push == nil {
+		return fmt.Errorf("unexpected task push")
+	}
+	return m.push(ctx, dealID, rd, size)
+}
+
+func newTestBuyer(t *testing.T, storage *state.Storage, eth blockchain.API, matcher *testMatcher) *Buyer {
+	return newTestBuyerWithTasks(t, storage, eth, matcher, &testTasks{})
+}
+
+func newTestBuyerWithTasks(t *testing.T, storage *state.Storage, eth blockchain.API, matcher *testMatcher, tasks Tasks) *Buyer {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	b, err := NewBuyer(
+		WithLogger(zap.NewNop().Sugar()),
+		WithStorage(storage),
+		WithEth(eth),
+		WithMatcher(matcher),
+		WithBenchmarks(testBenchList{}),
+		WithEthkey(key),
+		WithTasks(tasks),
+		WithConfig(&YAMLConfig{
+			MatcherRetryInterval:   10 * time.Millisecond,
+			History:                time.Hour,
+			DeployRetryInterval:    10 * time.Millisecond,
+			DeployMaxRetryInterval: 20 * time.Millisecond,
+			KeepAliveInterval:      10 * time.Millisecond,
+			KeepAliveTimeout:       50 * time.Millisecond,
+		}),
+	)
+	require.NoError(t, err)
+
+	return b
+}
+
+func newTestBid(price int64) *sonm.BidOrder {
+	return &sonm.BidOrder{
+		Duration: &sonm.Duration{Nanoseconds: int64(time.Hour)},
+		Price:    &sonm.Price{PerSecond: sonm.NewBigIntFromInt(price)},
+	}
+}
+
+func mockPlaceOrder(market *blockchain.MockMarketAPI, id int64) *gomock.Call {
+	return market.EXPECT().PlaceOrder(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key interface{}, order *sonm.Order) (*sonm.Order, error) {
+			order.Id = sonm.NewBigIntFromInt(id)
+			return order, nil
+		})
+}
+
+// waitFor polls the condition until it holds, failing the test if it
+// doesn't in time.
+func waitFor(t *testing.T, condition func() bool, format string, args ...interface{}) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			require.FailNowf(t, "timed out", format, args...)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func waitForStatus(t *testing.T, b *Buyer, planID string, status sonm.BidPlan_Status) *sonm.BidPlan {
+	var plan *sonm.BidPlan
+	waitFor(t, func() bool {
+		var err error
+		plan, err = b.BidPlan(planID)
+		require.NoError(t, err)
+		return plan.GetStatus() == status
+	}, "bid plan %s is not %s", planID, status)
+
+	return plan
+}
+
+func singlePlanID(t *testing.T, b *Buyer) string {
+	plans := b.BidPlans()
+	require.Len(t, plans, 1)
+	for id := range plans {
+		return id
+	}
+	return ""
+}
+
+func TestBuyerOpensDeal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	mockPlaceOrder(market, 1)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil)
+
+	attempts := 0
+	matcher := &testMatcher{fn: func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, fmt.Errorf("no matching orders")
+		}
+		return &sonm.Deal{Id: sonm.NewBigIntFromInt(42)}, nil
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyer(t, storage, eth, matcher)
+	b.Run(ctx)
+
+	order, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	assert.Equal(t, "1", order.GetId().Unwrap().String())
+	assert.Equal(t, sonm.OrderType_BID, order.GetOrderType())
+
+	plan := waitForStatus(t, b, singlePlanID(t, b), sonm.BidPlan_DEAL_OPENED)
+	assert.Equal(t, "42", plan.GetDealID().Unwrap().String())
+	assert.Empty(t, plan.GetLastError())
+}
+
+func TestBuyerResumesMatchingAfterRestart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	mockPlaceOrder(market, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The Node stops before matching is started.
+	b := newTestBuyer(t, storage, eth, blockingMatcher())
+	_, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil)
+
+	matcher := &testMatcher{fn: func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+		return &sonm.Deal{Id: sonm.NewBigIntFromInt(42)}, nil
+	}}
+
+	restored := newTestBuyer(t, storage, eth, matcher)
+	plan, err := restored.BidPlan(planID)
+	require.NoError(t, err)
+	assert.Equal(t, sonm.BidPlan_MATCHING, plan.GetStatus())
+	assert.Equal(t, "1", plan.GetOrderID().Unwrap().String())
+
+	restored.Run(ctx)
+	plan = waitForStatus(t, restored, planID, sonm.BidPlan_DEAL_OPENED)
+	assert.Equal(t, "42", plan.GetDealID().Unwrap().String())
+}
+
+func TestBuyerInactiveOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	mockPlaceOrder(market, 1)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_INACTIVE}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyer(t, storage, eth, blockingMatcher())
+	b.Run(ctx)
+
+	_, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+
+	waitForStatus(t, b, singlePlanID(t, b), sonm.BidPlan_CANCELLED)
+}
+
+func TestBuyerCancelBidPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	mockPlaceOrder(market, 1)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil)
+	market.EXPECT().CancelOrder(gomock.Any(), gomock.Any(), sonm.NewBigIntFromInt(1).Unwrap()).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyer(t, storage, eth, blockingMatcher())
+	b.Run(ctx)
+
+	_, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+
+	require.NoError(t, b.CancelBidPlan(ctx, planID))
+
+	plan, err := b.BidPlan(planID)
+	require.NoError(t, err)
+	assert.Equal(t, sonm.BidPlan_CANCELLED, plan.GetStatus())
+
+	assert.Error(t, b.CancelBidPlan(ctx, planID))
+}
+
+func TestBuyerReplaceBidPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	gomock.InOrder(
+		mockPlaceOrder(market, 1),
+		market.EXPECT().CancelOrder(gomock.Any(), gomock.Any(), sonm.NewBigIntFromInt(1).Unwrap()).Return(nil),
+		mockPlaceOrder(market, 2),
+	)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, id interface{}) (*sonm.Order, error) {
+			return &sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyer(t, storage, eth, blockingMatcher())
+	b.Run(ctx)
+
+	_, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+
+	plan, err := b.ReplaceBidPlan(ctx, planID, &sonm.Price{PerSecond: sonm.NewBigIntFromInt(200)})
+	require.NoError(t, err)
+	assert.Equal(t, sonm.BidPlan_MATCHING, plan.GetStatus())
+	assert.Equal(t, "2", plan.GetOrderID().Unwrap().String())
+	assert.Equal(t, "200", plan.GetBid().GetPrice().GetPerSecond().Unwrap().String())
+}
+
+func TestBuyerReplaceBidPlanFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	gomock.InOrder(
+		mockPlaceOrder(market, 1),
+		market.EXPECT().CancelOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		market.EXPECT().PlaceOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("insufficient funds")),
+	)
+
+	ctx := context.Background()
+
+	b := newTestBuyer(t, storage, eth, blockingMatcher())
+	_, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+
+	_, err = b.ReplaceBidPlan(ctx, planID, &sonm.Price{PerSecond: sonm.NewBigIntFromInt(200)})
+	require.Error(t, err)
+
+	plan, err := b.BidPlan(planID)
+	require.NoError(t, err)
+	assert.Equal(t, sonm.BidPlan_FAILED, plan.GetStatus())
+	assert.True(t, plan.GetOrderID().IsZero())
+	assert.Contains(t, plan.GetLastError(), "insufficient funds")
+}
@@ -0,0 +1,394 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/insonmnia/blacklist"
+	"github.com/sonm-io/core/proto"
+)
+
+// Tasks provides access to tasks on Workers the deals are opened with.
+type Tasks interface {
+	StartTask(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error)
+	TaskStatus(ctx context.Context, dealID *sonm.BigInt, taskID string) (*sonm.TaskStatusReply, error)
+	// PullTask writes the committed image of the given task. It should
+	// work even after the deal is closed, while the Worker keeps the image.
+	PullTask(ctx context.Context, dealID *sonm.BigInt, taskID string, wr io.Writer) error
+	PushTask(ctx context.Context, dealID *sonm.BigInt, rd io.Reader, size int64) error
+}
+
+// Deploy attaches the task to the bid plan of the given order. The task is
+// started as soon as the deal is opened, or immediately if it already is.
+//
+// Kept alive tasks are migrated to a new deal with the same BID when their
+// deal is lost.
+func (m *Buyer) Deploy(request *sonm.TaskDeploymentRequest) (*sonm.TaskDeployment, error) {
+	orderID := request.GetOrderID()
+	if orderID.IsZero() {
+		return nil, errors.New("order ID is required")
+	}
+	if request.GetSpec() == nil {
+		return nil, errors.New("task spec is required")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var plan *sonm.BidPlan
+	for _, candidate := range m.bidPlans {
+		if candidate.GetOrderID().Cmp(orderID) == 0 {
+			plan = candidate
+			break
+		}
+	}
+	if plan == nil {
+		return nil, fmt.Errorf("no bid plan for order %s", orderID.Unwrap().String())
+	}
+
+	switch plan.GetStatus() {
+	case sonm.BidPlan_MATCHING, sonm.BidPlan_DEAL_OPENED:
+	default:
+		return nil, fmt.Errorf("bid plan %s is already %s", plan.GetID(), plan.GetStatus())
+	}
+
+	if deployment, ok := m.deployments[plan.GetID()]; ok && deployment.GetStatus() != sonm.TaskDeployment_PENDING {
+		return nil, fmt.Errorf("task for bid plan %s is already %s", plan.GetID(), deployment.GetStatus())
+	}
+
+	deployment := &sonm.TaskDeployment{
+		BidPlanID: plan.GetID(),
+		Spec:      request.GetSpec(),
+		Deadline:  request.GetDeadline(),
+		KeepAlive: request.GetKeepAlive(),
+		Status:    sonm.TaskDeployment_PENDING,
+		UpdatedAt: sonm.NewTimestamp(time.Now()),
+	}
+	if plan.GetStatus() == sonm.BidPlan_DEAL_OPENED {
+		deployment.DealID = plan.GetDealID()
+		deployment.DealOpenedAt = deployment.UpdatedAt
+		deployment.Status = sonm.TaskDeployment_DEPLOYING
+	}
+
+	m.deployments[plan.GetID()] = deployment
+	if err := m.deploymentStorage.Save(m.deployments); err != nil {
+		delete(m.deployments, plan.GetID())
+		return nil, err
+	}
+
+	m.log.Infof("attached task to bid plan %s", plan.GetID())
+	m.startDeploying(deployment)
+
+	return deepcopy.Copy(deployment).(*sonm.TaskDeployment), nil
+}
+
+func (m *Buyer) Deployments() map[string]*sonm.TaskDeployment {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return deepcopy.Copy(m.deployments).(map[string]*sonm.TaskDeployment)
+}
+
+// restoreDeployments drops loaded deployments whose bid plans are gone.
+func (m *Buyer) restoreDeployments() error {
+	dropped := false
+	for id := range m.deployments {
+		if _, ok := m.bidPlans[id]; !ok {
+			delete(m.deployments, id)
+			dropped = true
+		}
+	}
+
+	if dropped {
+		return m.deploymentStorage.Save(m.deployments)
+	}
+	return nil
+}
+
+// assignDeploymentDeal starts deploying the task attached to the given bid
+// plan, if any.
+//
+// Must be called with the lock held.
+func (m *Buyer) assignDeploymentDeal(planID string, dealID *sonm.BigInt) error {
+	deployment, ok := m.deployments[planID]
+	if !ok || deployment.GetStatus() != sonm.TaskDeployment_PENDING {
+		return nil
+	}
+
+	now := sonm.NewTimestamp(time.Now())
+	deployment.DealID = dealID
+	deployment.DealOpenedAt = now
+	deployment.Status = sonm.TaskDeployment_DEPLOYING
+	deployment.UpdatedAt = now
+	if err := m.deploymentStorage.Save(m.deployments); err != nil {
+		return err
+	}
+
+	m.startDeploying(deployment)
+	return nil
+}
+
+// Must be called with the lock held.
+func (m *Buyer) startDeploying(deployment *sonm.TaskDeployment) {
+	if m.ctx == nil || deployment.GetStatus() != sonm.TaskDeployment_DEPLOYING {
+		return
+	}
+
+	planID := deployment.GetBidPlanID()
+	if m.deploying[planID] {
+		return
+	}
+
+	m.deploying[planID] = true
+	go m.deploy(m.ctx, planID)
+}
+
+func (m *Buyer) deploy(ctx context.Context, planID string) {
+	defer func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.deploying, planID)
+	}()
+
+	m.log.Infof("deploying task for bid plan %s", planID)
+
+	delay := m.config.DeployRetryInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		deployment, err := m.deployment(planID, sonm.TaskDeployment_DEPLOYING)
+		if err != nil {
+			m.log.Warnf("stopped deploying task for bid plan %s: %s", planID, err)
+			return
+		}
+
+		deal, err := m.eth.Market().GetDealInfo(ctx, deployment.GetDealID().Unwrap())
+		if err == nil && deal.GetStatus() == sonm.DealStatus_DEAL_CLOSED {
+			m.log.Infof("stopped deploying task for bid plan %s: deal %s is closed", planID, deal.GetId().Unwrap().String())
+			m.loseDeal(planID, errors.New("deal is closed"))
+			return
+		}
+
+		reply, err := m.startTask(ctx, deployment)
+		if err == nil {
+			m.updateDeployment(planID, func(deployment *sonm.TaskDeployment) {
+				deployment.Status = sonm.TaskDeployment_STARTED
+				deployment.TaskID = reply.GetId()
+				deployment.LastError = ""
+			})
+			m.log.Infof("started task %s for deal %s", reply.GetId(), deployment.GetDealID().Unwrap().String())
+
+			m.mu.Lock()
+			m.startWatching(m.deployments[planID])
+			m.mu.Unlock()
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		m.log.Warnf("could not start task for deal %s: %s", deployment.GetDealID().Unwrap().String(), err)
+
+		deadline := deployment.GetDeadline().Unwrap()
+		if deadline != 0 && time.Since(deployment.GetDealOpenedAt().Unix()) > deadline {
+			m.failDeployment(ctx, planID, deployment.GetDealID(), err)
+			return
+		}
+
+		m.updateDeployment(planID, func(deployment *sonm.TaskDeployment) {
+			deployment.Attempts++
+			deployment.LastError = err.Error()
+		})
+
+		timer.Reset(delay)
+		delay *= 2
+		if delay > m.config.DeployMaxRetryInterval {
+			delay = m.config.DeployMaxRetryInterval
+		}
+	}
+}
+
+// startTask starts the task of the deployment, using its restored image if
+// any. Restoring is best effort: if either pushing the image or starting the
+// task from it fails, the task is started from its original spec next time.
+func (m *Buyer) startTask(ctx context.Context, deployment *sonm.TaskDeployment) (*sonm.StartTaskReply, error) {
+	planID := deployment.GetBidPlanID()
+	request := &sonm.StartTaskRequest{
+		DealID: deployment.GetDealID(),
+		Spec:   deployment.GetSpec(),
+		TaskID: deploymentTaskID(deployment),
+	}
+
+	// The task may already be started by the previous attempt, whose reply
+	// has been lost either on the wire or due to the node restart.
+	status, err := m.tasks.TaskStatus(ctx, request.GetDealID(), request.GetTaskID())
+	if err == nil && status.GetStatus() != sonm.TaskStatusReply_BROKEN {
+		m.log.Infof("task %s for bid plan %s is already started", request.GetTaskID(), planID)
+		if deployment.GetRestoredImage() != "" {
+			os.Remove(m.imagePath(deployment))
+		}
+		return &sonm.StartTaskReply{Id: request.GetTaskID()}, nil
+	}
+
+	image := deployment.GetRestoredImage()
+	if image == "" {
+		return m.tasks.StartTask(ctx, request)
+	}
+
+	reply, err := m.startRestoredTask(ctx, request, deployment)
+	if ctx.Err() != nil {
+		return nil, err
+	}
+
+	os.Remove(m.imagePath(deployment))
+	if err != nil {
+		m.log.Warnf("could not restore image %s for bid plan %s, falling back to the original one: %s", image, planID, err)
+		m.updateDeployment(planID, func(deployment *sonm.TaskDeployment) {
+			deployment.RestoredImage = ""
+		})
+	}
+	return reply, err
+}
+
+// deploymentTaskID returns the ID the task of the deployment is started with.
+// It is the same for every attempt to start the task on the deployment's deal,
+// so the Worker does not start the task twice.
+func deploymentTaskID(deployment *sonm.TaskDeployment) string {
+	name := fmt.Sprintf("%s/%s", deployment.GetBidPlanID(), deployment.GetDealID().Unwrap().String())
+	return uuid.NewSHA1(uuid.NIL, []byte(name)).String()
+}
+
+func (m *Buyer) startRestoredTask(ctx context.Context, request *sonm.StartTaskRequest, deployment *sonm.TaskDeployment) (*sonm.StartTaskReply, error) {
+	file, err := os.Open(m.imagePath(deployment))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.tasks.PushTask(ctx, request.GetDealID(), file, info.Size()); err != nil {
+		return nil, fmt.Errorf("could not push image: %s", err)
+	}
+
+	request.Spec = deepcopy.Copy(request.GetSpec()).(*sonm.TaskSpec)
+	request.Spec.Container.Image = deployment.GetRestoredImage()
+
+	return m.tasks.StartTask(ctx, request)
+}
+
+// failDeployment closes the deal of the deployment, because the task can not
+// be started on it in time.
+func (m *Buyer) failDeployment(ctx context.Context, planID string, dealID *sonm.BigInt, cause error) {
+	m.updateDeployment(planID, func(deployment *sonm.TaskDeployment) {
+		deployment.Attempts++
+	})
+
+	if m.reportDeal(ctx, dealID, blacklist.TaskFailed) {
+		m.log.Infof("closed deal %s with blacklisting the supplier, because its task could not be started in time", dealID.Unwrap().String())
+		m.loseDeal(planID, cause)
+		return
+	}
+
+	m.log.Infof("closing deal %s, because its task could not be started in time", dealID.Unwrap().String())
+	if err := m.eth.Market().CloseDeal(ctx, m.ethkey, dealID.Unwrap(), false); err != nil {
+		m.log.Warnf("could not close deal %s: %s", dealID.Unwrap().String(), err)
+		cause = fmt.Errorf("%s; could not close deal: %s", cause, err)
+	}
+
+	m.loseDeal(planID, cause)
+}
+
+// reportDeal reports the deal misbehavior to the blacklist policy, returning
+// true if the deal has been closed while blacklisting the supplier.
+func (m *Buyer) reportDeal(ctx context.Context, dealID *sonm.BigInt, event blacklist.Event) bool {
+	if m.policy == nil {
+		return false
+	}
+
+	deal, err := m.eth.Market().GetDealInfo(ctx, dealID.Unwrap())
+	if err != nil {
+		m.log.Warnf("could not report deal %s: %s", dealID.Unwrap().String(), err)
+		return false
+	}
+
+	closed, err := m.policy.Report(ctx, deal, event)
+	if err != nil {
+		m.log.Warnf("could not report deal %s: %s", dealID.Unwrap().String(), err)
+	}
+
+	return closed
+}
+
+// loseDeal either marks the deployment as failed or starts migrating it to
+// a new deal if the task is kept alive.
+func (m *Buyer) loseDeal(planID string, cause error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deployment, ok := m.deployments[planID]
+	if !ok {
+		return
+	}
+
+	if deployment.GetKeepAlive() {
+		m.beginMigration(deployment, cause)
+		return
+	}
+
+	deployment.Status = sonm.TaskDeployment_FAILED
+	deployment.LastError = cause.Error()
+	deployment.UpdatedAt = sonm.NewTimestamp(time.Now())
+	if err := m.deploymentStorage.Save(m.deployments); err != nil {
+		m.log.Warnf("could not save task deployment of bid plan %s: %s", planID, err)
+	}
+}
+
+// deployment returns a copy of the deployment attached to the given bid plan
+// if it has any of the specified statuses.
+func (m *Buyer) deployment(planID string, statuses ...sonm.TaskDeployment_Status) (*sonm.TaskDeployment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deployment, ok := m.deployments[planID]
+	if !ok {
+		return nil, errors.New("no such deployment")
+	}
+	for _, status := range statuses {
+		if deployment.GetStatus() == status {
+			return deepcopy.Copy(deployment).(*sonm.TaskDeployment), nil
+		}
+	}
+	return nil, fmt.Errorf("deployment is %s", deployment.GetStatus())
+}
+
+func (m *Buyer) updateDeployment(planID string, fn func(deployment *sonm.TaskDeployment)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deployment, ok := m.deployments[planID]
+	if !ok {
+		return
+	}
+
+	fn(deployment)
+	deployment.UpdatedAt = sonm.NewTimestamp(time.Now())
+	if err := m.deploymentStorage.Save(m.deployments); err != nil {
+		m.log.Warnf("could not save task deployment of bid plan %s: %s", planID, err)
+	}
+}
@@ -0,0 +1,212 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitForDeploymentStatus(t *testing.T, b *Buyer, planID string, status sonm.TaskDeployment_Status) *sonm.TaskDeployment {
+	var deployment *sonm.TaskDeployment
+	waitFor(t, func() bool {
+		var ok bool
+		deployment, ok = b.Deployments()[planID]
+		require.True(t, ok)
+		return deployment.GetStatus() == status
+	}, "deployment %s is not %s", planID, status)
+
+	return deployment
+}
+
+func TestDeployOnDealOpened(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	mockPlaceOrder(market, 1)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil)
+	market.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Deal{Id: sonm.NewBigIntFromInt(42), Status: sonm.DealStatus_DEAL_ACCEPTED}, nil)
+
+	matched := make(chan struct{})
+	matcher := &testMatcher{fn: func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+		select {
+		case <-matched:
+			return &sonm.Deal{Id: sonm.NewBigIntFromInt(42)}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}}
+
+	mu := sync.Mutex{}
+	var requests []*sonm.StartTaskRequest
+	start := func(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, request)
+		if len(requests) == 1 {
+			return nil, fmt.Errorf("worker is unreachable")
+		}
+		return &sonm.StartTaskReply{Id: "task-1"}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyerWithTasks(t, storage, eth, matcher, &testTasks{start: start})
+	b.Run(ctx)
+
+	order, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+
+	spec := &sonm.TaskSpec{Container: &sonm.Container{Image: "sonm/test"}}
+	deployment, err := b.Deploy(&sonm.TaskDeploymentRequest{OrderID: order.GetId(), Spec: spec})
+	require.NoError(t, err)
+	assert.Equal(t, sonm.TaskDeployment_PENDING, deployment.GetStatus())
+
+	close(matched)
+
+	deployment = waitForDeploymentStatus(t, b, planID, sonm.TaskDeployment_STARTED)
+	assert.Equal(t, "task-1", deployment.GetTaskID())
+	assert.Equal(t, "42", deployment.GetDealID().Unwrap().String())
+	assert.Equal(t, uint64(1), deployment.GetAttempts())
+	assert.Empty(t, deployment.GetLastError())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "42", requests[1].GetDealID().Unwrap().String())
+	assert.Equal(t, "sonm/test", requests[1].GetSpec().GetContainer().GetImage())
+}
+
+func TestDeployDeadlineClosesDeal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	mockPlaceOrder(market, 1)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil)
+	market.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Deal{Id: sonm.NewBigIntFromInt(42), Status: sonm.DealStatus_DEAL_ACCEPTED}, nil)
+	market.EXPECT().CloseDeal(gomock.Any(), gomock.Any(), sonm.NewBigIntFromInt(42).Unwrap(), false).Return(nil)
+
+	matcher := &testMatcher{fn: func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+		return &sonm.Deal{Id: sonm.NewBigIntFromInt(42)}, nil
+	}}
+	start := func(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+		return nil, fmt.Errorf("worker is unreachable")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyerWithTasks(t, storage, eth, matcher, &testTasks{start: start})
+	b.Run(ctx)
+
+	order, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+	waitForStatus(t, b, planID, sonm.BidPlan_DEAL_OPENED)
+
+	deployment, err := b.Deploy(&sonm.TaskDeploymentRequest{
+		OrderID:  order.GetId(),
+		Spec:     &sonm.TaskSpec{},
+		Deadline: &sonm.Duration{Nanoseconds: int64(50 * time.Millisecond)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sonm.TaskDeployment_DEPLOYING, deployment.GetStatus())
+
+	deployment = waitForDeploymentStatus(t, b, planID, sonm.TaskDeployment_FAILED)
+	assert.Contains(t, deployment.GetLastError(), "worker is unreachable")
+	assert.True(t, deployment.GetAttempts() > 1)
+}
+
+func TestDeployAfterLostReply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	mockPlaceOrder(market, 1)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Order{Id: sonm.NewBigIntFromInt(1), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil)
+	market.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(&sonm.Deal{Id: sonm.NewBigIntFromInt(42), Status: sonm.DealStatus_DEAL_ACCEPTED}, nil)
+
+	matcher := &testMatcher{fn: func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+		return &sonm.Deal{Id: sonm.NewBigIntFromInt(42)}, nil
+	}}
+
+	// The task is started by the Worker, but the reply never reaches us.
+	mu := sync.Mutex{}
+	var requests []*sonm.StartTaskRequest
+	tasks := &testTasks{}
+	tasks.start = func(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, request)
+		tasks.markStarted(request.GetTaskID())
+		return nil, fmt.Errorf("connection reset")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyerWithTasks(t, storage, eth, matcher, tasks)
+	b.Run(ctx)
+
+	order, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+	waitForStatus(t, b, planID, sonm.BidPlan_DEAL_OPENED)
+
+	_, err = b.Deploy(&sonm.TaskDeploymentRequest{OrderID: order.GetId(), Spec: &sonm.TaskSpec{}})
+	require.NoError(t, err)
+
+	deployment := waitForDeploymentStatus(t, b, planID, sonm.TaskDeployment_STARTED)
+	assert.Equal(t, uint64(1), deployment.GetAttempts())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, requests, 1)
+	assert.NotEmpty(t, requests[0].GetTaskID())
+	assert.Equal(t, requests[0].GetTaskID(), deployment.GetTaskID())
+}
+
+func TestDeployUnknownOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	b := newTestBuyer(t, storage, blockchain.NewMockAPI(ctrl), blockingMatcher())
+
+	_, err := b.Deploy(&sonm.TaskDeploymentRequest{OrderID: sonm.NewBigIntFromInt(1), Spec: &sonm.TaskSpec{}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,341 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/proto"
+)
+
+// pullImageAttempts is the number of attempts to pull the committed image of
+// the lost task before starting it from scratch.
+const pullImageAttempts = 3
+
+// startWatching starts pinging the kept alive task in background, or resumes
+// its migration.
+//
+// Must be called with the lock held.
+func (m *Buyer) startWatching(deployment *sonm.TaskDeployment) {
+	if m.ctx == nil || deployment == nil || !deployment.GetKeepAlive() {
+		return
+	}
+
+	switch deployment.GetStatus() {
+	case sonm.TaskDeployment_STARTED, sonm.TaskDeployment_MIGRATING:
+	default:
+		return
+	}
+
+	planID := deployment.GetBidPlanID()
+	if m.watching[planID] {
+		return
+	}
+
+	m.watching[planID] = true
+	go m.keepAlive(m.ctx, planID)
+}
+
+func (m *Buyer) keepAlive(ctx context.Context, planID string) {
+	defer func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.watching, planID)
+	}()
+
+	deployment, err := m.deployment(planID, sonm.TaskDeployment_STARTED, sonm.TaskDeployment_MIGRATING)
+	if err != nil {
+		return
+	}
+
+	if deployment.GetStatus() == sonm.TaskDeployment_STARTED {
+		cause := m.watch(ctx, deployment)
+		if cause == nil {
+			return
+		}
+		m.log.Infof("task %s of bid plan %s is lost: %s", deployment.GetTaskID(), planID, cause)
+		m.loseDeal(planID, cause)
+	}
+
+	m.migrate(ctx, planID)
+}
+
+// watch pings the task until it is considered lost, returning the cause.
+// Nil is returned if the task is no longer watched.
+func (m *Buyer) watch(ctx context.Context, deployment *sonm.TaskDeployment) error {
+	planID := deployment.GetBidPlanID()
+	dealID := deployment.GetDealID()
+	taskID := deployment.GetTaskID()
+
+	m.log.Infof("watching task %s on deal %s", taskID, dealID.Unwrap().String())
+
+	lastSeen := time.Now()
+	ticker := time.NewTicker(m.config.KeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if _, err := m.deployment(planID, sonm.TaskDeployment_STARTED); err != nil {
+			return nil
+		}
+
+		deal, err := m.eth.Market().GetDealInfo(ctx, dealID.Unwrap())
+		if err != nil {
+			m.log.Warnf("could not get deal info for deal %s: %s", dealID.Unwrap().String(), err)
+			continue
+		}
+		if deal.GetStatus() == sonm.DealStatus_DEAL_CLOSED {
+			return errors.New("deal is closed")
+		}
+
+		_, err = m.tasks.TaskStatus(ctx, dealID, taskID)
+		if err == nil {
+			lastSeen = time.Now()
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		m.log.Warnf("could not ping task %s on deal %s: %s", taskID, dealID.Unwrap().String(), err)
+		if time.Since(lastSeen) < m.config.KeepAliveTimeout {
+			continue
+		}
+
+		m.log.Infof("closing deal %s, because its Worker is unreachable", dealID.Unwrap().String())
+		cause := fmt.Errorf("worker is unreachable: %s", err)
+		if err := m.eth.Market().CloseDeal(ctx, m.ethkey, dealID.Unwrap(), false); err != nil {
+			m.log.Warnf("could not close deal %s: %s", dealID.Unwrap().String(), err)
+			cause = fmt.Errorf("%s; could not close deal: %s", cause, err)
+		}
+		return cause
+	}
+}
+
+// beginMigration marks the deployment as migrating, remembering where its
+// task was running to be restored later. If the task has not been started on
+// the lost deal, the previously remembered one is kept.
+//
+// Must be called with the lock held.
+func (m *Buyer) beginMigration(deployment *sonm.TaskDeployment, cause error) {
+	if deployment.GetTaskID() != "" {
+		restoredImage := ""
+		if deployment.GetSpec().GetContainer().GetCommitOnStop() {
+			image, err := committedImage(deployment)
+			if err != nil {
+				m.log.Warnf("could not restore image of task %s: %s", deployment.GetTaskID(), err)
+			} else {
+				restoredImage = image
+			}
+		}
+
+		deployment.PreviousDealID = deployment.GetDealID()
+		deployment.PreviousTaskID = deployment.GetTaskID()
+		deployment.RestoredImage = restoredImage
+	}
+
+	deployment.Status = sonm.TaskDeployment_MIGRATING
+	deployment.LastError = cause.Error()
+	deployment.DealID = nil
+	deployment.TaskID = ""
+	deployment.DealOpenedAt = nil
+	deployment.Attempts = 0
+	deployment.UpdatedAt = sonm.NewTimestamp(time.Now())
+	if err := m.deploymentStorage.Save(m.deployments); err != nil {
+		m.log.Warnf("could not save task deployment of bid plan %s: %s", deployment.GetBidPlanID(), err)
+	}
+
+	m.startWatching(deployment)
+}
+
+// migrate places a replacement BID order for the migrating deployment and
+// moves the deployment to its bid plan, fetching the committed image of the
+// lost task first if required.
+func (m *Buyer) migrate(ctx context.Context, planID string) {
+	deployment, err := m.deployment(planID, sonm.TaskDeployment_MIGRATING)
+	if err != nil {
+		return
+	}
+
+	plan, err := m.BidPlan(planID)
+	if err != nil {
+		m.log.Warnf("could not migrate task of bid plan %s: %s", planID, err)
+		return
+	}
+
+	if image := deployment.GetRestoredImage(); image != "" {
+		if err := m.pullImageRetrying(ctx, deployment); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			m.log.Warnf("could not pull image %s, the task will be started from scratch: %s", image, err)
+			m.updateDeployment(planID, func(deployment *sonm.TaskDeployment) {
+				deployment.RestoredImage = ""
+			})
+		}
+	}
+
+	m.log.Infof("migrating task of bid plan %s", planID)
+
+	delay := m.config.DeployRetryInterval
+	for {
+		order, err := m.createBidPlan(ctx, plan.GetBid(), planID)
+		if err == nil {
+			m.log.Infof("placed order %s to migrate task of bid plan %s", order.GetId().Unwrap().String(), planID)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		m.log.Warnf("could not place order to migrate task of bid plan %s: %s", planID, err)
+		m.updateDeployment(planID, func(deployment *sonm.TaskDeployment) {
+			deployment.LastError = err.Error()
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > m.config.DeployMaxRetryInterval {
+			delay = m.config.DeployMaxRetryInterval
+		}
+	}
+}
+
+// moveDeployment reattaches the migrating deployment to the new bid plan.
+//
+// Must be called with the lock held.
+func (m *Buyer) moveDeployment(from, to string) error {
+	deployment, ok := m.deployments[from]
+	if !ok || deployment.GetStatus() != sonm.TaskDeployment_MIGRATING {
+		return fmt.Errorf("task of bid plan %s is not migrating", from)
+	}
+
+	delete(m.deployments, from)
+	deployment.BidPlanID = to
+	deployment.Status = sonm.TaskDeployment_PENDING
+	deployment.LastError = ""
+	deployment.Migrations++
+	deployment.UpdatedAt = sonm.NewTimestamp(time.Now())
+	m.deployments[to] = deployment
+
+	if err := m.deploymentStorage.Save(m.deployments); err != nil {
+		delete(m.deployments, to)
+		deployment.BidPlanID = from
+		deployment.Status = sonm.TaskDeployment_MIGRATING
+		deployment.Migrations--
+		m.deployments[from] = deployment
+		return err
+	}
+
+	m.log.Infof("moved task of bid plan %s to bid plan %s", from, to)
+	return nil
+}
+
+// pullImageRetrying pulls the committed image of the lost task, giving the
+// Worker some time to notice the deal close and commit the image.
+func (m *Buyer) pullImageRetrying(ctx context.Context, deployment *sonm.TaskDeployment) error {
+	var err error
+	for attempt := 0; attempt < pullImageAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(m.config.DeployRetryInterval):
+			}
+		}
+
+		if err = m.pullImage(ctx, deployment); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// pullImage saves the committed image of the lost task into the image
+// directory unless it is already there.
+func (m *Buyer) pullImage(ctx context.Context, deployment *sonm.TaskDeployment) error {
+	path := m.imagePath(deployment)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(m.config.ImageDir, 0700); err != nil {
+		return err
+	}
+
+	tmpPath := path + ".part"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+	defer file.Close()
+
+	if err := m.tasks.PullTask(ctx, deployment.GetPreviousDealID(), deployment.GetPreviousTaskID(), file); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+func (m *Buyer) imagePath(deployment *sonm.TaskDeployment) string {
+	name := fmt.Sprintf("%s_%s.tar", deployment.GetPreviousDealID().Unwrap().String(), deployment.GetPreviousTaskID())
+	return filepath.Join(m.config.ImageDir, name)
+}
+
+// committedImage returns the name the Worker commits the task's image with.
+func committedImage(deployment *sonm.TaskDeployment) (string, error) {
+	image := deployment.GetRestoredImage()
+	if image == "" {
+		image = deployment.GetSpec().GetContainer().GetImage()
+	}
+
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+
+	tagged, err := reference.WithTag(named, fmt.Sprintf("%s_%s", deployment.GetDealID().Unwrap().String(), deployment.GetTaskID()))
+	if err != nil {
+		return "", err
+	}
+
+	return tagged.String(), nil
+}
+
+// isAlive returns true if the deployment still requires its bid plan.
+func isAlive(deployment *sonm.TaskDeployment) bool {
+	if deployment == nil {
+		return false
+	}
+
+	switch deployment.GetStatus() {
+	case sonm.TaskDeployment_PENDING, sonm.TaskDeployment_DEPLOYING, sonm.TaskDeployment_MIGRATING:
+		return true
+	case sonm.TaskDeployment_STARTED:
+		return deployment.GetKeepAlive()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,234 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dealsByOrder mocks the market so that the N-th placed order is matched
+// with the deal 41+N.
+func dealsByOrder(market *blockchain.MockMarketAPI) *testMatcher {
+	mockPlaceOrder(market, 1)
+	mockPlaceOrder(market, 2)
+	market.EXPECT().GetOrderInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, id *big.Int) (*sonm.Order, error) {
+			return &sonm.Order{Id: sonm.NewBigInt(id), OrderStatus: sonm.OrderStatus_ORDER_ACTIVE}, nil
+		})
+
+	return &testMatcher{fn: func(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+		dealID := big.NewInt(0).Add(order.GetId().Unwrap(), big.NewInt(41))
+		return &sonm.Deal{Id: sonm.NewBigInt(dealID)}, nil
+	}}
+}
+
+func startTaskByDeal(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+	return &sonm.StartTaskReply{Id: fmt.Sprintf("task-%s", request.GetDealID().Unwrap().String())}, nil
+}
+
+func waitForMigration(t *testing.T, b *Buyer, migrations uint64) *sonm.TaskDeployment {
+	var migrated *sonm.TaskDeployment
+	waitFor(t, func() bool {
+		for _, deployment := range b.Deployments() {
+			if deployment.GetMigrations() == migrations && deployment.GetStatus() == sonm.TaskDeployment_STARTED {
+				migrated = deployment
+				return true
+			}
+		}
+		return false
+	}, "task is not migrated %d times", migrations)
+
+	return migrated
+}
+
+func TestKeepAliveMigratesOnDealClosed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	matcher := dealsByOrder(market)
+
+	mu := sync.Mutex{}
+	closed := false
+	market.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, id *big.Int) (*sonm.Deal, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			status := sonm.DealStatus_DEAL_ACCEPTED
+			if closed && id.Int64() == 42 {
+				status = sonm.DealStatus_DEAL_CLOSED
+			}
+			return &sonm.Deal{Id: sonm.NewBigInt(id), Status: status}, nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyerWithTasks(t, storage, eth, matcher, &testTasks{start: startTaskByDeal})
+	b.Run(ctx)
+
+	order, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+	planID := singlePlanID(t, b)
+
+	spec := &sonm.TaskSpec{Container: &sonm.Container{Image: "sonm/test"}}
+	_, err = b.Deploy(&sonm.TaskDeploymentRequest{OrderID: order.GetId(), Spec: spec, KeepAlive: true})
+	require.NoError(t, err)
+
+	waitForDeploymentStatus(t, b, planID, sonm.TaskDeployment_STARTED)
+
+	mu.Lock()
+	closed = true
+	mu.Unlock()
+
+	deployment := waitForMigration(t, b, 1)
+	assert.NotEqual(t, planID, deployment.GetBidPlanID())
+	assert.Equal(t, "43", deployment.GetDealID().Unwrap().String())
+	assert.Equal(t, "task-43", deployment.GetTaskID())
+	assert.Equal(t, "42", deployment.GetPreviousDealID().Unwrap().String())
+	assert.Equal(t, "task-42", deployment.GetPreviousTaskID())
+	assert.Empty(t, deployment.GetRestoredImage())
+	assert.Len(t, b.BidPlans(), 2)
+}
+
+func TestKeepAliveMigratesUnreachableTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	matcher := dealsByOrder(market)
+	market.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, id *big.Int) (*sonm.Deal, error) {
+			return &sonm.Deal{Id: sonm.NewBigInt(id), Status: sonm.DealStatus_DEAL_ACCEPTED}, nil
+		})
+	market.EXPECT().CloseDeal(gomock.Any(), gomock.Any(), big.NewInt(42), false).Return(nil)
+
+	tasks := &testTasks{
+		start: startTaskByDeal,
+		status: func(ctx context.Context, dealID *sonm.BigInt, taskID string) (*sonm.TaskStatusReply, error) {
+			if taskID != "task-43" {
+				return nil, fmt.Errorf("worker is unreachable")
+			}
+			return &sonm.TaskStatusReply{Status: sonm.TaskStatusReply_RUNNING}, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyerWithTasks(t, storage, eth, matcher, tasks)
+	b.Run(ctx)
+
+	order, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+
+	_, err = b.Deploy(&sonm.TaskDeploymentRequest{OrderID: order.GetId(), Spec: &sonm.TaskSpec{}, KeepAlive: true})
+	require.NoError(t, err)
+
+	deployment := waitForMigration(t, b, 1)
+	assert.Equal(t, "43", deployment.GetDealID().Unwrap().String())
+	assert.Equal(t, "42", deployment.GetPreviousDealID().Unwrap().String())
+}
+
+func TestKeepAliveRestoresCommittedImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	imageDir, err := ioutil.TempDir("", "sonm-buyer-images")
+	require.NoError(t, err)
+	defer os.RemoveAll(imageDir)
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+	matcher := dealsByOrder(market)
+
+	mu := sync.Mutex{}
+	var images []string
+	var pushed string
+	market.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, id *big.Int) (*sonm.Deal, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			// The first deal is closed as soon as the task is started on it.
+			status := sonm.DealStatus_DEAL_ACCEPTED
+			if id.Int64() == 42 && len(images) > 0 {
+				status = sonm.DealStatus_DEAL_CLOSED
+			}
+			return &sonm.Deal{Id: sonm.NewBigInt(id), Status: status}, nil
+		})
+
+	tasks := &testTasks{
+		start: func(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			images = append(images, request.GetSpec().GetContainer().GetImage())
+			return startTaskByDeal(ctx, request)
+		},
+		pull: func(ctx context.Context, dealID *sonm.BigInt, taskID string, wr io.Writer) error {
+			_, err := fmt.Fprintf(wr, "image of %s", taskID)
+			return err
+		},
+		push: func(ctx context.Context, dealID *sonm.BigInt, rd io.Reader, size int64) error {
+			data, err := ioutil.ReadAll(rd)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			pushed = string(data)
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBuyerWithTasks(t, storage, eth, matcher, tasks)
+	b.config.ImageDir = imageDir
+	b.Run(ctx)
+
+	order, err := b.CreateBidPlan(ctx, newTestBid(100))
+	require.NoError(t, err)
+
+	spec := &sonm.TaskSpec{Container: &sonm.Container{Image: "sonm/test", CommitOnStop: true}}
+	_, err = b.Deploy(&sonm.TaskDeploymentRequest{OrderID: order.GetId(), Spec: spec, KeepAlive: true})
+	require.NoError(t, err)
+
+	deployment := waitForMigration(t, b, 1)
+	assert.Equal(t, "docker.io/sonm/test:42_task-42", deployment.GetRestoredImage())
+	assert.Equal(t, "sonm/test", deployment.GetSpec().GetContainer().GetImage())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"sonm/test", "docker.io/sonm/test:42_task-42"}, images)
+	assert.Equal(t, "image of task-42", pushed)
+
+	files, err := ioutil.ReadDir(imageDir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
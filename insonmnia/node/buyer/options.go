@@ -0,0 +1,115 @@
+package buyer
+
+import (
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/insonmnia/benchmarks"
+	"github.com/sonm-io/core/insonmnia/blacklist"
+	"github.com/sonm-io/core/insonmnia/matcher"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/util/multierror"
+	"go.uber.org/zap"
+)
+
+type options struct {
+	log        *zap.SugaredLogger
+	storage    *state.Storage
+	eth        blockchain.API
+	matcher    matcher.Matcher
+	benchmarks benchmarks.BenchList
+	ethkey     *ecdsa.PrivateKey
+	tasks      Tasks
+	config     *YAMLConfig
+	policy     *blacklist.Policy
+}
+
+func WithLogger(log *zap.SugaredLogger) Option {
+	return func(opts *options) {
+		opts.log = log
+	}
+}
+
+func WithStorage(storage *state.Storage) Option {
+	return func(opts *options) {
+		opts.storage = storage
+	}
+}
+func WithEth(eth blockchain.API) Option {
+	return func(opts *options) {
+		opts.eth = eth
+	}
+}
+func WithMatcher(matcher matcher.Matcher) Option {
+	return func(opts *options) {
+		opts.matcher = matcher
+	}
+}
+func WithBenchmarks(benchmarks benchmarks.BenchList) Option {
+	return func(opts *options) {
+		opts.benchmarks = benchmarks
+	}
+}
+func WithEthkey(ethkey *ecdsa.PrivateKey) Option {
+	return func(opts *options) {
+		opts.ethkey = ethkey
+	}
+}
+func WithTasks(tasks Tasks) Option {
+	return func(opts *options) {
+		opts.tasks = tasks
+	}
+}
+func WithConfig(config *YAMLConfig) Option {
+	return func(opts *options) {
+		opts.config = config
+	}
+}
+
+// WithBlacklistPolicy sets the policy deal misbehavior is reported to.
+// Optional.
+func WithBlacklistPolicy(policy *blacklist.Policy) Option {
+	return func(opts *options) {
+		opts.policy = policy
+	}
+}
+func (m *options) Validate() error {
+	err := multierror.NewMultiError()
+
+	if m.log == nil {
+		err = multierror.Append(err, errors.New("WithLogger option is required"))
+	}
+
+	if m.storage == nil {
+		err = multierror.Append(err, errors.New("WithStorage option is required"))
+	}
+
+	if m.eth == nil {
+		err = multierror.Append(err, errors.New("WithEth option is required"))
+	}
+
+	if m.matcher == nil {
+		err = multierror.Append(err, errors.New("WithMatcher option is required"))
+	}
+
+	if m.benchmarks == nil {
+		err = multierror.Append(err, errors.New("WithBenchmarks option is required"))
+	}
+
+	if m.ethkey == nil {
+		err = multierror.Append(err, errors.New("WithEthkey option is required"))
+	}
+
+	if m.tasks == nil {
+		err = multierror.Append(err, errors.New("WithTasks option is required"))
+	}
+
+	if m.config == nil {
+		err = multierror.Append(err, errors.New("WithConfig option is required"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+type Option func(*options)
@@ -0,0 +1,129 @@
+package node
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	pb "github.com/sonm-io/core/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+const imageChunkSize = 1 * 1024 * 1024
+
+// buyerTasks provides the Buyer with access to tasks on Workers the deals
+// are opened with.
+type buyerTasks struct {
+	remotes *remoteOptions
+}
+
+func (m *buyerTasks) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*pb.StartTaskReply, error) {
+	return m.remotes.startTask(ctx, request)
+}
+
+func (m *buyerTasks) TaskStatus(ctx context.Context, dealID *pb.BigInt, taskID string) (*pb.TaskStatusReply, error) {
+	worker, cc, err := m.remotes.getWorkerClientForDeal(ctx, dealID.Unwrap().String())
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	return worker.TaskStatus(ctx, &pb.ID{Id: taskID})
+}
+
+// PullTask writes the committed image of the task. Unlike other methods it
+// works with closed deals, because the image is required after the deal is
+// lost.
+func (m *buyerTasks) PullTask(ctx context.Context, dealID *pb.BigInt, taskID string, wr io.Writer) error {
+	deal, err := m.remotes.eth.Market().GetDealInfo(ctx, dealID.Unwrap())
+	if err != nil {
+		return fmt.Errorf("could not get deal info for deal %s from blockchain: %s", dealID.Unwrap().String(), err)
+	}
+
+	worker, cc, err := m.remotes.getWorkerClientByEthAddr(ctx, deal.GetSupplierID().Unwrap().Hex())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	client, err := worker.PullTask(ctx, &pb.PullTaskRequest{
+		DealId: dealID.Unwrap().String(),
+		TaskId: taskID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start task pull on worker: %s", err)
+	}
+
+	for {
+		chunk, err := client.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive chunk from worker: %s", err)
+		}
+
+		if _, err := wr.Write(chunk.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *buyerTasks) PushTask(ctx context.Context, dealID *pb.BigInt, rd io.Reader, size int64) error {
+	worker, cc, err := m.remotes.getWorkerClientForDeal(ctx, dealID.Unwrap().String())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"deal": dealID.Unwrap().String(),
+		"size": strconv.FormatInt(size, 10),
+	}))
+
+	client, err := worker.PushTask(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start task push on worker: %s", err)
+	}
+
+	// The Worker reports progress for every chunk, so the next one is sent
+	// only after the previous is committed.
+	buf := make([]byte, imageChunkSize)
+	for {
+		n, err := io.ReadFull(rd, buf)
+		if n > 0 {
+			if err := client.Send(&pb.Chunk{Chunk: buf[:n]}); err != nil {
+				return fmt.Errorf("failed to send chunk to worker: %s", err)
+			}
+
+			for remaining := int64(n); remaining > 0; {
+				progress, err := client.Recv()
+				if err != nil {
+					return fmt.Errorf("failed to receive progress from worker: %s", err)
+				}
+				remaining -= progress.GetSize()
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := client.CloseSend(); err != nil {
+		return fmt.Errorf("failed to close stream: %s", err)
+	}
+
+	for {
+		if _, err := client.Recv(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("failed to push image: %s", err)
+		}
+	}
+}
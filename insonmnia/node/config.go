@@ -5,10 +5,13 @@ import (
 	"github.com/sonm-io/core/accounts"
 	"github.com/sonm-io/core/blockchain"
 	"github.com/sonm-io/core/insonmnia/benchmarks"
+	"github.com/sonm-io/core/insonmnia/blacklist"
 	"github.com/sonm-io/core/insonmnia/dwh"
 	"github.com/sonm-io/core/insonmnia/logging"
 	"github.com/sonm-io/core/insonmnia/matcher"
+	"github.com/sonm-io/core/insonmnia/node/buyer"
 	"github.com/sonm-io/core/insonmnia/npp"
+	"github.com/sonm-io/core/insonmnia/state"
 )
 
 type nodeConfig struct {
@@ -17,6 +20,18 @@ type nodeConfig struct {
 	AllowInsecureConnection bool   `yaml:"allow_insecure_connection" default:"false"`
 }
 
+type storageConfig struct {
+	Endpoint string `yaml:"endpoint" required:"true" default:"/var/lib/sonm/node.boltdb"`
+	Bucket   string `yaml:"bucket" required:"true" default:"sonm"`
+}
+
+func (m storageConfig) Unwrap() *state.StorageConfig {
+	return &state.StorageConfig{
+		Endpoint: m.Endpoint,
+		Bucket:   m.Bucket,
+	}
+}
+
 type Config struct {
 	Node              nodeConfig          `yaml:"node"`
 	NPP               npp.Config          `yaml:"npp"`
@@ -27,6 +42,9 @@ type Config struct {
 	MetricsListenAddr string              `yaml:"metrics_listen_addr" default:"127.0.0.1:14003"`
 	Benchmarks        benchmarks.Config   `yaml:"benchmarks"`
 	Matcher           *matcher.YAMLConfig `yaml:"matcher"`
+	Storage           storageConfig       `yaml:"store"`
+	Buyer             buyer.YAMLConfig    `yaml:"buyer"`
+	Blacklist         blacklist.Config    `yaml:"blacklist"`
 }
 
 // NewConfig loads localNode config from given .yaml file
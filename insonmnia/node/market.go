@@ -4,10 +4,8 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/noxiouz/zapctx/ctxlog"
 	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
-	"go.uber.org/zap"
 	"golang.org/x/net/context"
 )
 
@@ -48,82 +46,41 @@ func (m *marketAPI) GetOrderByID(ctx context.Context, req *pb.ID) (*pb.Order, er
 }
 
 func (m *marketAPI) CreateOrder(ctx context.Context, req *pb.BidOrder) (*pb.Order, error) {
-	knownBenchmarks := m.remotes.benchList.MapByCode()
-	givenBenchmarks := req.GetResources().GetBenchmarks()
-
-	if len(givenBenchmarks) > len(knownBenchmarks) {
-		return nil, fmt.Errorf("benchmark list too large")
-	}
-
-	benchmarksValues := make([]uint64, len(knownBenchmarks))
-	for code, value := range givenBenchmarks {
-		bench, ok := knownBenchmarks[code]
-		if !ok {
-			return nil, fmt.Errorf("unknown benchmark code \"%s\"", code)
-		}
-
-		benchmarksValues[bench.GetID()] = value
-	}
+	return m.remotes.buyer.CreateBidPlan(ctx, req)
+}
 
-	benchStruct, err := pb.NewBenchmarks(benchmarksValues)
+func (m *marketAPI) CancelOrder(ctx context.Context, req *pb.ID) (*pb.Empty, error) {
+	id, err := util.ParseBigInt(req.GetId())
 	if err != nil {
-		return nil, fmt.Errorf("could not parse benchmark values: %s", err)
-	}
-
-	var blacklist string
-	if req.GetBlacklist() != nil {
-		blacklist = req.GetBlacklist().Unwrap().Hex()
+		return nil, fmt.Errorf("could not get parse order id %s to BigInt: %s", req.GetId(), err)
 	}
 
-	order := &pb.Order{
-		OrderType:      pb.OrderType_BID,
-		OrderStatus:    pb.OrderStatus_ORDER_ACTIVE,
-		AuthorID:       pb.NewEthAddress(crypto.PubkeyToAddress(m.remotes.key.PublicKey)),
-		CounterpartyID: req.GetCounterparty(),
-		Duration:       uint64(req.GetDuration().Unwrap().Seconds()),
-		Price:          req.GetPrice().GetPerSecond(),
-		Netflags: pb.NetflagsToUint([3]bool{
-			req.GetResources().GetNetwork().GetOverlay(),
-			req.GetResources().GetNetwork().GetOutbound(),
-			req.GetResources().GetNetwork().GetIncoming(),
-		}),
-		IdentityLevel: req.GetIdentity(),
-		Blacklist:     blacklist,
-		Tag:           []byte(req.GetTag()),
-		Benchmarks:    benchStruct,
+	if err := m.remotes.eth.Market().CancelOrder(ctx, m.remotes.key, id); err != nil {
+		return nil, fmt.Errorf("could not get cancel order %s on blockchain: %s", req.GetId(), err)
 	}
 
-	order, err = m.remotes.eth.Market().PlaceOrder(ctx, m.remotes.key, order)
-	if err != nil {
-		return nil, fmt.Errorf("could not place order on blockchain: %s", err)
-	}
+	return &pb.Empty{}, nil
+}
 
-	go func() {
-		deal, err := m.remotes.orderMatcher.CreateDealByOrder(m.remotes.ctx, order)
-		if err != nil {
-			ctxlog.G(m.remotes.ctx).Warn("cannot open deal", zap.Error(err))
-			return
-		}
+func (m *marketAPI) BidPlans(ctx context.Context, req *pb.Empty) (*pb.BidPlansReply, error) {
+	return &pb.BidPlansReply{BidPlans: m.remotes.buyer.BidPlans()}, nil
+}
 
-		ctxlog.G(m.remotes.ctx).Info("opened deal for order",
-			zap.String("orderID", order.Id.Unwrap().String()),
-			zap.String("dealID", deal.Id.Unwrap().String()))
-	}()
+func (m *marketAPI) CancelBidPlan(ctx context.Context, req *pb.ID) (*pb.Empty, error) {
+	if err := m.remotes.buyer.CancelBidPlan(ctx, req.GetId()); err != nil {
+		return nil, fmt.Errorf("could not cancel bid plan %s: %s", req.GetId(), err)
+	}
 
-	return order, nil
+	return &pb.Empty{}, nil
 }
 
-func (m *marketAPI) CancelOrder(ctx context.Context, req *pb.ID) (*pb.Empty, error) {
-	id, err := util.ParseBigInt(req.GetId())
+func (m *marketAPI) ReplaceBidPlan(ctx context.Context, req *pb.ReplaceBidPlanRequest) (*pb.BidPlan, error) {
+	plan, err := m.remotes.buyer.ReplaceBidPlan(ctx, req.GetID(), req.GetPrice())
 	if err != nil {
-		return nil, fmt.Errorf("could not get parse order id %s to BigInt: %s", req.GetId(), err)
+		return nil, fmt.Errorf("could not replace bid plan %s: %s", req.GetID(), err)
 	}
 
-	if err := m.remotes.eth.Market().CancelOrder(ctx, m.remotes.key, id); err != nil {
-		return nil, fmt.Errorf("could not get cancel order %s on blockchain: %s", req.GetId(), err)
-	}
-
-	return &pb.Empty{}, nil
+	return plan, nil
 }
 
 func newMarketAPI(opts *remoteOptions) (pb.MarketServer, error) {
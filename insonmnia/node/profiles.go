@@ -0,0 +1,77 @@
+package node
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/proto"
+	"golang.org/x/net/context"
+)
+
+type profilesAPI struct {
+	remotes *remoteOptions
+}
+
+func newProfilesAPI(opts *remoteOptions) sonm.ProfilesServer {
+	return &profilesAPI{remotes: opts}
+}
+
+func (m *profilesAPI) Profile(ctx context.Context, addr *sonm.EthAddress) (*sonm.ProfileReply, error) {
+	if addr.IsZero() {
+		addr = sonm.NewEthAddress(crypto.PubkeyToAddress(m.remotes.key.PublicKey))
+	}
+
+	profile, err := m.remotes.dwh.GetProfileInfo(ctx, &sonm.EthID{Id: addr})
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot get profile from DWH")
+	}
+
+	var certificates []*sonm.Certificate
+	if len(profile.GetCertificates()) != 0 {
+		if err := json.Unmarshal([]byte(profile.GetCertificates()), &certificates); err != nil {
+			return nil, errors.Wrap(err, "cannot decode profile certificates")
+		}
+	}
+
+	return &sonm.ProfileReply{
+		Profile:      profile,
+		Certificates: certificates,
+	}, nil
+}
+
+func (m *profilesAPI) List(ctx context.Context, request *sonm.ProfilesRequest) (*sonm.ProfilesReply, error) {
+	return m.remotes.dwh.GetProfiles(ctx, request)
+}
+
+func (m *profilesAPI) CreateCertificate(ctx context.Context, request *sonm.CertificateRequest) (*sonm.BigInt, error) {
+	if len(request.GetValue()) == 0 {
+		return nil, errors.New("certificate value is required")
+	}
+
+	owner := crypto.PubkeyToAddress(m.remotes.key.PublicKey)
+	if !request.GetOwnerID().IsZero() {
+		owner = request.GetOwnerID().Unwrap()
+	}
+
+	attribute := new(big.Int).SetUint64(request.GetAttribute())
+	id, err := m.remotes.eth.ProfileRegistry().CreateCertificate(ctx, m.remotes.key, owner, attribute, request.GetValue())
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot create certificate")
+	}
+
+	return sonm.NewBigInt(id), nil
+}
+
+func (m *profilesAPI) RemoveCertificate(ctx context.Context, id *sonm.BigInt) (*sonm.Empty, error) {
+	if id.IsZero() {
+		return nil, errors.New("certificate ID is required")
+	}
+
+	if err := m.remotes.eth.ProfileRegistry().RemoveCertificate(ctx, m.remotes.key, id.Unwrap()); err != nil {
+		return nil, errors.WithMessage(err, "cannot remove certificate")
+	}
+
+	return &sonm.Empty{}, nil
+}
@@ -0,0 +1,71 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfilesCertificates(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	user, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	eth := blockchain.NewSimulatedAPI(crypto.PubkeyToAddress(owner.PublicKey))
+	_, err = eth.ProfileRegistry().AddValidator(ctx, owner, crypto.PubkeyToAddress(validator.PublicKey), 3)
+	require.NoError(t, err)
+
+	userAPI := &profilesAPI{remotes: &remoteOptions{key: user, eth: eth}}
+	validatorAPI := &profilesAPI{remotes: &remoteOptions{key: validator, eth: eth}}
+	userAddr := crypto.PubkeyToAddress(user.PublicKey)
+
+	// Self-signed attributes are issued for the Node's own address by default.
+	id, err := userAPI.CreateCertificate(ctx, &sonm.CertificateRequest{Attribute: 1001, Value: []byte("alice")})
+	require.NoError(t, err)
+
+	certificate, err := eth.ProfileRegistry().GetCertificate(ctx, id.Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, userAddr, certificate.GetOwnerID().Unwrap())
+
+	_, err = userAPI.CreateCertificate(ctx, &sonm.CertificateRequest{
+		OwnerID:   sonm.NewEthAddress(userAddr),
+		Attribute: 1303,
+		Value:     []byte("RU"),
+	})
+	assert.Error(t, err)
+
+	id, err = validatorAPI.CreateCertificate(ctx, &sonm.CertificateRequest{
+		OwnerID:   sonm.NewEthAddress(userAddr),
+		Attribute: 1303,
+		Value:     []byte("RU"),
+	})
+	require.NoError(t, err)
+
+	value, err := eth.ProfileRegistry().GetAttributeValue(ctx, userAddr, big.NewInt(1303))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("RU"), value)
+
+	_, err = validatorAPI.RemoveCertificate(ctx, id)
+	require.NoError(t, err)
+
+	value, err = eth.ProfileRegistry().GetAttributeValue(ctx, userAddr, big.NewInt(1303))
+	require.NoError(t, err)
+	assert.Empty(t, value)
+
+	_, err = validatorAPI.RemoveCertificate(ctx, id)
+	assert.Error(t, err)
+
+	_, err = userAPI.CreateCertificate(ctx, &sonm.CertificateRequest{Attribute: 1001})
+	assert.Error(t, err)
+}
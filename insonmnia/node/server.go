@@ -15,8 +15,11 @@ import (
 	"github.com/sonm-io/core/blockchain"
 	"github.com/sonm-io/core/insonmnia/auth"
 	"github.com/sonm-io/core/insonmnia/benchmarks"
+	"github.com/sonm-io/core/insonmnia/blacklist"
 	"github.com/sonm-io/core/insonmnia/matcher"
+	"github.com/sonm-io/core/insonmnia/node/buyer"
 	"github.com/sonm-io/core/insonmnia/npp"
+	"github.com/sonm-io/core/insonmnia/state"
 	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"github.com/sonm-io/core/util/rest"
@@ -43,6 +46,7 @@ type remoteOptions struct {
 	workerCreator workerClientCreator
 	benchList     benchmarks.BenchList
 	orderMatcher  matcher.Matcher
+	buyer         *buyer.Buyer
 }
 
 func (re *remoteOptions) getWorkerClientForDeal(ctx context.Context, id string) (*workerClient, io.Closer, error) {
@@ -67,6 +71,21 @@ func (re *remoteOptions) getWorkerClientForDeal(ctx context.Context, id string)
 	return client, closer, nil
 }
 
+func (re *remoteOptions) startTask(ctx context.Context, request *pb.StartTaskRequest) (*pb.StartTaskReply, error) {
+	worker, cc, err := re.getWorkerClientForDeal(ctx, request.GetDealID().Unwrap().String())
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	reply, err := worker.StartTask(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start task on worker: %s", err)
+	}
+
+	return reply, nil
+}
+
 func (re *remoteOptions) getWorkerClientByEthAddr(ctx context.Context, eth string) (*workerClient, io.Closer, error) {
 	addr := auth.NewAddrRaw(common.HexToAddress(eth), "")
 	return re.workerCreator(ctx, &addr)
@@ -143,7 +162,12 @@ func newRemoteOptions(ctx context.Context, key *ecdsa.PrivateKey, cfg *Config, c
 		orderMatcher = matcher.NewDisabledMatcher()
 	}
 
-	return &remoteOptions{
+	storage, err := state.NewState(ctx, cfg.Storage.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &remoteOptions{
 		ctx:           ctx,
 		key:           key,
 		eth:           eth,
@@ -151,7 +175,24 @@ func newRemoteOptions(ctx context.Context, key *ecdsa.PrivateKey, cfg *Config, c
 		workerCreator: workerFactory,
 		benchList:     benchList,
 		orderMatcher:  orderMatcher,
-	}, nil
+	}
+
+	opts.buyer, err = buyer.NewBuyer(
+		buyer.WithLogger(log.S(ctx).With("source", "buyer")),
+		buyer.WithStorage(storage),
+		buyer.WithEth(eth),
+		buyer.WithMatcher(orderMatcher),
+		buyer.WithBenchmarks(benchList),
+		buyer.WithEthkey(key),
+		buyer.WithTasks(&buyerTasks{remotes: opts}),
+		buyer.WithConfig(&cfg.Buyer),
+		buyer.WithBlacklistPolicy(blacklist.NewPolicy(cfg.Blacklist, eth, key, log.S(ctx).With("source", "blacklist"))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return opts, nil
 }
 
 // Node is LocalNode instance
@@ -174,6 +215,7 @@ type Node struct {
 	master    pb.MasterManagementServer
 	token     pb.TokenManagementServer
 	blacklist pb.BlacklistServer
+	profiles  pb.ProfilesServer
 }
 
 // New creates new Local Node instance
@@ -212,6 +254,9 @@ func New(ctx context.Context, config *Config, key *ecdsa.PrivateKey) (*Node, err
 	masterMgmt := newMasterManagementAPI(opts)
 	tokenMgmt := newTokenManagementAPI(opts)
 	blacklist := newBlacklistAPI(opts)
+	profiles := newProfilesAPI(opts)
+
+	opts.buyer.Run(ctx)
 
 	grpcServerOpts := []xgrpc.ServerOption{
 		xgrpc.DefaultTraceInterceptor(),
@@ -251,6 +296,9 @@ func New(ctx context.Context, config *Config, key *ecdsa.PrivateKey) (*Node, err
 	pb.RegisterBlacklistServer(srv, blacklist)
 	log.G(ctx).Info("blacklist management service registered")
 
+	pb.RegisterProfilesServer(srv, profiles)
+	log.G(ctx).Info("profiles service registered")
+
 	grpc_prometheus.Register(srv)
 
 	return &Node{
@@ -266,6 +314,7 @@ func New(ctx context.Context, config *Config, key *ecdsa.PrivateKey) (*Node, err
 		master:    masterMgmt,
 		token:     tokenMgmt,
 		blacklist: blacklist,
+		profiles:  profiles,
 	}, nil
 }
 
@@ -384,6 +433,10 @@ func (n *Node) serveHttp() error {
 	if err != nil {
 		return err
 	}
+	err = srv.RegisterService((*pb.ProfilesServer)(nil), n.profiles)
+	if err != nil {
+		return err
+	}
 
 	n.httpSrv = srv
 	return srv.Serve()
@@ -3,13 +3,16 @@ package node
 import (
 	"context"
 	"crypto/ecdsa"
+	"io/ioutil"
 	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/sonm-io/core/insonmnia/auth"
 	"github.com/sonm-io/core/insonmnia/dwh"
+	"github.com/sonm-io/core/insonmnia/node/buyer"
 	"github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"github.com/sonm-io/core/util/netutil"
@@ -24,6 +27,9 @@ func newTestNode(t *testing.T, key *ecdsa.PrivateKey) *Node {
 	relayAddr := netutil.TCPAddr{}
 	relayAddr.TCPAddr = net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 12345}
 
+	dir, err := ioutil.TempDir("", "sonm-node-test")
+	require.NoError(t, err)
+
 	ctx := context.Background()
 	nod, err := New(ctx, &Config{
 		Node: nodeConfig{
@@ -34,6 +40,13 @@ func newTestNode(t *testing.T, key *ecdsa.PrivateKey) *Node {
 		DWH: dwh.YAMLConfig{
 			Endpoint: "3f46ed4f779fd378f630d8cd996796c69a7738d2@127.0.0.1:12345",
 		},
+		Storage: storageConfig{
+			Endpoint: filepath.Join(dir, "node.boltdb"),
+			Bucket:   "sonm",
+		},
+		Buyer: buyer.YAMLConfig{
+			MatcherRetryInterval: time.Second,
+		},
 	}, key)
 	require.NoError(t, err)
 
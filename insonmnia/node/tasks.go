@@ -55,19 +55,7 @@ func (t *tasksAPI) List(ctx context.Context, req *pb.TaskListRequest) (*pb.TaskL
 }
 
 func (t *tasksAPI) Start(ctx context.Context, req *pb.StartTaskRequest) (*pb.StartTaskReply, error) {
-	dealID := req.GetDealID().Unwrap().String()
-	worker, cc, err := t.remotes.getWorkerClientForDeal(ctx, dealID)
-	if err != nil {
-		return nil, err
-	}
-	defer cc.Close()
-
-	reply, err := worker.StartTask(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to start task on worker: %s", err)
-	}
-
-	return reply, nil
+	return t.remotes.startTask(ctx, req)
 }
 
 func (t *tasksAPI) JoinNetwork(ctx context.Context, req *pb.JoinNetworkRequest) (*pb.NetworkSpec, error) {
@@ -128,6 +116,38 @@ func (t *tasksAPI) Logs(req *pb.TaskLogsRequest, srv pb.TaskManagement_LogsServe
 	}
 }
 
+func (t *tasksAPI) Events(req *pb.TaskEventsRequest, srv pb.TaskManagement_EventsServer) error {
+	if req.GetDealID().IsZero() {
+		return errors.New("deal ID is required for task events")
+	}
+
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(srv.Context(), req.GetDealID().Unwrap().String())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	eventsClient, err := workerClient.TaskEvents(srv.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to task events on worker: %s", err)
+	}
+
+	for {
+		event, err := eventsClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failure during receiving task events from worker: %s", err)
+		}
+
+		if err := srv.Send(event); err != nil {
+			return fmt.Errorf("failed to send task event: %s", err)
+		}
+	}
+}
+
 func (t *tasksAPI) Stop(ctx context.Context, id *pb.TaskID) (*pb.Empty, error) {
 	workerClient, cc, err := t.remotes.getWorkerClientForDeal(ctx, id.GetDealID().Unwrap().String())
 	if err != nil {
@@ -138,6 +158,42 @@ func (t *tasksAPI) Stop(ctx context.Context, id *pb.TaskID) (*pb.Empty, error) {
 	return workerClient.StopTask(ctx, &pb.ID{Id: id.GetId()})
 }
 
+func (t *tasksAPI) StartGroup(ctx context.Context, req *pb.StartTaskGroupRequest) (*pb.StartTaskGroupReply, error) {
+	dealID := req.GetDealID().Unwrap().String()
+	worker, cc, err := t.remotes.getWorkerClientForDeal(ctx, dealID)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	reply, err := worker.StartTaskGroup(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start task group on worker: %s", err)
+	}
+
+	return reply, nil
+}
+
+func (t *tasksAPI) GroupStatus(ctx context.Context, id *pb.TaskID) (*pb.TaskGroupStatusReply, error) {
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(ctx, id.GetDealID().Unwrap().String())
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	return workerClient.TaskGroupStatus(ctx, &pb.ID{Id: id.GetId()})
+}
+
+func (t *tasksAPI) StopGroup(ctx context.Context, id *pb.TaskID) (*pb.Empty, error) {
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(ctx, id.GetDealID().Unwrap().String())
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	return workerClient.StopTaskGroup(ctx, &pb.ID{Id: id.GetId()})
+}
+
 func (t *tasksAPI) PushTask(clientStream pb.TaskManagement_PushTaskServer) error {
 	meta, err := t.extractStreamMeta(clientStream)
 	if err != nil {
@@ -300,6 +356,19 @@ func (t *tasksAPI) extractStreamMeta(clientStream pb.TaskManagement_PushTaskServ
 	}, nil
 }
 
+func (t *tasksAPI) Deploy(ctx context.Context, req *pb.TaskDeploymentRequest) (*pb.TaskDeployment, error) {
+	deployment, err := t.remotes.buyer.Deploy(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not deploy task: %s", err)
+	}
+
+	return deployment, nil
+}
+
+func (t *tasksAPI) Deployments(ctx context.Context, req *pb.Empty) (*pb.TaskDeploymentsReply, error) {
+	return &pb.TaskDeploymentsReply{Deployments: t.remotes.buyer.Deployments()}, nil
+}
+
 func newTasksAPI(opts *remoteOptions) (pb.TaskManagementServer, error) {
 	return &tasksAPI{
 		ctx:     opts.ctx,
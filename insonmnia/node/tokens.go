@@ -1,9 +1,16 @@
 package node
 
 import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	log "github.com/noxiouz/zapctx/ctxlog"
 	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
 	"github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
 	"golang.org/x/net/context"
 )
 
@@ -38,6 +45,148 @@ func (t *tokenAPI) Balance(ctx context.Context, _ *sonm.Empty) (*sonm.BalanceRep
 	}, nil
 }
 
+func (t *tokenAPI) Transfer(ctx context.Context, request *sonm.TokenTransferRequest) (*sonm.TokenTransaction, error) {
+	if request.GetTo() == nil {
+		return nil, errors.New("recipient address is required")
+	}
+	amount, err := requireAmount(request.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	token := t.remotes.eth.LiveToken()
+	if request.GetSidechain() {
+		token = t.remotes.eth.SideToken()
+	}
+
+	to := request.GetTo().Unwrap()
+	log.G(ctx).Info("transferring tokens", zap.String("to", to.Hex()), zap.String("amount", amount.String()),
+		zap.Bool("sidechain", request.GetSidechain()))
+
+	tx, err := token.Transfer(ctx, t.remotes.key, to, amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot transfer tokens")
+	}
+
+	return waitTokenTransaction(ctx, token, tx)
+}
+
+func (t *tokenAPI) MarketAllowance(ctx context.Context, _ *sonm.Empty) (*sonm.BigInt, error) {
+	addr := crypto.PubkeyToAddress(t.remotes.key.PublicKey)
+
+	allowance, err := t.remotes.eth.SideToken().AllowanceOf(ctx, addr, blockchain.MarketAddr())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get market allowance")
+	}
+
+	return sonm.NewBigInt(allowance), nil
+}
+
+func (t *tokenAPI) ApproveMarket(ctx context.Context, request *sonm.BigInt) (*sonm.TokenTransaction, error) {
+	if request.Unwrap().Sign() < 0 {
+		return nil, errors.New("allowance cannot be negative")
+	}
+
+	log.G(ctx).Info("approving market allowance", zap.String("amount", request.Unwrap().String()))
+	return t.approve(ctx, t.remotes.eth.SideToken(), blockchain.MarketAddr(), request.Unwrap())
+}
+
+func (t *tokenAPI) Deposit(ctx context.Context, request *sonm.BigInt) (*sonm.TokenTransaction, error) {
+	log.G(ctx).Info("depositing tokens", zap.String("amount", request.Unwrap().String()))
+	return t.payIn(ctx, t.remotes.eth.LiveToken(), t.remotes.eth.MasterchainGate(), blockchain.GatekeeperLiveAddr(), request)
+}
+
+func (t *tokenAPI) Withdraw(ctx context.Context, request *sonm.BigInt) (*sonm.TokenTransaction, error) {
+	log.G(ctx).Info("withdrawing tokens", zap.String("amount", request.Unwrap().String()))
+	return t.payIn(ctx, t.remotes.eth.SideToken(), t.remotes.eth.SidechainGate(), blockchain.GatekeeperSidechainAddr(), request)
+}
+
+// payIn allows the gatekeeper to take the given amount of tokens and asks it
+// to transfer them to the mirrored chain.
+func (t *tokenAPI) payIn(ctx context.Context, token blockchain.TokenAPI, gate blockchain.SimpleGatekeeperAPI, gateAddr common.Address, request *sonm.BigInt) (*sonm.TokenTransaction, error) {
+	amount, err := requireAmount(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := t.approve(ctx, token, gateAddr, amount); err != nil {
+		return nil, err
+	}
+
+	tx, err := gate.PayIn(ctx, t.remotes.key, amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot pay in tokens to gatekeeper")
+	}
+
+	return waitGateTransaction(ctx, gate, tx)
+}
+
+// approve sets the allowance of the given spender. The token requires the
+// allowance to be reset to zero before it is changed, so this is done first
+// if required.
+func (t *tokenAPI) approve(ctx context.Context, token blockchain.TokenAPI, spender common.Address, amount *big.Int) (*sonm.TokenTransaction, error) {
+	addr := crypto.PubkeyToAddress(t.remotes.key.PublicKey)
+
+	allowance, err := token.AllowanceOf(ctx, addr, spender)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get current allowance")
+	}
+
+	if allowance.Cmp(amount) == 0 {
+		return &sonm.TokenTransaction{}, nil
+	}
+
+	if allowance.Sign() != 0 && amount.Sign() != 0 {
+		tx, err := token.Approve(ctx, t.remotes.key, spender, big.NewInt(0))
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot reset allowance")
+		}
+		if _, err := waitTokenTransaction(ctx, token, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := token.Approve(ctx, t.remotes.key, spender, amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot approve allowance")
+	}
+
+	return waitTokenTransaction(ctx, token, tx)
+}
+
+func requireAmount(amount *sonm.BigInt) (*big.Int, error) {
+	if amount.Unwrap().Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	return amount.Unwrap(), nil
+}
+
+func waitTokenTransaction(ctx context.Context, token blockchain.TokenAPI, tx *types.Transaction) (*sonm.TokenTransaction, error) {
+	receipt, err := token.WaitTransaction(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot confirm transaction %s", tx.Hash().Hex())
+	}
+
+	return newTokenTransaction(tx, receipt), nil
+}
+
+func waitGateTransaction(ctx context.Context, gate blockchain.SimpleGatekeeperAPI, tx *types.Transaction) (*sonm.TokenTransaction, error) {
+	receipt, err := gate.WaitTransaction(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot confirm transaction %s", tx.Hash().Hex())
+	}
+
+	return newTokenTransaction(tx, receipt), nil
+}
+
+func newTokenTransaction(tx *types.Transaction, receipt *blockchain.Receipt) *sonm.TokenTransaction {
+	return &sonm.TokenTransaction{
+		Hash:        tx.Hash().Hex(),
+		BlockNumber: uint64(receipt.BlockNumber),
+	}
+}
+
 func newTokenManagementAPI(opts *remoteOptions) sonm.TokenManagementServer {
 	return &tokenAPI{remotes: opts}
 }
@@ -0,0 +1,92 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTokenAPI(t *testing.T) (*tokenAPI, *blockchain.SimulatedAPI) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	eth := blockchain.NewSimulatedAPI(crypto.PubkeyToAddress(key.PublicKey))
+	_, err = eth.TestToken().GetTokens(context.Background(), key)
+	require.NoError(t, err)
+
+	return &tokenAPI{remotes: &remoteOptions{key: key, eth: eth}}, eth
+}
+
+func TestTokenTransfer(t *testing.T) {
+	api, eth := newTestTokenAPI(t)
+	ctx := context.Background()
+
+	to := crypto.PubkeyToAddress(api.remotes.key.PublicKey)
+	to[0]++
+
+	tx, err := api.Transfer(ctx, &sonm.TokenTransferRequest{
+		To:     sonm.NewEthAddress(to),
+		Amount: sonm.NewBigIntFromInt(42),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, tx.GetHash())
+	assert.NotZero(t, tx.GetBlockNumber())
+
+	balance, err := eth.LiveToken().BalanceOf(ctx, to)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), balance)
+
+	_, err = api.Transfer(ctx, &sonm.TokenTransferRequest{
+		To:        sonm.NewEthAddress(to),
+		Amount:    sonm.NewBigIntFromInt(42),
+		Sidechain: true,
+	})
+	assert.Error(t, err)
+
+	_, err = api.Transfer(ctx, &sonm.TokenTransferRequest{To: sonm.NewEthAddress(to)})
+	assert.Error(t, err)
+}
+
+func TestTokenApproveMarket(t *testing.T) {
+	api, _ := newTestTokenAPI(t)
+	ctx := context.Background()
+
+	for _, amount := range []int64{100, 200, 200, 0} {
+		_, err := api.ApproveMarket(ctx, sonm.NewBigIntFromInt(amount))
+		require.NoError(t, err)
+
+		allowance, err := api.MarketAllowance(ctx, &sonm.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(amount), allowance.Unwrap())
+	}
+}
+
+func TestTokenDeposit(t *testing.T) {
+	api, eth := newTestTokenAPI(t)
+	ctx := context.Background()
+	addr := crypto.PubkeyToAddress(api.remotes.key.PublicKey)
+
+	before, err := eth.LiveToken().BalanceOf(ctx, addr)
+	require.NoError(t, err)
+
+	tx, err := api.Deposit(ctx, sonm.NewBigIntFromInt(1000))
+	require.NoError(t, err)
+	assert.NotEmpty(t, tx.GetHash())
+
+	after, err := eth.LiveToken().BalanceOf(ctx, addr)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), before.Sub(before, after))
+
+	gate, err := eth.LiveToken().BalanceOf(ctx, blockchain.GatekeeperLiveAddr())
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), gate)
+
+	_, err = api.Withdraw(ctx, sonm.NewBigIntFromInt(1000))
+	assert.Error(t, err)
+}
@@ -80,10 +80,7 @@ func (m *Scheduler) Consume(askPlan *sonm.AskPlan) error {
 }
 
 func (m *Scheduler) ConsumeTask(askPlanID string, taskID string, resources *sonm.AskPlanResources) error {
-	copy := &sonm.AskPlanResources{
-		GPU:     deepcopy.Copy(resources.GetGPU()).(*sonm.AskPlanGPU),
-		Storage: deepcopy.Copy(resources.GetStorage()).(*sonm.AskPlanStorage),
-	}
+	copy := taskResources(resources)
 
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -97,6 +94,52 @@ func (m *Scheduler) ConsumeTask(askPlanID string, taskID string, resources *sonm
 	return pool.consume(taskID, copy)
 }
 
+// ConsumeTaskGroup consumes resources for a group of tasks running within
+// the same ask plan.
+//
+// Resources of all tasks are summed up and checked against the ask plan as a
+// whole, so either all tasks are accepted or none of them.
+func (m *Scheduler) ConsumeTaskGroup(askPlanID string, tasks map[string]*sonm.AskPlanResources) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pool, ok := m.askPlanPools[askPlanID]
+	if !ok {
+		return fmt.Errorf("could not consume resources for task group - ask Plan with id %s not found", askPlanID)
+	}
+
+	total := &sonm.AskPlanResources{}
+	consumed := &sonm.AskPlanResources{}
+	copies := map[string]*sonm.AskPlanResources{}
+	for taskID, resources := range tasks {
+		if _, ok := pool.used[taskID]; ok {
+			return fmt.Errorf("resources with ID %s has been already consumed", taskID)
+		}
+		if err := total.Add(resources); err != nil {
+			return fmt.Errorf("could not sum up resources for task %s: %s", taskID, err)
+		}
+
+		copies[taskID] = taskResources(resources)
+		if err := consumed.Add(copies[taskID]); err != nil {
+			return fmt.Errorf("could not sum up resources for task %s: %s", taskID, err)
+		}
+	}
+
+	if ok, desc := pool.all.Contains(total); !ok {
+		return fmt.Errorf("task group does not fit the ask plan: %s", desc)
+	}
+	if err := pool.pollConsume(consumed); err != nil {
+		return err
+	}
+
+	for taskID, resources := range copies {
+		m.taskToAskPlan[taskID] = askPlanID
+		pool.used[taskID] = resources
+	}
+
+	return nil
+}
+
 func (m *Scheduler) Release(askPlanID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -124,6 +167,15 @@ func (m *Scheduler) ReleaseTask(taskID string) error {
 	return nil
 }
 
+// taskResources returns resources that are exclusively consumed by a task
+// from its ask plan.
+func taskResources(resources *sonm.AskPlanResources) *sonm.AskPlanResources {
+	return &sonm.AskPlanResources{
+		GPU:     deepcopy.Copy(resources.GetGPU()).(*sonm.AskPlanGPU),
+		Storage: deepcopy.Copy(resources.GetStorage()).(*sonm.AskPlanStorage),
+	}
+}
+
 type pool struct {
 	all  *sonm.AskPlanResources
 	used map[string]*sonm.AskPlanResources
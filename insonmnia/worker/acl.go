@@ -133,6 +133,22 @@ func newFromNamedTaskDealExtractor(worker *Worker, name string) DealExtractor {
 	}
 }
 
+// newFromTaskGroupDealExtractor extracts deal ID from the main task of the
+// group whose ID is passed as "Id" field of the request.
+func newFromTaskGroupDealExtractor(worker *Worker) DealExtractor {
+	taskExtractor := newFromTaskDealExtractor(worker)
+
+	return func(ctx context.Context, request interface{}) (structs.DealID, error) {
+		groupID := request.(*sonm.ID).GetId()
+		taskIDs := worker.taskGroupIDs(groupID)
+		if len(taskIDs) == 0 {
+			return "", status.Errorf(codes.NotFound, "task group %s not found", groupID)
+		}
+
+		return taskExtractor(ctx, &sonm.ID{Id: taskIDs[0]})
+	}
+}
+
 func newRequestDealExtractor(fn func(request interface{}) (structs.DealID, error)) DealExtractor {
 	return newCustomDealExtractor(func(ctx context.Context, request interface{}) (structs.DealID, error) {
 		return fn(request)
@@ -145,6 +161,39 @@ func newCustomDealExtractor(fn func(ctx context.Context, request interface{}) (s
 	}
 }
 
+// taskLogsAuthorization allows the deal's consumer to access stored logs of
+// its tasks, even after the deal is closed.
+type taskLogsAuthorization struct {
+	logs *taskLogStore
+}
+
+func newTaskLogsAuthorization(logs *taskLogStore) auth.Authorization {
+	return &taskLogsAuthorization{logs: logs}
+}
+
+func (a *taskLogsAuthorization) Authorize(ctx context.Context, request interface{}) error {
+	dealID := request.(*sonm.TaskLogsRequest).GetDealID()
+	if dealID.IsZero() {
+		return errNoDealProvided
+	}
+
+	wallet, err := auth.ExtractWalletFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	consumer, err := a.logs.Consumer(dealID.Unwrap().String())
+	if err != nil {
+		return status.Errorf(codes.NotFound, "no logs found for deal %s", dealID.Unwrap().String())
+	}
+
+	if consumer != wallet.Hex() {
+		return status.Errorf(codes.Unauthenticated, "wallet mismatch: %s", wallet.Hex())
+	}
+
+	return nil
+}
+
 type anyOfAuth struct {
 	authorizers []auth.Authorization
 }
@@ -0,0 +1,254 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"github.com/sonm-io/core/util/multierror"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	committedImagesStorageKey = "committed_images"
+	committedImagesGCInterval = time.Hour
+)
+
+// committedImage describes an image committed from the task of a closed
+// deal. Such images are kept on the Worker, so the deal's consumer can pull
+// them after the deal is closed, for example to restore the task elsewhere.
+// The images are removed after the retention period.
+type committedImage struct {
+	DealID     string    `json:"deal_id"`
+	TaskID     string    `json:"task_id"`
+	ImageName  string    `json:"image_name"`
+	ConsumerID string    `json:"consumer_id"`
+	ClosedAt   time.Time `json:"closed_at"`
+}
+
+func committedImageKey(dealID, taskID string) string {
+	return fmt.Sprintf("%s_%s", dealID, taskID)
+}
+
+// committedImageRef returns the reference the task's image is committed
+// with, which is the image it was started from tagged with the deal and the
+// task IDs.
+func committedImageRef(imageName, dealID, taskID string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil {
+		return "", err
+	}
+
+	tagged, err := reference.WithTag(named, committedImageKey(dealID, taskID))
+	if err != nil {
+		return "", err
+	}
+
+	return tagged.String(), nil
+}
+
+// saveCommittedImages remembers images committed from the given tasks of the
+// closed deal.
+//
+// Must be called with the mutex held.
+func (m *Worker) saveCommittedImages(deal *pb.Deal, tasks map[string]*ContainerInfo) error {
+	records, err := m.loadCommittedImages()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for taskID, info := range tasks {
+		if !info.CommitOnStop {
+			continue
+		}
+
+		records[committedImageKey(info.DealID, taskID)] = &committedImage{
+			DealID:     info.DealID,
+			TaskID:     taskID,
+			ImageName:  info.ImageName,
+			ConsumerID: deal.GetConsumerID().Unwrap().Hex(),
+			ClosedAt:   time.Now(),
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return m.committedImages.Save(records)
+}
+
+func (m *Worker) loadCommittedImages() (map[string]*committedImage, error) {
+	records := map[string]*committedImage{}
+	if err := m.committedImages.Load(&records); err != nil {
+		return nil, fmt.Errorf("could not load committed images: %s", err)
+	}
+
+	return records, nil
+}
+
+// runCommittedImagesGC periodically removes committed images, whose
+// retention period has passed, until the context is canceled.
+func (m *Worker) runCommittedImagesGC(ctx context.Context) {
+	ticker := util.NewImmediateTicker(committedImagesGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.collectCommittedImages(ctx, time.Now()); err != nil {
+				log.G(ctx).Warn("failed to remove expired committed images", zap.Error(err))
+			}
+		}
+	}
+}
+
+// collectCommittedImages removes committed images of deals closed more than
+// the retention period ago along with their records. Records of images that
+// failed to be removed are kept, so the removal is retried later.
+func (m *Worker) collectCommittedImages(ctx context.Context, now time.Time) error {
+	records, err := m.expiredCommittedImages(now)
+	if err != nil {
+		return err
+	}
+
+	result := multierror.NewMultiError()
+	var removed []string
+	for key, record := range records {
+
+		ref, err := committedImageRef(record.ImageName, record.DealID, record.TaskID)
+		if err == nil {
+			err = m.ovs.RemoveImage(ctx, ref)
+		}
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to remove image of task %s: %s", key, err))
+			continue
+		}
+
+		removed = append(removed, key)
+	}
+
+	if len(removed) == 0 {
+		return result.ErrorOrNil()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Records are reloaded, because new ones might be saved meanwhile.
+	records, err = m.loadCommittedImages()
+	if err != nil {
+		return err
+	}
+	for _, key := range removed {
+		delete(records, key)
+	}
+	if err := m.committedImages.Save(records); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// expiredCommittedImages returns records of committed images, whose
+// retention period has passed.
+//
+// Records saved without the deal closing time are stamped with the current
+// time, so their retention period starts now.
+func (m *Worker) expiredCommittedImages(now time.Time) (map[string]*committedImage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	records, err := m.loadCommittedImages()
+	if err != nil {
+		return nil, err
+	}
+
+	expired := map[string]*committedImage{}
+	stamped := false
+	for key, record := range records {
+		if record.ClosedAt.IsZero() {
+			record.ClosedAt = now
+			stamped = true
+		}
+		if now.Sub(record.ClosedAt) >= m.cfg.CommittedImages.Retention {
+			expired[key] = record
+		}
+	}
+
+	if stamped {
+		if err := m.committedImages.Save(records); err != nil {
+			return nil, err
+		}
+	}
+
+	return expired, nil
+}
+
+// pullableImage returns the name of the image the given task was started
+// from, either while the task is still known or after its deal is closed and
+// the image has been committed.
+func (m *Worker) pullableImage(dealID, taskID string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if info, ok := m.containers[taskID]; ok && info.DealID == dealID {
+		return info.ImageName, nil
+	}
+
+	records, err := m.loadCommittedImages()
+	if err != nil {
+		return "", err
+	}
+
+	record, ok := records[committedImageKey(dealID, taskID)]
+	if !ok {
+		return "", status.Errorf(codes.NotFound, "no task with id %s", taskID)
+	}
+
+	return record.ImageName, nil
+}
+
+// committedImagesDealInfo supplies deal info for the deal authorization. Deals
+// with committed images are already closed, so their consumer is taken from
+// the committed images bookkeeping instead of the salesman.
+type committedImagesDealInfo struct {
+	worker *Worker
+}
+
+func (m *committedImagesDealInfo) GetDealInfo(ctx context.Context, id *pb.ID) (*pb.DealInfoReply, error) {
+	m.worker.mu.Lock()
+	records, err := m.worker.loadCommittedImages()
+	m.worker.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.DealID != id.GetId() {
+			continue
+		}
+
+		dealID, err := pb.NewBigIntFromString(record.DealID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.DealInfoReply{
+			Deal: &pb.Deal{Id: dealID, ConsumerID: pb.NewEthAddress(common.HexToAddress(record.ConsumerID))},
+		}, nil
+	}
+
+	return m.worker.GetDealInfo(ctx, id)
+}
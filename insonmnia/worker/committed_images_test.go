@@ -0,0 +1,119 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/insonmnia/auth"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type testPullTaskStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data bytes.Buffer
+}
+
+func (m *testPullTaskStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *testPullTaskStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (m *testPullTaskStream) SetTrailer(metadata.MD) {}
+
+func (m *testPullTaskStream) Send(chunk *pb.Chunk) error {
+	_, err := m.data.Write(chunk.GetChunk())
+	return err
+}
+
+func newTestPullTaskStream(wallet common.Address) *testPullTaskStream {
+	return &testPullTaskStream{
+		ctx: peer.NewContext(testCtx(), &peer.Peer{
+			AuthInfo: auth.EthAuthInfo{TLS: credentials.TLSInfo{}, Wallet: wallet},
+		}),
+	}
+}
+
+func TestPullTaskAfterDealClose(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ovs := NewMockOverseer(controller)
+	ovs.EXPECT().OnDealFinish(gomock.Any(), "container-1").Return(nil)
+	ovs.EXPECT().OnDealFinish(gomock.Any(), "container-2").Return(nil)
+	ovs.EXPECT().Save(gomock.Any(), "docker.io/sonm/task:42_task-1").
+		Return(ImageInfo{Size: 5}, ioutil.NopCloser(strings.NewReader("image")), nil)
+
+	m, _, cleanup := newTestWorker(t, ovs)
+	defer cleanup()
+
+	m.containers = map[string]*ContainerInfo{
+		"task-1": {ID: "container-1", DealID: "42", ImageName: "sonm/task", CommitOnStop: true},
+		"task-2": {ID: "container-2", DealID: "42", ImageName: "sonm/other"},
+	}
+	require.NoError(t, m.setupAuthorization())
+
+	consumer := common.HexToAddress("0x8125721c2413d99a33e351e1f6bb4e56b6b633fd")
+	require.NoError(t, m.cancelDealTasks(newTestClosedDeal(42, consumer)))
+	assert.Empty(t, m.containers)
+
+	stream := newTestPullTaskStream(consumer)
+	require.NoError(t, m.PullTask(&pb.PullTaskRequest{DealId: "42", TaskId: "task-1"}, stream))
+	assert.Equal(t, "image", stream.data.String())
+
+	// Tasks without commit on stop leave nothing to pull.
+	err := m.PullTask(&pb.PullTaskRequest{DealId: "42", TaskId: "task-2"}, newTestPullTaskStream(consumer))
+	require.Error(t, err)
+
+	// Only the deal's consumer is allowed to pull committed images.
+	err = m.PullTask(&pb.PullTaskRequest{DealId: "42", TaskId: "task-1"}, newTestPullTaskStream(common.Address{}))
+	require.Error(t, err)
+}
+
+func TestCollectCommittedImages(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ovs := NewMockOverseer(controller)
+	ovs.EXPECT().RemoveImage(gomock.Any(), "docker.io/sonm/old:41_task-1").Return(nil)
+	ovs.EXPECT().RemoveImage(gomock.Any(), "docker.io/sonm/broken:40_task-1").Return(errors.New("image is in use"))
+
+	m, _, cleanup := newTestWorker(t, ovs)
+	defer cleanup()
+	m.cfg.CommittedImages.Retention = time.Hour
+
+	now := time.Now()
+	require.NoError(t, m.committedImages.Save(map[string]*committedImage{
+		"40_task-1": {DealID: "40", TaskID: "task-1", ImageName: "sonm/broken", ClosedAt: now.Add(-2 * time.Hour)},
+		"41_task-1": {DealID: "41", TaskID: "task-1", ImageName: "sonm/old", ClosedAt: now.Add(-2 * time.Hour)},
+		"42_task-1": {DealID: "42", TaskID: "task-1", ImageName: "sonm/new", ClosedAt: now.Add(-time.Minute)},
+		"43_task-1": {DealID: "43", TaskID: "task-1", ImageName: "sonm/legacy"},
+	}))
+
+	require.Error(t, m.collectCommittedImages(testCtx(), now))
+
+	records, err := m.loadCommittedImages()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	// Images that failed to be removed are retried later.
+	assert.Contains(t, records, "40_task-1")
+	assert.Contains(t, records, "42_task-1")
+	// Records without the closing time are kept for the whole period.
+	assert.Equal(t, now.Unix(), records["43_task-1"].ClosedAt.Unix())
+}
@@ -1,6 +1,8 @@
 package worker
 
 import (
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jinzhu/configor"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -37,26 +39,53 @@ type DevConfig struct {
 	DisableMasterApproval bool `yaml:"disable_master_approval"`
 }
 
+// RuntimeConfig selects the container runtime used to run tasks.
+type RuntimeConfig struct {
+	// Type is either "docker" or "runc".
+	Type string     `yaml:"type" default:"docker"`
+	Runc RuncConfig `yaml:"runc"`
+}
+
+// RuncConfig configures running tasks using an OCI runtime directly,
+// without Docker daemon.
+type RuncConfig struct {
+	// Binary is a path to the OCI runtime binary.
+	Binary string `yaml:"binary" default:"runc"`
+	// Root is a directory where images, container bundles and the runtime
+	// state are stored.
+	Root string `yaml:"root" default:"/var/lib/sonm/runc"`
+}
+
+// CommittedImagesConfig configures keeping images committed from tasks of
+// closed deals.
+type CommittedImagesConfig struct {
+	// Retention is the duration images are kept for after the deal is closed.
+	Retention time.Duration `yaml:"retention" default:"168h"`
+}
+
 type Config struct {
-	Endpoint          string              `yaml:"endpoint" required:"true"`
-	Logging           logging.Config      `yaml:"logging"`
-	Resources         *ResourcesConfig    `yaml:"resources" required:"false" `
-	Blockchain        *blockchain.Config  `yaml:"blockchain"`
-	NPP               npp.Config          `yaml:"npp"`
-	SSH               *SSHConfig          `yaml:"ssh" required:"false" `
-	PublicIPs         []string            `yaml:"public_ip_addrs" required:"false" `
-	Plugins           plugin.Config       `yaml:"plugins"`
-	Storage           state.StorageConfig `yaml:"store"`
-	Benchmarks        benchmarks.Config   `yaml:"benchmarks"`
-	Whitelist         WhitelistConfig     `yaml:"whitelist"`
-	MetricsListenAddr string              `yaml:"metrics_listen_addr" default:"127.0.0.1:14000"`
-	DWH               dwh.YAMLConfig      `yaml:"dwh"`
-	Matcher           *matcher.YAMLConfig `yaml:"matcher"`
-	Salesman          salesman.YAMLConfig `yaml:"salesman"`
-	Master            common.Address      `yaml:"master" required:"true"`
-	Development       *DevConfig          `yaml:"development"`
-	Admin             *common.Address     `yaml:"admin"`
-	Debug             *debug.Config       `yaml:"debug"`
+	Endpoint          string                `yaml:"endpoint" required:"true"`
+	Logging           logging.Config        `yaml:"logging"`
+	Resources         *ResourcesConfig      `yaml:"resources" required:"false" `
+	Blockchain        *blockchain.Config    `yaml:"blockchain"`
+	NPP               npp.Config            `yaml:"npp"`
+	SSH               *SSHConfig            `yaml:"ssh" required:"false" `
+	PublicIPs         []string              `yaml:"public_ip_addrs" required:"false" `
+	Plugins           plugin.Config         `yaml:"plugins"`
+	Runtime           RuntimeConfig         `yaml:"runtime"`
+	Logs              LogsConfig            `yaml:"task_logs"`
+	CommittedImages   CommittedImagesConfig `yaml:"committed_images"`
+	Storage           state.StorageConfig   `yaml:"store"`
+	Benchmarks        benchmarks.Config     `yaml:"benchmarks"`
+	Whitelist         WhitelistConfig       `yaml:"whitelist"`
+	MetricsListenAddr string                `yaml:"metrics_listen_addr" default:"127.0.0.1:14000"`
+	DWH               dwh.YAMLConfig        `yaml:"dwh"`
+	Matcher           *matcher.YAMLConfig   `yaml:"matcher"`
+	Salesman          salesman.YAMLConfig   `yaml:"salesman"`
+	Master            common.Address        `yaml:"master" required:"true"`
+	Development       *DevConfig            `yaml:"development"`
+	Admin             *common.Address       `yaml:"admin"`
+	Debug             *debug.Config         `yaml:"debug"`
 }
 
 // NewConfig creates a new Worker config from the specified YAML file.
@@ -6,16 +6,21 @@ import (
 	"io"
 
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
+	"github.com/sonm-io/core/insonmnia/worker/volume"
 	"github.com/sonm-io/core/util/multierror"
 	"go.uber.org/zap"
 
 	"github.com/docker/distribution/reference"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
+	volumetypes "github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/gliderlabs/ssh"
 	log "github.com/noxiouz/zapctx/ctxlog"
+	pb "github.com/sonm-io/core/proto"
 )
 
 type containerDescriptor struct {
@@ -26,16 +31,25 @@ type containerDescriptor struct {
 	CommitedImageID string
 	description     Description
 	stats           types.StatsJSON
+	health          pb.TaskStatusReply_Health
+	// Restarting is set when the container is being restarted by the
+	// Worker, for example because of failed health checks.
+	restarting bool
+	// Stopped is set when the container is being stopped by the Worker.
+	stopped bool
 
 	cleanup plugin.Cleanup
+	// SharedVolumes keeps data of volumes shared between tasks of the group.
+	sharedVolumes *volume.SharedVolumes
 }
 
 func newContainer(ctx context.Context, dockerClient *client.Client, d Description, tuners *plugin.Repository) (*containerDescriptor, error) {
 	log.S(ctx).Infof("start container with application, reference %s", d.Reference.String())
 
 	cont := containerDescriptor{
-		client:      dockerClient,
-		description: d,
+		client:        dockerClient,
+		description:   d,
+		sharedVolumes: tuners.SharedVolumes(),
 	}
 
 	exposedPorts, portBindings, err := d.Expose()
@@ -46,7 +60,14 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 
 	log.G(ctx).Debug("exposing ports", zap.Any("portBindings", portBindings))
 
-	// NOTE: command to launch must be specified via ENTRYPOINT and CMD in Dockerfile
+	labels := make(map[string]string, len(d.Labels)+2)
+	for key, value := range d.Labels {
+		labels[key] = value
+	}
+	labels[overseerTag] = ""
+	labels[dealIDTag] = d.DealId
+
+	// NOTE: when not specified explicitly the command to launch is taken from ENTRYPOINT and CMD in Dockerfile.
 	var config = container.Config{
 		AttachStdin:  false,
 		AttachStdout: false,
@@ -56,9 +77,15 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 
 		Image: d.Reference.String(),
 		// TODO: set actual name
-		Labels:  map[string]string{overseerTag: "", dealIDTag: d.DealId},
-		Env:     d.FormatEnv(),
-		Volumes: make(map[string]struct{}),
+		Labels:      labels,
+		Env:         d.FormatEnv(),
+		Cmd:         d.Cmd,
+		Entrypoint:  d.Entrypoint,
+		WorkingDir:  d.WorkingDir,
+		User:        d.User,
+		Hostname:    d.Hostname,
+		Healthcheck: d.Healthcheck.Unwrap(),
+		Volumes:     make(map[string]struct{}),
 	}
 
 	// NOTE: all ports are EXPOSE as PublishAll
@@ -75,6 +102,34 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 		Resources:       d.Resources.ToHostConfigResources(d.CGroupParent),
 	}
 
+	if len(d.networkMode) > 0 {
+		// Ports can't be published for containers sharing the network
+		// namespace of another container.
+		hostConfig.NetworkMode = container.NetworkMode(d.networkMode)
+		hostConfig.PublishAllPorts = false
+		hostConfig.PortBindings = nil
+		config.ExposedPorts = nil
+	}
+
+	for _, m := range d.mounts {
+		name, ok := d.sharedVolumes[m.Source]
+		if !ok {
+			continue
+		}
+
+		if err := cont.createSharedVolume(ctx, name); err != nil {
+			log.G(ctx).Error("failed to create shared volume", zap.String("volume", name), zap.Error(err))
+			return nil, err
+		}
+
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Type:     mount.TypeVolume,
+			Source:   name,
+			Target:   m.Target,
+			ReadOnly: m.ReadOnly(),
+		})
+	}
+
 	networkingConfig := network.NetworkingConfig{}
 
 	cleanup, err := tuners.Tune(ctx, &d, &hostConfig, &networkingConfig)
@@ -93,6 +148,9 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 	cont.ID = resp.ID
 	cont.log = log.S(ctx).With(zap.String("container_id", cont.ID))
 	cont.cleanup = cleanup
+	if d.Healthcheck != nil {
+		cont.health = pb.TaskStatusReply_HEALTH_STARTING
+	}
 	if len(resp.Warnings) > 0 {
 		log.G(ctx).Warn("ContainerCreate finished with warnings", zap.Strings("warnings", resp.Warnings))
 	}
@@ -161,6 +219,33 @@ func (c *containerDescriptor) execCommand(ctx context.Context, cmd []string, env
 	return
 }
 
+// dockerExecConnection adapts Docker's hijacked connection to the
+// ExecConnection interface.
+type dockerExecConnection struct {
+	types.HijackedResponse
+	isTty bool
+}
+
+func (m *dockerExecConnection) Write(p []byte) (int, error) {
+	return m.Conn.Write(p)
+}
+
+func (m *dockerExecConnection) CopyOutput(stdout, stderr io.Writer) error {
+	// Without TTY Docker multiplexes both streams into a single connection.
+	if m.isTty {
+		_, err := io.Copy(stdout, m.Reader)
+		return err
+	}
+
+	_, err := stdcopy.StdCopy(stdout, stderr, m.Reader)
+	return err
+}
+
+func (m *dockerExecConnection) Close() error {
+	m.HijackedResponse.Close()
+	return nil
+}
+
 func (c *containerDescriptor) Kill(ctx context.Context) (err error) {
 	c.log.Info("kill the container")
 	if err = c.client.ContainerKill(ctx, c.ID, "SIGKILL"); err != nil {
@@ -176,14 +261,51 @@ func (c *containerDescriptor) Remove(ctx context.Context) error {
 	if err := containerRemove(ctx, c.client, c.ID); err != nil {
 		result = multierror.Append(result, err)
 	}
-	if len(c.CommitedImageID) != 0 {
+	// Images committed on stop outlive the deal, so its consumer is able to
+	// pull them, they are removed after the retention period.
+	if len(c.CommitedImageID) != 0 && !c.description.CommitOnStop {
 		if err := imageRemove(ctx, c.client, c.CommitedImageID); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
+	for _, name := range c.description.sharedVolumes {
+		// Shared volumes can not be removed while they are used by other
+		// containers of the group, the last one removes them.
+		if err := c.client.VolumeRemove(ctx, name, false); err != nil {
+			c.log.Debugf("shared volume %s has not been removed: %s", name, err)
+			continue
+		}
+		if c.sharedVolumes != nil {
+			if err := c.sharedVolumes.Remove(c.description.DealId, name); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
 	return result.ErrorOrNil()
 }
 
+// createSharedVolume creates the Docker volume shared between tasks of the
+// group unless it exists. Its data is kept in the deal's storage, which is
+// limited by the size bought in the ask plan.
+func (c *containerDescriptor) createSharedVolume(ctx context.Context, name string) error {
+	if c.sharedVolumes == nil {
+		return fmt.Errorf("shared volumes are not supported")
+	}
+
+	path, err := c.sharedVolumes.Create(c.description.DealId, name, c.description.StorageQuota())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.VolumeCreate(ctx, volumetypes.VolumesCreateBody{
+		Name:       name,
+		Driver:     "local",
+		DriverOpts: map[string]string{"type": "none", "o": "bind", "device": path},
+	})
+
+	return err
+}
+
 //TODO: pass context
 func (c *containerDescriptor) Cleanup() error {
 	return c.cleanup.Close()
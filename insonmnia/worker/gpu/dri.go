@@ -151,6 +151,17 @@ func readSysClassValue(f string) (uint64, error) {
 	return parseSysClassValue(raw)
 }
 
+// readSysClassDecimalValue reads value from /sys/class/xxx file and
+// try to parse it as decimal integer
+func readSysClassDecimalValue(f string) (uint64, error) {
+	raw, err := ioutil.ReadFile(f)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 64)
+}
+
 // parseSysClassValue parses data that was read from /sys/class/xxx as uint64 value
 func parseSysClassValue(v []byte) (uint64, error) {
 	hexWithEOL := strings.Replace(string(v), "0x", "", 1)
@@ -54,6 +54,21 @@ func (f *fakeGPUTuner) Devices() []*sonm.GPUDevice {
 	return f.devices
 }
 
+func (f *fakeGPUTuner) Metrics(ids []GPUID) (map[GPUID]*sonm.GPUUsage, error) {
+	f.log.Debug("requesting metrics from fake GPU driver", zap.Any("device_ids", ids))
+
+	metrics := map[GPUID]*sonm.GPUUsage{}
+	for _, id := range ids {
+		for _, dev := range f.devices {
+			if dev.GetID() == string(id) {
+				metrics[id] = &sonm.GPUUsage{MemoryTotal: dev.GetMemory()}
+			}
+		}
+	}
+
+	return metrics, nil
+}
+
 func (f *fakeGPUTuner) Close() error {
 	f.log.Debug("closing fake GPU driver")
 	return nil
@@ -17,6 +17,9 @@ type Tuner interface {
 	Tune(hostconfig *container.HostConfig, ids []GPUID) error
 	// Devices returns device list that this tuner can control
 	Devices() []*pb.GPUDevice
+	// Metrics returns current usage of devices with given IDs. Unknown IDs
+	// are silently skipped, because they may be controlled by another tuner.
+	Metrics(ids []GPUID) (map[GPUID]*pb.GPUUsage, error)
 	// Close closes the tuner and removes related files and sockets from the host system
 	Close() error
 }
@@ -45,4 +48,6 @@ func (NilTuner) Tune(hostconfig *container.HostConfig, ids []GPUID) error { retu
 
 func (NilTuner) Devices() []*pb.GPUDevice { return nil }
 
+func (NilTuner) Metrics(ids []GPUID) (map[GPUID]*pb.GPUUsage, error) { return nil, nil }
+
 func (NilTuner) Close() error { return nil }
@@ -114,6 +114,59 @@ func (g *nvidiaTuner) Devices() []*pb.GPUDevice {
 	return devices
 }
 
+// Metrics collects GPU usage using NVIDIA management library.
+func (g *nvidiaTuner) Metrics(ids []GPUID) (map[GPUID]*pb.GPUUsage, error) {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	var cards = make(map[string]nvidiaDevice)
+	for _, id := range ids {
+		if card, ok := g.devMap[id]; ok {
+			cards[card.devicePath] = card
+		}
+	}
+
+	if len(cards) == 0 {
+		return nil, nil
+	}
+
+	if err := nvidia.Init(); err != nil {
+		return nil, fmt.Errorf("failed to init NVML: %v", err)
+	}
+	defer func() { nvidia.Shutdown() }()
+
+	devices, err := nvidia.LookupDevices()
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup GPU devices: %v", err)
+	}
+
+	metrics := map[GPUID]*pb.GPUUsage{}
+	for _, d := range devices {
+		card, ok := cards[d.Path]
+		if !ok {
+			continue
+		}
+
+		status, err := d.Status()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GPU status for %s: %v", card.ID(), err)
+		}
+
+		usage := &pb.GPUUsage{MemoryTotal: card.mem}
+		if status.Utilization.GPU != nil {
+			usage.Utilization = uint64(*status.Utilization.GPU)
+		}
+		// Memory usage is presented in Megabytes.
+		if status.Memory.GlobalUsed != nil {
+			usage.MemoryUsed = *status.Memory.GlobalUsed * 1024 * 1024
+		}
+
+		metrics[card.ID()] = usage
+	}
+
+	return metrics, nil
+}
+
 func (g *nvidiaTuner) Close() error {
 	if err := g.listener.Close(); err != nil {
 		return err
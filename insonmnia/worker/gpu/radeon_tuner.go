@@ -135,6 +135,39 @@ func (tun radeonTuner) Devices() []*pb.GPUDevice {
 	return devices
 }
 
+// Metrics collects GPU usage using amdgpu sysfs interface.
+func (tun radeonTuner) Metrics(ids []GPUID) (map[GPUID]*pb.GPUUsage, error) {
+	tun.m.Lock()
+	defer tun.m.Unlock()
+
+	metrics := map[GPUID]*pb.GPUUsage{}
+	for _, id := range ids {
+		card, ok := tun.devMap[id]
+		if !ok {
+			continue
+		}
+
+		sysDevPath := fmt.Sprintf("/sys/class/drm/%s/device/", card.Name)
+		utilization, err := readSysClassDecimalValue(sysDevPath + "gpu_busy_percent")
+		if err != nil {
+			return nil, fmt.Errorf("cannot read GPU utilization for %s: %v", id, err)
+		}
+
+		memoryUsed, err := readSysClassDecimalValue(sysDevPath + "mem_info_vram_used")
+		if err != nil {
+			return nil, fmt.Errorf("cannot read GPU memory usage for %s: %v", id, err)
+		}
+
+		metrics[id] = &pb.GPUUsage{
+			Utilization: utilization,
+			MemoryUsed:  memoryUsed,
+			MemoryTotal: card.Memory,
+		}
+	}
+
+	return metrics, nil
+}
+
 func (tun radeonTuner) Close() error {
 	return nil
 }
@@ -66,6 +66,20 @@ func (t *L2TPTuner) Tuned(ID string) bool {
 	return false
 }
 
+func (t *L2TPTuner) Restore(ctx context.Context, ID string) (Cleanup, bool) {
+	configPath := t.cfg.ConfigDir + "/" + ID
+	if _, err := os.Stat(configPath); err != nil {
+		return nil, false
+	}
+
+	return &L2TPCleaner{
+		ctx:        ctx,
+		cli:        t.cli,
+		networkID:  ID,
+		configPath: configPath,
+	}, true
+}
+
 func (t *L2TPTuner) Run(ctx context.Context) error {
 	syscall.Unlink(t.cfg.NetSocketPath)
 	netListener, err := net.Listen("unix", t.cfg.NetSocketPath)
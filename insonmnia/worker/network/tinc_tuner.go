@@ -148,6 +148,18 @@ func (t *TincTuner) Tuned(ID string) bool {
 	return t.netDriver.HasNetwork(ID)
 }
 
+func (t *TincTuner) Restore(ctx context.Context, ID string) (Cleanup, bool) {
+	if !t.Tuned(ID) {
+		return nil, false
+	}
+
+	return &TincCleaner{
+		ctx:       ctx,
+		client:    t.client,
+		networkID: ID,
+	}, true
+}
+
 func (t *TincTuner) GenerateInvitation(ID string) (structs.Network, error) {
 	return t.netDriver.GenerateInvitation(ID)
 }
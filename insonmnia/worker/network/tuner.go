@@ -18,4 +18,8 @@ type Tuner interface {
 	Tune(ctx context.Context, net structs.Network, hostConfig *container.HostConfig, netConfig *network.NetworkingConfig) (Cleanup, error)
 	GenerateInvitation(ID string) (structs.Network, error)
 	Tuned(ID string) bool
+	// Restore rebuilds the cleanup of the network previously created by Tune,
+	// for example after the Worker restart. Returns false if the network is
+	// not managed by this tuner.
+	Restore(ctx context.Context, ID string) (Cleanup, bool)
 }
@@ -285,7 +285,7 @@ func (m *options) setupSSH() error {
 
 func (m *options) setupOverseer() error {
 	if m.ovs == nil {
-		ovs, err := NewOverseer(m.ctx, m.plugins)
+		ovs, err := newRuntimeOverseer(m.ctx, m.cfg.Runtime, m.plugins)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
@@ -27,9 +29,15 @@ import (
 	pb "github.com/sonm-io/core/proto"
 )
 
+const (
+	runtimeDocker = "docker"
+	runtimeRunc   = "runc"
+)
+
 const overseerTag = "sonm.overseer"
 const dealIDTag = "sonm.dealid"
 const dieEvent = "die"
+const healthStatusEvent = "health_status"
 
 // Description for a target application.
 // TODO: Drop duplication (sonm.Container)
@@ -40,6 +48,13 @@ type Description struct {
 	Resources     *pb.AskPlanResources
 	CGroupParent  string
 	Cmd           []string
+	Entrypoint    []string
+	WorkingDir    string
+	User          string
+	Labels        map[string]string
+	Hostname      string
+	Healthcheck   *pb.ContainerHealthcheck
+	Preemption    *pb.ContainerPreemption
 	Env           map[string]string
 	TaskId        string
 	DealId        string
@@ -53,12 +68,33 @@ type Description struct {
 
 	networks []structs.Network
 	expose   []string
+	// NetworkIDs are IDs of networks the restored container is connected to,
+	// which are cleaned up after the container is finished.
+	networkIDs []string
+
+	// NetworkMode overrides container's network mode, for example to join
+	// the network namespace of another container.
+	networkMode string
+	// SharedVolumes maps mount sources to names of Docker volumes that are
+	// shared between tasks of the same group.
+	sharedVolumes map[string]string
+	// StorageQuota limits the total size of deal-scoped volumes, i.e. it is
+	// the storage bought in the ask plan.
+	storageQuota uint64
 }
 
 func (d *Description) ID() string {
 	return d.TaskId
 }
 
+func (d *Description) DealID() string {
+	return d.DealId
+}
+
+func (d *Description) StorageQuota() uint64 {
+	return d.storageQuota
+}
+
 func (d *Description) Volumes() map[string]*pb.Volume {
 	return d.volumes
 }
@@ -92,6 +128,21 @@ func (d *Description) Expose() (nat.PortSet, nat.PortMap, error) {
 	return nat.ParsePortSpecs(d.expose)
 }
 
+// ContainerStatus describes a container state transition observed by the
+// Overseer.
+type ContainerStatus struct {
+	Status pb.TaskStatusReply_Status
+	// ExitCode of the container's main process, valid only when the
+	// transition was caused by the container exit.
+	ExitCode int
+	// OOMKilled is true when the container has been killed due to out of
+	// memory.
+	OOMKilled bool
+	// Restarted is true when the container has been restarted by its
+	// restart policy after the exit.
+	Restarted bool
+}
+
 // ContainerInfo is a brief information about containers
 type ContainerInfo struct {
 	status       pb.TaskStatusReply_Status
@@ -104,6 +155,23 @@ type ContainerInfo struct {
 	CgroupParent string
 	NetworkIDs   []string
 	DealID       string
+	AskID        string
+	Resources    *pb.AskPlanResources
+	GPUDevices   []gpu.GPUID
+	CommitOnStop bool
+	RegistryAuth string
+	// Volumes and Mounts describe volumes the task's container is started
+	// with, so that they can be cleaned up after the Worker restart.
+	Volumes map[string]*pb.Volume
+	Mounts  []volume.Mount
+	// GroupID is an ID of the task group this task belongs to, if any.
+	GroupID string
+	// GroupVolumes maps mount sources to names of Docker volumes shared
+	// between tasks of the group.
+	GroupVolumes map[string]string
+	// Preemption describes how the task is notified before its deal is
+	// closed by the supplier.
+	Preemption *pb.ContainerPreemption
 }
 
 func (c *ContainerInfo) IntoProto(ctx context.Context) *pb.TaskStatusReply {
@@ -128,51 +196,83 @@ func (c *ContainerInfo) IntoProto(ctx context.Context) *pb.TaskStatusReply {
 		ImageName:          c.ImageName,
 		PortMap:            ports,
 		Uptime:             uint64(time.Now().Sub(c.StartAt).Nanoseconds()),
-		Usage:              nil,
-		AllocatedResources: nil,
+		AllocatedResources: c.Resources,
 	}
 }
 
-// ContainerMetrics are metrics collected from Docker about running containers
+// ContainerMetrics are metrics collected from the container runtime about
+// running containers.
 type ContainerMetrics struct {
-	cpu types.CPUStats
-	mem types.MemoryStats
-	net map[string]types.NetworkStats
+	cpu   *pb.CPUUsage
+	mem   *pb.MemoryUsage
+	blkio *pb.BlockIOUsage
+	net   map[string]*pb.NetworkUsage
+	gpu   map[string]*pb.GPUUsage
+	// Health is not a metric actually, but it's the most convenient way to
+	// deliver it along with other runtime information.
+	health pb.TaskStatusReply_Health
 }
 
 func (m *ContainerMetrics) Marshal() *pb.ResourceUsage {
-	network := make(map[string]*pb.NetworkUsage)
-	for i, n := range m.net {
-		network[i] = &pb.NetworkUsage{
-			TxBytes:   n.TxBytes,
-			RxBytes:   n.RxBytes,
-			TxPackets: n.TxPackets,
-			RxPackets: n.RxPackets,
-			TxErrors:  n.TxErrors,
-			RxErrors:  n.RxErrors,
-		}
-	}
-
 	return &pb.ResourceUsage{
-		Cpu: &pb.CPUUsage{
-			Total: m.cpu.CPUUsage.TotalUsage,
-		},
-		Memory: &pb.MemoryUsage{
-			MaxUsage: m.mem.MaxUsage,
-		},
-		Network: network,
+		Cpu:     m.cpu,
+		Memory:  m.mem,
+		Network: m.net,
+		BlockIO: m.blkio,
+		Gpu:     m.gpu,
 	}
 }
 
-type ExecConnection types.HijackedResponse
+// ImageInfo describes an image stored in the container runtime.
+type ImageInfo struct {
+	ID string
+	// Size of the image archive in bytes.
+	Size int64
+}
+
+// LogOptions control which container logs are fetched.
+//
+// Runtimes that do not record log timestamps may ignore "Since",
+// "Timestamps" and "Details" options. Such runtimes store the output as is
+// and honour offsets instead, while others ignore offsets.
+type LogOptions struct {
+	ShowStdout bool
+	ShowStderr bool
+	Since      string
+	Timestamps bool
+	Follow     bool
+	Tail       string
+	Details    bool
+	// StdoutOffset and StderrOffset are the numbers of bytes of the
+	// corresponding streams to skip.
+	StdoutOffset int64
+	StderrOffset int64
+}
+
+// ExecConnection is a connection to the process executed inside a running
+// container.
+type ExecConnection interface {
+	// Write writes into the process's stdin.
+	io.Writer
+	// CloseWrite closes the process's stdin.
+	CloseWrite() error
+	// CopyOutput copies the process's stdout and stderr into the given
+	// writers until the process exits. For processes attached to a TTY both
+	// streams are written into stdout.
+	CopyOutput(stdout, stderr io.Writer) error
+	// Close terminates the connection.
+	Close() error
+}
 
 // Overseer watches all worker's applications.
 type Overseer interface {
-	// Load loads an image from the specified reader to the Docker.
+	// Load loads an image from the specified reader, which should contain an
+	// image archive in "docker save" format, to the runtime.
 	Load(ctx context.Context, rd io.Reader) (imageLoadStatus, error)
 
-	// Save saves an image from the Docker into the returned reader.
-	Save(ctx context.Context, imageID string) (types.ImageInspect, io.ReadCloser, error)
+	// Save saves an image from the runtime into the returned reader using
+	// "docker save" archive format.
+	Save(ctx context.Context, imageID string) (ImageInfo, io.ReadCloser, error)
 
 	// Spool prepares an application for its further start.
 	//
@@ -183,24 +283,42 @@ type Overseer interface {
 	//
 	// After successful starting an application becomes a target for accepting request, but not guarantees
 	// to complete them.
-	Start(ctx context.Context, description Description) (chan pb.TaskStatusReply_Status, ContainerInfo, error)
+	Start(ctx context.Context, description Description) (chan ContainerStatus, ContainerInfo, error)
 
 	// Exec a given command in running container
-	Exec(ctx context.Context, Id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (types.HijackedResponse, error)
+	Exec(ctx context.Context, Id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (ExecConnection, error)
+
+	// Attach restores tracking of an already running container, for example
+	// after the Worker restart.
+	Attach(ctx context.Context, containerID string, description Description) (chan ContainerStatus, error)
 
 	// Stop terminates the container.
 	Stop(ctx context.Context, containerID string) error
 
 	// Makes all cleanup related to closed deal
+	//
+	// Images committed on stop are kept, they must be removed explicitly
+	// using RemoveImage.
 	OnDealFinish(ctx context.Context, containerID string) error
 
+	// RemoveImage removes the image with the given reference, for example
+	// the one committed from the task of a closed deal.
+	RemoveImage(ctx context.Context, ref string) error
+
+	// Preempt notifies the container that its deal is going to be closed
+	// using the methods specified in the container's description.
+	Preempt(ctx context.Context, containerID string, notice PreemptionNotice) error
+
 	// Info returns runtime statistics collected from all running containers.
 	//
 	// Depending on the implementation this can be cached.
 	Info(ctx context.Context) (map[string]ContainerMetrics, error)
 
-	// Fetch logs of the container
-	Logs(ctx context.Context, id string, opts types.ContainerLogsOptions) (io.ReadCloser, error)
+	// Fetch logs of the container.
+	//
+	// Stdout and stderr are multiplexed into the returned stream using
+	// Docker's "stdcopy" framing regardless of the runtime.
+	Logs(ctx context.Context, id string, opts LogOptions) (io.ReadCloser, error)
 
 	// Close terminates all associated asynchronous operations and prepares the Overseer for shutting down.
 	Close() error
@@ -219,7 +337,7 @@ type overseer struct {
 	// protects containers map
 	mu         sync.Mutex
 	containers map[string]*containerDescriptor
-	statuses   map[string]chan pb.TaskStatusReply_Status
+	statuses   map[string]chan ContainerStatus
 }
 
 func (o *overseer) supportGPU() bool {
@@ -240,7 +358,7 @@ func NewOverseer(ctx context.Context, plugins *plugin.Repository) (Overseer, err
 		plugins:    plugins,
 		client:     dockerClient,
 		containers: make(map[string]*containerDescriptor),
-		statuses:   make(map[string]chan pb.TaskStatusReply_Status),
+		statuses:   make(map[string]chan ContainerStatus),
 	}
 
 	go ovr.collectStats()
@@ -249,24 +367,107 @@ func NewOverseer(ctx context.Context, plugins *plugin.Repository) (Overseer, err
 	return ovr, nil
 }
 
+// newRuntimeOverseer creates an Overseer for the configured container
+// runtime.
+func newRuntimeOverseer(ctx context.Context, cfg RuntimeConfig, plugins *plugin.Repository) (Overseer, error) {
+	switch cfg.Type {
+	case "", runtimeDocker:
+		return NewOverseer(ctx, plugins)
+	case runtimeRunc:
+		return newRuncOverseer(ctx, cfg.Runc)
+	default:
+		return nil, fmt.Errorf("unknown container runtime: %s", cfg.Type)
+	}
+}
+
 func (o *overseer) Info(ctx context.Context) (map[string]ContainerMetrics, error) {
 	info := make(map[string]ContainerMetrics)
+	gpuDevices := make(map[string][]gpu.GPUID)
 
 	o.mu.Lock()
 	for _, container := range o.containers {
-		metrics := ContainerMetrics{
-			cpu: container.stats.CPUStats,
-			mem: container.stats.MemoryStats,
-			net: container.stats.Networks,
-		}
+		metrics := newDockerContainerMetrics(container.stats)
+		metrics.health = container.health
 
 		info[container.ID] = metrics
+		gpuDevices[container.ID] = container.description.GPUDevices
 	}
 	o.mu.Unlock()
 
+	// GPU metrics are collected outside of the lock, because querying
+	// drivers may take a while.
+	for id, devices := range gpuDevices {
+		if len(devices) == 0 {
+			continue
+		}
+
+		usage, err := o.plugins.GPUMetrics(devices)
+		if err != nil {
+			log.G(ctx).Warn("failed to collect GPU metrics", zap.String("id", id), zap.Error(err))
+			continue
+		}
+
+		metrics := info[id]
+		metrics.gpu = usage
+		info[id] = metrics
+	}
+
 	return info, nil
 }
 
+func newDockerContainerMetrics(stats types.StatsJSON) ContainerMetrics {
+	network := make(map[string]*pb.NetworkUsage)
+	for i, n := range stats.Networks {
+		network[i] = &pb.NetworkUsage{
+			TxBytes:   n.TxBytes,
+			RxBytes:   n.RxBytes,
+			TxPackets: n.TxPackets,
+			RxPackets: n.RxPackets,
+			TxErrors:  n.TxErrors,
+			RxErrors:  n.RxErrors,
+			TxDropped: n.TxDropped,
+			RxDropped: n.RxDropped,
+		}
+	}
+
+	blockIO := &pb.BlockIOUsage{}
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			blockIO.ReadBytes += entry.Value
+		case "write":
+			blockIO.WriteBytes += entry.Value
+		}
+	}
+	for _, entry := range stats.BlkioStats.IoServicedRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			blockIO.ReadOps += entry.Value
+		case "write":
+			blockIO.WriteOps += entry.Value
+		}
+	}
+
+	return ContainerMetrics{
+		cpu: &pb.CPUUsage{
+			Total:            stats.CPUStats.CPUUsage.TotalUsage,
+			PerCore:          stats.CPUStats.CPUUsage.PercpuUsage,
+			KernelMode:       stats.CPUStats.CPUUsage.UsageInKernelmode,
+			UserMode:         stats.CPUStats.CPUUsage.UsageInUsermode,
+			ThrottledPeriods: stats.CPUStats.ThrottlingData.ThrottledPeriods,
+			ThrottledTime:    stats.CPUStats.ThrottlingData.ThrottledTime,
+			Periods:          stats.CPUStats.ThrottlingData.Periods,
+		},
+		mem: &pb.MemoryUsage{
+			MaxUsage: stats.MemoryStats.MaxUsage,
+			Usage:    stats.MemoryStats.Usage,
+			Limit:    stats.MemoryStats.Limit,
+		},
+		blkio: blockIO,
+		net:   network,
+	}
+}
+
 func (o *overseer) Close() error {
 	o.cancel()
 	return nil
@@ -292,40 +493,11 @@ func (o *overseer) handleStreamingEvents(ctx context.Context, sinceUnix int64, f
 		case message := <-messages:
 			last = message.TimeNano
 
-			switch message.Status {
-			case dieEvent:
-				id := message.Actor.ID
-				log.G(ctx).Info("container has died", zap.String("id", id))
-
-				var c *containerDescriptor
-				o.mu.Lock()
-				c, containerFound := o.containers[id]
-				s, statusFound := o.statuses[id]
-				// We intentionally do not delete container from the map to save history for deal.
-				// It will be removed from that map after deal finishes and corresponding container would be deleted
-				delete(o.statuses, id)
-				o.mu.Unlock()
-
-				if !containerFound {
-					// NOTE: it could be orphaned container from our previous launch
-					log.G(ctx).Warn("unknown container with sonm tag will be removed", zap.String("id", id))
-					containerRemove(o.ctx, o.client, id)
-					continue
-				}
-				if statusFound {
-					s <- pb.TaskStatusReply_BROKEN
-					close(s)
-				}
-				if c.description.CommitOnStop {
-					log.G(ctx).Info("trying to upload container")
-					err := c.upload(ctx)
-					if err != nil {
-						log.G(ctx).Error("failed to commit container", zap.String("id", id), zap.Error(err))
-					}
-				}
-				if err := c.Cleanup(); err != nil {
-					log.G(ctx).Error("failed to clean up container", zap.String("id", id), zap.Error(err))
-				}
+			switch {
+			case message.Status == dieEvent:
+				o.handleDieEvent(ctx, message)
+			case strings.HasPrefix(message.Status, healthStatusEvent):
+				o.handleHealthStatusEvent(ctx, message)
 			default:
 				log.G(ctx).Warn("received unknown event", zap.String("status", message.Status))
 			}
@@ -333,6 +505,170 @@ func (o *overseer) handleStreamingEvents(ctx context.Context, sinceUnix int64, f
 	}
 }
 
+func (o *overseer) handleDieEvent(ctx context.Context, message events.Message) {
+	id := message.Actor.ID
+	log.G(ctx).Info("container has died", zap.String("id", id))
+
+	containerStatus := ContainerStatus{
+		Status: pb.TaskStatusReply_BROKEN,
+	}
+	if exitCode, err := strconv.Atoi(message.Actor.Attributes["exitCode"]); err == nil {
+		containerStatus.ExitCode = exitCode
+	}
+
+	cjson, err := o.client.ContainerInspect(ctx, id)
+	if err == nil && cjson.State != nil {
+		containerStatus.OOMKilled = cjson.State.OOMKilled
+		// Docker marks the container as restarting before emitting the
+		// "die" event if its restart policy allows to restart it.
+		containerStatus.Restarted = cjson.State.Restarting || cjson.State.Running
+	}
+
+	o.mu.Lock()
+	if c, ok := o.containers[id]; ok && c.restarting {
+		// The container is being restarted by us.
+		c.restarting = false
+		containerStatus.Restarted = true
+	}
+	o.mu.Unlock()
+
+	if containerStatus.Restarted {
+		log.G(ctx).Info("container is being restarted", zap.String("id", id), zap.Int("exitCode", containerStatus.ExitCode))
+		o.notifyRestarted(id, containerStatus)
+		// The container is still alive, so it must not be cleaned up.
+		return
+	}
+
+	var c *containerDescriptor
+	o.mu.Lock()
+	c, containerFound := o.containers[id]
+	s, statusFound := o.statuses[id]
+	// We intentionally do not delete container from the map to save history for deal.
+	// It will be removed from that map after deal finishes and corresponding container would be deleted
+	delete(o.statuses, id)
+	o.mu.Unlock()
+
+	if !containerFound {
+		// NOTE: it could be orphaned container from our previous launch
+		log.G(ctx).Warn("unknown container with sonm tag will be removed", zap.String("id", id))
+		containerRemove(o.ctx, o.client, id)
+		return
+	}
+	if statusFound {
+		s <- containerStatus
+		close(s)
+	}
+	if c.description.CommitOnStop {
+		log.G(ctx).Info("trying to upload container")
+		err := c.upload(ctx)
+		if err != nil {
+			log.G(ctx).Error("failed to commit container", zap.String("id", id), zap.Error(err))
+		}
+	}
+	if err := c.Cleanup(); err != nil {
+		log.G(ctx).Error("failed to clean up container", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// notifyRestarted reports the restarted container as running.
+//
+// The status channel is taken out of the map while sending, so that Stop or
+// OnDealFinish can't close it concurrently, and is put back afterwards
+// unless the container has been stopped or forgotten meanwhile.
+func (o *overseer) notifyRestarted(id string, status ContainerStatus) {
+	o.mu.Lock()
+	s, ok := o.statuses[id]
+	delete(o.statuses, id)
+	if c, ok := o.containers[id]; ok && c.description.Healthcheck != nil {
+		c.health = pb.TaskStatusReply_HEALTH_STARTING
+	}
+	o.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	status.Status = pb.TaskStatusReply_RUNNING
+	s <- status
+
+	o.mu.Lock()
+	c, ok := o.containers[id]
+	stopped := ok && c.stopped
+	if ok && !stopped {
+		o.statuses[id] = s
+	}
+	o.mu.Unlock()
+
+	if ok && !stopped {
+		return
+	}
+	if stopped {
+		// Stop has not found the channel to report about.
+		s <- ContainerStatus{Status: pb.TaskStatusReply_FINISHED}
+	}
+	close(s)
+}
+
+func (o *overseer) handleHealthStatusEvent(ctx context.Context, message events.Message) {
+	id := message.Actor.ID
+	health := parseHealthStatus(strings.TrimSpace(strings.TrimPrefix(message.Status, healthStatusEvent+":")))
+
+	o.mu.Lock()
+	c, ok := o.containers[id]
+	if ok {
+		c.health = health
+	}
+	o.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	log.G(ctx).Info("container health status has been changed", zap.String("id", id), zap.Stringer("health", health))
+
+	if health == pb.TaskStatusReply_UNHEALTHY {
+		go o.onUnhealthy(ctx, c)
+	}
+}
+
+func (o *overseer) onUnhealthy(ctx context.Context, c *containerDescriptor) {
+	switch c.description.Healthcheck.GetOnUnhealthy() {
+	case pb.ContainerHealthcheck_RESTART:
+		log.G(ctx).Info("restarting unhealthy container", zap.String("id", c.ID))
+
+		o.mu.Lock()
+		c.restarting = true
+		o.mu.Unlock()
+
+		if err := o.client.ContainerRestart(ctx, c.ID, nil); err != nil {
+			log.G(ctx).Error("failed to restart unhealthy container", zap.String("id", c.ID), zap.Error(err))
+
+			o.mu.Lock()
+			c.restarting = false
+			o.mu.Unlock()
+		}
+	case pb.ContainerHealthcheck_STOP:
+		log.G(ctx).Info("stopping unhealthy container", zap.String("id", c.ID))
+		// The task will be marked as broken after receiving "die" event.
+		if err := c.Kill(ctx); err != nil {
+			log.G(ctx).Error("failed to stop unhealthy container", zap.String("id", c.ID), zap.Error(err))
+		}
+	}
+}
+
+func parseHealthStatus(status string) pb.TaskStatusReply_Health {
+	switch status {
+	case types.Starting:
+		return pb.TaskStatusReply_HEALTH_STARTING
+	case types.Healthy:
+		return pb.TaskStatusReply_HEALTHY
+	case types.Unhealthy:
+		return pb.TaskStatusReply_UNHEALTHY
+	default:
+		return pb.TaskStatusReply_NO_HEALTHCHECK
+	}
+}
+
 func (o *overseer) watchEvents() {
 	backoff := NewBackoffTimer(time.Second, time.Second*32)
 	defer backoff.Stop()
@@ -341,6 +677,7 @@ func (o *overseer) watchEvents() {
 
 	filterArgs := filters.NewArgs()
 	filterArgs.Add("event", dieEvent)
+	filterArgs.Add("event", healthStatusEvent)
 	filterArgs.Add("label", overseerTag)
 
 	var err error
@@ -436,18 +773,18 @@ func (o *overseer) Load(ctx context.Context, rd io.Reader) (imageLoadStatus, err
 	return decodeImageLoad(response)
 }
 
-func (o *overseer) Save(ctx context.Context, imageID string) (types.ImageInspect, io.ReadCloser, error) {
+func (o *overseer) Save(ctx context.Context, imageID string) (ImageInfo, io.ReadCloser, error) {
 	imageInspect, _, err := o.client.ImageInspectWithRaw(ctx, imageID)
 	if err != nil {
-		return types.ImageInspect{}, nil, err
+		return ImageInfo{}, nil, err
 	}
 
 	rd, err := o.client.ImageSave(ctx, []string{imageID})
 	if err != nil {
-		return types.ImageInspect{}, nil, err
+		return ImageInfo{}, nil, err
 	}
 
-	return imageInspect, rd, nil
+	return ImageInfo{ID: imageInspect.ID, Size: imageInspect.Size}, rd, nil
 }
 
 func (o *overseer) Spool(ctx context.Context, d Description) error {
@@ -472,7 +809,7 @@ func (o *overseer) Spool(ctx context.Context, d Description) error {
 	return nil
 }
 
-func (o *overseer) Start(ctx context.Context, description Description) (status chan pb.TaskStatusReply_Status, cinfo ContainerInfo, err error) {
+func (o *overseer) Start(ctx context.Context, description Description) (status chan ContainerStatus, cinfo ContainerInfo, err error) {
 	if description.IsGPURequired() && !o.supportGPU() {
 		err = fmt.Errorf("GPU required but not supported or disabled")
 		return
@@ -488,7 +825,7 @@ func (o *overseer) Start(ctx context.Context, description Description) (status c
 
 	o.mu.Lock()
 	o.containers[pr.ID] = pr
-	status = make(chan pb.TaskStatusReply_Status)
+	status = make(chan ContainerStatus)
 	o.statuses[pr.ID] = status
 	o.mu.Unlock()
 
@@ -519,28 +856,71 @@ func (o *overseer) Start(ctx context.Context, description Description) (status c
 	return status, cinfo, nil
 }
 
-func (o *overseer) Exec(ctx context.Context, id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (ret types.HijackedResponse, err error) {
+func (o *overseer) Attach(ctx context.Context, containerID string, description Description) (chan ContainerStatus, error) {
+	cjson, err := o.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := cjson.Config.Labels[overseerTag]; !ok {
+		return nil, fmt.Errorf("container %s is not managed by the worker", containerID)
+	}
+
+	if cjson.State == nil || !cjson.State.Running {
+		return nil, fmt.Errorf("container %s is not running", containerID)
+	}
+
+	descriptor := &containerDescriptor{
+		client:        o.client,
+		log:           log.S(ctx).With(zap.String("container_id", cjson.ID)),
+		ID:            cjson.ID,
+		description:   description,
+		cleanup:       o.plugins.Restore(ctx, &description, description.networkIDs),
+		sharedVolumes: o.plugins.SharedVolumes(),
+	}
+	if cjson.State.Health != nil {
+		descriptor.health = parseHealthStatus(cjson.State.Health.Status)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	status := make(chan ContainerStatus)
+	o.containers[descriptor.ID] = descriptor
+	o.statuses[descriptor.ID] = status
+
+	return status, nil
+}
+
+func (o *overseer) Exec(ctx context.Context, id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (ExecConnection, error) {
 	o.mu.Lock()
 	descriptor, dok := o.containers[id]
 	o.mu.Unlock()
 	if !dok {
-		err = fmt.Errorf("no such container %s", id)
-		return
+		return nil, fmt.Errorf("no such container %s", id)
 	}
-	ret, err = descriptor.execCommand(ctx, cmd, env, isTty, wCh)
-	return
+
+	conn, err := descriptor.execCommand(ctx, cmd, env, isTty, wCh)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dockerExecConnection{HijackedResponse: conn, isTty: isTty}, nil
 }
 
 func (o *overseer) Stop(ctx context.Context, containerid string) error {
 	o.mu.Lock()
 
 	descriptor, dok := o.containers[containerid]
+	if dok {
+		descriptor.stopped = true
+	}
 	status, sok := o.statuses[containerid]
 	delete(o.statuses, containerid)
 	o.mu.Unlock()
 
 	if sok {
-		status <- pb.TaskStatusReply_FINISHED
+		status <- ContainerStatus{Status: pb.TaskStatusReply_FINISHED}
 		close(status)
 	}
 
@@ -551,6 +931,18 @@ func (o *overseer) Stop(ctx context.Context, containerid string) error {
 	return descriptor.Kill(ctx)
 }
 
+func (o *overseer) Preempt(ctx context.Context, containerID string, notice PreemptionNotice) error {
+	o.mu.Lock()
+	descriptor, ok := o.containers[containerID]
+	o.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no such container %s", containerID)
+	}
+
+	return descriptor.preempt(ctx, notice)
+}
+
 func (o *overseer) OnDealFinish(ctx context.Context, containerID string) error {
 	o.mu.Lock()
 	defer o.mu.Unlock()
@@ -583,6 +975,18 @@ func (o *overseer) OnDealFinish(ctx context.Context, containerID string) error {
 	return result.ErrorOrNil()
 }
 
-func (o *overseer) Logs(ctx context.Context, id string, opts types.ContainerLogsOptions) (io.ReadCloser, error) {
-	return o.client.ContainerLogs(ctx, id, opts)
+func (o *overseer) RemoveImage(ctx context.Context, ref string) error {
+	return imageRemove(ctx, o.client, ref)
+}
+
+func (o *overseer) Logs(ctx context.Context, id string, opts LogOptions) (io.ReadCloser, error) {
+	return o.client.ContainerLogs(ctx, id, types.ContainerLogsOptions{
+		ShowStdout: opts.ShowStdout,
+		ShowStderr: opts.ShowStderr,
+		Since:      opts.Since,
+		Timestamps: opts.Timestamps,
+		Follow:     opts.Follow,
+		Tail:       opts.Tail,
+		Details:    opts.Details,
+	})
 }
@@ -0,0 +1,1450 @@
+//go:build linux
+// +build linux
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/gliderlabs/ssh"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pborman/uuid"
+	"github.com/sonm-io/core/insonmnia/worker/volume"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/multierror"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	runcStateRunning        = "running"
+	runcStartTimeout        = 30 * time.Second
+	runcPollInterval        = 500 * time.Millisecond
+	runcStatsInterval       = 30 * time.Second
+	runcConsoleTimeout      = 10 * time.Second
+	runcUnknownExitCode     = -1
+	runcHealthcheckRetry    = 3
+	runcHealthcheckInterval = 30 * time.Second
+)
+
+type runcState struct {
+	ID     string `json:"id"`
+	Pid    int    `json:"pid"`
+	Status string `json:"status"`
+}
+
+// runcStats is a subset of statistics reported by "runc events --stats".
+type runcStats struct {
+	Data struct {
+		CPU struct {
+			Usage struct {
+				Total  uint64   `json:"total"`
+				Percpu []uint64 `json:"percpu"`
+				Kernel uint64   `json:"kernel"`
+				User   uint64   `json:"user"`
+			} `json:"usage"`
+			Throttling struct {
+				Periods          uint64 `json:"periods"`
+				ThrottledPeriods uint64 `json:"throttledPeriods"`
+				ThrottledTime    uint64 `json:"throttledTime"`
+			} `json:"throttling"`
+		} `json:"cpu"`
+		Memory struct {
+			Usage struct {
+				Limit uint64 `json:"limit"`
+				Usage uint64 `json:"usage"`
+				Max   uint64 `json:"max"`
+			} `json:"usage"`
+		} `json:"memory"`
+		Blkio struct {
+			IoServiceBytesRecursive []runcBlkioEntry `json:"ioServiceBytesRecursive"`
+			IoServicedRecursive     []runcBlkioEntry `json:"ioServicedRecursive"`
+		} `json:"blkio"`
+		NetworkInterfaces []struct {
+			Name      string
+			RxBytes   uint64
+			RxPackets uint64
+			RxErrors  uint64
+			RxDropped uint64
+			TxBytes   uint64
+			TxPackets uint64
+			TxErrors  uint64
+			TxDropped uint64
+		} `json:"network_interfaces"`
+	} `json:"data"`
+}
+
+type runcBlkioEntry struct {
+	Op    string `json:"op"`
+	Value uint64 `json:"value"`
+}
+
+func newRuncContainerMetrics(stats runcStats) ContainerMetrics {
+	network := make(map[string]*pb.NetworkUsage)
+	for _, n := range stats.Data.NetworkInterfaces {
+		network[n.Name] = &pb.NetworkUsage{
+			TxBytes:   n.TxBytes,
+			RxBytes:   n.RxBytes,
+			TxPackets: n.TxPackets,
+			RxPackets: n.RxPackets,
+			TxErrors:  n.TxErrors,
+			RxErrors:  n.RxErrors,
+			TxDropped: n.TxDropped,
+			RxDropped: n.RxDropped,
+		}
+	}
+
+	blockIO := &pb.BlockIOUsage{}
+	for _, entry := range stats.Data.Blkio.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			blockIO.ReadBytes += entry.Value
+		case "write":
+			blockIO.WriteBytes += entry.Value
+		}
+	}
+	for _, entry := range stats.Data.Blkio.IoServicedRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			blockIO.ReadOps += entry.Value
+		case "write":
+			blockIO.WriteOps += entry.Value
+		}
+	}
+
+	cpu := stats.Data.CPU
+	mem := stats.Data.Memory.Usage
+
+	return ContainerMetrics{
+		cpu: &pb.CPUUsage{
+			Total:            cpu.Usage.Total,
+			PerCore:          cpu.Usage.Percpu,
+			KernelMode:       cpu.Usage.Kernel,
+			UserMode:         cpu.Usage.User,
+			ThrottledPeriods: cpu.Throttling.ThrottledPeriods,
+			ThrottledTime:    cpu.Throttling.ThrottledTime,
+			Periods:          cpu.Throttling.Periods,
+		},
+		mem: &pb.MemoryUsage{
+			MaxUsage: mem.Max,
+			Usage:    mem.Usage,
+			Limit:    mem.Limit,
+		},
+		blkio: blockIO,
+		net:   network,
+	}
+}
+
+// runcProcess tracks the exit of the container's main process.
+type runcProcess struct {
+	exited   chan struct{}
+	exitCode int
+}
+
+func newRuncProcess() *runcProcess {
+	return &runcProcess{
+		exited:   make(chan struct{}),
+		exitCode: runcUnknownExitCode,
+	}
+}
+
+func (m *runcProcess) exit(err error) {
+	switch err := err.(type) {
+	case nil:
+		m.exitCode = 0
+	case *exec.ExitError:
+		if status, ok := err.Sys().(syscall.WaitStatus); ok {
+			m.exitCode = status.ExitStatus()
+			if status.Signaled() {
+				m.exitCode = 128 + int(status.Signal())
+			}
+		}
+	}
+
+	close(m.exited)
+}
+
+type runcContainer struct {
+	ID          string
+	description Description
+	bundle      string
+	image       *runcImage
+	health      pb.TaskStatusReply_Health
+	metrics     ContainerMetrics
+	restarts    int
+	// Restarting is set when the container is being restarted by the
+	// Worker because of failed health checks.
+	restarting     bool
+	committedImage string
+}
+
+func (m *runcContainer) rootfs() string {
+	return filepath.Join(m.bundle, "rootfs")
+}
+
+// runcOverseer runs containers using an OCI runtime binary, runc by
+// default, without Docker daemon.
+//
+// Each container gets its own bundle with an overlay root filesystem on top
+// of the image unpacked into the local image store. Containers get a private
+// network namespace with the loopback interface only, unless they join the
+// network namespace of another container.
+// Neither GPU, nor volume and network plugins are supported.
+type runcOverseer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    RuncConfig
+	images *runcImageStore
+	// SharedVolumes keeps data of volumes shared between tasks of groups.
+	sharedVolumes *volume.SharedVolumes
+
+	mu         sync.Mutex
+	containers map[string]*runcContainer
+	statuses   map[string]chan ContainerStatus
+}
+
+func newRuncOverseer(ctx context.Context, cfg RuncConfig) (Overseer, error) {
+	if _, err := exec.LookPath(cfg.Binary); err != nil {
+		return nil, fmt.Errorf("failed to find OCI runtime binary: %v", err)
+	}
+
+	for _, dir := range []string{"state", "containers", "volumes", "tmp"} {
+		if err := os.MkdirAll(filepath.Join(cfg.Root, dir), 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	images, err := newRuncImageStore(filepath.Join(cfg.Root, "images"))
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := volume.NewDealStorage(filepath.Join(cfg.Root, "volumes"), volume.LocalQuotaLoop)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	ovs := &runcOverseer{
+		ctx:           ctx,
+		cancel:        cancel,
+		cfg:           cfg,
+		images:        images,
+		sharedVolumes: volume.NewSharedVolumes(storage),
+		containers:    map[string]*runcContainer{},
+		statuses:      map[string]chan ContainerStatus{},
+	}
+
+	go ovs.collectStats()
+
+	return ovs, nil
+}
+
+func (o *runcOverseer) command(args ...string) *exec.Cmd {
+	return exec.Command(o.cfg.Binary, append([]string{"--root", filepath.Join(o.cfg.Root, "state")}, args...)...)
+}
+
+func (o *runcOverseer) run(ctx context.Context, args ...string) ([]byte, error) {
+	cmd := o.command(args...)
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %v: %s", o.cfg.Binary, args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return stdout.Bytes(), nil
+	case <-ctx.Done():
+		cmd.Process.Kill()
+		return nil, ctx.Err()
+	}
+}
+
+func (o *runcOverseer) state(ctx context.Context, id string) (*runcState, error) {
+	output, err := o.run(ctx, "state", id)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &runcState{}
+	if err := json.Unmarshal(output, state); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (o *runcOverseer) isRunning(ctx context.Context, id string) bool {
+	state, err := o.state(ctx, id)
+	return err == nil && state.Status == runcStateRunning
+}
+
+func (o *runcOverseer) container(id string) (*runcContainer, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	c, ok := o.containers[id]
+	if !ok {
+		return nil, fmt.Errorf("no such container %s", id)
+	}
+
+	return c, nil
+}
+
+func (o *runcOverseer) Load(ctx context.Context, rd io.Reader) (imageLoadStatus, error) {
+	refs, err := o.images.Load(rd)
+	if err != nil {
+		log.G(o.ctx).Error("failed to load an image", zap.Error(err))
+		return imageLoadStatus{}, err
+	}
+
+	return imageLoadStatus{Status: "Loaded image: " + strings.Join(refs, ", ")}, nil
+}
+
+func (o *runcOverseer) Save(ctx context.Context, imageID string) (ImageInfo, io.ReadCloser, error) {
+	return o.images.Save(imageID)
+}
+
+func (o *runcOverseer) Spool(ctx context.Context, d Description) error {
+	if _, _, err := o.images.Get(d.Reference.String()); err != nil {
+		return fmt.Errorf("%v: pulling images from registries is not supported by the runc runtime, push the image to the worker first", err)
+	}
+
+	return nil
+}
+
+func (o *runcOverseer) Start(ctx context.Context, description Description) (chan ContainerStatus, ContainerInfo, error) {
+	if err := o.checkSupported(description); err != nil {
+		return nil, ContainerInfo{}, err
+	}
+
+	image, rootfs, err := o.images.Get(description.Reference.String())
+	if err != nil {
+		return nil, ContainerInfo{}, err
+	}
+
+	c := &runcContainer{
+		ID:          uuid.New(),
+		description: description,
+		image:       image,
+	}
+	c.bundle = filepath.Join(o.cfg.Root, "containers", c.ID)
+	if description.Healthcheck != nil {
+		c.health = pb.TaskStatusReply_HEALTH_STARTING
+	}
+
+	log.S(ctx).Infof("start container %s with application, reference %s", c.ID, description.Reference.String())
+
+	if err := o.createBundle(ctx, c, rootfs); err != nil {
+		o.removeBundle(ctx, c)
+		return nil, ContainerInfo{}, err
+	}
+
+	status := make(chan ContainerStatus)
+	o.mu.Lock()
+	o.containers[c.ID] = c
+	o.statuses[c.ID] = status
+	o.mu.Unlock()
+
+	process, err := o.startProcess(c)
+	if err != nil {
+		o.mu.Lock()
+		delete(o.containers, c.ID)
+		delete(o.statuses, c.ID)
+		o.mu.Unlock()
+
+		o.removeBundle(ctx, c)
+		return nil, ContainerInfo{}, err
+	}
+
+	go o.supervise(c, process)
+
+	info := ContainerInfo{
+		status:       pb.TaskStatusReply_RUNNING,
+		ID:           c.ID,
+		CgroupParent: description.CGroupParent,
+	}
+
+	return status, info, nil
+}
+
+func (o *runcOverseer) checkSupported(d Description) error {
+	if d.IsGPURequired() {
+		return fmt.Errorf("GPU is not supported by the runc runtime")
+	}
+	if len(d.volumes) > 0 {
+		return fmt.Errorf("volumes are not supported by the runc runtime")
+	}
+	if len(d.networks) > 0 {
+		return fmt.Errorf("networks are not supported by the runc runtime")
+	}
+	if len(d.expose) > 0 {
+		return fmt.Errorf("exposing ports is not supported by the runc runtime")
+	}
+	for _, m := range d.mounts {
+		if _, ok := d.sharedVolumes[m.Source]; !ok {
+			return fmt.Errorf("mount of %s is not supported by the runc runtime", m.Source)
+		}
+	}
+
+	return nil
+}
+
+func (o *runcOverseer) createBundle(ctx context.Context, c *runcContainer, lower string) error {
+	for _, dir := range []string{"rootfs", "upper", "work"} {
+		if err := os.MkdirAll(filepath.Join(c.bundle, dir), 0700); err != nil {
+			return err
+		}
+	}
+
+	if err := mountOverlay(lower, filepath.Join(c.bundle, "upper"), filepath.Join(c.bundle, "work"), c.rootfs()); err != nil {
+		return fmt.Errorf("failed to mount container root filesystem: %v", err)
+	}
+
+	spec, err := o.newSpec(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(c.bundle, "config.json"), data, 0600)
+}
+
+func (o *runcOverseer) newSpec(ctx context.Context, c *runcContainer) (*specs.Spec, error) {
+	d := c.description
+
+	args, err := runcProcessArgs(d, c.image)
+	if err != nil {
+		return nil, err
+	}
+
+	userSpec := d.User
+	if len(userSpec) == 0 {
+		userSpec = c.image.User
+	}
+	user, err := resolveRuncUser(c.rootfs(), userSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	cwd := d.WorkingDir
+	if len(cwd) == 0 {
+		cwd = c.image.WorkingDir
+	}
+	if len(cwd) == 0 {
+		cwd = "/"
+	}
+
+	hostname := d.Hostname
+	if len(hostname) == 0 {
+		hostname = c.ID[:runcHostnameMaxLength]
+	}
+
+	var netns string
+	if strings.HasPrefix(d.networkMode, "container:") {
+		state, err := o.state(ctx, strings.TrimPrefix(d.networkMode, "container:"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to join network namespace: %v", err)
+		}
+
+		netns = fmt.Sprintf("/proc/%d/ns/net", state.Pid)
+	}
+
+	mounts := defaultRuncMounts()
+	for _, m := range d.mounts {
+		source, err := o.sharedVolumes.Create(d.DealId, d.sharedVolumes[m.Source], d.StorageQuota())
+		if err != nil {
+			return nil, err
+		}
+
+		options := []string{"rbind", "rw"}
+		if m.ReadOnly() {
+			options[1] = "ro"
+		}
+
+		mounts = append(mounts, specs.Mount{
+			Destination: m.Target,
+			Type:        "bind",
+			Source:      source,
+			Options:     options,
+		})
+	}
+
+	resources := d.Resources.ToCgroupResources()
+	resources.Devices = []specs.LinuxDeviceCgroup{{Allow: false, Access: "rwm"}}
+
+	var cgroupsPath string
+	if len(d.CGroupParent) > 0 {
+		cgroupsPath = filepath.Join("/", d.CGroupParent, c.ID)
+	}
+
+	return &specs.Spec{
+		Version: specs.Version,
+		Process: &specs.Process{
+			User: user,
+			Args: args,
+			Env:  runcProcessEnv(c.image.Env, d.FormatEnv(), []string{"HOSTNAME=" + hostname}),
+			Cwd:  cwd,
+			Capabilities: &specs.LinuxCapabilities{
+				Bounding:    defaultRuncCapabilities,
+				Effective:   defaultRuncCapabilities,
+				Inheritable: defaultRuncCapabilities,
+				Permitted:   defaultRuncCapabilities,
+			},
+			Rlimits: []specs.POSIXRlimit{{Type: "RLIMIT_NOFILE", Hard: defaultRuncOpenFiles, Soft: defaultRuncOpenFiles}},
+		},
+		Root:     &specs.Root{Path: c.rootfs()},
+		Hostname: hostname,
+		Mounts:   mounts,
+		Linux: &specs.Linux{
+			Resources:   resources,
+			CgroupsPath: cgroupsPath,
+			Namespaces:  runcNamespaces(netns),
+			MaskedPaths: []string{
+				"/proc/kcore",
+				"/proc/latency_stats",
+				"/proc/timer_list",
+				"/proc/timer_stats",
+				"/proc/sched_debug",
+				"/sys/firmware",
+				"/proc/scsi",
+			},
+			ReadonlyPaths: []string{
+				"/proc/asound",
+				"/proc/bus",
+				"/proc/fs",
+				"/proc/irq",
+				"/proc/sys",
+				"/proc/sysrq-trigger",
+			},
+		},
+	}, nil
+}
+
+// startProcess starts the container's main process and waits until it is
+// running.
+//
+// Stdout and stderr of the container are redirected into files, allowing
+// the container to outlive the Worker.
+func (o *runcOverseer) startProcess(c *runcContainer) (*runcProcess, error) {
+	stdout, err := os.OpenFile(filepath.Join(c.bundle, "stdout.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer stdout.Close()
+
+	stderr, err := os.OpenFile(filepath.Join(c.bundle, "stderr.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer stderr.Close()
+
+	// The previous instance of the container should be deleted before
+	// restarting.
+	o.run(o.ctx, "delete", "--force", c.ID)
+
+	cmd := o.command("run", "--bundle", c.bundle, c.ID)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	process := newRuncProcess()
+	go func() {
+		process.exit(cmd.Wait())
+	}()
+
+	ctx, cancel := context.WithTimeout(o.ctx, runcStartTimeout)
+	defer cancel()
+
+	timer := time.NewTicker(runcPollInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-process.exited:
+			// Quickly finished containers are not an error, the exit is
+			// reported asynchronously like for others.
+			return process, nil
+		case <-timer.C:
+			if o.isRunning(ctx, c.ID) {
+				o.startHealthcheck(c, process)
+				return process, nil
+			}
+		case <-ctx.Done():
+			cmd.Process.Kill()
+			return nil, fmt.Errorf("container %s has not been started: %v", c.ID, ctx.Err())
+		}
+	}
+}
+
+// pollProcess tracks containers started by the previous Worker instance,
+// whose exit codes can't be obtained.
+func (o *runcOverseer) pollProcess(id string) *runcProcess {
+	process := newRuncProcess()
+
+	go func() {
+		timer := time.NewTicker(runcPollInterval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-timer.C:
+				if !o.isRunning(o.ctx, id) {
+					process.exit(fmt.Errorf("container %s is not running", id))
+					return
+				}
+			case <-o.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return process
+}
+
+// supervise waits for the container exit, restarting it if required by its
+// restart policy or health checks.
+func (o *runcOverseer) supervise(c *runcContainer, process *runcProcess) {
+	for {
+		select {
+		case <-process.exited:
+		case <-o.ctx.Done():
+			// Containers survive the Worker restart.
+			return
+		}
+
+		log.G(o.ctx).Info("container has died", zap.String("id", c.ID), zap.Int("exitCode", process.exitCode))
+
+		o.mu.Lock()
+		// Containers stopped explicitly have no status channel.
+		_, running := o.statuses[c.ID]
+		restart := running && (c.restarting || runcShouldRestart(c.description.RestartPolicy, process.exitCode, c.restarts))
+		if restart {
+			c.restarts++
+			c.restarting = false
+			if c.description.Healthcheck != nil {
+				c.health = pb.TaskStatusReply_HEALTH_STARTING
+			}
+		}
+		o.mu.Unlock()
+
+		containerStatus := ContainerStatus{
+			Status:   pb.TaskStatusReply_BROKEN,
+			ExitCode: process.exitCode,
+		}
+
+		if restart {
+			log.G(o.ctx).Info("container is being restarted", zap.String("id", c.ID))
+
+			restarted, err := o.startProcess(c)
+			if err == nil {
+				o.mu.Lock()
+				s, ok := o.statuses[c.ID]
+				o.mu.Unlock()
+
+				if ok {
+					containerStatus.Status = pb.TaskStatusReply_RUNNING
+					containerStatus.Restarted = true
+					s <- containerStatus
+				}
+
+				process = restarted
+				continue
+			}
+
+			log.G(o.ctx).Error("failed to restart container", zap.String("id", c.ID), zap.Error(err))
+		}
+
+		o.mu.Lock()
+		s, ok := o.statuses[c.ID]
+		delete(o.statuses, c.ID)
+		o.mu.Unlock()
+
+		if ok {
+			s <- containerStatus
+			close(s)
+		}
+
+		if c.description.CommitOnStop {
+			if err := o.commit(c); err != nil {
+				log.G(o.ctx).Error("failed to commit container", zap.String("id", c.ID), zap.Error(err))
+			}
+		}
+
+		return
+	}
+}
+
+func (o *runcOverseer) startHealthcheck(c *runcContainer, process *runcProcess) {
+	if c.description.Healthcheck == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(o.ctx)
+	go func() {
+		select {
+		case <-process.exited:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	go o.healthcheck(ctx, c)
+}
+
+// healthcheck periodically executes the health check command inside the
+// container, following Docker semantics.
+func (o *runcOverseer) healthcheck(ctx context.Context, c *runcContainer) {
+	config := c.description.Healthcheck.Unwrap()
+	args, err := runcHealthcheckArgs(config.Test)
+	if err != nil {
+		log.G(ctx).Warn("invalid health check", zap.String("id", c.ID), zap.Error(err))
+		return
+	}
+
+	interval := config.Interval
+	if interval == 0 {
+		interval = runcHealthcheckInterval
+	}
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = runcHealthcheckInterval
+	}
+	retries := config.Retries
+	if retries == 0 {
+		retries = runcHealthcheckRetry
+	}
+	startedAt := time.Now()
+
+	timer := time.NewTicker(interval)
+	defer timer.Stop()
+
+	failures := 0
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		_, err := o.run(checkCtx, append([]string{"exec", c.ID}, args...)...)
+		cancel()
+
+		health := pb.TaskStatusReply_HEALTHY
+		if err != nil {
+			// Failures during the start period are not counted.
+			if time.Since(startedAt) >= config.StartPeriod {
+				failures++
+			}
+			if failures < retries {
+				continue
+			}
+			health = pb.TaskStatusReply_UNHEALTHY
+		} else {
+			failures = 0
+		}
+
+		o.mu.Lock()
+		previous := c.health
+		c.health = health
+		o.mu.Unlock()
+
+		if health == previous {
+			continue
+		}
+
+		log.G(ctx).Info("container health status has been changed", zap.String("id", c.ID), zap.Stringer("health", health))
+
+		if health == pb.TaskStatusReply_UNHEALTHY {
+			o.onUnhealthy(ctx, c)
+			return
+		}
+	}
+}
+
+func (o *runcOverseer) onUnhealthy(ctx context.Context, c *runcContainer) {
+	switch c.description.Healthcheck.GetOnUnhealthy() {
+	case pb.ContainerHealthcheck_RESTART:
+		log.G(ctx).Info("restarting unhealthy container", zap.String("id", c.ID))
+
+		o.mu.Lock()
+		c.restarting = true
+		o.mu.Unlock()
+
+		// The container will be restarted by its supervisor.
+		if err := o.kill(ctx, c.ID); err != nil {
+			log.G(ctx).Error("failed to restart unhealthy container", zap.String("id", c.ID), zap.Error(err))
+
+			o.mu.Lock()
+			c.restarting = false
+			o.mu.Unlock()
+		}
+	case pb.ContainerHealthcheck_STOP:
+		log.G(ctx).Info("stopping unhealthy container", zap.String("id", c.ID))
+		// The task will be marked as broken by the supervisor.
+		if err := o.kill(ctx, c.ID); err != nil {
+			log.G(ctx).Error("failed to stop unhealthy container", zap.String("id", c.ID), zap.Error(err))
+		}
+	}
+}
+
+func (o *runcOverseer) kill(ctx context.Context, id string) error {
+	if !o.isRunning(ctx, id) {
+		return nil
+	}
+
+	_, err := o.run(ctx, "kill", id, "KILL")
+	return err
+}
+
+func (o *runcOverseer) Attach(ctx context.Context, containerID string, description Description) (chan ContainerStatus, error) {
+	if !o.isRunning(ctx, containerID) {
+		return nil, fmt.Errorf("container %s is not running", containerID)
+	}
+
+	c := &runcContainer{
+		ID:          containerID,
+		description: description,
+		bundle:      filepath.Join(o.cfg.Root, "containers", containerID),
+	}
+
+	image, _, err := o.images.Get(description.Reference.String())
+	if err != nil {
+		return nil, err
+	}
+	c.image = image
+
+	process := o.pollProcess(containerID)
+	if description.Healthcheck != nil {
+		c.health = pb.TaskStatusReply_HEALTH_STARTING
+		o.startHealthcheck(c, process)
+	}
+
+	status := make(chan ContainerStatus)
+	o.mu.Lock()
+	o.containers[c.ID] = c
+	o.statuses[c.ID] = status
+	o.mu.Unlock()
+
+	go o.supervise(c, process)
+
+	return status, nil
+}
+
+func (o *runcOverseer) Exec(ctx context.Context, id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (ExecConnection, error) {
+	if _, err := o.container(id); err != nil {
+		return nil, err
+	}
+
+	args := []string{"exec", "--user", "0:0"}
+	for _, value := range env {
+		args = append(args, "--env", value)
+	}
+
+	if isTty {
+		return o.execTTY(ctx, id, args, cmd, wCh)
+	}
+
+	command := o.command(append(append(args, id), cmd...)...)
+	stdin, err := command.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := command.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := command.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := command.Start(); err != nil {
+		return nil, err
+	}
+
+	return &runcExecConnection{cmd: command, stdin: stdin, stdout: stdout, stderr: stderr}, nil
+}
+
+// execTTY executes the command attached to a pseudoterminal, whose master
+// end is received from runc via the console socket.
+func (o *runcOverseer) execTTY(ctx context.Context, id string, args []string, cmd []string, wCh <-chan ssh.Window) (ExecConnection, error) {
+	dir, err := ioutil.TempDir(filepath.Join(o.cfg.Root, "tmp"), "console")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	socketPath := filepath.Join(dir, "console.sock")
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: socketPath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	defer listener.Close()
+
+	type consoleResult struct {
+		console *os.File
+		err     error
+	}
+	consoleCh := make(chan consoleResult, 1)
+	go func() {
+		console, err := receiveConsole(listener)
+		consoleCh <- consoleResult{console: console, err: err}
+	}()
+
+	args = append(args, "--tty", "--detach", "--console-socket", socketPath, id)
+	if _, err := o.run(ctx, append(args, cmd...)...); err != nil {
+		return nil, err
+	}
+
+	var result consoleResult
+	select {
+	case result = <-consoleCh:
+	case <-time.After(runcConsoleTimeout):
+		return nil, fmt.Errorf("console for container %s has not been received", id)
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	conn := &runcConsoleConnection{
+		console: result.console,
+		done:    make(chan struct{}),
+	}
+	go conn.resize(ctx, wCh)
+
+	return conn, nil
+}
+
+func receiveConsole(listener *net.UnixListener) (*os.File, error) {
+	conn, err := listener.AcceptUnix()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	name := make([]byte, 4096)
+	oob := make([]byte, unix.CmsgSpace(4))
+	n, oobn, _, _, err := conn.ReadMsgUnix(name, oob)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) != 1 {
+		return nil, fmt.Errorf("expected single control message, got %d", len(messages))
+	}
+
+	fds, err := unix.ParseUnixRights(&messages[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(fds) != 1 {
+		return nil, fmt.Errorf("expected single file descriptor, got %d", len(fds))
+	}
+
+	return os.NewFile(uintptr(fds[0]), string(name[:n])), nil
+}
+
+// runcExecConnection is a connection to the process executed without TTY.
+type runcExecConnection struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.Reader
+	stderr io.Reader
+}
+
+func (m *runcExecConnection) Write(p []byte) (int, error) {
+	return m.stdin.Write(p)
+}
+
+func (m *runcExecConnection) CloseWrite() error {
+	return m.stdin.Close()
+}
+
+func (m *runcExecConnection) CopyOutput(stdout, stderr io.Writer) error {
+	errs := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(stdout, m.stdout)
+		errs <- err
+	}()
+	go func() {
+		_, err := io.Copy(stderr, m.stderr)
+		errs <- err
+	}()
+
+	result := multierror.NewMultiError()
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	if err := m.cmd.Wait(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (m *runcExecConnection) Close() error {
+	if m.cmd.ProcessState == nil {
+		m.cmd.Process.Kill()
+	}
+
+	return nil
+}
+
+// runcConsoleConnection is a connection to the process executed with TTY.
+type runcConsoleConnection struct {
+	console *os.File
+	once    sync.Once
+	done    chan struct{}
+}
+
+func (m *runcConsoleConnection) Write(p []byte) (int, error) {
+	return m.console.Write(p)
+}
+
+func (m *runcConsoleConnection) CloseWrite() error {
+	// Pseudoterminals can not be half-closed.
+	return nil
+}
+
+func (m *runcConsoleConnection) CopyOutput(stdout, stderr io.Writer) error {
+	_, err := io.Copy(stdout, m.console)
+	// Reading from the master end fails with EIO after the process exits.
+	if pathErr, ok := err.(*os.PathError); ok && pathErr.Err == syscall.EIO {
+		return nil
+	}
+
+	return err
+}
+
+func (m *runcConsoleConnection) Close() error {
+	m.once.Do(func() {
+		close(m.done)
+	})
+
+	return m.console.Close()
+}
+
+func (m *runcConsoleConnection) resize(ctx context.Context, wCh <-chan ssh.Window) {
+	for {
+		select {
+		case w, ok := <-wCh:
+			if !ok {
+				return
+			}
+
+			size := &unix.Winsize{Row: uint16(w.Height), Col: uint16(w.Width)}
+			if err := unix.IoctlSetWinsize(int(m.console.Fd()), unix.TIOCSWINSZ, size); err != nil {
+				log.G(ctx).Warn("failed to resize tty", zap.Error(err))
+			}
+		case <-m.done:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (o *runcOverseer) Stop(ctx context.Context, containerID string) error {
+	o.mu.Lock()
+	_, ok := o.containers[containerID]
+	status, sok := o.statuses[containerID]
+	delete(o.statuses, containerID)
+	o.mu.Unlock()
+
+	if sok {
+		status <- ContainerStatus{Status: pb.TaskStatusReply_FINISHED}
+		close(status)
+	}
+
+	if !ok {
+		return fmt.Errorf("no such container %s", containerID)
+	}
+
+	return o.kill(ctx, containerID)
+}
+
+func (o *runcOverseer) Preempt(ctx context.Context, containerID string, notice PreemptionNotice) error {
+	c, err := o.container(containerID)
+	if err != nil {
+		return err
+	}
+
+	preemption := c.description.Preemption
+	result := multierror.NewMultiError()
+
+	data, err := json.Marshal(notice)
+	if err != nil {
+		return err
+	}
+
+	// The notice must be in place by the time the task handles the signal.
+	if script := preemption.Script(data); script != "" {
+		if _, err := o.run(ctx, "exec", containerID, "sh", "-c", script); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if preemption.GetSignal() != "" {
+		if _, err := o.run(ctx, "kill", containerID, preemption.GetSignal()); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (o *runcOverseer) OnDealFinish(ctx context.Context, containerID string) error {
+	o.mu.Lock()
+	c, ok := o.containers[containerID]
+	delete(o.containers, containerID)
+	status, sok := o.statuses[containerID]
+	delete(o.statuses, containerID)
+	o.mu.Unlock()
+
+	if sok {
+		close(status)
+	}
+	if !ok {
+		return fmt.Errorf("unknown container %s", containerID)
+	}
+
+	result := multierror.NewMultiError()
+	if err := o.kill(ctx, containerID); err != nil {
+		result = multierror.Append(result, err)
+	}
+	if c.description.CommitOnStop && len(c.committedImage) == 0 {
+		if err := o.commit(c); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	// Unlike the bundle, the committed image is kept, so the deal's consumer
+	// is able to pull it after the deal is closed.
+	if err := o.removeBundle(ctx, c); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (o *runcOverseer) RemoveImage(ctx context.Context, ref string) error {
+	return o.images.Remove(ref)
+}
+
+// commit stores the container's root filesystem as a new image, tagged the
+// same way as Docker containers are.
+func (o *runcOverseer) commit(c *runcContainer) error {
+	tag := fmt.Sprintf("%s_%s", c.description.DealId, c.description.TaskId)
+	ref, err := reference.WithTag(reference.TrimNamed(c.description.Reference), tag)
+	if err != nil {
+		return err
+	}
+
+	image := *c.image
+	image.Reference = ref.String()
+	if err := o.images.Commit(&image, c.rootfs()); err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	c.committedImage = image.Reference
+	o.mu.Unlock()
+
+	log.G(o.ctx).Info("committed container", zap.String("id", c.ID), zap.String("image", image.Reference))
+
+	return nil
+}
+
+func (o *runcOverseer) removeBundle(ctx context.Context, c *runcContainer) error {
+	result := multierror.NewMultiError()
+
+	if _, err := o.run(ctx, "delete", "--force", c.ID); err != nil {
+		log.G(ctx).Debug("failed to delete container", zap.String("id", c.ID), zap.Error(err))
+	}
+	if err := unmountOverlay(c.rootfs()); err != nil {
+		result = multierror.Append(result, err)
+	}
+	if err := os.RemoveAll(c.bundle); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	o.mu.Lock()
+	used := map[string]bool{}
+	for _, other := range o.containers {
+		for _, name := range other.description.sharedVolumes {
+			used[name] = true
+		}
+	}
+	o.mu.Unlock()
+
+	// Shared volumes are removed along with the last container using them.
+	for _, name := range c.description.sharedVolumes {
+		if !used[name] {
+			if err := o.sharedVolumes.Remove(c.description.DealId, name); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (o *runcOverseer) Info(ctx context.Context) (map[string]ContainerMetrics, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	info := make(map[string]ContainerMetrics, len(o.containers))
+	for id, c := range o.containers {
+		metrics := c.metrics
+		metrics.health = c.health
+		info[id] = metrics
+	}
+
+	return info, nil
+}
+
+func (o *runcOverseer) collectStats() {
+	timer := time.NewTicker(runcStatsInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			o.mu.Lock()
+			ids := make([]string, 0, len(o.containers))
+			for id := range o.containers {
+				ids = append(ids, id)
+			}
+			o.mu.Unlock()
+
+			for _, id := range ids {
+				if !o.isRunning(o.ctx, id) {
+					continue
+				}
+
+				output, err := o.run(o.ctx, "events", "--stats", id)
+				if err != nil {
+					log.G(o.ctx).Warn("failed to get Stats", zap.String("id", id), zap.Error(err))
+					continue
+				}
+
+				stats := runcStats{}
+				if err := json.Unmarshal(output, &stats); err != nil {
+					log.G(o.ctx).Warn("failed to decode container Stats", zap.String("id", id), zap.Error(err))
+					continue
+				}
+
+				o.mu.Lock()
+				if c, ok := o.containers[id]; ok {
+					c.metrics = newRuncContainerMetrics(stats)
+				}
+				o.mu.Unlock()
+			}
+		case <-o.ctx.Done():
+			return
+		}
+	}
+}
+
+func (o *runcOverseer) Logs(ctx context.Context, id string, opts LogOptions) (io.ReadCloser, error) {
+	c, err := o.container(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tail := -1
+	if len(opts.Tail) > 0 && opts.Tail != "all" {
+		if tail, err = strconv.Atoi(opts.Tail); err != nil {
+			return nil, fmt.Errorf("invalid tail value %s: %v", opts.Tail, err)
+		}
+	}
+
+	type logStream struct {
+		path   string
+		stream stdcopy.StdType
+		offset int64
+	}
+	var streams []logStream
+	if opts.ShowStdout {
+		streams = append(streams, logStream{path: filepath.Join(c.bundle, "stdout.log"), stream: stdcopy.Stdout, offset: opts.StdoutOffset})
+	}
+	if opts.ShowStderr {
+		streams = append(streams, logStream{path: filepath.Join(c.bundle, "stderr.log"), stream: stdcopy.Stderr, offset: opts.StderrOffset})
+	}
+
+	rd, wr := io.Pipe()
+	go func() {
+		errs := make(chan error, len(streams))
+		for _, s := range streams {
+			go func(s logStream) {
+				errs <- o.copyLog(ctx, id, s.path, s.offset, tail, opts.Follow, stdcopy.NewStdWriter(wr, s.stream))
+			}(s)
+		}
+
+		result := multierror.NewMultiError()
+		for range streams {
+			if err := <-errs; err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+
+		wr.CloseWithError(result.ErrorOrNil())
+	}()
+
+	return rd, nil
+}
+
+// copyLog copies the log file starting from the given offset, optionally
+// limited to the given number of last lines, and following it until the
+// container exits.
+func (o *runcOverseer) copyLog(ctx context.Context, id string, path string, offset int64, tail int, follow bool, wr io.Writer) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	if tail >= 0 {
+		tailOffset, err := tailOffset(file, tail)
+		if err != nil {
+			return err
+		}
+		if tailOffset > offset {
+			offset = tailOffset
+		}
+	}
+	if offset > 0 {
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if _, err := io.Copy(wr, file); err != nil {
+			return err
+		}
+
+		if !follow {
+			return nil
+		}
+
+		if !o.isRunning(ctx, id) {
+			// Copy the remaining output written before the exit.
+			_, err := io.Copy(wr, file)
+			return err
+		}
+
+		select {
+		case <-time.After(runcPollInterval):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// tailOffset returns the offset of the given number of last lines in the
+// file.
+func tailOffset(file *os.File, lines int) (int64, error) {
+	stat, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	if lines == 0 {
+		return stat.Size(), nil
+	}
+
+	offset := stat.Size()
+	buf := make([]byte, 32*1024)
+	found := 0
+	for offset > 0 {
+		size := int64(len(buf))
+		if offset < size {
+			size = offset
+		}
+		offset -= size
+
+		if _, err := file.ReadAt(buf[:size], offset); err != nil && err != io.EOF {
+			return 0, err
+		}
+
+		for i := size - 1; i >= 0; i-- {
+			if buf[i] != '\n' {
+				continue
+			}
+			// The trailing newline does not start a new line.
+			if offset+i == stat.Size()-1 {
+				continue
+			}
+			found++
+			if found == lines {
+				return offset + i + 1, nil
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+func (o *runcOverseer) Close() error {
+	o.cancel()
+	return nil
+}
+
+func mountOverlay(lower, upper, work, target string) error {
+	options := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", lower, upper, work)
+	return unix.Mount("overlay", target, "overlay", 0, options)
+}
+
+func unmountOverlay(target string) error {
+	if err := unix.Unmount(target, unix.MNT_DETACH); err != nil && err != unix.EINVAL && err != unix.ENOENT {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package worker
+
+import (
+	"context"
+	"errors"
+)
+
+func newRuncOverseer(ctx context.Context, cfg RuncConfig) (Overseer, error) {
+	return nil, errors.New("runc runtime is supported only on Linux")
+}
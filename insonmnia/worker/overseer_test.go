@@ -13,6 +13,7 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
+	pb "github.com/sonm-io/core/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -155,3 +156,68 @@ func TestExpose(t *testing.T) {
 		"22/tcp":    {{HostIP: "", HostPort: ""}},
 	}, portBinding)
 }
+
+func TestContainerMetricsMarshal(t *testing.T) {
+	metrics := newDockerContainerMetrics(types.StatsJSON{Stats: types.Stats{
+		CPUStats: types.CPUStats{
+			CPUUsage: types.CPUUsage{
+				TotalUsage:  300,
+				PercpuUsage: []uint64{100, 200},
+			},
+			ThrottlingData: types.ThrottlingData{Periods: 10, ThrottledPeriods: 2},
+		},
+		MemoryStats: types.MemoryStats{Usage: 512, MaxUsage: 1024, Limit: 2048},
+		BlkioStats: types.BlkioStats{
+			IoServiceBytesRecursive: []types.BlkioStatEntry{
+				{Major: 8, Op: "Read", Value: 10},
+				{Major: 8, Op: "Write", Value: 20},
+				{Major: 9, Op: "Read", Value: 5},
+				{Major: 9, Op: "Total", Value: 25},
+			},
+			IoServicedRecursive: []types.BlkioStatEntry{
+				{Major: 8, Op: "Read", Value: 1},
+				{Major: 8, Op: "Write", Value: 2},
+			},
+		},
+	}})
+	metrics.gpu = map[string]*pb.GPUUsage{
+		"card0": {Utilization: 42, MemoryUsed: 1, MemoryTotal: 2},
+	}
+
+	usage := metrics.Marshal()
+	assert.Equal(t, uint64(300), usage.GetCpu().GetTotal())
+	assert.Equal(t, []uint64{100, 200}, usage.GetCpu().GetPerCore())
+	assert.Equal(t, uint64(2), usage.GetCpu().GetThrottledPeriods())
+	assert.Equal(t, uint64(512), usage.GetMemory().GetUsage())
+	assert.Equal(t, uint64(2048), usage.GetMemory().GetLimit())
+	assert.Equal(t, &pb.BlockIOUsage{ReadBytes: 15, WriteBytes: 20, ReadOps: 1, WriteOps: 2}, usage.GetBlockIO())
+	assert.Equal(t, uint64(42), usage.GetGpu()["card0"].GetUtilization())
+}
+
+func TestOvsNotifyRestarted(t *testing.T) {
+	ovs := &overseer{
+		containers: map[string]*containerDescriptor{"c1": {ID: "c1"}},
+		statuses:   map[string]chan ContainerStatus{},
+	}
+	status := make(chan ContainerStatus)
+	ovs.statuses["c1"] = status
+
+	done := make(chan struct{})
+	go func() {
+		ovs.notifyRestarted("c1", ContainerStatus{Restarted: true})
+		close(done)
+	}()
+	assert.Equal(t, ContainerStatus{Status: pb.TaskStatusReply_RUNNING, Restarted: true}, <-status)
+	<-done
+
+	// The channel is put back once the status is delivered.
+	assert.Equal(t, status, ovs.statuses["c1"])
+
+	// Stopping the container while it is being restarted finishes it.
+	ovs.containers["c1"].stopped = true
+	go ovs.notifyRestarted("c1", ContainerStatus{Restarted: true})
+	assert.Equal(t, pb.TaskStatusReply_RUNNING, (<-status).Status)
+	assert.Equal(t, pb.TaskStatusReply_FINISHED, (<-status).Status)
+	_, ok := <-status
+	assert.False(t, ok)
+}
@@ -3,6 +3,7 @@ package plugin
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 
 	"github.com/docker/docker/api/types/container"
@@ -41,6 +42,11 @@ type GPUProvider interface {
 type VolumeProvider interface {
 	// ID returns a unique identifier that will be used as a new volume name.
 	ID() string
+	// DealID returns ID of the deal, which deal-scoped volumes are bound to.
+	DealID() string
+	// StorageQuota returns the size in bytes deal-scoped volumes are limited
+	// to.
+	StorageQuota() uint64
 	// Volumes returns volumes specified for configuring.
 	Volumes() map[string]*sonm.Volume
 	// Mounts returns all mounts whose source equals to the volume name
@@ -55,6 +61,7 @@ type NetworkProvider interface {
 // Repository describes a place where all SONM plugins for Docker live.
 type Repository struct {
 	volumes       map[string]volume.VolumeDriver
+	sharedVolumes *volume.SharedVolumes
 	gpuTuners     map[sonm.GPUVendorType]gpu.Tuner
 	networkTuners map[string]minet.Tuner
 }
@@ -71,11 +78,13 @@ func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
 
 	log.G(ctx).Info("initializing SONM plugins")
 
+	var storage *volume.DealStorage
 	for ty, options := range cfg.Volumes.Drivers {
 		log.G(ctx).Debug("initializing Volume plugin", zap.String("type", ty))
 
 		driver, err := volume.NewVolumeDriver(ctx, ty,
 			volume.WithPluginSocketDir(cfg.SocketDir),
+			volume.WithRootDir(cfg.Volumes.Root),
 			volume.WithOptions(options),
 		)
 
@@ -84,8 +93,22 @@ func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
 		}
 
 		r.volumes[ty] = driver
+		if local, ok := volume.LocalDealStorage(driver); ok {
+			storage = local
+		}
 	}
 
+	// Shared volumes live in the local volumes' storage to be limited by the
+	// same quota.
+	if storage == nil {
+		var err error
+		storage, err = volume.NewDealStorage(filepath.Join(cfg.Volumes.Root, "shared"), volume.LocalQuotaLoop)
+		if err != nil {
+			return nil, fmt.Errorf("cannot initialize shared volumes: %v", err)
+		}
+	}
+	r.sharedVolumes = volume.NewSharedVolumes(storage)
+
 	for vendor, options := range cfg.GPUs {
 		log.G(ctx).Debug("initializing GPU plugin",
 			zap.String("vendor", vendor), zap.Any("options", options))
@@ -157,6 +180,12 @@ func (r *Repository) Tune(ctx context.Context, provider Provider, hostCfg *conta
 	return &cleanup, nil
 }
 
+// SharedVolumes returns volumes shared between tasks of task groups, nil if
+// the repository is not configured.
+func (r *Repository) SharedVolumes() *volume.SharedVolumes {
+	return r.sharedVolumes
+}
+
 // HasGPU returns true if the Repository has at least one GPU plugin loaded
 func (r *Repository) HasGPU() bool {
 	return len(r.gpuTuners) > 0
@@ -199,6 +228,28 @@ func (r *Repository) TuneGPU(provider GPUProvider, cfg *container.HostConfig) er
 	return nil
 }
 
+// GPUMetrics collects usage of GPU devices with the given IDs from all
+// loaded GPU plugins.
+func (r *Repository) GPUMetrics(ids []gpu.GPUID) (map[string]*sonm.GPUUsage, error) {
+	metrics := map[string]*sonm.GPUUsage{}
+	if len(ids) == 0 {
+		return metrics, nil
+	}
+
+	for ty, tuner := range r.gpuTuners {
+		usage, err := tuner.Metrics(ids)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", ty.String(), err)
+		}
+
+		for id, u := range usage {
+			metrics[string(id)] = u
+		}
+	}
+
+	return metrics, nil
+}
+
 // TuneVolumes creates volumes required for the given provider with further
 // host config tuning with mount settings.
 func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, cfg *container.HostConfig) (Cleanup, error) {
@@ -219,7 +270,15 @@ func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, c
 
 		id := fmt.Sprintf("%s/%s", provider.ID(), volumeName)
 
-		v, err := driver.CreateVolume(id, options.Options)
+		var v volume.Volume
+		var err error
+		dealDriver, isDealScoped := driver.(volume.DealVolumeDriver)
+		if isDealScoped {
+			id = fmt.Sprintf("%s/%s", provider.DealID(), volumeName)
+			v, err = dealDriver.CreateDealVolume(provider.DealID(), volumeName, provider.StorageQuota(), options.Options)
+		} else {
+			v, err = driver.CreateVolume(id, options.Options)
+		}
 		if err != nil {
 			cleanup.Close()
 			return nil, err
@@ -243,12 +302,33 @@ func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, c
 			}
 		}
 
-		cleanup.Add(&volumeCleanup{driver: driver, id: id})
+		// Deal-scoped volumes must keep data until the deal is finished.
+		if !isDealScoped {
+			cleanup.Add(&volumeCleanup{driver: driver, id: id})
+		}
 	}
 
 	return &cleanup, nil
 }
 
+// RemoveDealVolumes removes all deal-scoped volumes of the given deal.
+func (r *Repository) RemoveDealVolumes(dealID string) error {
+	errs := make([]error, 0)
+	for ty, driver := range r.volumes {
+		if dealDriver, ok := driver.(volume.DealVolumeDriver); ok {
+			if err := dealDriver.RemoveDealVolumes(dealID); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s", ty, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove volumes of deal %s: %v", dealID, errs)
+	}
+
+	return nil
+}
+
 func (r *Repository) TuneNetworks(ctx context.Context, provider NetworkProvider, hostCfg *container.HostConfig, netCfg *network.NetworkingConfig) (Cleanup, error) {
 	log.G(ctx).Info("tuning networks")
 	cleanup := newNestedCleanup()
@@ -269,6 +349,43 @@ func (r *Repository) TuneNetworks(ctx context.Context, provider NetworkProvider,
 	return &cleanup, nil
 }
 
+// Restore rebuilds the cleanup of plugin resources created by Tune for the
+// given provider, whose container has been connected to the networks with
+// the given IDs. Used to track containers again after the Worker restart.
+//
+// GPUs need no cleanup, while deal-scoped volumes are removed when the deal
+// is finished.
+func (r *Repository) Restore(ctx context.Context, provider VolumeProvider, networkIDs []string) Cleanup {
+	cleanup := newNestedCleanup()
+
+	for volumeName, options := range provider.Volumes() {
+		if len(provider.Mounts(volumeName)) == 0 {
+			continue
+		}
+
+		driver, ok := r.volumes[options.Type]
+		if !ok {
+			continue
+		}
+		if _, isDealScoped := driver.(volume.DealVolumeDriver); isDealScoped {
+			continue
+		}
+
+		cleanup.Add(&volumeCleanup{driver: driver, id: fmt.Sprintf("%s/%s", provider.ID(), volumeName)})
+	}
+
+	for _, id := range networkIDs {
+		for _, tuner := range r.networkTuners {
+			if c, ok := tuner.Restore(ctx, id); ok {
+				cleanup.Add(c)
+				break
+			}
+		}
+	}
+
+	return &cleanup
+}
+
 func (r *Repository) JoinNetwork(ID string) (structs.Network, error) {
 	for _, net := range r.networkTuners {
 		if net.Tuned(ID) {
@@ -0,0 +1,111 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/sonm-io/core/insonmnia/structs"
+	minet "github.com/sonm-io/core/insonmnia/worker/network"
+	"github.com/sonm-io/core/insonmnia/worker/volume"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testVolumeDriver struct {
+	removed []string
+}
+
+func (m *testVolumeDriver) CreateVolume(name string, options map[string]string) (volume.Volume, error) {
+	return nil, nil
+}
+
+func (m *testVolumeDriver) RemoveVolume(name string) error {
+	m.removed = append(m.removed, name)
+	return nil
+}
+
+func (m *testVolumeDriver) Close() error {
+	return nil
+}
+
+type testNetworkTuner struct {
+	networks map[string]bool
+	removed  []string
+}
+
+func (m *testNetworkTuner) Tune(ctx context.Context, net structs.Network, hostConfig *container.HostConfig, netConfig *network.NetworkingConfig) (minet.Cleanup, error) {
+	return nil, nil
+}
+
+func (m *testNetworkTuner) GenerateInvitation(ID string) (structs.Network, error) {
+	return nil, nil
+}
+
+func (m *testNetworkTuner) Tuned(ID string) bool {
+	return m.networks[ID]
+}
+
+func (m *testNetworkTuner) Restore(ctx context.Context, ID string) (minet.Cleanup, bool) {
+	if !m.Tuned(ID) {
+		return nil, false
+	}
+	return &testNetworkCleanup{tuner: m, id: ID}, true
+}
+
+type testNetworkCleanup struct {
+	tuner *testNetworkTuner
+	id    string
+}
+
+func (m *testNetworkCleanup) Close() error {
+	m.tuner.removed = append(m.tuner.removed, m.id)
+	return nil
+}
+
+type testVolumeProvider struct {
+	volumes map[string]*sonm.Volume
+	mounts  []volume.Mount
+}
+
+func (m *testVolumeProvider) ID() string {
+	return "task-1"
+}
+
+func (m *testVolumeProvider) DealID() string {
+	return "42"
+}
+
+func (m *testVolumeProvider) StorageQuota() uint64 {
+	return 0
+}
+
+func (m *testVolumeProvider) Volumes() map[string]*sonm.Volume {
+	return m.volumes
+}
+
+func (m *testVolumeProvider) Mounts(source string) []volume.Mount {
+	return m.mounts
+}
+
+func TestRepositoryRestore(t *testing.T) {
+	driver := &testVolumeDriver{}
+	tuner := &testNetworkTuner{networks: map[string]bool{"overlay": true}}
+
+	r := EmptyRepository()
+	r.volumes["test"] = driver
+	r.networkTuners["test"] = tuner
+
+	provider := &testVolumeProvider{
+		volumes: map[string]*sonm.Volume{"data": {Type: "test"}, "unknown": {Type: "other"}},
+		mounts:  []volume.Mount{{Source: "data", Target: "/data"}},
+	}
+
+	cleanup := r.Restore(context.Background(), provider, []string{"bridge", "overlay"})
+	require.NoError(t, cleanup.Close())
+
+	assert.Equal(t, []string{"task-1/data"}, driver.removed)
+	assert.Equal(t, []string{"overlay"}, tuner.removed)
+}
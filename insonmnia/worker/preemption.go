@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/worker/salesman"
+	"github.com/sonm-io/core/util/multierror"
+)
+
+// preemptionTimeout limits the time of delivering the preemption notice to
+// a single task.
+const preemptionTimeout = 30 * time.Second
+
+// PreemptionNotice is delivered to tasks before their deal is closed by the
+// supplier.
+type PreemptionNotice struct {
+	DealID   string    `json:"dealID"`
+	Deadline time.Time `json:"deadline"`
+	Reason   string    `json:"reason"`
+}
+
+// preemptDealTasks delivers the preemption notice to all running tasks of
+// the deal that have requested it.
+func (m *Worker) preemptDealTasks(preemption *salesman.Preemption) {
+	dealID := preemption.Deal.GetId().Unwrap().String()
+
+	tasks := map[string]string{}
+	m.mu.Lock()
+	for id, container := range m.containers {
+		if container.DealID == dealID && container.Preemption != nil && isTaskAlive(container.status) {
+			tasks[id] = container.ID
+		}
+	}
+	m.mu.Unlock()
+
+	notice := PreemptionNotice{
+		DealID:   dealID,
+		Deadline: preemption.Deadline,
+		Reason:   preemption.Reason,
+	}
+
+	for id, containerID := range tasks {
+		ctx, cancel := context.WithTimeout(m.ctx, preemptionTimeout)
+		err := m.ovs.Preempt(ctx, containerID, notice)
+		cancel()
+
+		if err != nil {
+			log.S(m.ctx).Warnf("could not deliver preemption notice to task %s of deal %s: %s", id, dealID, err)
+			continue
+		}
+
+		log.S(m.ctx).Infof("delivered preemption notice to task %s of deal %s", id, dealID)
+	}
+}
+
+func (c *containerDescriptor) preempt(ctx context.Context, notice PreemptionNotice) error {
+	preemption := c.description.Preemption
+	result := multierror.NewMultiError()
+
+	data, err := json.Marshal(notice)
+	if err != nil {
+		return err
+	}
+
+	// The notice must be in place by the time the task handles the signal.
+	if script := preemption.Script(data); script != "" {
+		if err := c.execScript(ctx, script); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if preemption.GetSignal() != "" {
+		if err := c.client.ContainerKill(ctx, c.ID, preemption.GetSignal()); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to send %s: %s", preemption.GetSignal(), err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// execScript runs the shell script inside the container, waiting for it to
+// finish.
+func (c *containerDescriptor) execScript(ctx context.Context, script string) error {
+	cfg := types.ExecConfig{
+		User:         "root",
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          []string{"sh", "-c", script},
+	}
+
+	execID, err := c.client.ContainerExecCreate(ctx, c.ID, cfg)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.client.ContainerExecAttach(ctx, execID.ID, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	output := bytes.Buffer{}
+	if _, err := stdcopy.StdCopy(&output, &output, conn.Reader); err != nil {
+		return err
+	}
+
+	inspect, err := c.client.ContainerExecInspect(ctx, execID.ID)
+	if err != nil {
+		return err
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("script exited with code %d: %s", inspect.ExitCode, strings.TrimSpace(output.String()))
+	}
+
+	return nil
+}
@@ -0,0 +1,664 @@
+package worker
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	"github.com/sonm-io/core/util/multierror"
+)
+
+const (
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = ".wh..wh..opq"
+	// maxSymlinks limits the number of symlinks resolved in a single path,
+	// the same way the kernel does.
+	maxSymlinks = 40
+)
+
+// runcImage describes an image unpacked into the local image store.
+type runcImage struct {
+	Reference  string   `json:"reference"`
+	Env        []string `json:"env"`
+	Entrypoint []string `json:"entrypoint"`
+	Cmd        []string `json:"cmd"`
+	WorkingDir string   `json:"working_dir"`
+	User       string   `json:"user"`
+}
+
+// dockerArchiveManifest is an entry of "manifest.json" file from archives
+// produced by "docker save".
+type dockerArchiveManifest struct {
+	Config   string
+	RepoTags []string
+	Layers   []string
+}
+
+// dockerImageConfig is a subset of Docker image config we care about.
+type dockerImageConfig struct {
+	Config struct {
+		Env        []string `json:"Env"`
+		Entrypoint []string `json:"Entrypoint"`
+		Cmd        []string `json:"Cmd"`
+		WorkingDir string   `json:"WorkingDir"`
+		User       string   `json:"User"`
+	} `json:"config"`
+}
+
+// runcImageStore keeps images unpacked into root filesystems, ready to be
+// used as lower layers of containers' overlay mounts.
+//
+// There is no registry support, images must be loaded into the store using
+// archives in "docker save" format.
+type runcImageStore struct {
+	root string
+}
+
+func newRuncImageStore(root string) (*runcImageStore, error) {
+	if err := os.MkdirAll(filepath.Join(root, "tmp"), 0700); err != nil {
+		return nil, err
+	}
+
+	return &runcImageStore{root: root}, nil
+}
+
+func (m *runcImageStore) path(ref string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(reference.TagNameOnly(named).String()))
+	return filepath.Join(m.root, hex.EncodeToString(hash[:])), nil
+}
+
+// Get returns the image description with the path to its root filesystem.
+func (m *runcImageStore) Get(ref string) (*runcImage, string, error) {
+	path, err := m.path(ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(path, "image.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", fmt.Errorf("image %s not found", ref)
+		}
+		return nil, "", err
+	}
+
+	image := &runcImage{}
+	if err := json.Unmarshal(data, image); err != nil {
+		return nil, "", err
+	}
+
+	return image, filepath.Join(path, "rootfs"), nil
+}
+
+// Put atomically stores the root filesystem prepared in the given directory
+// as the image. The directory is moved into the store.
+func (m *runcImageStore) Put(image *runcImage, rootfs string) error {
+	path, err := m.path(image.Reference)
+	if err != nil {
+		return err
+	}
+
+	staging, err := ioutil.TempDir(filepath.Join(m.root, "tmp"), "image")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+
+	data, err := json.Marshal(image)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(staging, "image.json"), data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(rootfs, filepath.Join(staging, "rootfs")); err != nil {
+		return err
+	}
+
+	// Images that are in use by running containers are kept alive by
+	// overlay mounts even after being replaced.
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+
+	return os.Rename(staging, path)
+}
+
+// Remove removes the image from the store.
+func (m *runcImageStore) Remove(ref string) error {
+	path, err := m.path(ref)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(path)
+}
+
+// Commit stores a copy of the given root filesystem as a new image.
+func (m *runcImageStore) Commit(image *runcImage, rootfs string) error {
+	target, err := m.TempDir()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(target)
+
+	copied := filepath.Join(target, "rootfs")
+	if err := copyTree(rootfs, copied); err != nil {
+		return err
+	}
+
+	return m.Put(image, copied)
+}
+
+// TempDir creates a new temporary directory on the same filesystem as the
+// store.
+func (m *runcImageStore) TempDir() (string, error) {
+	return ioutil.TempDir(filepath.Join(m.root, "tmp"), "")
+}
+
+// Load unpacks images from the archive in "docker save" format, returning
+// their references.
+func (m *runcImageStore) Load(rd io.Reader) ([]string, error) {
+	archive, err := m.TempDir()
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(archive)
+
+	// Entries of the archive can go in any order, so it should be unpacked
+	// entirely before reading the manifest.
+	if err := applyLayer(rd, archive); err != nil {
+		return nil, fmt.Errorf("failed to unpack image archive: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(archive, "manifest.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image archive manifest: %v", err)
+	}
+
+	var manifests []dockerArchiveManifest
+	if err := json.Unmarshal(data, &manifests); err != nil {
+		return nil, fmt.Errorf("failed to decode image archive manifest: %v", err)
+	}
+
+	var refs []string
+	for _, manifest := range manifests {
+		if len(manifest.RepoTags) == 0 {
+			return nil, fmt.Errorf("image %s has no tags", manifest.Config)
+		}
+
+		config := dockerImageConfig{}
+		data, err := ioutil.ReadFile(filepath.Join(archive, filepath.Clean("/"+manifest.Config)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image config: %v", err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("failed to decode image config: %v", err)
+		}
+
+		for _, tag := range manifest.RepoTags {
+			rootfs := filepath.Join(archive, "rootfs")
+			if err := os.MkdirAll(rootfs, 0755); err != nil {
+				return nil, err
+			}
+
+			for _, layer := range manifest.Layers {
+				if err := applyLayerFile(filepath.Join(archive, filepath.Clean("/"+layer)), rootfs); err != nil {
+					return nil, fmt.Errorf("failed to apply layer %s: %v", layer, err)
+				}
+			}
+
+			image := &runcImage{
+				Reference:  tag,
+				Env:        config.Config.Env,
+				Entrypoint: config.Config.Entrypoint,
+				Cmd:        config.Config.Cmd,
+				WorkingDir: config.Config.WorkingDir,
+				User:       config.Config.User,
+			}
+			if err := m.Put(image, rootfs); err != nil {
+				return nil, err
+			}
+
+			refs = append(refs, tag)
+		}
+	}
+
+	return refs, nil
+}
+
+// Save packs the image into a single-layer archive in "docker save" format.
+//
+// The archive is prepared in a temporary file, which is removed after
+// closing the returned reader.
+func (m *runcImageStore) Save(ref string) (ImageInfo, io.ReadCloser, error) {
+	image, rootfs, err := m.Get(ref)
+	if err != nil {
+		return ImageInfo{}, nil, err
+	}
+
+	dir, err := m.TempDir()
+	if err != nil {
+		return ImageInfo{}, nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	layer, err := os.Create(filepath.Join(dir, "layer.tar"))
+	if err != nil {
+		return ImageInfo{}, nil, err
+	}
+	defer layer.Close()
+
+	hasher := sha256.New()
+	if err := writeTree(io.MultiWriter(layer, hasher), rootfs); err != nil {
+		return ImageInfo{}, nil, err
+	}
+
+	layerID := hex.EncodeToString(hasher.Sum(nil))
+
+	config := dockerImageConfig{}
+	config.Config.Env = image.Env
+	config.Config.Entrypoint = image.Entrypoint
+	config.Config.Cmd = image.Cmd
+	config.Config.WorkingDir = image.WorkingDir
+	config.Config.User = image.User
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		return ImageInfo{}, nil, err
+	}
+	configHash := sha256.Sum256(configData)
+	imageID := hex.EncodeToString(configHash[:])
+
+	manifest, err := json.Marshal([]dockerArchiveManifest{{
+		Config:   imageID + ".json",
+		RepoTags: []string{image.Reference},
+		Layers:   []string{layerID + "/layer.tar"},
+	}})
+	if err != nil {
+		return ImageInfo{}, nil, err
+	}
+
+	archive, err := ioutil.TempFile(filepath.Join(m.root, "tmp"), "archive")
+	if err != nil {
+		return ImageInfo{}, nil, err
+	}
+
+	if err := writeImageArchive(archive, layer, layerID, imageID, configData, manifest); err != nil {
+		archive.Close()
+		os.Remove(archive.Name())
+		return ImageInfo{}, nil, err
+	}
+
+	stat, err := archive.Stat()
+	if err != nil {
+		archive.Close()
+		os.Remove(archive.Name())
+		return ImageInfo{}, nil, err
+	}
+
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		archive.Close()
+		os.Remove(archive.Name())
+		return ImageInfo{}, nil, err
+	}
+
+	return ImageInfo{ID: "sha256:" + imageID, Size: stat.Size()}, &tempFile{File: archive}, nil
+}
+
+func writeImageArchive(wr io.Writer, layer *os.File, layerID, imageID string, config, manifest []byte) error {
+	stat, err := layer.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err := layer.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(wr)
+
+	if err := tw.WriteHeader(&tar.Header{Name: layerID + "/", Typeflag: tar.TypeDir, Mode: 0755}); err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: layerID + "/layer.tar", Typeflag: tar.TypeReg, Mode: 0644, Size: stat.Size()}); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, layer); err != nil {
+		return err
+	}
+
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{name: imageID + ".json", data: config},
+		{name: "manifest.json", data: manifest},
+	}
+	for _, file := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: file.name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(file.data))}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(file.data); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// tempFile is a file that is removed after closing.
+type tempFile struct {
+	*os.File
+}
+
+func (m *tempFile) Close() error {
+	result := multierror.NewMultiError()
+	if err := m.File.Close(); err != nil {
+		result = multierror.Append(result, err)
+	}
+	if err := os.Remove(m.File.Name()); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+func applyLayerFile(path string, dir string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return applyLayer(file, dir)
+}
+
+// applyLayer unpacks the possibly gzipped tar layer into the given
+// directory, processing AUFS whiteouts the same way Docker does.
+func applyLayer(rd io.Reader, dir string) error {
+	buffered := bufio.NewReader(rd)
+	magic, err := buffered.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		rd = gz
+	} else {
+		rd = buffered
+	}
+
+	tr := tar.NewReader(rd)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean("/" + header.Name)
+		if name == "/" {
+			continue
+		}
+		// Symlinks from lower layers or from this one must never redirect
+		// the entry outside of the directory.
+		parent, err := scopedPath(dir, filepath.Dir(name))
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %v", header.Name, err)
+		}
+		base := filepath.Base(name)
+		path := filepath.Join(parent, base)
+
+		if base == whiteoutOpaque {
+			entries, err := ioutil.ReadDir(parent)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			for _, entry := range entries {
+				if err := os.RemoveAll(filepath.Join(parent, entry.Name())); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if strings.HasPrefix(base, whiteoutPrefix) {
+			if err := os.RemoveAll(filepath.Join(parent, strings.TrimPrefix(base, whiteoutPrefix))); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(parent, 0755); err != nil {
+			return err
+		}
+
+		if err := applyTarEntry(tr, header, dir, path); err != nil {
+			return fmt.Errorf("failed to unpack %s: %v", header.Name, err)
+		}
+	}
+}
+
+func applyTarEntry(tr *tar.Reader, header *tar.Header, dir, path string) error {
+	mode := os.FileMode(header.Mode).Perm()
+
+	// Existing entries are replaced rather than followed, except for
+	// directories being merged.
+	if info, err := os.Lstat(path); err == nil && !(info.IsDir() && header.Typeflag == tar.TypeDir) {
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+
+	switch header.Typeflag {
+	case tar.TypeDir:
+		if err := os.MkdirAll(path, mode); err != nil {
+			return err
+		}
+		if err := os.Chmod(path, mode); err != nil {
+			return err
+		}
+	case tar.TypeReg, tar.TypeRegA:
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(file, tr); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+		if err := os.Chmod(path, mode); err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		target := header.Linkname
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(path), target)
+		} else {
+			target = filepath.Join(dir, target)
+		}
+		if !isWithinDir(dir, target) {
+			return fmt.Errorf("symlink target %s is outside of the rootfs", header.Linkname)
+		}
+		if err := os.Symlink(header.Linkname, path); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		name := filepath.Clean("/" + header.Linkname)
+		parent, err := scopedPath(dir, filepath.Dir(name))
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(parent, filepath.Base(name))
+		if !isWithinDir(dir, target) {
+			return fmt.Errorf("link target %s is outside of the rootfs", header.Linkname)
+		}
+		if err := os.Link(target, path); err != nil {
+			return err
+		}
+	default:
+		// Device nodes, FIFOs and other special files are provided by the
+		// runtime.
+		return nil
+	}
+
+	// Preserving ownership requires root privileges, which are needed for
+	// running containers anyway.
+	if err := os.Lchown(path, header.Uid, header.Gid); err != nil && !os.IsPermission(err) {
+		return err
+	}
+
+	return nil
+}
+
+// scopedPath joins the path with the root directory the way securejoin does:
+// symlinks in its components are resolved as if the root directory were the
+// filesystem root, so they are never followed outside of it. The last
+// component is resolved too, while missing components are kept as is.
+func scopedPath(root, path string) (string, error) {
+	var (
+		current = "/"
+		pending = strings.Split(path, "/")
+		links   = 0
+	)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if name == "" || name == "." {
+			continue
+		}
+
+		next := filepath.Join(current, name)
+		info, err := os.Lstat(filepath.Join(root, next))
+		if err != nil {
+			if os.IsNotExist(err) {
+				current = next
+				continue
+			}
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			current = next
+			continue
+		}
+
+		links++
+		if links > maxSymlinks {
+			return "", fmt.Errorf("too many levels of symbolic links in %s", path)
+		}
+		target, err := os.Readlink(filepath.Join(root, next))
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(target) {
+			current = "/"
+		}
+		pending = append(strings.Split(target, "/"), pending...)
+	}
+
+	return filepath.Join(root, current), nil
+}
+
+// isWithinDir reports whether the path is the directory itself or lies
+// under it.
+func isWithinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, "../")
+}
+
+// writeTree packs the directory into a tar archive.
+func writeTree(wr io.Writer, dir string) error {
+	tw := tar.NewWriter(wr)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+
+		var link string
+		switch {
+		case info.Mode()&os.ModeSymlink != 0:
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		case info.Mode()&(os.ModeSocket|os.ModeDevice|os.ModeNamedPipe) != 0:
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func copyTree(src, dst string) error {
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+
+	rd, wr := io.Pipe()
+	go func() {
+		wr.CloseWithError(writeTree(wr, src))
+	}()
+
+	err := applyLayer(rd, dst)
+	rd.CloseWithError(err)
+
+	return err
+}
@@ -0,0 +1,234 @@
+package worker
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tarEntry struct {
+	name     string
+	data     string
+	link     string
+	hardlink bool
+}
+
+func newTar(t *testing.T, entries ...tarEntry) []byte {
+	buf := bytes.Buffer{}
+	tw := tar.NewWriter(&buf)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0644, Typeflag: tar.TypeReg, Size: int64(len(entry.data))}
+		switch {
+		case len(entry.link) > 0 && entry.hardlink:
+			header.Typeflag = tar.TypeLink
+			header.Linkname = entry.link
+			header.Size = 0
+		case len(entry.link) > 0:
+			header.Typeflag = tar.TypeSymlink
+			header.Linkname = entry.link
+			header.Size = 0
+		case entry.name[len(entry.name)-1] == '/':
+			header.Typeflag = tar.TypeDir
+			header.Mode = 0755
+		}
+
+		require.NoError(t, tw.WriteHeader(header))
+		_, err := tw.Write([]byte(entry.data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	return buf.Bytes()
+}
+
+func newImageArchive(t *testing.T, tag string) []byte {
+	base := newTar(t,
+		tarEntry{name: "etc/"},
+		tarEntry{name: "etc/passwd", data: "root:x:0:0:root:/root:/bin/sh\n"},
+		tarEntry{name: "etc/motd", data: "hello"},
+		tarEntry{name: "opt/app/"},
+		tarEntry{name: "opt/app/old", data: "old"},
+	)
+	top := newTar(t,
+		tarEntry{name: "etc/.wh.motd"},
+		tarEntry{name: "opt/app/.wh..wh..opq"},
+		tarEntry{name: "opt/app/new", data: "new"},
+		tarEntry{name: "opt/link", link: "app/new"},
+	)
+
+	config, err := json.Marshal(map[string]interface{}{
+		"config": map[string]interface{}{
+			"Env":        []string{"A=1"},
+			"Cmd":        []string{"/bin/app"},
+			"WorkingDir": "/opt/app",
+		},
+	})
+	require.NoError(t, err)
+
+	manifest, err := json.Marshal([]dockerArchiveManifest{{
+		Config:   "config.json",
+		RepoTags: []string{tag},
+		Layers:   []string{"base/layer.tar", "top/layer.tar"},
+	}})
+	require.NoError(t, err)
+
+	return newTar(t,
+		tarEntry{name: "manifest.json", data: string(manifest)},
+		tarEntry{name: "base/layer.tar", data: string(base)},
+		tarEntry{name: "top/layer.tar", data: string(top)},
+		tarEntry{name: "config.json", data: string(config)},
+	)
+}
+
+func newTestImageStore(t *testing.T) (*runcImageStore, func()) {
+	dir, err := ioutil.TempDir("", "runc-images")
+	require.NoError(t, err)
+
+	store, err := newRuncImageStore(dir)
+	require.NoError(t, err)
+
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func TestRuncImageStoreLoad(t *testing.T) {
+	store, cleanup := newTestImageStore(t)
+	defer cleanup()
+
+	refs, err := store.Load(bytes.NewReader(newImageArchive(t, "sonm/app:1.0")))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sonm/app:1.0"}, refs)
+
+	image, rootfs, err := store.Get("docker.io/sonm/app:1.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A=1"}, image.Env)
+	assert.Equal(t, []string{"/bin/app"}, image.Cmd)
+	assert.Equal(t, "/opt/app", image.WorkingDir)
+
+	data, err := ioutil.ReadFile(filepath.Join(rootfs, "opt", "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+
+	// Whiteouts remove files from lower layers.
+	for _, path := range []string{"etc/motd", "opt/app/old", "etc/.wh.motd", "opt/app/.wh..wh..opq"} {
+		_, err := os.Lstat(filepath.Join(rootfs, path))
+		assert.True(t, os.IsNotExist(err), path)
+	}
+
+	_, _, err = store.Get("sonm/app:2.0")
+	assert.Error(t, err)
+}
+
+func TestRuncImageStoreSaveLoad(t *testing.T) {
+	store, cleanup := newTestImageStore(t)
+	defer cleanup()
+
+	_, err := store.Load(bytes.NewReader(newImageArchive(t, "sonm/app:1.0")))
+	require.NoError(t, err)
+
+	info, rd, err := store.Save("sonm/app:1.0")
+	require.NoError(t, err)
+	archive, err := ioutil.ReadAll(rd)
+	require.NoError(t, err)
+	require.NoError(t, rd.Close())
+	assert.Equal(t, int64(len(archive)), info.Size)
+
+	require.NoError(t, store.Remove("sonm/app:1.0"))
+
+	refs, err := store.Load(bytes.NewReader(archive))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sonm/app:1.0"}, refs)
+
+	image, rootfs, err := store.Get("sonm/app:1.0")
+	require.NoError(t, err)
+	assert.Equal(t, "/opt/app", image.WorkingDir)
+
+	data, err := ioutil.ReadFile(filepath.Join(rootfs, "opt", "app", "new"))
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+}
+
+func TestApplyLayerRejectsEscape(t *testing.T) {
+	dir, err := ioutil.TempDir("", "runc-layer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rootfs := filepath.Join(dir, "rootfs")
+	require.NoError(t, os.Mkdir(rootfs, 0755))
+
+	require.NoError(t, applyLayer(bytes.NewReader(newTar(t, tarEntry{name: "../escaped", data: "x"})), rootfs))
+
+	_, err = os.Stat(filepath.Join(dir, "escaped"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(rootfs, "escaped"))
+	assert.NoError(t, err)
+}
+
+func TestApplyLayerSymlinkEscape(t *testing.T) {
+	dir, err := ioutil.TempDir("", "runc-layer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rootfs := filepath.Join(dir, "rootfs")
+	outside := filepath.Join(dir, "outside")
+	require.NoError(t, os.Mkdir(rootfs, 0755))
+	require.NoError(t, os.Mkdir(outside, 0755))
+
+	// The symlink is resolved within the rootfs, so the file is written to
+	// "rootfs/<outside>" instead of the host directory.
+	layer := newTar(t,
+		tarEntry{name: "evil", link: outside},
+		tarEntry{name: "evil/escaped", data: "x"},
+	)
+	require.NoError(t, applyLayer(bytes.NewReader(layer), rootfs))
+
+	_, err = os.Stat(filepath.Join(outside, "escaped"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(rootfs, outside, "escaped"))
+	assert.NoError(t, err)
+
+	// Symlinks pointing above the rootfs are rejected.
+	layer = newTar(t, tarEntry{name: "up", link: "../outside"})
+	assert.Error(t, applyLayer(bytes.NewReader(layer), rootfs))
+}
+
+func TestApplyLayerHardlinkEscape(t *testing.T) {
+	dir, err := ioutil.TempDir("", "runc-layer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rootfs := filepath.Join(dir, "rootfs")
+	outside := filepath.Join(dir, "outside")
+	require.NoError(t, os.Mkdir(rootfs, 0755))
+	require.NoError(t, os.Mkdir(outside, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0600))
+
+	layer := newTar(t,
+		tarEntry{name: "evil", link: outside},
+		tarEntry{name: "stolen", link: "evil/secret", hardlink: true},
+	)
+	assert.Error(t, applyLayer(bytes.NewReader(layer), rootfs))
+	_, err = os.Stat(filepath.Join(rootfs, "stolen"))
+	assert.True(t, os.IsNotExist(err))
+
+	layer = newTar(t,
+		tarEntry{name: "stolen", link: "../outside/secret", hardlink: true},
+	)
+	assert.Error(t, applyLayer(bytes.NewReader(layer), rootfs))
+
+	// Hardlinks within the rootfs are fine.
+	layer = newTar(t,
+		tarEntry{name: "file", data: "data"},
+		tarEntry{name: "same", link: "file", hardlink: true},
+	)
+	require.NoError(t, applyLayer(bytes.NewReader(layer), rootfs))
+	data, err := ioutil.ReadFile(filepath.Join(rootfs, "same"))
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+}
@@ -0,0 +1,233 @@
+package worker
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+const (
+	defaultRuncPath       = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+	defaultRuncOpenFiles  = 1048576
+	runcHostnameMaxLength = 12
+)
+
+// defaultRuncCapabilities are the same capabilities Docker grants to
+// containers by default.
+var defaultRuncCapabilities = []string{
+	"CAP_CHOWN",
+	"CAP_DAC_OVERRIDE",
+	"CAP_FSETID",
+	"CAP_FOWNER",
+	"CAP_MKNOD",
+	"CAP_NET_RAW",
+	"CAP_SETGID",
+	"CAP_SETUID",
+	"CAP_SETFCAP",
+	"CAP_SETPCAP",
+	"CAP_NET_BIND_SERVICE",
+	"CAP_SYS_CHROOT",
+	"CAP_KILL",
+	"CAP_AUDIT_WRITE",
+}
+
+func defaultRuncMounts() []specs.Mount {
+	return []specs.Mount{
+		{Destination: "/proc", Type: "proc", Source: "proc"},
+		{Destination: "/dev", Type: "tmpfs", Source: "tmpfs", Options: []string{"nosuid", "strictatime", "mode=755", "size=65536k"}},
+		{Destination: "/dev/pts", Type: "devpts", Source: "devpts", Options: []string{"nosuid", "noexec", "newinstance", "ptmxmode=0666", "mode=0620", "gid=5"}},
+		{Destination: "/dev/shm", Type: "tmpfs", Source: "shm", Options: []string{"nosuid", "noexec", "nodev", "mode=1777", "size=65536k"}},
+		{Destination: "/dev/mqueue", Type: "mqueue", Source: "mqueue", Options: []string{"nosuid", "noexec", "nodev"}},
+		{Destination: "/sys", Type: "sysfs", Source: "sysfs", Options: []string{"nosuid", "noexec", "nodev", "ro"}},
+		{Destination: "/sys/fs/cgroup", Type: "cgroup", Source: "cgroup", Options: []string{"nosuid", "noexec", "nodev", "relatime", "ro"}},
+		// Containers share the name resolution settings of the host, even
+		// though they have no network access, unless joined to a container
+		// that has one.
+		{Destination: "/etc/resolv.conf", Type: "bind", Source: "/etc/resolv.conf", Options: []string{"rbind", "ro"}},
+		{Destination: "/etc/hosts", Type: "bind", Source: "/etc/hosts", Options: []string{"rbind", "ro"}},
+	}
+}
+
+// runcNamespaces returns namespaces of a container. The container always
+// gets its own network namespace having the loopback interface only, so it
+// can't reach the services of the host. Given the path to the network
+// namespace of another container, it joins that namespace instead.
+func runcNamespaces(netns string) []specs.LinuxNamespace {
+	return []specs.LinuxNamespace{
+		{Type: specs.PIDNamespace},
+		{Type: specs.IPCNamespace},
+		{Type: specs.UTSNamespace},
+		{Type: specs.MountNamespace},
+		{Type: specs.NetworkNamespace, Path: netns},
+	}
+}
+
+// runcProcessArgs resolves the command to launch the same way Docker does:
+// overriding the entrypoint resets the command from the image.
+func runcProcessArgs(d Description, image *runcImage) ([]string, error) {
+	entrypoint := image.Entrypoint
+	cmd := image.Cmd
+	if len(d.Entrypoint) > 0 {
+		entrypoint = d.Entrypoint
+		cmd = nil
+	}
+	if len(d.Cmd) > 0 {
+		cmd = d.Cmd
+	}
+
+	args := append(append([]string{}, entrypoint...), cmd...)
+	if len(args) == 0 {
+		return nil, errors.New("no command specified")
+	}
+
+	return args, nil
+}
+
+// runcProcessEnv merges environment variables, the latter ones take
+// precedence.
+func runcProcessEnv(envs ...[]string) []string {
+	var keys []string
+	values := map[string]string{}
+	for _, env := range envs {
+		for _, value := range env {
+			key := strings.SplitN(value, "=", 2)[0]
+			if _, ok := values[key]; !ok {
+				keys = append(keys, key)
+			}
+			values[key] = value
+		}
+	}
+
+	if _, ok := values["PATH"]; !ok {
+		keys = append(keys, "PATH")
+		values["PATH"] = defaultRuncPath
+	}
+
+	result := make([]string, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, values[key])
+	}
+
+	return result
+}
+
+// resolveRuncUser converts "user[:group]" specification into numeric IDs
+// using "/etc/passwd" and "/etc/group" files from the container's root
+// filesystem.
+func resolveRuncUser(rootfs string, spec string) (specs.User, error) {
+	if len(spec) == 0 {
+		return specs.User{}, nil
+	}
+
+	parts := strings.SplitN(spec, ":", 2)
+
+	user := specs.User{}
+	uid, gid, err := lookupRuncID(filepath.Join(rootfs, "etc", "passwd"), parts[0])
+	if err != nil {
+		return specs.User{}, fmt.Errorf("failed to resolve user %s: %v", parts[0], err)
+	}
+	user.UID = uid
+	user.GID = gid
+
+	if len(parts) == 2 {
+		gid, _, err := lookupRuncID(filepath.Join(rootfs, "etc", "group"), parts[1])
+		if err != nil {
+			return specs.User{}, fmt.Errorf("failed to resolve group %s: %v", parts[1], err)
+		}
+		user.GID = gid
+	}
+
+	return user, nil
+}
+
+// lookupRuncID finds the entry with the given name or numeric ID in the file
+// of "/etc/passwd" format, returning the third and fourth fields.
+//
+// Numeric IDs that are absent in the file are returned as is.
+func lookupRuncID(path string, name string) (uint32, uint32, error) {
+	id, parseErr := strconv.ParseUint(name, 10, 32)
+
+	// The file belongs to the image, so it must not point outside of it.
+	if info, err := os.Lstat(path); err != nil || info.Mode()&os.ModeSymlink != 0 {
+		if parseErr == nil {
+			return uint32(id), 0, nil
+		}
+		return 0, 0, fmt.Errorf("%s is not available", filepath.Base(path))
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if len(fields) < 3 {
+			continue
+		}
+		if fields[0] != name && fields[2] != name {
+			continue
+		}
+
+		first, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		var second uint64
+		if len(fields) > 3 {
+			// The field is a list of users for groups.
+			second, _ = strconv.ParseUint(fields[3], 10, 32)
+		}
+
+		return uint32(first), uint32(second), nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	if parseErr == nil {
+		return uint32(id), 0, nil
+	}
+
+	return 0, 0, errors.New("no such entry")
+}
+
+// runcShouldRestart decides whether an exited container should be restarted
+// according to its restart policy, mimicking Docker.
+func runcShouldRestart(policy container.RestartPolicy, exitCode int, restarts int) bool {
+	switch {
+	case policy.IsAlways(), policy.IsUnlessStopped():
+		return true
+	case policy.IsOnFailure():
+		return exitCode != 0 && (policy.MaximumRetryCount == 0 || restarts < policy.MaximumRetryCount)
+	default:
+		return false
+	}
+}
+
+// runcHealthcheckArgs converts Docker's health check test into the command
+// to be executed inside the container.
+func runcHealthcheckArgs(test []string) ([]string, error) {
+	if len(test) < 2 {
+		return nil, errors.New("empty health check command")
+	}
+
+	switch test[0] {
+	case "CMD":
+		return test[1:], nil
+	case "CMD-SHELL":
+		return []string{"/bin/sh", "-c", test[1]}, nil
+	default:
+		return nil, fmt.Errorf("unknown health check type %s", test[0])
+	}
+}
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuncProcessArgs(t *testing.T) {
+	image := &runcImage{Entrypoint: []string{"/entrypoint.sh"}, Cmd: []string{"serve"}}
+
+	args, err := runcProcessArgs(Description{}, image)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/entrypoint.sh", "serve"}, args)
+
+	args, err = runcProcessArgs(Description{Cmd: []string{"test"}}, image)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/entrypoint.sh", "test"}, args)
+
+	// Overriding the entrypoint resets the image's command.
+	args, err = runcProcessArgs(Description{Entrypoint: []string{"/bin/sh"}}, image)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/sh"}, args)
+
+	_, err = runcProcessArgs(Description{}, &runcImage{})
+	assert.Error(t, err)
+}
+
+func TestRuncProcessEnv(t *testing.T) {
+	env := runcProcessEnv([]string{"A=1", "B=2"}, []string{"B=3", "C=4"})
+	assert.Equal(t, []string{"A=1", "B=3", "C=4", defaultRuncPath}, env)
+
+	env = runcProcessEnv([]string{"PATH=/bin"})
+	assert.Equal(t, []string{"PATH=/bin"}, env)
+}
+
+func TestResolveRuncUser(t *testing.T) {
+	rootfs, err := ioutil.TempDir("", "runc-rootfs")
+	require.NoError(t, err)
+	defer os.RemoveAll(rootfs)
+
+	require.NoError(t, os.Mkdir(filepath.Join(rootfs, "etc"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(rootfs, "etc", "passwd"), []byte("root:x:0:0:root:/root:/bin/sh\nsonm:x:1000:1001::/home/sonm:/bin/sh\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(rootfs, "etc", "group"), []byte("root:x:0:\nstaff:x:50:sonm\n"), 0644))
+
+	user, err := resolveRuncUser(rootfs, "")
+	require.NoError(t, err)
+	assert.Equal(t, specs.User{}, user)
+
+	user, err = resolveRuncUser(rootfs, "sonm")
+	require.NoError(t, err)
+	assert.Equal(t, specs.User{UID: 1000, GID: 1001}, user)
+
+	user, err = resolveRuncUser(rootfs, "sonm:staff")
+	require.NoError(t, err)
+	assert.Equal(t, specs.User{UID: 1000, GID: 50}, user)
+
+	user, err = resolveRuncUser(rootfs, "2000:3000")
+	require.NoError(t, err)
+	assert.Equal(t, specs.User{UID: 2000, GID: 3000}, user)
+
+	_, err = resolveRuncUser(rootfs, "nobody")
+	assert.Error(t, err)
+}
+
+func TestRuncNamespaces(t *testing.T) {
+	namespaces := runcNamespaces("")
+	assert.Contains(t, namespaces, specs.LinuxNamespace{Type: specs.NetworkNamespace})
+
+	namespaces = runcNamespaces("/proc/42/ns/net")
+	assert.Contains(t, namespaces, specs.LinuxNamespace{Type: specs.NetworkNamespace, Path: "/proc/42/ns/net"})
+	assert.NotContains(t, namespaces, specs.LinuxNamespace{Type: specs.NetworkNamespace})
+}
+
+func TestRuncShouldRestart(t *testing.T) {
+	assert.False(t, runcShouldRestart(container.RestartPolicy{}, 1, 0))
+	assert.True(t, runcShouldRestart(container.RestartPolicy{Name: "always"}, 0, 10))
+	assert.True(t, runcShouldRestart(container.RestartPolicy{Name: "on-failure"}, 1, 10))
+	assert.False(t, runcShouldRestart(container.RestartPolicy{Name: "on-failure"}, 0, 0))
+	assert.True(t, runcShouldRestart(container.RestartPolicy{Name: "on-failure", MaximumRetryCount: 3}, 1, 2))
+	assert.False(t, runcShouldRestart(container.RestartPolicy{Name: "on-failure", MaximumRetryCount: 3}, 1, 3))
+}
+
+func TestRuncHealthcheckArgs(t *testing.T) {
+	args, err := runcHealthcheckArgs([]string{"CMD", "curl", "localhost"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"curl", "localhost"}, args)
+
+	args, err = runcHealthcheckArgs([]string{"CMD-SHELL", "curl localhost || exit 1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/sh", "-c", "curl localhost || exit 1"}, args)
+
+	_, err = runcHealthcheckArgs([]string{"NONE"})
+	assert.Error(t, err)
+	_, err = runcHealthcheckArgs([]string{"UNKNOWN", "test"})
+	assert.Error(t, err)
+}
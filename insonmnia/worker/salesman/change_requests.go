@@ -0,0 +1,265 @@
+package salesman
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+)
+
+// maxChangeRequestDecisions is the number of the latest decisions kept in
+// the ask plan.
+const maxChangeRequestDecisions = 10
+
+// watchChangeRequests processes change requests sent for the deals of ask
+// plans as they appear in the blockchain, resubscribing when the events
+// stream is interrupted.
+func (m *Salesman) watchChangeRequests(ctx context.Context) {
+	for {
+		events, err := m.subscribeChangeRequests(ctx)
+		if err != nil {
+			return
+		}
+
+		if !m.processChangeRequestEvents(ctx, events) {
+			return
+		}
+	}
+}
+
+// processChangeRequestEvents processes change requests from the events
+// stream, returning false if the context is canceled and true if the stream
+// is interrupted.
+func (m *Salesman) processChangeRequestEvents(ctx context.Context, events chan *blockchain.Event) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return true
+			}
+
+			switch data := event.Data.(type) {
+			case *blockchain.DealChangeRequestSentData:
+				m.processChangeRequest(ctx, data.ID)
+			case *blockchain.ErrorData:
+				m.log.Debugf("failed to receive blockchain events: %s", data.Err)
+			}
+		}
+	}
+}
+
+// subscribeChangeRequests subscribes to the blockchain events starting from
+// the last block, retrying until the context is canceled. Requests sent
+// before are caught up with by the sync routine.
+func (m *Salesman) subscribeChangeRequests(ctx context.Context) (chan *blockchain.Event, error) {
+	for {
+		lastBlock, err := m.eth.Events().GetLastBlock(ctx)
+		if err == nil {
+			events, err := m.eth.Events().GetEvents(ctx, big.NewInt(0).SetUint64(lastBlock), nil)
+			if err == nil {
+				m.mu.Lock()
+				m.changeRequestsBlock = lastBlock
+				m.mu.Unlock()
+				return events, nil
+			}
+		}
+
+		m.log.Warnf("could not subscribe to change requests: %s", err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(m.config.SyncInterval):
+		}
+	}
+}
+
+// processPendingChangeRequests processes change requests for the deal of
+// the given ask plan, that have been sent while the worker was not watching
+// the blockchain.
+//
+// Requests are caught up with once per the watcher's subscription, scanning
+// only blocks after the previous catch up. Failed decisions are retried on
+// the next call.
+func (m *Salesman) processPendingChangeRequests(ctx context.Context, plan *sonm.AskPlan) error {
+	if !plan.GetChangeRequests().GetEnabled() {
+		return nil
+	}
+
+	dealID := plan.GetDealID().Unwrap()
+
+	m.mu.Lock()
+	toBlock := m.changeRequestsBlock
+	fromBlock, scanned := m.changeRequestsScanned[dealID.String()]
+	m.mu.Unlock()
+
+	// Until the watcher is subscribed there is no block to catch up to.
+	if toBlock == 0 || scanned && fromBlock >= toBlock {
+		return nil
+	}
+
+	requests, err := m.eth.Market().GetDealChangeRequestsInfo(ctx, dealID, fromBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, request := range requests {
+		if request.GetRequestType() != sonm.OrderType_BID || request.GetStatus() != sonm.ChangeRequestStatus_REQUEST_CREATED {
+			continue
+		}
+		if isChangeRequestDecided(plan, request.GetId()) {
+			continue
+		}
+
+		if err := m.processChangeRequest(ctx, request.GetId().Unwrap()); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	m.changeRequestsScanned[dealID.String()] = toBlock
+	m.mu.Unlock()
+
+	return nil
+}
+
+// isChangeRequestDecided checks whether the decision on the change request
+// has already been sent. Failed decisions are retried.
+func isChangeRequestDecided(plan *sonm.AskPlan, id *sonm.BigInt) bool {
+	for _, decision := range plan.GetChangeRequestDecisions() {
+		if decision.GetId().Cmp(id) == 0 && decision.GetError() == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// processChangeRequest decides on the change request, returning an error if
+// the decision could not be sent.
+func (m *Salesman) processChangeRequest(ctx context.Context, id *big.Int) error {
+	// Both the blockchain watcher and the sync routine process requests,
+	// the same request must not be decided twice.
+	m.changeRequestsMu.Lock()
+	defer m.changeRequestsMu.Unlock()
+
+	request, err := m.eth.Market().GetDealChangeRequestInfo(ctx, id)
+	if err != nil {
+		m.log.Warnf("could not get change request %s: %s", id.String(), err)
+		return err
+	}
+
+	// Only requests sent by consumers are waiting for our decision, the
+	// market may also have accepted the request already.
+	if request.GetRequestType() != sonm.OrderType_BID || request.GetStatus() != sonm.ChangeRequestStatus_REQUEST_CREATED {
+		return nil
+	}
+
+	plan, err := m.AskPlanByDeal(request.GetDealID())
+	if err != nil {
+		return nil
+	}
+
+	dealID := request.GetDealID().Unwrap().String()
+	if !plan.GetChangeRequests().GetEnabled() {
+		m.log.Infof("change request %s for deal %s of ask plan %s is left for manual processing", id.String(), dealID, plan.GetID())
+		return nil
+	}
+
+	deal, err := m.eth.Market().GetDealInfo(ctx, request.GetDealID().Unwrap())
+	if err != nil {
+		m.log.Warnf("could not get deal info for change request %s: %s", id.String(), err)
+		return err
+	}
+
+	decision := &sonm.ChangeRequestDecision{
+		Id:        sonm.NewBigInt(id),
+		DealID:    request.GetDealID(),
+		Price:     &sonm.Price{PerSecond: request.GetPrice()},
+		Duration:  &sonm.Duration{Nanoseconds: int64(time.Duration(request.GetDuration()) * time.Second)},
+		Timestamp: sonm.NewTimestamp(time.Now()),
+	}
+
+	if reason := checkChangeRequest(plan.GetChangeRequests(), deal, request); reason != nil {
+		decision.Reason = reason.Error()
+		m.log.Infof("rejecting change request %s for deal %s of ask plan %s: %s", id.String(), dealID, plan.GetID(), reason)
+		if err := m.eth.Market().CancelChangeRequest(ctx, m.ethkey, id); err != nil {
+			decision.Error = err.Error()
+		}
+	} else {
+		decision.Approved = true
+		m.log.Infof("approving change request %s for deal %s of ask plan %s", id.String(), dealID, plan.GetID())
+		matchingRequest := &sonm.DealChangeRequest{
+			DealID:      request.GetDealID(),
+			RequestType: sonm.OrderType_ASK,
+			Duration:    request.GetDuration(),
+			Price:       request.GetPrice(),
+		}
+		if _, err := m.eth.Market().CreateChangeRequest(ctx, m.ethkey, matchingRequest); err != nil {
+			decision.Error = err.Error()
+		}
+	}
+
+	if decision.Error != "" {
+		m.log.Warnf("could not send decision on change request %s for deal %s: %s", id.String(), dealID, decision.Error)
+	}
+
+	if err := m.addChangeRequestDecision(plan.GetID(), decision); err != nil {
+		m.log.Warnf("could not save decision on change request %s for ask plan %s: %s", id.String(), plan.GetID(), err)
+	}
+
+	if decision.Error != "" {
+		return fmt.Errorf("could not send decision on change request %s: %s", id.String(), decision.Error)
+	}
+
+	return nil
+}
+
+// checkChangeRequest returns the reason to reject the change request or nil
+// if it satisfies the rules.
+func checkChangeRequest(rules *sonm.ChangeRequestRules, deal *sonm.Deal, request *sonm.DealChangeRequest) error {
+	if counterparties := rules.GetCounterparties(); len(counterparties) != 0 {
+		allowed := false
+		for _, counterparty := range counterparties {
+			if counterparty.Unwrap() == deal.GetConsumerID().Unwrap() {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("consumer %s is not allowed to change deals", deal.GetConsumerID().Unwrap().Hex())
+		}
+	}
+
+	if minPrice := rules.GetMinPrice().GetPerSecond(); !minPrice.IsZero() && request.GetPrice().Cmp(minPrice) < 0 {
+		return fmt.Errorf("price %s is less than the minimum %s", request.GetPrice().Unwrap().String(), minPrice.Unwrap().String())
+	}
+
+	maxDuration := rules.GetMaxDuration().Unwrap()
+	if duration := time.Duration(request.GetDuration()) * time.Second; maxDuration != 0 && duration > maxDuration {
+		return fmt.Errorf("duration %s is greater than the maximum %s", duration, maxDuration)
+	}
+
+	return nil
+}
+
+func (m *Salesman) addChangeRequestDecision(planID string, decision *sonm.ChangeRequestDecision) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	plan, ok := m.askPlans[planID]
+	if !ok {
+		return fmt.Errorf("no such plan %s", planID)
+	}
+
+	plan.ChangeRequestDecisions = append(plan.ChangeRequestDecisions, decision)
+	if len(plan.ChangeRequestDecisions) > maxChangeRequestDecisions {
+		plan.ChangeRequestDecisions = plan.ChangeRequestDecisions[len(plan.ChangeRequestDecisions)-maxChangeRequestDecisions:]
+	}
+
+	return m.askPlanStorage.Save(m.askPlans)
+}
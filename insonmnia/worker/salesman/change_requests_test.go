@@ -0,0 +1,124 @@
+package salesman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCheckChangeRequest(t *testing.T) {
+	consumer := common.HexToAddress("0x1")
+	deal := &sonm.Deal{ConsumerID: sonm.NewEthAddress(consumer)}
+	request := &sonm.DealChangeRequest{
+		Price:    sonm.NewBigIntFromInt(100),
+		Duration: 3600,
+	}
+
+	assert.NoError(t, checkChangeRequest(nil, deal, request))
+	assert.NoError(t, checkChangeRequest(&sonm.ChangeRequestRules{
+		Enabled:        true,
+		MinPrice:       &sonm.Price{PerSecond: sonm.NewBigIntFromInt(100)},
+		MaxDuration:    &sonm.Duration{Nanoseconds: int64(time.Hour)},
+		Counterparties: []*sonm.EthAddress{sonm.NewEthAddress(consumer)},
+	}, deal, request))
+
+	assert.Error(t, checkChangeRequest(&sonm.ChangeRequestRules{
+		MinPrice: &sonm.Price{PerSecond: sonm.NewBigIntFromInt(101)},
+	}, deal, request))
+	assert.Error(t, checkChangeRequest(&sonm.ChangeRequestRules{
+		MaxDuration: &sonm.Duration{Nanoseconds: int64(time.Minute)},
+	}, deal, request))
+	assert.Error(t, checkChangeRequest(&sonm.ChangeRequestRules{
+		Counterparties: []*sonm.EthAddress{sonm.NewEthAddress(common.HexToAddress("0x2"))},
+	}, deal, request))
+}
+
+func TestProcessPendingChangeRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	eth := blockchain.NewMockAPI(ctrl)
+	market := blockchain.NewMockMarketAPI(ctrl)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+
+	plan := &sonm.AskPlan{
+		ID:             "plan",
+		DealID:         sonm.NewBigIntFromInt(1),
+		ChangeRequests: &sonm.ChangeRequestRules{Enabled: true},
+		ChangeRequestDecisions: []*sonm.ChangeRequestDecision{
+			{Id: sonm.NewBigIntFromInt(1), Approved: true},
+			{Id: sonm.NewBigIntFromInt(2), Approved: true, Error: "failed"},
+		},
+	}
+
+	m := &Salesman{
+		options:        &options{log: zap.NewNop().Sugar(), eth: eth},
+		askPlanStorage: state.NewKeyedStorage("ask_plans", storage),
+		askPlans:       map[string]*sonm.AskPlan{"plan": plan},
+
+		changeRequestsBlock:   10,
+		changeRequestsScanned: map[string]uint64{},
+	}
+
+	newRequest := func(id int64, requestType sonm.OrderType, status sonm.ChangeRequestStatus) *sonm.DealChangeRequest {
+		return &sonm.DealChangeRequest{
+			Id:          sonm.NewBigIntFromInt(id),
+			DealID:      sonm.NewBigIntFromInt(1),
+			RequestType: requestType,
+			Duration:    3600,
+			Price:       sonm.NewBigIntFromInt(10),
+			Status:      status,
+		}
+	}
+
+	// Only the request with the failed decision is still waiting for us.
+	pending := newRequest(2, sonm.OrderType_BID, sonm.ChangeRequestStatus_REQUEST_CREATED)
+	market.EXPECT().GetDealChangeRequestsInfo(gomock.Any(), big.NewInt(1), uint64(0)).Return([]*sonm.DealChangeRequest{
+		newRequest(1, sonm.OrderType_BID, sonm.ChangeRequestStatus_REQUEST_CREATED),
+		pending,
+		newRequest(3, sonm.OrderType_ASK, sonm.ChangeRequestStatus_REQUEST_CREATED),
+		newRequest(4, sonm.OrderType_BID, sonm.ChangeRequestStatus_REQUEST_ACCEPTED),
+	}, nil)
+	market.EXPECT().GetDealChangeRequestInfo(gomock.Any(), big.NewInt(2)).Return(pending, nil)
+	market.EXPECT().GetDealInfo(gomock.Any(), big.NewInt(1)).Return(&sonm.Deal{Id: sonm.NewBigIntFromInt(1)}, nil)
+	market.EXPECT().CreateChangeRequest(gomock.Any(), gomock.Any(), &sonm.DealChangeRequest{
+		DealID:      sonm.NewBigIntFromInt(1),
+		RequestType: sonm.OrderType_ASK,
+		Duration:    3600,
+		Price:       sonm.NewBigIntFromInt(10),
+	}).Return(big.NewInt(5), nil)
+
+	require.NoError(t, m.processPendingChangeRequests(context.Background(), m.AskPlans()["plan"]))
+
+	decisions := m.askPlans["plan"].GetChangeRequestDecisions()
+	require.Len(t, decisions, 3)
+	assert.Equal(t, sonm.NewBigIntFromInt(2), decisions[2].GetId())
+	assert.True(t, decisions[2].GetApproved())
+	assert.Empty(t, decisions[2].GetError())
+
+	// Caught up requests are not scanned again until the watcher resubscribes.
+	assert.Equal(t, uint64(10), m.changeRequestsScanned["1"])
+	require.NoError(t, m.processPendingChangeRequests(context.Background(), m.AskPlans()["plan"]))
+
+	m.changeRequestsBlock = 20
+	market.EXPECT().GetDealChangeRequestsInfo(gomock.Any(), big.NewInt(1), uint64(10)).Return(nil, nil)
+	require.NoError(t, m.processPendingChangeRequests(context.Background(), m.AskPlans()["plan"]))
+	assert.Equal(t, uint64(20), m.changeRequestsScanned["1"])
+
+	// Plans with automatic processing disabled are left alone.
+	m.askPlans["plan"].ChangeRequests.Enabled = false
+	require.NoError(t, m.processPendingChangeRequests(context.Background(), m.AskPlans()["plan"]))
+}
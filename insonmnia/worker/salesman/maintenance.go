@@ -0,0 +1,131 @@
+package salesman
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+)
+
+// maintenance is the persistent state of the maintenance mode. While in
+// maintenance the salesman keeps ask plans, but does not place orders for
+// them, letting the Worker drain.
+type maintenance struct {
+	Since           time.Time     `json:"since"`
+	SpotGracePeriod time.Duration `json:"spotGracePeriod"`
+}
+
+// StartMaintenance switches the salesman to the maintenance mode. Unmatched
+// orders are cancelled on the next sync, forward deals are allowed to reach
+// their end, while spot deals are closed after the grace period.
+func (m *Salesman) StartMaintenance(spotGracePeriod time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.maintenance != nil {
+		return errors.New("worker is already in maintenance mode")
+	}
+
+	state := &maintenance{Since: time.Now(), SpotGracePeriod: spotGracePeriod}
+	if err := m.maintenanceStorage.Save(state); err != nil {
+		return err
+	}
+
+	m.maintenance = state
+	m.log.Infof("started maintenance, spot deals will be closed in %s", spotGracePeriod)
+	return nil
+}
+
+// StopMaintenance switches the salesman back to the normal mode, so orders
+// for ask plans are placed again.
+func (m *Salesman) StopMaintenance() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.maintenance == nil {
+		return errors.New("worker is not in maintenance mode")
+	}
+
+	if err := m.maintenanceStorage.Save(nil); err != nil {
+		return err
+	}
+
+	m.maintenance = nil
+	m.log.Info("stopped maintenance")
+	return nil
+}
+
+// MaintenanceStatus returns the drain progress.
+func (m *Salesman) MaintenanceStatus() *sonm.MaintenanceStatusReply {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reply := &sonm.MaintenanceStatusReply{
+		Orders: []*sonm.BigInt{},
+		Deals:  []*sonm.BigInt{},
+	}
+	if m.maintenance == nil {
+		return reply
+	}
+
+	reply.Enabled = true
+	reply.Since = sonm.NewTimestamp(m.maintenance.Since)
+	reply.SpotGracePeriod = &sonm.Duration{Nanoseconds: int64(m.maintenance.SpotGracePeriod)}
+	for _, plan := range m.askPlans {
+		if !plan.GetDealID().IsZero() {
+			reply.Deals = append(reply.Deals, plan.GetDealID())
+		} else if !plan.GetOrderID().IsZero() {
+			reply.Orders = append(reply.Orders, plan.GetOrderID())
+		}
+	}
+	reply.Drained = len(reply.Orders) == 0 && len(reply.Deals) == 0
+
+	return reply
+}
+
+func (m *Salesman) maintenanceState() *maintenance {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.maintenance == nil {
+		return nil
+	}
+
+	state := *m.maintenance
+	return &state
+}
+
+// cancelPlanOrder cancels the unmatched order of the plan.
+func (m *Salesman) cancelPlanOrder(ctx context.Context, plan *sonm.AskPlan) error {
+	if err := m.eth.Market().CancelOrder(ctx, m.ethkey, plan.GetOrderID().Unwrap()); err != nil {
+		m.log.Infof("could not cancel order - %s, checking order to update info", err)
+		return m.checkOrder(ctx, plan)
+	}
+
+	m.log.Infof("cancelled order %s for ask plan %s because of maintenance", plan.GetOrderID().Unwrap().String(), plan.GetID())
+	return m.assignOrder(plan.ID, nil)
+}
+
+// maybeCloseSpotDeal closes the plan's spot deal if the maintenance grace
+// period has passed. Tasks are notified about preemption in advance.
+func (m *Salesman) maybeCloseSpotDeal(ctx context.Context, plan *sonm.AskPlan, state *maintenance) error {
+	deal, err := m.eth.Market().GetDealInfo(ctx, plan.GetDealID().Unwrap())
+	if err != nil {
+		return err
+	}
+	if !deal.IsSpot() || deal.GetStatus() != sonm.DealStatus_DEAL_ACCEPTED {
+		return nil
+	}
+
+	if deadline := m.preempt(deal, state.Since.Add(state.SpotGracePeriod), "worker maintenance"); time.Now().Before(deadline) {
+		return nil
+	}
+
+	if err := m.eth.Market().CloseDeal(ctx, m.ethkey, deal.GetId().Unwrap(), false); err != nil {
+		return err
+	}
+
+	m.log.Infof("closed spot deal %s for ask plan %s because of maintenance", deal.GetId().Unwrap().String(), plan.GetID())
+	return nil
+}
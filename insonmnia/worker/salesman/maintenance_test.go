@@ -0,0 +1,57 @@
+package salesman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMaintenance(t *testing.T) {
+	storage, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	m := &Salesman{
+		options:            &options{log: zap.NewNop().Sugar()},
+		maintenanceStorage: state.NewKeyedStorage("maintenance", storage),
+		askPlans: map[string]*sonm.AskPlan{
+			"deal":  {ID: "deal", OrderID: sonm.NewBigIntFromInt(1), DealID: sonm.NewBigIntFromInt(2)},
+			"order": {ID: "order", OrderID: sonm.NewBigIntFromInt(3)},
+			"idle":  {ID: "idle"},
+		},
+	}
+
+	assert.False(t, m.MaintenanceStatus().GetEnabled())
+	assert.Error(t, m.StopMaintenance())
+
+	require.NoError(t, m.StartMaintenance(time.Hour))
+	assert.Error(t, m.StartMaintenance(time.Hour))
+
+	status := m.MaintenanceStatus()
+	assert.True(t, status.GetEnabled())
+	assert.False(t, status.GetDrained())
+	assert.Equal(t, time.Hour, status.GetSpotGracePeriod().Unwrap())
+	assert.Equal(t, []*sonm.BigInt{sonm.NewBigIntFromInt(3)}, status.GetOrders())
+	assert.Equal(t, []*sonm.BigInt{sonm.NewBigIntFromInt(2)}, status.GetDeals())
+
+	var restored *maintenance
+	require.NoError(t, m.maintenanceStorage.Load(&restored))
+	require.NotNil(t, restored)
+	assert.Equal(t, time.Hour, restored.SpotGracePeriod)
+
+	m.askPlans["deal"].DealID = nil
+	m.askPlans["deal"].OrderID = nil
+	m.askPlans["order"].OrderID = nil
+	assert.True(t, m.MaintenanceStatus().GetDrained())
+
+	require.NoError(t, m.StopMaintenance())
+	assert.False(t, m.MaintenanceStatus().GetEnabled())
+
+	restored = nil
+	require.NoError(t, m.maintenanceStorage.Load(&restored))
+	assert.Nil(t, restored)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/sonm-io/core/insonmnia/matcher"
 	"github.com/sonm-io/core/insonmnia/resource"
 	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util/multierror"
 	"go.uber.org/zap"
 )
@@ -24,6 +25,7 @@ type options struct {
 	matcher       matcher.Matcher
 	ethkey        *ecdsa.PrivateKey
 	config        *YAMLConfig
+	dwh           sonm.DWHClient
 }
 
 func WithLogger(log *zap.SugaredLogger) Option {
@@ -72,6 +74,15 @@ func WithConfig(config *YAMLConfig) Option {
 		opts.config = config
 	}
 }
+
+// WithDWH sets the DWH client used by pricing strategies following the
+// market. Optional.
+func WithDWH(dwh sonm.DWHClient) Option {
+	return func(opts *options) {
+		opts.dwh = dwh
+	}
+}
+
 func (m *options) Validate() error {
 	err := multierror.NewMultiError()
 
@@ -0,0 +1,66 @@
+package salesman
+
+import (
+	"time"
+
+	"github.com/sonm-io/core/proto"
+)
+
+// Preemption is a notice that the deal is going to be closed by the supplier
+// earlier than the consumer expects, so tasks running on it can save their
+// state.
+type Preemption struct {
+	Deal *sonm.Deal
+	// Deadline is the time the deal will be closed at.
+	Deadline time.Time
+	Reason   string
+}
+
+// Preemptions returns the channel preemption notices are sent to.
+func (m *Salesman) Preemptions() <-chan *Preemption {
+	return m.preemptionsCh
+}
+
+// preempt notifies about the deal going to be closed at the given time,
+// returning the time the deal can actually be closed at. The notice is sent
+// once per deal and gives tasks at least the preemption grace period.
+func (m *Salesman) preempt(deal *sonm.Deal, closeAt time.Time, reason string) time.Time {
+	id := deal.GetId().Unwrap().String()
+
+	m.mu.Lock()
+	deadline, ok := m.preemptions[id]
+	if !ok {
+		deadline = time.Now().Add(m.config.PreemptionGracePeriod)
+		if closeAt.After(deadline) {
+			deadline = closeAt
+		}
+		m.preemptions[id] = deadline
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		m.log.Infof("notifying tasks of deal %s about preemption at %s: %s", id, deadline.Format(time.RFC3339), reason)
+		m.preemptionsCh <- &Preemption{Deal: deal, Deadline: deadline, Reason: reason}
+	}
+
+	return deadline
+}
+
+// preemptionDeadline returns the time the preempted deal can be closed at.
+func (m *Salesman) preemptionDeadline(deal *sonm.Deal) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deadline, ok := m.preemptions[deal.GetId().Unwrap().String()]
+	return deadline, ok
+}
+
+// isShortened returns true if the deal has become shorter than the previous
+// one, which happens when a change request is accepted.
+func isShortened(previous, deal *sonm.Deal) bool {
+	if previous == nil || deal.IsSpot() || deal.GetStatus() != sonm.DealStatus_DEAL_ACCEPTED {
+		return false
+	}
+
+	return previous.IsSpot() || deal.GetDuration() < previous.GetDuration()
+}
@@ -0,0 +1,58 @@
+package salesman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPreempt(t *testing.T) {
+	m := &Salesman{
+		options: &options{
+			log:    zap.NewNop().Sugar(),
+			config: &YAMLConfig{PreemptionGracePeriod: time.Minute},
+		},
+		preemptions:   map[string]time.Time{},
+		preemptionsCh: make(chan *Preemption, 10),
+	}
+
+	deal := &sonm.Deal{Id: sonm.NewBigIntFromInt(1), Status: sonm.DealStatus_DEAL_ACCEPTED}
+
+	// Tasks are given at least the grace period.
+	deadline := m.preempt(deal, time.Now(), "test")
+	assert.True(t, deadline.After(time.Now().Add(time.Minute-time.Second)))
+
+	preemption := <-m.Preemptions()
+	assert.Equal(t, deal, preemption.Deal)
+	assert.Equal(t, deadline, preemption.Deadline)
+	assert.Equal(t, "test", preemption.Reason)
+
+	// The notice is sent once.
+	assert.Equal(t, deadline, m.preempt(deal, time.Now().Add(time.Hour), "test"))
+	assert.Len(t, m.preemptionsCh, 0)
+
+	stored, ok := m.preemptionDeadline(deal)
+	require.True(t, ok)
+	assert.Equal(t, deadline, stored)
+
+	other := &sonm.Deal{Id: sonm.NewBigIntFromInt(2), Status: sonm.DealStatus_DEAL_ACCEPTED}
+	closeAt := time.Now().Add(time.Hour)
+	assert.Equal(t, closeAt, m.preempt(other, closeAt, "test"))
+}
+
+func TestIsShortened(t *testing.T) {
+	deal := &sonm.Deal{Duration: 3600, Status: sonm.DealStatus_DEAL_ACCEPTED}
+
+	assert.False(t, isShortened(nil, deal))
+	assert.False(t, isShortened(&sonm.Deal{Duration: 3600}, deal))
+	assert.False(t, isShortened(&sonm.Deal{Duration: 1800}, deal))
+	assert.True(t, isShortened(&sonm.Deal{Duration: 7200}, deal))
+	assert.False(t, isShortened(&sonm.Deal{}, &sonm.Deal{Status: sonm.DealStatus_DEAL_ACCEPTED}))
+
+	deal.Status = sonm.DealStatus_DEAL_CLOSED
+	assert.False(t, isShortened(&sonm.Deal{Duration: 7200}, deal))
+}
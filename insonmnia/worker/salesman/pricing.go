@@ -0,0 +1,178 @@
+package salesman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/sonm-io/core/proto"
+)
+
+// orderPrice returns the price per second the plan's order should be placed
+// with according to the plan's pricing strategy.
+func (m *Salesman) orderPrice(ctx context.Context, plan *sonm.AskPlan) (*big.Int, error) {
+	price := plan.GetPrice().GetPerSecond().Unwrap()
+	strategy := plan.GetPricing()
+
+	switch strategy.GetType() {
+	case sonm.PricingStrategy_FIXED:
+		return price, nil
+	case sonm.PricingStrategy_MARKET:
+		marketPrice, err := m.marketPrice(ctx, plan)
+		if err != nil {
+			return nil, err
+		}
+		if marketPrice != nil {
+			price = marketPrice
+		}
+	case sonm.PricingStrategy_DECAY:
+		if plan.GetUnmatchedSince() != nil {
+			price = decayPrice(price, strategy, plan.GetUnmatchedSince().Unix(), time.Now())
+		}
+	case sonm.PricingStrategy_USD_PEGGED:
+		rate, err := m.eth.OracleUSD().GetCurrentPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get current SNM/USD rate: %s", err)
+		}
+		price = peggedPrice(price, rate)
+		if price == nil {
+			return nil, errors.New("oracle returned invalid SNM/USD rate")
+		}
+	default:
+		return nil, fmt.Errorf("unknown pricing strategy %s", strategy.GetType())
+	}
+
+	if floor := strategy.GetFloor().GetPerSecond(); !floor.IsZero() && price.Cmp(floor.Unwrap()) < 0 {
+		price = floor.Unwrap()
+	}
+
+	return price, nil
+}
+
+// marketPrice returns the highest price of active BIDs the plan's order
+// could be matched with or nil if there are no such BIDs.
+func (m *Salesman) marketPrice(ctx context.Context, plan *sonm.AskPlan) (*big.Int, error) {
+	if m.dwh == nil {
+		return nil, errors.New("DWH is required to follow the market price")
+	}
+
+	benchmarks, err := m.hardware.ResourcesToBenchmarks(plan.GetResources())
+	if err != nil {
+		return nil, fmt.Errorf("could not get benchmarks for ask plan %s: %s", plan.ID, err)
+	}
+
+	net := plan.GetResources().GetNetwork()
+	request := &sonm.OrdersRequest{
+		Type:   sonm.OrderType_BID,
+		Status: sonm.OrderStatus_ORDER_ACTIVE,
+		CounterpartyID: []*sonm.EthAddress{
+			sonm.NewEthAddress(common.Address{}),
+			sonm.NewEthAddress(crypto.PubkeyToAddress(m.ethkey.PublicKey)),
+		},
+		Duration: &sonm.MaxMinUint64{Max: uint64(plan.GetDuration().Unwrap().Seconds())},
+		Netflags: &sonm.CmpUint64{
+			Value:    sonm.NetflagsToUint([3]bool{net.GetOverlay(), net.GetOutbound(), net.GetIncoming()}),
+			Operator: sonm.CmpOp_LTE,
+		},
+		Benchmarks: map[uint64]*sonm.MaxMinUint64{},
+		Sortings:   []*sonm.SortingOption{{Field: "Price", Order: sonm.SortingOrder_Desc}},
+		Limit:      1,
+	}
+	if !plan.GetCounterparty().IsZero() {
+		request.AuthorID = plan.GetCounterparty()
+	}
+	for id, value := range benchmarks.GetValues() {
+		request.Benchmarks[uint64(id)] = &sonm.MaxMinUint64{Max: value}
+	}
+
+	reply, err := m.dwh.GetOrders(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("could not get comparable orders from DWH: %s", err)
+	}
+	if len(reply.GetOrders()) == 0 {
+		return nil, nil
+	}
+
+	return reply.GetOrders()[0].GetOrder().GetPrice().Unwrap(), nil
+}
+
+// decayPrice decreases the price by the strategy's decay step for each decay
+// period passed since the given time.
+func decayPrice(price *big.Int, strategy *sonm.PricingStrategy, since, now time.Time) *big.Int {
+	period := strategy.GetDecayPeriod().Unwrap()
+	step := strategy.GetDecayStep()
+	if period <= 0 || step == 0 {
+		return price
+	}
+	if step > 100 {
+		step = 100
+	}
+
+	result := new(big.Int).Set(price)
+	multiplier := big.NewInt(int64(100 - step))
+	for steps := now.Sub(since) / period; steps > 0 && result.Sign() > 0; steps-- {
+		result.Mul(result, multiplier)
+		result.Div(result, big.NewInt(100))
+	}
+
+	return result
+}
+
+// peggedPrice converts the price in SNM tokens to USD using the given oracle
+// rate, which is the amount of tokens per USD. Returns nil if the rate is
+// invalid.
+func peggedPrice(price, rate *big.Int) *big.Int {
+	if rate == nil || rate.Sign() <= 0 {
+		return nil
+	}
+
+	result := new(big.Int).Mul(price, big.NewInt(params.Ether))
+	return result.Div(result, rate)
+}
+
+// priceDrifted returns true if the price differs from the current one by
+// more than the given threshold in percents.
+//
+// The threshold is relative, so it can't be applied to the zero price: in
+// this case any other price is considered drifted.
+func priceDrifted(current, price *big.Int, threshold uint64) bool {
+	if current.Sign() == 0 {
+		return price.Sign() != 0
+	}
+
+	diff := new(big.Int).Sub(price, current)
+	diff.Abs(diff)
+	diff.Mul(diff, big.NewInt(100))
+
+	return diff.Cmp(new(big.Int).Mul(current, new(big.Int).SetUint64(threshold))) > 0
+}
+
+// maybeReplaceOrder cancels the plan's active order if the price calculated
+// by the plan's pricing strategy has drifted beyond the threshold. The order
+// is placed again with the new price on the next sync.
+func (m *Salesman) maybeReplaceOrder(ctx context.Context, plan *sonm.AskPlan, order *sonm.Order) error {
+	if plan.GetPricing().GetType() == sonm.PricingStrategy_FIXED {
+		return nil
+	}
+
+	price, err := m.orderPrice(ctx, plan)
+	if err != nil {
+		return fmt.Errorf("could not calculate price for ask plan %s: %s", plan.ID, err)
+	}
+	if !priceDrifted(order.GetPrice().Unwrap(), price, plan.GetPricing().GetThreshold()) {
+		return nil
+	}
+
+	m.log.Infof("re-placing order %s for ask plan %s: price has changed from %s to %s USD/s", order.GetId().Unwrap().String(),
+		plan.ID, order.GetPrice().ToPriceString(), sonm.NewBigInt(price).ToPriceString())
+	if err := m.eth.Market().CancelOrder(ctx, m.ethkey, order.GetId().Unwrap()); err != nil {
+		return fmt.Errorf("could not cancel order %s: %s", order.GetId().Unwrap().String(), err)
+	}
+
+	return m.assignOrder(plan.ID, nil)
+}
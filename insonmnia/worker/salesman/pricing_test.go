@@ -0,0 +1,43 @@
+package salesman
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecayPrice(t *testing.T) {
+	since := time.Now()
+	strategy := &sonm.PricingStrategy{
+		Type:        sonm.PricingStrategy_DECAY,
+		DecayStep:   10,
+		DecayPeriod: &sonm.Duration{Nanoseconds: int64(time.Hour)},
+	}
+
+	assert.Equal(t, big.NewInt(1000), decayPrice(big.NewInt(1000), strategy, since, since.Add(30*time.Minute)))
+	assert.Equal(t, big.NewInt(900), decayPrice(big.NewInt(1000), strategy, since, since.Add(time.Hour)))
+	assert.Equal(t, big.NewInt(810), decayPrice(big.NewInt(1000), strategy, since, since.Add(150*time.Minute)))
+
+	strategy.DecayStep = 200
+	assert.Zero(t, decayPrice(big.NewInt(1000), strategy, since, since.Add(time.Hour)).Sign())
+
+	strategy.DecayPeriod = nil
+	assert.Equal(t, big.NewInt(1000), decayPrice(big.NewInt(1000), strategy, since, since.Add(time.Hour)))
+}
+
+func TestPeggedPrice(t *testing.T) {
+	assert.Equal(t, big.NewInt(500), peggedPrice(big.NewInt(1000), big.NewInt(2e18)))
+	assert.Nil(t, peggedPrice(big.NewInt(1000), big.NewInt(0)))
+}
+
+func TestPriceDrifted(t *testing.T) {
+	assert.False(t, priceDrifted(big.NewInt(1000), big.NewInt(1000), 0))
+	assert.True(t, priceDrifted(big.NewInt(1000), big.NewInt(1001), 0))
+	assert.False(t, priceDrifted(big.NewInt(1000), big.NewInt(1050), 5))
+	assert.True(t, priceDrifted(big.NewInt(1000), big.NewInt(949), 5))
+	assert.False(t, priceDrifted(big.NewInt(0), big.NewInt(0), 5))
+	assert.True(t, priceDrifted(big.NewInt(0), big.NewInt(1), 5))
+}
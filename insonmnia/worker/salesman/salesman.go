@@ -40,19 +40,34 @@ type YAMLConfig struct {
 	SyncStepTimeout      time.Duration `yaml:"sync_step_timeout" default:"2m"`
 	SyncInterval         time.Duration `yaml:"sync_interval" default:"10s"`
 	MatcherRetryInterval time.Duration `yaml:"matcher_retry_interval" default:"10s"`
+	// PreemptionGracePeriod is the minimum time given to tasks between the
+	// preemption notice and closing their deal.
+	PreemptionGracePeriod time.Duration `yaml:"preemption_grace_period" default:"5m"`
 }
 
 type Salesman struct {
 	*options
-	askPlanStorage *state.KeyedStorage
+	askPlanStorage     *state.KeyedStorage
+	maintenanceStorage *state.KeyedStorage
 
 	askPlans       map[string]*sonm.AskPlan
 	askPlanCGroups map[string]cgroups.CGroup
 	deals          map[string]*sonm.Deal
 	orders         map[string]*sonm.Order
-
-	dealsCh chan *sonm.Deal
-	mu      sync.Mutex
+	maintenance    *maintenance
+	preemptions    map[string]time.Time
+
+	// changeRequestsBlock is the block the change requests watcher has
+	// been subscribed from, requests sent before it are caught up with by
+	// the sync routine. changeRequestsScanned maps deals into blocks their
+	// requests have been caught up with to.
+	changeRequestsBlock   uint64
+	changeRequestsScanned map[string]uint64
+
+	dealsCh          chan *sonm.Deal
+	preemptionsCh    chan *Preemption
+	changeRequestsMu sync.Mutex
+	mu               sync.Mutex
 }
 
 func NewSalesman(opts ...Option) (*Salesman, error) {
@@ -65,12 +80,16 @@ func NewSalesman(opts ...Option) (*Salesman, error) {
 	}
 
 	s := &Salesman{
-		options:        o,
-		askPlanStorage: state.NewKeyedStorage("ask_plans", o.storage),
-		askPlanCGroups: map[string]cgroups.CGroup{},
-		deals:          map[string]*sonm.Deal{},
-		orders:         map[string]*sonm.Order{},
-		dealsCh:        make(chan *sonm.Deal, 100),
+		options:               o,
+		askPlanStorage:        state.NewKeyedStorage("ask_plans", o.storage),
+		maintenanceStorage:    state.NewKeyedStorage("maintenance", o.storage),
+		askPlanCGroups:        map[string]cgroups.CGroup{},
+		deals:                 map[string]*sonm.Deal{},
+		orders:                map[string]*sonm.Order{},
+		preemptions:           map[string]time.Time{},
+		changeRequestsScanned: map[string]uint64{},
+		dealsCh:               make(chan *sonm.Deal, 100),
+		preemptionsCh:         make(chan *Preemption, 100),
 	}
 
 	if err := s.restoreState(); err != nil {
@@ -96,6 +115,7 @@ func (m *Salesman) Run(ctx context.Context) <-chan *sonm.Deal {
 			}
 		}
 		go m.syncRoutine(ctx)
+		go m.watchChangeRequests(ctx)
 	}()
 	return m.dealsCh
 }
@@ -121,6 +141,8 @@ func (m *Salesman) AskPlans() map[string]*sonm.AskPlan {
 func (m *Salesman) CreateAskPlan(askPlan *sonm.AskPlan) (string, error) {
 	id := uuid.New()
 	askPlan.ID = id
+	askPlan.ChangeRequestDecisions = nil
+	askPlan.UnmatchedSince = nil
 	if err := askPlan.GetResources().GetGPU().Normalize(m.hardware); err != nil {
 		return "", err
 	}
@@ -175,6 +197,10 @@ func (m *Salesman) maybeShutdownAskPlan(ctx context.Context, plan *sonm.AskPlan)
 		}
 		if dealInfo.Status == sonm.DealStatus_DEAL_ACCEPTED {
 			if dealInfo.GetDuration() == 0 {
+				if deadline := m.preempt(dealInfo, time.Now(), "ask plan is removed"); time.Now().Before(deadline) {
+					m.log.Debugf("spot deal %s for ask plan %s is preempted, waiting for tasks until %s", dealInfo.GetId(), plan.GetID(), deadline)
+					return nil
+				}
 				m.log.Infof("closing spot deal %s for ask plan %s", dealInfo.GetId(), plan.GetID())
 				if err := m.eth.Market().CloseDeal(ctx, m.ethkey, plan.GetDealID().Unwrap(), false); err != nil {
 					return err
@@ -257,6 +283,7 @@ func (m *Salesman) syncRoutine(ctx context.Context) {
 func (m *Salesman) syncWithBlockchain(ctx context.Context) {
 	m.log.Debugf("syncing salesman with blockchain")
 	plans := m.AskPlans()
+	drain := m.maintenanceState()
 	for _, plan := range plans {
 		orderId := plan.GetOrderID()
 		dealId := plan.GetDealID()
@@ -268,12 +295,22 @@ func (m *Salesman) syncWithBlockchain(ctx context.Context) {
 		} else if !dealId.IsZero() {
 			if err := m.loadCheckDeal(ctxWithTimeout, plan); err != nil {
 				m.log.Warnf("could not check deal %s for plan %s: %s", dealId.Unwrap().String(), plan.ID, err)
+			} else if err := m.processPendingChangeRequests(ctxWithTimeout, plan); err != nil {
+				m.log.Warnf("could not process change requests for deal %s of plan %s: %s", dealId.Unwrap().String(), plan.ID, err)
+			} else if drain != nil {
+				if err := m.maybeCloseSpotDeal(ctxWithTimeout, plan, drain); err != nil {
+					m.log.Warnf("could not close spot deal %s for plan %s: %s", dealId.Unwrap().String(), plan.ID, err)
+				}
 			}
 		} else if !orderId.IsZero() {
-			if err := m.checkOrder(ctxWithTimeout, plan); err != nil {
+			if drain != nil {
+				if err := m.cancelPlanOrder(ctxWithTimeout, plan); err != nil {
+					m.log.Warnf("could not cancel order %s for plan %s: %s", orderId.Unwrap().String(), plan.ID, err)
+				}
+			} else if err := m.checkOrder(ctxWithTimeout, plan); err != nil {
 				m.log.Warnf("could not check order %s for plan %s: %s", orderId.Unwrap().String(), plan.ID, err)
 			}
-		} else {
+		} else if drain == nil {
 			order, err := m.placeOrder(ctxWithTimeout, plan)
 			if err != nil {
 				m.log.Warnf("could not place order for plan %s: %s", plan.ID, err)
@@ -290,6 +327,9 @@ func (m *Salesman) restoreState() error {
 	if err := m.askPlanStorage.Load(&m.askPlans); err != nil {
 		return fmt.Errorf("could not restore salesman state: %s", err)
 	}
+	if err := m.maintenanceStorage.Load(&m.maintenance); err != nil {
+		return fmt.Errorf("could not restore maintenance state: %s", err)
+	}
 	for _, plan := range m.askPlans {
 		if err := m.resources.Consume(plan); err != nil {
 			m.log.Warnf("dropping ask plan due to resource changes")
@@ -303,7 +343,6 @@ func (m *Salesman) restoreState() error {
 			}
 		}
 	}
-	//TODO: restore tasks
 	return nil
 }
 
@@ -397,6 +436,9 @@ func (m *Salesman) maybeBillDeal(ctx context.Context, deal *sonm.Deal) error {
 func (m *Salesman) maybeCloseDeal(ctx context.Context, deal *sonm.Deal) error {
 	if deal.GetDuration() != 0 {
 		endTime := deal.GetStartTime().Unix().Add(time.Second * time.Duration(deal.GetDuration()))
+		if deadline, ok := m.preemptionDeadline(deal); ok && deadline.After(endTime) {
+			endTime = deadline
+		}
 		if time.Now().After(endTime) {
 			if err := m.eth.Market().CloseDeal(ctx, m.ethkey, deal.GetId().Unwrap(), false); err != nil {
 				return err
@@ -430,19 +472,25 @@ func (m *Salesman) registerDeal(deal *sonm.Deal) {
 	m.dealsCh <- deal
 	id := deal.GetId().Unwrap().String()
 	m.mu.Lock()
-	defer m.mu.Unlock()
-	_, has := m.deals[id]
+	previous, has := m.deals[id]
 	if deal.Status == sonm.DealStatus_DEAL_ACCEPTED {
+		m.deals[id] = deal
 		if !has {
-			m.deals[id] = deal
 			m.log.Infof("registered deal %s", deal.GetId().Unwrap().String())
 		}
 	} else {
+		delete(m.preemptions, id)
 		if has {
 			delete(m.deals, id)
 			m.log.Infof("unregistered deal %s", deal.GetId().Unwrap().String())
 		}
 	}
+	m.mu.Unlock()
+
+	if isShortened(previous, deal) {
+		endTime := deal.GetStartTime().Unix().Add(time.Duration(deal.GetDuration()) * time.Second)
+		m.preempt(deal, endTime, "deal is shortened by change request")
+	}
 }
 
 func (m *Salesman) assignDeal(planID string, dealID *sonm.BigInt) error {
@@ -453,6 +501,9 @@ func (m *Salesman) assignDeal(planID string, dealID *sonm.BigInt) error {
 		return fmt.Errorf("could not assign deal %s to plan %s: no such plan", dealID.Unwrap().String(), planID)
 	}
 	plan.DealID = dealID
+	if dealID != nil {
+		plan.UnmatchedSince = nil
+	}
 	if err := m.askPlanStorage.Save(m.askPlans); err != nil {
 		return err
 	}
@@ -468,6 +519,9 @@ func (m *Salesman) assignOrder(planID string, orderID *sonm.BigInt) error {
 		return fmt.Errorf("could not assign order %s to plan %s: no such plan", orderID.Unwrap().String(), planID)
 	}
 	plan.OrderID = orderID
+	if orderID != nil && plan.UnmatchedSince == nil {
+		plan.UnmatchedSince = sonm.NewTimestamp(time.Now())
+	}
 	if err := m.askPlanStorage.Save(m.askPlans); err != nil {
 		return err
 	}
@@ -494,7 +548,7 @@ func (m *Salesman) checkOrder(ctx context.Context, plan *sonm.AskPlan) error {
 	} else if order.OrderStatus != sonm.OrderStatus_ORDER_ACTIVE {
 		return m.assignOrder(plan.ID, nil)
 	}
-	return nil
+	return m.maybeReplaceOrder(ctx, plan, order)
 }
 
 func (m *Salesman) placeOrder(ctx context.Context, plan *sonm.AskPlan) (*sonm.Order, error) {
@@ -503,6 +557,11 @@ func (m *Salesman) placeOrder(ctx context.Context, plan *sonm.AskPlan) (*sonm.Or
 		return nil, fmt.Errorf("could not get benchmarks for ask plan %s: %s", plan.ID, err)
 	}
 
+	price, err := m.orderPrice(ctx, plan)
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate price for ask plan %s: %s", plan.ID, err)
+	}
+
 	net := plan.GetResources().GetNetwork()
 	order := &sonm.Order{
 		OrderType:      sonm.OrderType_ASK,
@@ -510,7 +569,7 @@ func (m *Salesman) placeOrder(ctx context.Context, plan *sonm.AskPlan) (*sonm.Or
 		AuthorID:       sonm.NewEthAddress(crypto.PubkeyToAddress(m.ethkey.PublicKey)),
 		CounterpartyID: plan.GetCounterparty(),
 		Duration:       uint64(plan.GetDuration().Unwrap().Seconds()),
-		Price:          plan.GetPrice().GetPerSecond(),
+		Price:          sonm.NewBigInt(price),
 		//TODO:refactor NetFlags in separqate PR
 		Netflags:      sonm.NetflagsToUint([3]bool{net.GetOverlay(), net.GetOutbound(), net.GetIncoming()}),
 		IdentityLevel: plan.GetIdentity(),
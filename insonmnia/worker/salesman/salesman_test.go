@@ -0,0 +1,28 @@
+package salesman
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorage(t *testing.T) (*state.Storage, func()) {
+	dir, err := ioutil.TempDir("", "sonm-salesman-test")
+	require.NoError(t, err)
+
+	storage, err := state.NewState(context.Background(), &state.StorageConfig{
+		Endpoint: filepath.Join(dir, "worker.boltdb"),
+		Bucket:   "sonm",
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+	}
+	require.NoError(t, err)
+
+	return storage, func() { os.RemoveAll(dir) }
+}
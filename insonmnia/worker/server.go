@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/docker/distribution/reference"
-	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 	"github.com/ethereum/go-ethereum/common"
@@ -36,6 +35,7 @@ import (
 	bm "github.com/sonm-io/core/insonmnia/benchmarks"
 	"github.com/sonm-io/core/insonmnia/hardware"
 	"github.com/sonm-io/core/insonmnia/resource"
+	"github.com/sonm-io/core/insonmnia/state"
 	"github.com/sonm-io/core/insonmnia/structs"
 	"github.com/sonm-io/core/insonmnia/worker/volume"
 	pb "github.com/sonm-io/core/proto"
@@ -62,6 +62,9 @@ var (
 		workerAPIPrefix + "CreateAskPlan",
 		workerAPIPrefix + "RemoveAskPlan",
 		workerAPIPrefix + "PurgeAskPlans",
+		workerAPIPrefix + "StartMaintenance",
+		workerAPIPrefix + "MaintenanceStatus",
+		workerAPIPrefix + "StopMaintenance",
 	}
 )
 
@@ -79,6 +82,14 @@ type Worker struct {
 	// Maps StartRequest's IDs to containers' IDs
 	// TODO: It's doubtful that we should keep this map here instead in the Overseer.
 	containers map[string]*ContainerInfo
+	// Persistent storage for containers bookkeeping.
+	taskStorage *state.KeyedStorage
+	// Persistent storage for images committed from tasks of closed deals.
+	committedImages *state.KeyedStorage
+	// Task lifecycle events subscriptions.
+	taskEvents *taskEventBroker
+	// Persistent storage for tasks' output.
+	taskLogs *taskLogStore
 
 	controlGroup  cgroups.CGroup
 	cGroupManager cgroups.CGroupManager
@@ -94,8 +105,11 @@ func NewWorker(opts ...Option) (m *Worker, err error) {
 	}
 
 	m = &Worker{
-		options:    o,
-		containers: make(map[string]*ContainerInfo),
+		options:         o,
+		containers:      make(map[string]*ContainerInfo),
+		taskStorage:     state.NewKeyedStorage(tasksStorageKey, o.storage),
+		committedImages: state.NewKeyedStorage(committedImagesStorageKey, o.storage),
+		taskEvents:      newTaskEventBroker(),
 	}
 
 	if err := m.SetupDefaults(); err != nil {
@@ -108,6 +122,16 @@ func NewWorker(opts ...Option) (m *Worker, err error) {
 		return nil, err
 	}
 
+	if err := m.setupTaskLogs(); err != nil {
+		m.Close()
+		return nil, err
+	}
+
+	if err := m.setupCommittedImages(); err != nil {
+		m.Close()
+		return nil, err
+	}
+
 	if err := m.setupAuthorization(); err != nil {
 		m.Close()
 		return nil, err
@@ -255,9 +279,30 @@ func (m *Worker) setupAuthorization() error {
 		auth.Allow(taskAPIPrefix+"StartTask").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
 			return structs.DealID(request.(*pb.StartTaskRequest).GetDealID().Unwrap().String()), nil
 		}))),
-		auth.Allow(taskAPIPrefix+"TaskLogs").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"StartTaskGroup").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
+			return structs.DealID(request.(*pb.StartTaskGroupRequest).GetDealID().Unwrap().String()), nil
+		}))),
+		auth.Allow(taskAPIPrefix+"StopTaskGroup").With(newDealAuthorization(m.ctx, m, newFromTaskGroupDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"TaskGroupStatus").With(newAnyOfAuth(
+			managementAuth,
+			newDealAuthorization(m.ctx, m, newFromTaskGroupDealExtractor(m)),
+		)),
+		auth.Allow(taskAPIPrefix+"TaskLogs").With(newAnyOfAuth(
+			newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m)),
+			newTaskLogsAuthorization(m.taskLogs),
+		)),
+		auth.Allow(taskAPIPrefix+"TaskEvents").With(newAnyOfAuth(
+			managementAuth,
+			newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
+				dealID := request.(*pb.TaskEventsRequest).GetDealID()
+				if dealID.IsZero() {
+					return "", errors.New("deal ID is required")
+				}
+				return structs.DealID(dealID.Unwrap().String()), nil
+			})),
+		)),
 		auth.Allow(taskAPIPrefix+"PushTask").With(newDealAuthorization(m.ctx, m, newContextDealExtractor())),
-		auth.Allow(taskAPIPrefix+"PullTask").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
+		auth.Allow(taskAPIPrefix+"PullTask").With(newDealAuthorization(m.ctx, &committedImagesDealInfo{worker: m}, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
 			return structs.DealID(request.(*pb.PullTaskRequest).DealId), nil
 		}))),
 		auth.Allow(taskAPIPrefix+"GetDealInfo").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
@@ -310,11 +355,13 @@ func (m *Worker) setupHardware() error {
 	return nil
 }
 
-func (m *Worker) listenDeals(dealsCh <-chan *pb.Deal) {
+func (m *Worker) listenDeals(dealsCh <-chan *pb.Deal, preemptionsCh <-chan *salesman.Preemption) {
 	for {
 		select {
 		case <-m.ctx.Done():
 			return
+		case preemption := <-preemptionsCh:
+			go m.preemptDealTasks(preemption)
 		case deal := <-dealsCh:
 			if deal.Status == pb.DealStatus_DEAL_CLOSED {
 				if err := m.cancelDealTasks(deal); err != nil {
@@ -327,23 +374,42 @@ func (m *Worker) listenDeals(dealsCh <-chan *pb.Deal) {
 
 func (m *Worker) cancelDealTasks(deal *pb.Deal) error {
 	dealID := deal.GetId().Unwrap().String()
-	var toDelete []*ContainerInfo
+	toDelete := map[string]*ContainerInfo{}
+	result := multierror.NewMultiError()
 
 	m.mu.Lock()
 	for key, container := range m.containers {
 		if container.DealID == dealID {
-			toDelete = append(toDelete, container)
+			toDelete[key] = container
 			delete(m.containers, key)
 		}
 	}
+	m.saveTasks()
+	// Images committed on stop outlive the deal, so its consumer is still
+	// able to pull them.
+	if err := m.saveCommittedImages(deal, toDelete); err != nil {
+		result = multierror.Append(result, err)
+	}
 	m.mu.Unlock()
 
-	result := multierror.NewMultiError()
 	for _, container := range toDelete {
 		if err := m.ovs.OnDealFinish(m.ctx, container.ID); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
+
+	// Deal-scoped volumes are wiped after the deal is closed, no matter
+	// whether its tasks are still running or have been stopped earlier.
+	if m.plugins != nil {
+		if err := m.plugins.RemoveDealVolumes(dealID); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if err := m.taskLogs.Finish(dealID, time.Now()); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	return result.ErrorOrNil()
 }
 
@@ -351,7 +417,121 @@ func (m *Worker) saveContainerInfo(id string, info ContainerInfo) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	prev, ok := m.containers[id]
 	m.containers[id] = &info
+	m.saveTasks()
+
+	if !ok || prev.status != info.status {
+		m.taskEvents.Publish(newTaskEvent(id, info.DealID, ContainerStatus{Status: info.status}))
+	}
+}
+
+// saveTasks dumps containers bookkeeping into the persistent storage.
+//
+// Must be called with the mutex held.
+func (m *Worker) saveTasks() {
+	records := make(map[string]*taskRecord, len(m.containers))
+	for id, info := range m.containers {
+		records[id] = newTaskRecord(info)
+	}
+
+	if err := m.taskStorage.Save(records); err != nil {
+		log.S(m.ctx).Warnf("failed to save tasks state: %s", err)
+	}
+}
+
+// restoreTasks loads containers bookkeeping from the persistent storage and
+// matches it against containers that are still alive. Tasks that can not be
+// restored are marked as broken.
+func (m *Worker) restoreTasks() error {
+	records := map[string]*taskRecord{}
+	if err := m.taskStorage.Load(&records); err != nil {
+		return fmt.Errorf("could not restore tasks state: %s", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, record := range records {
+		info, err := record.IntoContainerInfo()
+		if err != nil {
+			log.S(m.ctx).Warnf("dropping task %s due to malformed state: %s", id, err)
+			continue
+		}
+
+		m.containers[id] = info
+
+		if !isTaskAlive(info.status) {
+			continue
+		}
+
+		if err := m.restoreTask(id, info); err != nil {
+			log.S(m.ctx).Warnf("could not restore task %s, marking it as broken: %s", id, err)
+			info.status = pb.TaskStatusReply_BROKEN
+			continue
+		}
+
+		log.S(m.ctx).Infof("restored task %s for deal %s", id, info.DealID)
+	}
+
+	m.saveTasks()
+	return nil
+}
+
+func (m *Worker) restoreTask(id string, info *ContainerInfo) error {
+	if len(info.ID) == 0 {
+		return errors.New("task has no associated container")
+	}
+
+	dealID, err := pb.NewBigIntFromString(info.DealID)
+	if err != nil {
+		return err
+	}
+
+	ask, err := m.salesman.AskPlanByDeal(dealID)
+	if err != nil {
+		return err
+	}
+
+	ref, err := reference.ParseNormalizedNamed(info.ImageName)
+	if err != nil {
+		return err
+	}
+
+	if info.Resources == nil {
+		info.Resources = &pb.AskPlanResources{}
+	}
+
+	if err := m.resources.ConsumeTask(ask.ID, id, info.Resources); err != nil {
+		return err
+	}
+
+	d := Description{
+		Reference:    ref,
+		Resources:    info.Resources,
+		DealId:       info.DealID,
+		TaskId:       id,
+		CommitOnStop: info.CommitOnStop,
+		GPUDevices:   info.GPUDevices,
+		Preemption:   info.Preemption,
+
+		volumes:       info.Volumes,
+		mounts:        info.Mounts,
+		networkIDs:    info.NetworkIDs,
+		sharedVolumes: info.GroupVolumes,
+	}
+
+	statusListener, err := m.ovs.Attach(m.ctx, info.ID, d)
+	if err != nil {
+		m.resources.ReleaseTask(id)
+		return err
+	}
+
+	info.AskID = ask.ID
+	go m.listenForStatus(statusListener, id)
+	m.startTaskLogs(id, *info)
+
+	return nil
 }
 
 func (m *Worker) GetContainerInfo(id string) (*ContainerInfo, bool) {
@@ -415,29 +595,46 @@ func (m *Worker) FreeDevices(ctx context.Context, request *pb.Empty) (*pb.Device
 }
 
 func (m *Worker) setStatus(status *pb.TaskStatusReply, id string) {
+	m.updateStatus(id, "", ContainerStatus{Status: status.GetStatus()})
+}
+
+// updateStatus records the task status transition and notifies task events
+// subscribers about it.
+//
+// The given deal ID is assigned to tasks that are not registered yet.
+func (m *Worker) updateStatus(id string, dealID string, status ContainerStatus) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	_, ok := m.containers[id]
+	info, ok := m.containers[id]
 	if !ok {
-		m.containers[id] = &ContainerInfo{}
+		info = &ContainerInfo{DealID: dealID}
+		m.containers[id] = info
 	}
 
-	m.containers[id].status = status.GetStatus()
+	changed := info.status != status.Status || status.Restarted
+	info.status = status.Status
 	if status.Status == pb.TaskStatusReply_BROKEN || status.Status == pb.TaskStatusReply_FINISHED {
 		m.resources.ReleaseTask(id)
 	}
+	m.saveTasks()
+
+	if changed {
+		m.taskEvents.Publish(newTaskEvent(id, info.DealID, status))
+	}
 }
 
-func (m *Worker) listenForStatus(statusListener chan pb.TaskStatusReply_Status, id string) {
-	select {
-	case newStatus, ok := <-statusListener:
-		if !ok {
+func (m *Worker) listenForStatus(statusListener chan ContainerStatus, id string) {
+	for {
+		select {
+		case newStatus, ok := <-statusListener:
+			if !ok {
+				return
+			}
+			m.updateStatus(id, "", newStatus)
+		case <-m.ctx.Done():
 			return
 		}
-		m.setStatus(&pb.TaskStatusReply{Status: newStatus}, id)
-	case <-m.ctx.Done():
-		return
 	}
 }
 
@@ -472,25 +669,18 @@ func (m *Worker) PullTask(request *pb.PullTaskRequest, stream pb.Worker_PullTask
 
 	ctx := log.WithLogger(m.ctx, log.G(m.ctx).With(zap.String("request", "pull task"), zap.String("id", uuid.New())))
 
-	task, err := m.TaskStatus(ctx, &pb.ID{Id: request.GetTaskId()})
+	imageName, err := m.pullableImage(request.GetDealId(), request.GetTaskId())
 	if err != nil {
 		log.G(m.ctx).Warn("could not fetch task history by deal", zap.Error(err))
 		return err
 	}
 
-	named, err := reference.ParseNormalizedNamed(task.GetImageName())
+	imageID, err := committedImageRef(imageName, request.GetDealId(), request.GetTaskId())
 	if err != nil {
-		log.G(m.ctx).Warn("could not parse image to reference", zap.Error(err), zap.String("image", task.GetImageName()))
+		log.G(m.ctx).Warn("could not tag image", zap.Error(err), zap.String("image", imageName))
 		return err
 	}
 
-	tagged, err := reference.WithTag(named, fmt.Sprintf("%s_%s", request.GetDealId(), request.GetTaskId()))
-	if err != nil {
-		log.G(m.ctx).Warn("could not tag image", zap.Error(err), zap.String("image", task.GetImageName()))
-		return err
-	}
-	imageID := tagged.String()
-
 	log.G(ctx).Debug("pulling image", zap.String("imageID", imageID))
 
 	info, rd, err := m.ovs.Save(stream.Context(), imageID)
@@ -520,38 +710,35 @@ func (m *Worker) PullTask(request *pb.PullTaskRequest, stream pb.Worker_PullTask
 	return nil
 }
 
-func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*pb.StartTaskReply, error) {
+func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (reply *pb.StartTaskReply, err error) {
 	log.G(m.ctx).Info("handling StartTask request", zap.Any("request", request))
 
 	spec := request.GetSpec()
-	registry := spec.GetRegistry()
-	image := spec.GetContainer().GetImage()
-	allowed, reference, err := m.whitelist.Allowed(ctx, image, registry.Auth())
+	reference, err := m.allowedImage(ctx, spec)
 	if err != nil {
 		return nil, err
 	}
 
-	if !allowed {
-		return nil, status.Errorf(codes.PermissionDenied, "specified image is forbidden to run")
-	}
-
-	taskID := uuid.New()
-
 	dealID := request.GetDealID()
-	ask, err := m.salesman.AskPlanByDeal(dealID)
+	taskID, reply, err := m.reserveTask(dealID.Unwrap().String(), request.GetTaskID())
 	if err != nil {
 		return nil, err
 	}
+	if reply != nil {
+		log.G(ctx).Info("task is already started", zap.String("task_id", taskID))
+		return reply, nil
+	}
+	defer func() {
+		if err != nil {
+			m.releaseTaskReservation(taskID)
+		}
+	}()
 
-	cgroup, err := m.salesman.CGroup(ask.ID)
+	ask, err := m.salesman.AskPlanByDeal(dealID)
 	if err != nil {
 		return nil, err
 	}
 
-	publicKey, err := parsePublicKey(spec.Container.SshKey)
-	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid public key provided %v", err)
-	}
 	if spec.GetResources() == nil {
 		spec.Resources = &pb.AskPlanResources{}
 	}
@@ -563,7 +750,7 @@ func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*
 	err = spec.GetResources().GetGPU().Normalize(hasher)
 	if err != nil {
 		log.G(ctx).Error("could not normalize GPU resources", zap.Error(err))
-		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+		m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_BROKEN})
 		return nil, status.Errorf(codes.Internal, "could not normalize GPU resources: %s", err)
 	}
 
@@ -572,6 +759,96 @@ func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*
 		return nil, fmt.Errorf("could not start task: %s", err)
 	}
 
+	return m.startTask(ctx, taskID, dealID, ask, reference, spec, nil)
+}
+
+// reserveTask reserves the ID for the task to be started for the given deal.
+//
+// An empty requested ID is replaced with a random one. When the task with the
+// requested ID is already started for the same deal its reply is returned
+// instead, so a retried StartTask request does not start the task twice.
+// Broken tasks are allowed to be started again under the same ID.
+func (m *Worker) reserveTask(dealID, taskID string) (string, *pb.StartTaskReply, error) {
+	if taskID == "" {
+		taskID = uuid.New()
+	} else if uuid.Parse(taskID) == nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, "task id %s is not a valid UUID", taskID)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info, ok := m.containers[taskID]
+	if !ok {
+		m.containers[taskID] = &ContainerInfo{DealID: dealID}
+		return taskID, nil, nil
+	}
+
+	if info.DealID != dealID {
+		return "", nil, status.Errorf(codes.AlreadyExists, "task %s belongs to another deal", taskID)
+	}
+
+	switch info.status {
+	case pb.TaskStatusReply_RUNNING, pb.TaskStatusReply_FINISHED:
+		return taskID, &pb.StartTaskReply{
+			Id:         taskID,
+			PortMap:    info.IntoProto(m.ctx).GetPortMap(),
+			NetworkIDs: info.NetworkIDs,
+		}, nil
+	case pb.TaskStatusReply_BROKEN:
+		m.containers[taskID] = &ContainerInfo{DealID: dealID}
+		return taskID, nil, nil
+	default:
+		return "", nil, status.Errorf(codes.Unavailable, "task %s is being started", taskID)
+	}
+}
+
+// releaseTaskReservation forgets the reserved task ID if the task has failed
+// to start before any status has been assigned to it.
+func (m *Worker) releaseTaskReservation(taskID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if info, ok := m.containers[taskID]; ok && info.status == pb.TaskStatusReply_UNKNOWN {
+		delete(m.containers, taskID)
+	}
+}
+
+// allowedImage checks whether the image of the given task spec is allowed
+// to run on this worker.
+func (m *Worker) allowedImage(ctx context.Context, spec *pb.TaskSpec) (reference.Named, error) {
+	registry := spec.GetRegistry()
+	image := spec.GetContainer().GetImage()
+	allowed, reference, err := m.whitelist.Allowed(ctx, image, registry.Auth())
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "specified image is forbidden to run")
+	}
+
+	return reference, nil
+}
+
+// startTask spools and starts a container for the task with already
+// consumed resources.
+//
+// Resources are released if the task can not be started. The group is
+// optional and describes the task group settings, if any.
+func (m *Worker) startTask(ctx context.Context, taskID string, dealID *pb.BigInt, ask *pb.AskPlan, reference reference.Named, spec *pb.TaskSpec, group *taskGroupMember) (*pb.StartTaskReply, error) {
+	cgroup, err := m.salesman.CGroup(ask.ID)
+	if err != nil {
+		m.resources.ReleaseTask(taskID)
+		return nil, err
+	}
+
+	publicKey, err := parsePublicKey(spec.Container.SshKey)
+	if err != nil {
+		m.resources.ReleaseTask(taskID)
+		return nil, status.Errorf(codes.Unauthenticated, "invalid public key provided %v", err)
+	}
+
 	// This can be canceled by using "resourceHandle.commit()".
 	//defer resourceHandle.release()
 
@@ -588,19 +865,19 @@ func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*
 	networks, err := structs.NewNetworkSpecs(spec.Container.Networks)
 	if err != nil {
 		log.G(ctx).Error("failed to parse networking specification", zap.Error(err))
-		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+		m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_BROKEN})
 		return nil, status.Errorf(codes.Internal, "failed to parse networking specification: %s", err)
 	}
 	gpuids, err := m.hardware.GPUIDs(spec.GetResources().GetGPU())
 	if err != nil {
 		log.G(ctx).Error("failed to fetch GPU IDs ", zap.Error(err))
-		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+		m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_BROKEN})
 		return nil, status.Errorf(codes.Internal, "failed to fetch GPU IDs: %s", err)
 	}
 
 	if len(spec.GetContainer().GetExpose()) > 0 {
 		if !ask.GetResources().GetNetwork().GetIncoming() {
-			m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+			m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_BROKEN})
 			return nil, fmt.Errorf("incoming network is required due to explicit `expose` settings, but not allowed for `%s` deal", dealID.Unwrap())
 		}
 	}
@@ -611,38 +888,64 @@ func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*
 		RestartPolicy: spec.Container.RestartPolicy.Unwrap(),
 		CGroupParent:  cgroup.Suffix(),
 		Resources:     spec.Resources,
-		DealId:        request.GetDealID().Unwrap().String(),
+		DealId:        dealID.Unwrap().String(),
 		TaskId:        taskID,
 		CommitOnStop:  spec.Container.CommitOnStop,
 		GPUDevices:    gpuids,
+		Cmd:           spec.Container.Command,
+		Entrypoint:    spec.Container.Entrypoint,
+		WorkingDir:    spec.Container.WorkingDir,
+		User:          spec.Container.User,
+		Labels:        spec.Container.Labels,
+		Hostname:      spec.Container.Hostname,
+		Healthcheck:   spec.Container.Healthcheck,
+		Preemption:    spec.Container.Preemption,
 		Env:           spec.Container.Env,
 		volumes:       spec.Container.Volumes,
 		mounts:        mounts,
 		networks:      networks,
+		storageQuota:  ask.GetResources().GetStorage().GetSize().GetBytes(),
+	}
+
+	if group != nil {
+		d.networkMode = group.networkMode
+		d.sharedVolumes = group.volumes
 	}
 
 	// TODO: Detect whether it's the first time allocation. If so - release resources on error.
 
-	m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_SPOOLING}, taskID)
+	m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_SPOOLING})
 	log.G(m.ctx).Info("spooling an image")
 	if err := m.ovs.Spool(ctx, d); err != nil {
 		log.G(ctx).Error("failed to Spool an image", zap.Error(err))
-		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+		m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_BROKEN})
 		return nil, status.Errorf(codes.Internal, "failed to Spool %v", err)
 	}
 
-	m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_SPAWNING}, taskID)
+	m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_SPAWNING})
 	log.G(m.ctx).Info("spawning an image")
 	statusListener, containerInfo, err := m.ovs.Start(m.ctx, d)
 	if err != nil {
 		log.G(ctx).Error("failed to spawn an image", zap.Error(err))
-		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+		m.updateStatus(taskID, dealID.Unwrap().String(), ContainerStatus{Status: pb.TaskStatusReply_BROKEN})
 		return nil, status.Errorf(codes.Internal, "failed to Spawn %v", err)
 	}
 	containerInfo.PublicKey = publicKey
 	containerInfo.StartAt = time.Now()
 	containerInfo.ImageName = reference.String()
 	containerInfo.DealID = dealID.Unwrap().String()
+	containerInfo.AskID = ask.ID
+	containerInfo.Resources = spec.Resources
+	containerInfo.GPUDevices = gpuids
+	containerInfo.CommitOnStop = spec.Container.CommitOnStop
+	containerInfo.RegistryAuth = spec.Registry.Auth()
+	containerInfo.Volumes = spec.Container.Volumes
+	containerInfo.Mounts = mounts
+	containerInfo.Preemption = spec.Container.Preemption
+	if group != nil {
+		containerInfo.GroupID = group.ID
+		containerInfo.GroupVolumes = group.volumes
+	}
 
 	var reply = pb.StartTaskReply{
 		Id:         taskID,
@@ -684,6 +987,7 @@ func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*
 	m.saveContainerInfo(taskID, containerInfo)
 
 	go m.listenForStatus(statusListener, taskID)
+	m.startTaskLogs(taskID, containerInfo)
 
 	return &reply, nil
 }
@@ -737,18 +1041,41 @@ func (m *Worker) CollectTasksStatuses(statuses ...pb.TaskStatusReply_Status) map
 	return result
 }
 
-// TaskLogs returns logs from container
+// TaskLogs returns logs from container.
+//
+// Logs of running tasks are streamed directly from the container runtime
+// unless filtering or structured output is requested, otherwise they are
+// served from the persistent log storage, which keeps them even after the
+// deal is closed.
 func (m *Worker) TaskLogs(request *pb.TaskLogsRequest, server pb.Worker_TaskLogsServer) error {
 	log.G(m.ctx).Info("handling TaskLogs request", zap.Any("request", request))
 	if err := m.eventAuthorization.Authorize(server.Context(), auth.Event(taskAPIPrefix+"TaskLogs"), request); err != nil {
 		return err
 	}
-	log.G(m.ctx).Info("handling TaskLogs request", zap.Any("request", request))
-	cid, ok := m.getContainerIdByTaskId(request.Id)
+
+	dealID := ""
+	if !request.GetDealID().IsZero() {
+		dealID = request.GetDealID().Unwrap().String()
+	}
+
+	info, ok := m.GetContainerInfo(request.Id)
 	if !ok {
-		return status.Errorf(codes.NotFound, "no job with id %s", request.Id)
+		if len(dealID) == 0 {
+			return status.Errorf(codes.NotFound, "no job with id %s", request.Id)
+		}
+
+		return m.sendTaskLogs(request, dealID, server)
+	}
+
+	if len(dealID) > 0 && dealID != info.DealID {
+		return status.Errorf(codes.NotFound, "no job with id %s within deal %s", request.Id, dealID)
 	}
-	opts := types.ContainerLogsOptions{
+
+	if request.Structured || len(request.Grep) > 0 || len(request.Until) > 0 || len(info.ID) == 0 {
+		return m.sendTaskLogs(request, info.DealID, server)
+	}
+
+	opts := LogOptions{
 		ShowStdout: request.Type == pb.TaskLogsRequest_STDOUT || request.Type == pb.TaskLogsRequest_BOTH,
 		ShowStderr: request.Type == pb.TaskLogsRequest_STDERR || request.Type == pb.TaskLogsRequest_BOTH,
 		Since:      request.Since,
@@ -757,7 +1084,7 @@ func (m *Worker) TaskLogs(request *pb.TaskLogsRequest, server pb.Worker_TaskLogs
 		Tail:       request.Tail,
 		Details:    request.Details,
 	}
-	reader, err := m.ovs.Logs(server.Context(), cid, opts)
+	reader, err := m.ovs.Logs(server.Context(), info.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -777,6 +1104,45 @@ func (m *Worker) TaskLogs(request *pb.TaskLogsRequest, server pb.Worker_TaskLogs
 	}
 }
 
+// TaskEvents streams task lifecycle events until the client disconnects.
+//
+// Events can be restricted to tasks of a single deal. Clients that are too
+// slow to consume events are disconnected with "ResourceExhausted" code and
+// are expected to resubscribe.
+func (m *Worker) TaskEvents(request *pb.TaskEventsRequest, server pb.Worker_TaskEventsServer) error {
+	log.G(m.ctx).Info("handling TaskEvents request", zap.Any("request", request))
+	if err := m.eventAuthorization.Authorize(server.Context(), auth.Event(taskAPIPrefix+"TaskEvents"), request); err != nil {
+		return err
+	}
+
+	dealID := ""
+	if !request.GetDealID().IsZero() {
+		dealID = request.GetDealID().Unwrap().String()
+	}
+
+	subscriber := m.taskEvents.Subscribe(dealID)
+	defer m.taskEvents.Unsubscribe(subscriber)
+
+	for {
+		select {
+		case event, ok := <-subscriber.Events():
+			if !ok {
+				if subscriber.overflowed {
+					return status.Errorf(codes.ResourceExhausted, "too many pending task events")
+				}
+				return nil
+			}
+			if err := server.Send(event); err != nil {
+				return err
+			}
+		case <-server.Context().Done():
+			return server.Context().Err()
+		case <-m.ctx.Done():
+			return m.ctx.Err()
+		}
+	}
+}
+
 //TODO: proper request
 func (m *Worker) JoinNetwork(ctx context.Context, request *pb.WorkerJoinNetworkRequest) (*pb.NetworkSpec, error) {
 	spec, err := m.plugins.JoinNetwork(request.NetworkID)
@@ -815,7 +1181,7 @@ func (m *Worker) TaskStatus(ctx context.Context, req *pb.ID) (*pb.TaskStatusRepl
 
 	reply := info.IntoProto(m.ctx)
 	reply.Usage = metric.Marshal()
-	// todo: fill `reply.AllocatedResources` field.
+	reply.Health = metric.health
 
 	return reply, nil
 }
@@ -876,6 +1242,23 @@ func (m *Worker) runBenchmarks() error {
 	return m.storage.SetHardwareHash(m.hardware.Hash())
 }
 
+func (m *Worker) setupTaskLogs() error {
+	taskLogs, err := newTaskLogStore(m.cfg.Logs)
+	if err != nil {
+		return err
+	}
+
+	m.taskLogs = taskLogs
+	go m.taskLogs.Run(m.ctx)
+
+	return nil
+}
+
+func (m *Worker) setupCommittedImages() error {
+	go m.runCommittedImagesGC(m.ctx)
+	return nil
+}
+
 func (m *Worker) setupResources() error {
 	m.resources = resource.NewScheduler(m.ctx, m.hardware)
 	return nil
@@ -892,14 +1275,22 @@ func (m *Worker) setupSalesman() error {
 		salesman.WithMatcher(m.matcher),
 		salesman.WithEthkey(m.key),
 		salesman.WithConfig(&m.cfg.Salesman),
+		salesman.WithDWH(m.dwh),
 	)
 	if err != nil {
 		return err
 	}
 	m.salesman = salesman
 
+	// Tasks must be restored before the salesman starts to sync with the
+	// blockchain, otherwise we can miss deals that have been closed while
+	// the Worker was down.
+	if err := m.restoreTasks(); err != nil {
+		return err
+	}
+
 	ch := m.salesman.Run(m.ctx)
-	go m.listenDeals(ch)
+	go m.listenDeals(ch, m.salesman.Preemptions())
 	return nil
 }
 
@@ -1021,7 +1412,7 @@ func (m *Worker) execBenchmarkContainerWithResults(d Description) (map[string]*b
 		return nil, fmt.Errorf("cannot start container with benchmark: %v", err)
 	}
 
-	logOpts := types.ContainerLogsOptions{
+	logOpts := LogOptions{
 		ShowStdout: true,
 		Follow:     true,
 		Since:      strconv.FormatInt(time.Now().Unix(), 10),
@@ -1038,7 +1429,7 @@ func (m *Worker) execBenchmarkContainerWithResults(d Description) (map[string]*b
 
 	select {
 	case s := <-statusChan:
-		if s == pb.TaskStatusReply_FINISHED || s == pb.TaskStatusReply_BROKEN {
+		if s.Status == pb.TaskStatusReply_FINISHED || s.Status == pb.TaskStatusReply_BROKEN {
 			if _, err := stdcopy.StdCopy(&stdoutBuf, &stderrBuf, reader); err != nil {
 				return nil, fmt.Errorf("cannot read logs into buffer: %v", err)
 			}
@@ -1145,6 +1536,28 @@ func (m *Worker) PurgeAskPlans(ctx context.Context, _ *pb.Empty) (*pb.Empty, err
 	return &pb.Empty{}, nil
 }
 
+func (m *Worker) StartMaintenance(ctx context.Context, request *pb.MaintenanceRequest) (*pb.Empty, error) {
+	log.G(m.ctx).Info("handling StartMaintenance request", zap.Duration("spotGracePeriod", request.GetSpotGracePeriod().Unwrap()))
+
+	if err := m.salesman.StartMaintenance(request.GetSpotGracePeriod().Unwrap()); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (m *Worker) MaintenanceStatus(ctx context.Context, _ *pb.Empty) (*pb.MaintenanceStatusReply, error) {
+	return m.salesman.MaintenanceStatus(), nil
+}
+
+func (m *Worker) StopMaintenance(ctx context.Context, _ *pb.Empty) (*pb.Empty, error) {
+	log.G(m.ctx).Info("handling StopMaintenance request")
+
+	if err := m.salesman.StopMaintenance(); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
 func (m *Worker) GetDealInfo(ctx context.Context, id *pb.ID) (*pb.DealInfoReply, error) {
 	log.G(m.ctx).Info("handling GetDealInfo request")
 
@@ -1179,9 +1592,7 @@ func (m *Worker) getDealInfo(dealID *pb.BigInt) (*pb.DealInfoReply, error) {
 			task := c.IntoProto(m.ctx)
 
 			// task is running or preparing to start
-			if c.status == pb.TaskStatusReply_SPOOLING ||
-				c.status == pb.TaskStatusReply_SPAWNING ||
-				c.status == pb.TaskStatusReply_RUNNING {
+			if isTaskAlive(c.status) {
 				running[id] = task
 			} else {
 				completed[id] = task
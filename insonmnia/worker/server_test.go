@@ -4,14 +4,24 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/insonmnia/worker/plugin"
+	"github.com/sonm-io/core/insonmnia/worker/volume"
 	pb "github.com/sonm-io/core/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -36,6 +46,136 @@ func setupTestResponder() *httptest.Server {
 	return ts
 }
 
+// newTestWorker constructs a Worker with persistent storage, task logs and
+// local volumes living in a temporary directory, which is returned too.
+func newTestWorker(t *testing.T, ovs Overseer) (*Worker, string, func()) {
+	dir, err := ioutil.TempDir("", "sonm-worker-test")
+	require.NoError(t, err)
+	cleanup := func() { os.RemoveAll(dir) }
+
+	storage, err := state.NewState(testCtx(), &state.StorageConfig{
+		Endpoint: filepath.Join(dir, "worker.boltdb"),
+		Bucket:   "sonm",
+	})
+	if err != nil {
+		cleanup()
+	}
+	require.NoError(t, err)
+
+	taskLogs, err := newTaskLogStore(LogsConfig{Root: filepath.Join(dir, "logs")})
+	if err != nil {
+		cleanup()
+	}
+	require.NoError(t, err)
+
+	plugins, err := plugin.NewRepository(testCtx(), plugin.Config{
+		SocketDir: dir,
+		Volumes: plugin.VolumesConfig{
+			Root:    filepath.Join(dir, "volumes"),
+			Drivers: map[string]map[string]string{"local": {"quota": volume.LocalQuotaNone}},
+		},
+	})
+	if err != nil {
+		cleanup()
+	}
+	require.NoError(t, err)
+
+	m := &Worker{
+		options: &options{
+			ctx:     testCtx(),
+			cfg:     &Config{},
+			key:     key,
+			ovs:     ovs,
+			storage: storage,
+			plugins: plugins,
+		},
+		containers:      map[string]*ContainerInfo{},
+		taskStorage:     state.NewKeyedStorage(tasksStorageKey, storage),
+		committedImages: state.NewKeyedStorage(committedImagesStorageKey, storage),
+		taskEvents:      newTaskEventBroker(),
+		taskLogs:        taskLogs,
+	}
+
+	return m, dir, cleanup
+}
+
+func newTestClosedDeal(id int64, consumer common.Address) *pb.Deal {
+	return &pb.Deal{
+		Id:         pb.NewBigIntFromInt(id),
+		ConsumerID: pb.NewEthAddress(consumer),
+		Status:     pb.DealStatus_DEAL_CLOSED,
+	}
+}
+
+func TestCancelDealTasksRemovesDealVolumes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m, dir, cleanup := newTestWorker(t, NewMockOverseer(controller))
+	defer cleanup()
+
+	// The deal's only task has been stopped before the deal is closed, so
+	// the Worker has no containers of the deal anymore.
+	volumeDir := filepath.Join(dir, "volumes", "local", "42")
+	require.NoError(t, os.MkdirAll(filepath.Join(volumeDir, "data"), 0700))
+	require.NoError(t, ioutil.WriteFile(volumeDir+".img", []byte{}, 0600))
+
+	require.NoError(t, m.cancelDealTasks(newTestClosedDeal(42, addr)))
+
+	_, err := os.Stat(volumeDir)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(volumeDir + ".img")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestReserveTask(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m, _, cleanup := newTestWorker(t, NewMockOverseer(controller))
+	defer cleanup()
+
+	running := uuid.New()
+	spawning := uuid.New()
+	broken := uuid.New()
+	m.containers = map[string]*ContainerInfo{
+		running:  {ID: "container-1", DealID: "42", status: pb.TaskStatusReply_RUNNING, NetworkIDs: []string{"net"}},
+		spawning: {DealID: "42", status: pb.TaskStatusReply_SPAWNING},
+		broken:   {ID: "container-2", DealID: "42", status: pb.TaskStatusReply_BROKEN},
+	}
+
+	// Retrying the start of the running task returns it instead of starting
+	// another one.
+	id, reply, err := m.reserveTask("42", running)
+	require.NoError(t, err)
+	assert.Equal(t, running, id)
+	assert.Equal(t, running, reply.GetId())
+	assert.Equal(t, []string{"net"}, reply.GetNetworkIDs())
+
+	_, _, err = m.reserveTask("43", running)
+	assert.Error(t, err)
+	_, _, err = m.reserveTask("42", spawning)
+	assert.Error(t, err)
+	_, _, err = m.reserveTask("42", "task-1")
+	assert.Error(t, err)
+
+	id, reply, err = m.reserveTask("42", broken)
+	require.NoError(t, err)
+	assert.Equal(t, broken, id)
+	assert.Nil(t, reply)
+
+	id, reply, err = m.reserveTask("42", "")
+	require.NoError(t, err)
+	assert.Nil(t, reply)
+	require.Contains(t, m.containers, id)
+
+	// The reservation prevents concurrent starts and is released on failure.
+	_, _, err = m.reserveTask("42", id)
+	assert.Error(t, err)
+	m.releaseTaskReservation(id)
+	assert.NotContains(t, m.containers, id)
+}
+
 func TestTransformEnvVars(t *testing.T) {
 	vars := map[string]string{
 		"key1": "value1",
@@ -5,7 +5,6 @@ import (
 	"io/ioutil"
 	"net"
 
-	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/gliderlabs/ssh"
 	log "github.com/noxiouz/zapctx/ctxlog"
 	"go.uber.org/zap"
@@ -104,18 +103,12 @@ func (s *sshServer) process(session ssh.Session) (status int) {
 	outputErr := make(chan error)
 
 	go func() {
-		var err error
-		if isTty {
-			_, err = io.Copy(session, stream.Reader)
-		} else {
-			_, err = stdcopy.StdCopy(session, session.Stderr(), stream.Reader)
-		}
-		outputErr <- err
+		outputErr <- stream.CopyOutput(session, session.Stderr())
 	}()
 
 	go func() {
 		defer stream.CloseWrite()
-		io.Copy(stream.Conn, session)
+		io.Copy(stream, session)
 	}()
 
 	err = <-outputErr
@@ -0,0 +1,110 @@
+package worker
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/sonm-io/core/proto"
+)
+
+const taskEventsBufferSize = 128
+
+func newTaskEvent(id string, dealID string, status ContainerStatus) *pb.TaskEvent {
+	event := &pb.TaskEvent{
+		Id:        id,
+		Status:    status.Status,
+		ExitCode:  int64(status.ExitCode),
+		OOMKilled: status.OOMKilled,
+		Restarted: status.Restarted,
+		Timestamp: pb.NewTimestamp(time.Now()),
+	}
+
+	if id, err := pb.NewBigIntFromString(dealID); err == nil {
+		event.DealID = id
+	}
+
+	return event
+}
+
+// taskEventSubscriber receives task events matching its deal filter.
+//
+// The subscriber's channel is closed either after unsubscribing or when the
+// subscriber is too slow to consume events, i.e. its buffer overflows. In the
+// latter case "overflowed" flag is set, allowing to distinguish these cases.
+type taskEventSubscriber struct {
+	dealID     string
+	events     chan *pb.TaskEvent
+	overflowed bool
+}
+
+func (m *taskEventSubscriber) Events() <-chan *pb.TaskEvent {
+	return m.events
+}
+
+func (m *taskEventSubscriber) match(event *pb.TaskEvent) bool {
+	if len(m.dealID) == 0 {
+		return true
+	}
+
+	return event.GetDealID().Unwrap().String() == m.dealID
+}
+
+// taskEventBroker fans out task lifecycle events to all subscribers.
+//
+// Publishing never blocks, so it is safe to publish events while holding
+// other locks.
+type taskEventBroker struct {
+	mu          sync.Mutex
+	subscribers map[*taskEventSubscriber]struct{}
+}
+
+func newTaskEventBroker() *taskEventBroker {
+	return &taskEventBroker{
+		subscribers: map[*taskEventSubscriber]struct{}{},
+	}
+}
+
+// Subscribe registers a new subscriber for events of tasks of the given
+// deal. An empty deal ID means all tasks.
+func (m *taskEventBroker) Subscribe(dealID string) *taskEventSubscriber {
+	subscriber := &taskEventSubscriber{
+		dealID: dealID,
+		events: make(chan *pb.TaskEvent, taskEventsBufferSize),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.subscribers[subscriber] = struct{}{}
+
+	return subscriber
+}
+
+func (m *taskEventBroker) Unsubscribe(subscriber *taskEventSubscriber) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.subscribers[subscriber]; ok {
+		delete(m.subscribers, subscriber)
+		close(subscriber.events)
+	}
+}
+
+func (m *taskEventBroker) Publish(event *pb.TaskEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for subscriber := range m.subscribers {
+		if !subscriber.match(event) {
+			continue
+		}
+
+		select {
+		case subscriber.events <- event:
+		default:
+			subscriber.overflowed = true
+			delete(m.subscribers, subscriber)
+			close(subscriber.events)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"testing"
+
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskEventBrokerFilterByDeal(t *testing.T) {
+	broker := newTaskEventBroker()
+
+	all := broker.Subscribe("")
+	defer broker.Unsubscribe(all)
+	deal := broker.Subscribe("42")
+	defer broker.Unsubscribe(deal)
+
+	broker.Publish(newTaskEvent("task-1", "42", ContainerStatus{Status: pb.TaskStatusReply_RUNNING}))
+	broker.Publish(newTaskEvent("task-2", "43", ContainerStatus{
+		Status:    pb.TaskStatusReply_BROKEN,
+		ExitCode:  137,
+		OOMKilled: true,
+	}))
+
+	require.Len(t, all.Events(), 2)
+	require.Len(t, deal.Events(), 1)
+
+	event := <-deal.Events()
+	assert.Equal(t, "task-1", event.GetId())
+	assert.Equal(t, "42", event.GetDealID().Unwrap().String())
+	assert.Equal(t, pb.TaskStatusReply_RUNNING, event.GetStatus())
+
+	<-all.Events()
+	event = <-all.Events()
+	assert.Equal(t, "task-2", event.GetId())
+	assert.Equal(t, int64(137), event.GetExitCode())
+	assert.True(t, event.GetOOMKilled())
+}
+
+func TestTaskEventBrokerOverflow(t *testing.T) {
+	broker := newTaskEventBroker()
+	subscriber := broker.Subscribe("")
+
+	for i := 0; i < taskEventsBufferSize+1; i++ {
+		broker.Publish(newTaskEvent("task", "", ContainerStatus{Status: pb.TaskStatusReply_RUNNING}))
+	}
+
+	for range subscriber.Events() {
+	}
+	assert.True(t, subscriber.overflowed)
+
+	// Unsubscribing after overflow must not panic.
+	broker.Unsubscribe(subscriber)
+}
@@ -0,0 +1,237 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/docker/distribution/reference"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/pborman/uuid"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/multierror"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// taskGroupMember describes settings of a task that is started as a part of
+// the task group.
+type taskGroupMember struct {
+	ID string
+	// networkMode is used to join the network namespace of the group's main
+	// container.
+	networkMode string
+	// volumes maps group volume names to Docker volume names.
+	volumes map[string]string
+}
+
+func newTaskGroupVolumes(groupID string, names []string) map[string]string {
+	volumes := make(map[string]string, len(names))
+	for _, name := range names {
+		volumes[name] = fmt.Sprintf("sonm-%s-%s", groupID, name)
+	}
+
+	return volumes
+}
+
+// StartTaskGroup starts all tasks of the group within the deal.
+//
+// Tasks are started sequentially, the first one is the main task, whose
+// network namespace is shared with others. If any task fails to start all
+// previously started tasks are stopped and resources of the rest are
+// released.
+func (m *Worker) StartTaskGroup(ctx context.Context, request *pb.StartTaskGroupRequest) (*pb.StartTaskGroupReply, error) {
+	log.G(m.ctx).Info("handling StartTaskGroup request", zap.Any("request", request))
+
+	specs := request.GetSpec().GetTasks()
+	references := make([]reference.Named, len(specs))
+	for id, spec := range specs {
+		ref, err := m.allowedImage(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+
+		references[id] = ref
+	}
+
+	dealID := request.GetDealID()
+	ask, err := m.salesman.AskPlanByDeal(dealID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := &pb.AskPlanHasher{AskPlanResources: ask.GetResources()}
+	taskIDs := make([]string, len(specs))
+	resources := map[string]*pb.AskPlanResources{}
+	for id, spec := range specs {
+		if spec.GetResources() == nil {
+			spec.Resources = &pb.AskPlanResources{}
+		}
+		// Only the main task receives all deal's GPUs by default.
+		if id == 0 && spec.GetResources().GetGPU() == nil {
+			spec.Resources.GPU = ask.Resources.GPU
+		}
+
+		if err := spec.GetResources().GetGPU().Normalize(hasher); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not normalize GPU resources for task #%d: %s", id, err)
+		}
+
+		taskIDs[id] = uuid.New()
+		resources[taskIDs[id]] = spec.Resources
+	}
+
+	if err := m.resources.ConsumeTaskGroup(ask.ID, resources); err != nil {
+		return nil, fmt.Errorf("could not start task group: %s", err)
+	}
+
+	groupID := uuid.New()
+	volumes := newTaskGroupVolumes(groupID, request.GetSpec().GetVolumes())
+	reply := &pb.StartTaskGroupReply{
+		Id: groupID,
+	}
+
+	rollback := func(started int) {
+		for _, taskID := range taskIDs[:started] {
+			if _, err := m.StopTask(ctx, &pb.ID{Id: taskID}); err != nil {
+				log.G(ctx).Warn("failed to stop task group member", zap.String("task", taskID), zap.Error(err))
+			}
+		}
+		// Resources of the failed task may have been already released.
+		for _, taskID := range taskIDs[started:] {
+			m.resources.ReleaseTask(taskID)
+		}
+	}
+
+	var mainContainerID string
+	for id, spec := range specs {
+		member := &taskGroupMember{
+			ID:      groupID,
+			volumes: volumes,
+		}
+		if id > 0 {
+			member.networkMode = "container:" + mainContainerID
+		}
+
+		taskReply, err := m.startTask(ctx, taskIDs[id], dealID, ask, references[id], spec, member)
+		if err != nil {
+			log.G(ctx).Warn("failed to start task group, rolling back", zap.String("group", groupID), zap.Int("task", id), zap.Error(err))
+			rollback(id)
+			return nil, fmt.Errorf("failed to start task #%d: %s", id, err)
+		}
+
+		if id == 0 {
+			containerID, ok := m.getContainerIdByTaskId(taskReply.GetId())
+			if !ok {
+				// Should never happen, because the task has been just started.
+				log.G(ctx).Warn("failed to find main task, rolling back", zap.String("group", groupID), zap.String("task", taskReply.GetId()))
+				rollback(id + 1)
+				return nil, fmt.Errorf("failed to find main task %s", taskReply.GetId())
+			}
+			mainContainerID = containerID
+		}
+
+		reply.Tasks = append(reply.Tasks, taskReply)
+	}
+
+	return reply, nil
+}
+
+// StopTaskGroup stops all tasks of the group, sidecars go first.
+func (m *Worker) StopTaskGroup(ctx context.Context, request *pb.ID) (*pb.Empty, error) {
+	log.G(ctx).Info("handling StopTaskGroup request", zap.Any("request", request))
+
+	taskIDs := m.taskGroupIDs(request.GetId())
+	if len(taskIDs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no task group with id %s", request.GetId())
+	}
+
+	result := multierror.NewMultiError()
+	for id := len(taskIDs) - 1; id >= 0; id-- {
+		if _, err := m.StopTask(ctx, &pb.ID{Id: taskIDs[id]}); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// TaskGroupStatus returns statuses of all tasks of the group along with the
+// aggregated one. Members whose status can not be retrieved are reported as
+// unknown with the reason described separately.
+func (m *Worker) TaskGroupStatus(ctx context.Context, request *pb.ID) (*pb.TaskGroupStatusReply, error) {
+	taskIDs := m.taskGroupIDs(request.GetId())
+	if len(taskIDs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no task group with id %s", request.GetId())
+	}
+
+	reply := &pb.TaskGroupStatusReply{
+		Tasks:  map[string]*pb.TaskStatusReply{},
+		Errors: map[string]string{},
+	}
+
+	statuses := make([]pb.TaskStatusReply_Status, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		taskStatus, err := m.TaskStatus(ctx, &pb.ID{Id: taskID})
+		if err != nil {
+			log.G(ctx).Warn("failed to get task group member status", zap.String("task", taskID), zap.Error(err))
+			taskStatus = &pb.TaskStatusReply{Status: pb.TaskStatusReply_UNKNOWN}
+			reply.Errors[taskID] = err.Error()
+		}
+
+		reply.Tasks[taskID] = taskStatus
+		statuses = append(statuses, taskStatus.GetStatus())
+	}
+
+	reply.Status = taskGroupStatus(statuses)
+
+	return reply, nil
+}
+
+// taskGroupIDs returns IDs of tasks of the given group ordered by the start
+// time, i.e. the main task goes first.
+func (m *Worker) taskGroupIDs(groupID string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var tasks []string
+	for id, info := range m.containers {
+		if len(groupID) > 0 && info.GroupID == groupID {
+			tasks = append(tasks, id)
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return m.containers[tasks[i]].StartAt.Before(m.containers[tasks[j]].StartAt)
+	})
+
+	return tasks
+}
+
+// taskGroupStatus aggregates statuses of group members.
+func taskGroupStatus(statuses []pb.TaskStatusReply_Status) pb.TaskStatusReply_Status {
+	finished := 0
+	result := pb.TaskStatusReply_RUNNING
+	for _, s := range statuses {
+		switch s {
+		case pb.TaskStatusReply_BROKEN:
+			return pb.TaskStatusReply_BROKEN
+		case pb.TaskStatusReply_FINISHED:
+			finished++
+		case pb.TaskStatusReply_UNKNOWN, pb.TaskStatusReply_SPOOLING, pb.TaskStatusReply_SPAWNING:
+			if s < result {
+				result = s
+			}
+		}
+	}
+
+	if finished == len(statuses) {
+		return pb.TaskStatusReply_FINISHED
+	}
+
+	return result
+}
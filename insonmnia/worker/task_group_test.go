@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"testing"
+
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskGroupStatus(t *testing.T) {
+	tests := []struct {
+		statuses []pb.TaskStatusReply_Status
+		expected pb.TaskStatusReply_Status
+	}{
+		{
+			statuses: []pb.TaskStatusReply_Status{pb.TaskStatusReply_RUNNING, pb.TaskStatusReply_RUNNING},
+			expected: pb.TaskStatusReply_RUNNING,
+		},
+		{
+			statuses: []pb.TaskStatusReply_Status{pb.TaskStatusReply_RUNNING, pb.TaskStatusReply_SPAWNING},
+			expected: pb.TaskStatusReply_SPAWNING,
+		},
+		{
+			statuses: []pb.TaskStatusReply_Status{pb.TaskStatusReply_FINISHED, pb.TaskStatusReply_FINISHED},
+			expected: pb.TaskStatusReply_FINISHED,
+		},
+		{
+			statuses: []pb.TaskStatusReply_Status{pb.TaskStatusReply_RUNNING, pb.TaskStatusReply_FINISHED},
+			expected: pb.TaskStatusReply_RUNNING,
+		},
+		{
+			statuses: []pb.TaskStatusReply_Status{pb.TaskStatusReply_RUNNING, pb.TaskStatusReply_BROKEN},
+			expected: pb.TaskStatusReply_BROKEN,
+		},
+		{
+			statuses: []pb.TaskStatusReply_Status{pb.TaskStatusReply_RUNNING, pb.TaskStatusReply_UNKNOWN},
+			expected: pb.TaskStatusReply_UNKNOWN,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, taskGroupStatus(tt.statuses), "%v", tt.statuses)
+	}
+}
+
+func TestNewTaskGroupVolumes(t *testing.T) {
+	volumes := newTaskGroupVolumes("group", []string{"data", "logs"})
+	assert.Equal(t, map[string]string{"data": "sonm-group-data", "logs": "sonm-group-logs"}, volumes)
+}
@@ -0,0 +1,1005 @@
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"github.com/sonm-io/core/util/multierror"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	taskLogFileName           = "output.log"
+	taskLogMetaFileName       = "meta.json"
+	taskLogsGCInterval        = time.Hour
+	taskLogsBatchSize         = 100
+	taskLogsSubscriberBufSize = 1024
+	taskLogsMaxLineSize       = 1024 * 1024
+)
+
+// LogsConfig configures the persistent storage of tasks' output.
+type LogsConfig struct {
+	// Root is a directory where logs are stored.
+	Root string `yaml:"root" default:"/var/lib/sonm/logs"`
+	// Retention is the duration logs are kept for after the deal is closed.
+	Retention time.Duration `yaml:"retention" default:"72h"`
+	// MaxFileSize is the size in bytes a log file is rotated after.
+	MaxFileSize int64 `yaml:"max_file_size" default:"16777216"`
+	// MaxFiles is the number of log files kept per task, including the
+	// current one.
+	MaxFiles int `yaml:"max_files" default:"4"`
+}
+
+// taskLogRecord is a single line of the task's output.
+type taskLogRecord struct {
+	// Seq is the sequence number of the record within the task's log.
+	Seq       uint64                `json:"seq"`
+	Stream    pb.TaskLogLine_Stream `json:"stream"`
+	Timestamp time.Time             `json:"ts"`
+	Line      string                `json:"line"`
+	// Offset is the position in the container's output stream right after
+	// the line, which allows to continue reading the stream after it.
+	Offset int64 `json:"offset,omitempty"`
+}
+
+func (m *taskLogRecord) IntoProto() *pb.TaskLogLine {
+	return &pb.TaskLogLine{
+		Stream:    m.Stream,
+		Timestamp: pb.NewTimestamp(m.Timestamp),
+		Line:      m.Line,
+	}
+}
+
+// taskLogsMeta describes logs of all tasks within a deal.
+type taskLogsMeta struct {
+	// Consumer is the deal's consumer, who is allowed to read logs after
+	// the deal is closed.
+	Consumer   string     `json:"consumer"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// taskLogFilter selects log lines to be returned to the client.
+type taskLogFilter struct {
+	stdout bool
+	stderr bool
+	since  time.Time
+	until  time.Time
+	grep   *regexp.Regexp
+	// tail limits the number of the last lines returned, negative value
+	// means all lines.
+	tail   int
+	follow bool
+}
+
+func newTaskLogFilter(request *pb.TaskLogsRequest, now time.Time) (*taskLogFilter, error) {
+	since, err := parseLogTime(request.GetSince(), now)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since: %v", err)
+	}
+
+	until, err := parseLogTime(request.GetUntil(), now)
+	if err != nil {
+		return nil, fmt.Errorf("invalid until: %v", err)
+	}
+
+	filter := &taskLogFilter{
+		stdout: request.GetType() == pb.TaskLogsRequest_STDOUT || request.GetType() == pb.TaskLogsRequest_BOTH,
+		stderr: request.GetType() == pb.TaskLogsRequest_STDERR || request.GetType() == pb.TaskLogsRequest_BOTH,
+		since:  since,
+		until:  until,
+		tail:   -1,
+		follow: request.GetFollow(),
+	}
+
+	if len(request.GetGrep()) > 0 {
+		filter.grep, err = regexp.Compile(request.GetGrep())
+		if err != nil {
+			return nil, fmt.Errorf("invalid grep: %v", err)
+		}
+	}
+
+	if tail := request.GetTail(); len(tail) > 0 && tail != "all" {
+		filter.tail, err = strconv.Atoi(tail)
+		if err != nil || filter.tail < 0 {
+			return nil, fmt.Errorf("invalid tail: %s", tail)
+		}
+	}
+
+	return filter, nil
+}
+
+func (m *taskLogFilter) Match(record *taskLogRecord) bool {
+	switch record.Stream {
+	case pb.TaskLogLine_STDOUT:
+		if !m.stdout {
+			return false
+		}
+	case pb.TaskLogLine_STDERR:
+		if !m.stderr {
+			return false
+		}
+	}
+
+	if !m.since.IsZero() && record.Timestamp.Before(m.since) {
+		return false
+	}
+	if !m.until.IsZero() && record.Timestamp.After(m.until) {
+		return false
+	}
+	if m.grep != nil && !m.grep.MatchString(record.Line) {
+		return false
+	}
+
+	return true
+}
+
+// parseLogTime parses time the same way Docker does for its "since" logs
+// option, i.e. either as absolute time, unix timestamp or a duration relative
+// to now.
+func parseLogTime(value string, now time.Time) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	parts := strings.SplitN(value, ".", 2)
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time %s", value)
+	}
+
+	var nanoseconds int64
+	if len(parts) == 2 {
+		fraction := parts[1]
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+		fraction += strings.Repeat("0", 9-len(fraction))
+		if nanoseconds, err = strconv.ParseInt(fraction, 10, 64); err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse time %s", value)
+		}
+	}
+
+	return time.Unix(seconds, nanoseconds), nil
+}
+
+// taskLogSubscriber receives records appended to the task's log while
+// following it.
+//
+// Like with task events the channel is closed either when the log is closed
+// or when the subscriber is too slow, which is reported by "overflowed" flag.
+type taskLogSubscriber struct {
+	records    chan *taskLogRecord
+	overflowed bool
+}
+
+// taskLog is an opened for writing log of a single task.
+type taskLog struct {
+	store *taskLogStore
+	key   string
+	dir   string
+	refs  int
+
+	mu            sync.Mutex
+	file          *os.File
+	size          int64
+	seq           uint64
+	lastTimestamp time.Time
+	offsets       map[pb.TaskLogLine_Stream]int64
+	subscribers   map[*taskLogSubscriber]struct{}
+}
+
+// Append writes the line into the log, rotating log files when required.
+// The offset is the position in the container's output stream after the
+// line.
+func (m *taskLog) Append(stream pb.TaskLogLine_Stream, timestamp time.Time, line string, offset int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	record := &taskLogRecord{
+		Seq:       m.seq + 1,
+		Stream:    stream,
+		Timestamp: timestamp,
+		Line:      line,
+		Offset:    offset,
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if m.size > 0 && m.size+int64(len(data)) > m.store.cfg.MaxFileSize {
+		if err := m.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := m.file.Write(data); err != nil {
+		return err
+	}
+
+	m.size += int64(len(data))
+	m.seq = record.Seq
+	m.lastTimestamp = timestamp
+	m.offsets[stream] = offset
+
+	for subscriber := range m.subscribers {
+		select {
+		case subscriber.records <- record:
+		default:
+			subscriber.overflowed = true
+			delete(m.subscribers, subscriber)
+			close(subscriber.records)
+		}
+	}
+
+	return nil
+}
+
+// LastTimestamp returns the timestamp of the last appended line.
+func (m *taskLog) LastTimestamp() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.lastTimestamp
+}
+
+// Offset returns the position in the container's output stream after the
+// last appended line of the stream.
+func (m *taskLog) Offset(stream pb.TaskLogLine_Stream) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.offsets[stream]
+}
+
+// Close releases the log. The underlying file is closed and followers are
+// notified when the last reference is released.
+func (m *taskLog) Close() error {
+	return m.store.release(m)
+}
+
+// rotate shifts log files, removing the oldest one.
+//
+// Must be called with the mutex held.
+func (m *taskLog) rotate() error {
+	if err := m.file.Close(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(m.dir, taskLogFileName)
+	maxFiles := m.store.cfg.MaxFiles
+	if maxFiles < 1 {
+		maxFiles = 1
+	}
+
+	if err := os.Remove(fmt.Sprintf("%s.%d", path, maxFiles-1)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for id := maxFiles - 2; id > 0; id-- {
+		if err := os.Rename(fmt.Sprintf("%s.%d", path, id), fmt.Sprintf("%s.%d", path, id+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if maxFiles > 1 {
+		if err := os.Rename(path, path+".1"); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	m.file = file
+	m.size = 0
+
+	return nil
+}
+
+func (m *taskLog) subscribe() *taskLogSubscriber {
+	subscriber := &taskLogSubscriber{
+		records: make(chan *taskLogRecord, taskLogsSubscriberBufSize),
+	}
+	m.subscribers[subscriber] = struct{}{}
+
+	return subscriber
+}
+
+func (m *taskLog) unsubscribe(subscriber *taskLogSubscriber) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.subscribers[subscriber]; ok {
+		delete(m.subscribers, subscriber)
+		close(subscriber.records)
+	}
+}
+
+// taskLogStore keeps tasks' output on the local disk, keyed by deal and task
+// IDs, so logs survive removing containers.
+//
+// Logs are stored as rotated JSON lines files in "<root>/<deal>/<task>"
+// directories and are removed after the retention period passes since the
+// deal has been closed.
+type taskLogStore struct {
+	cfg LogsConfig
+
+	mu sync.Mutex
+	// Logs that are opened for writing, keyed by "<deal>/<task>".
+	tasks map[string]*taskLog
+}
+
+func newTaskLogStore(cfg LogsConfig) (*taskLogStore, error) {
+	if len(cfg.Root) == 0 {
+		return nil, errors.New("task logs root directory is required")
+	}
+
+	if err := os.MkdirAll(cfg.Root, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create task logs directory: %v", err)
+	}
+
+	return &taskLogStore{
+		cfg:   cfg,
+		tasks: map[string]*taskLog{},
+	}, nil
+}
+
+// Run periodically removes logs whose retention period has passed until the
+// context is canceled.
+func (m *taskLogStore) Run(ctx context.Context) {
+	ticker := util.NewImmediateTicker(taskLogsGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.collectGarbage(time.Now()); err != nil {
+				log.G(ctx).Warn("failed to remove expired task logs", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Open opens the task's log for writing.
+func (m *taskLogStore) Open(dealID, taskID string) (*taskLog, error) {
+	dir, err := m.taskDir(dealID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := dealID + "/" + taskID
+	if task, ok := m.tasks[key]; ok {
+		task.refs++
+		return task, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	task := &taskLog{
+		store:       m,
+		key:         key,
+		dir:         dir,
+		refs:        1,
+		offsets:     map[pb.TaskLogLine_Stream]int64{},
+		subscribers: map[*taskLogSubscriber]struct{}{},
+	}
+
+	// Continue numbering after the last stored record, which is required
+	// to deduplicate records while following. Streams are continued after
+	// the last stored records too.
+	files, err := openTaskLogFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		readTaskLogRecords(file, func(record *taskLogRecord) {
+			task.seq = record.Seq
+			task.lastTimestamp = record.Timestamp
+			task.offsets[record.Stream] = record.Offset
+		})
+		file.Close()
+	}
+
+	path := filepath.Join(dir, taskLogFileName)
+	task.file, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := task.file.Stat()
+	if err != nil {
+		task.file.Close()
+		return nil, err
+	}
+	task.size = info.Size()
+
+	m.tasks[key] = task
+
+	return task, nil
+}
+
+func (m *taskLogStore) release(task *taskLog) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	task.refs--
+	if task.refs > 0 {
+		return nil
+	}
+
+	delete(m.tasks, task.key)
+
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	for subscriber := range task.subscribers {
+		delete(task.subscribers, subscriber)
+		close(subscriber.records)
+	}
+
+	return task.file.Close()
+}
+
+// Read passes the task's log records matching the filter to the given
+// function in batches.
+//
+// When following, records are passed as they are appended until the task's
+// log is closed or the context is canceled.
+func (m *taskLogStore) Read(ctx context.Context, dealID, taskID string, filter *taskLogFilter, fn func(records []*taskLogRecord) error) error {
+	dir, err := m.taskDir(dealID, taskID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	task, ok := m.tasks[dealID+"/"+taskID]
+	if ok {
+		task.mu.Lock()
+	}
+
+	// Files must be opened under the lock to prevent racing with rotation.
+	files, err := openTaskLogFiles(dir)
+	var subscriber *taskLogSubscriber
+	if err == nil && ok && filter.follow {
+		subscriber = task.subscribe()
+	}
+
+	if ok {
+		task.mu.Unlock()
+	}
+	m.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return status.Errorf(codes.NotFound, "no logs found for task %s", taskID)
+	}
+	if subscriber != nil {
+		defer task.unsubscribe(subscriber)
+	}
+
+	var lastSeq uint64
+	var records []*taskLogRecord
+	for _, file := range files {
+		err := readTaskLogRecords(file, func(record *taskLogRecord) {
+			lastSeq = record.Seq
+			if filter.Match(record) {
+				records = append(records, record)
+				if filter.tail >= 0 && len(records) > filter.tail {
+					records = records[1:]
+				}
+			}
+		})
+		file.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for len(records) > 0 {
+		size := taskLogsBatchSize
+		if size > len(records) {
+			size = len(records)
+		}
+
+		if err := fn(records[:size]); err != nil {
+			return err
+		}
+		records = records[size:]
+	}
+
+	if subscriber == nil {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case record, ok := <-subscriber.records:
+			if !ok {
+				if subscriber.overflowed {
+					return status.Error(codes.ResourceExhausted, "too slow logs consumer")
+				}
+				return nil
+			}
+
+			if record.Seq <= lastSeq {
+				continue
+			}
+			if !filter.until.IsZero() && record.Timestamp.After(filter.until) {
+				return nil
+			}
+			if !filter.Match(record) {
+				continue
+			}
+
+			if err := fn([]*taskLogRecord{record}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SetConsumer remembers the deal's consumer, who is allowed to read logs
+// of its tasks after the deal is closed.
+func (m *taskLogStore) SetConsumer(dealID string, consumer string) error {
+	return m.updateMeta(dealID, func(meta *taskLogsMeta) {
+		meta.Consumer = consumer
+	})
+}
+
+// Consumer returns the consumer of the deal whose tasks' logs are stored.
+func (m *taskLogStore) Consumer(dealID string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	meta, err := m.loadMeta(dealID)
+	if err != nil {
+		return "", err
+	}
+	if len(meta.Consumer) == 0 {
+		return "", fmt.Errorf("no logs found for deal %s", dealID)
+	}
+
+	return meta.Consumer, nil
+}
+
+// Finish marks logs of the deal as finished, starting their retention
+// period.
+func (m *taskLogStore) Finish(dealID string, now time.Time) error {
+	return m.updateMeta(dealID, func(meta *taskLogsMeta) {
+		if meta.FinishedAt == nil {
+			meta.FinishedAt = &now
+		}
+	})
+}
+
+func (m *taskLogStore) updateMeta(dealID string, fn func(meta *taskLogsMeta)) error {
+	dir, err := m.dealDir(dealID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	meta, err := m.loadMeta(dealID)
+	if err != nil {
+		return err
+	}
+
+	fn(meta)
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, taskLogMetaFileName)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// loadMeta reads the deal's logs metadata, returning an empty one if there
+// is no metadata yet.
+//
+// Must be called with the mutex held.
+func (m *taskLogStore) loadMeta(dealID string) (*taskLogsMeta, error) {
+	dir, err := m.dealDir(dealID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &taskLogsMeta{}
+	data, err := ioutil.ReadFile(filepath.Join(dir, taskLogMetaFileName))
+	if os.IsNotExist(err) {
+		return meta, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("malformed task logs metadata: %v", err)
+	}
+
+	return meta, nil
+}
+
+func (m *taskLogStore) collectGarbage(now time.Time) error {
+	entries, err := ioutil.ReadDir(m.cfg.Root)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	active := map[string]bool{}
+	for key := range m.tasks {
+		active[strings.SplitN(key, "/", 2)[0]] = true
+	}
+
+	for _, entry := range entries {
+		dealID := entry.Name()
+		if !entry.IsDir() || active[dealID] {
+			continue
+		}
+
+		meta, err := m.loadMeta(dealID)
+		if err != nil {
+			return err
+		}
+
+		if meta.FinishedAt == nil || now.Sub(*meta.FinishedAt) < m.cfg.Retention {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(m.cfg.Root, dealID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *taskLogStore) dealDir(dealID string) (string, error) {
+	if !isSafePathComponent(dealID) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid deal ID %q", dealID)
+	}
+
+	return filepath.Join(m.cfg.Root, dealID), nil
+}
+
+func (m *taskLogStore) taskDir(dealID, taskID string) (string, error) {
+	dir, err := m.dealDir(dealID)
+	if err != nil {
+		return "", err
+	}
+
+	if !isSafePathComponent(taskID) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid task ID %q", taskID)
+	}
+
+	return filepath.Join(dir, taskID), nil
+}
+
+func isSafePathComponent(name string) bool {
+	return len(name) > 0 && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// openTaskLogFiles opens all task's log files, the oldest goes first.
+func openTaskLogFiles(dir string) ([]*os.File, error) {
+	path := filepath.Join(dir, taskLogFileName)
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		return nil, err
+	}
+
+	type rotated struct {
+		path string
+		id   int
+	}
+
+	var paths []rotated
+	for _, match := range matches {
+		id, err := strconv.Atoi(strings.TrimPrefix(match, path+"."))
+		if err != nil {
+			continue
+		}
+		paths = append(paths, rotated{path: match, id: id})
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].id > paths[j].id
+	})
+	paths = append(paths, rotated{path: path})
+
+	var files []*os.File
+	for _, p := range paths {
+		file, err := os.Open(p.path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			for _, file := range files {
+				file.Close()
+			}
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// readTaskLogRecords decodes records from the log file, skipping malformed
+// ones, for example partially written.
+func readTaskLogRecords(file *os.File, fn func(record *taskLogRecord)) error {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 2*taskLogsMaxLineSize)
+	for scanner.Scan() {
+		record := &taskLogRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			continue
+		}
+
+		fn(record)
+	}
+
+	return scanner.Err()
+}
+
+// taskLogLineWriter splits the container's output stream into lines,
+// appending them to the task's log.
+//
+// Lines are expected to be prefixed with timestamps. Lines produced not
+// after the "after" time are skipped, because they are already stored.
+// Runtimes without timestamps continue the stream after the stored lines
+// instead, so the position in the stream is tracked starting from the given
+// offset.
+type taskLogLineWriter struct {
+	log    *taskLog
+	stream pb.TaskLogLine_Stream
+	after  time.Time
+	offset int64
+	buf    []byte
+}
+
+func newTaskLogLineWriter(log *taskLog, stream pb.TaskLogLine_Stream, after time.Time, offset int64) *taskLogLineWriter {
+	return &taskLogLineWriter{
+		log:    log,
+		stream: stream,
+		after:  after,
+		offset: offset,
+	}
+}
+
+func (m *taskLogLineWriter) Write(p []byte) (int, error) {
+	m.buf = append(m.buf, p...)
+
+	for {
+		id := bytes.IndexByte(m.buf, '\n')
+		if id < 0 {
+			break
+		}
+
+		m.offset += int64(id + 1)
+		if err := m.appendLine(m.buf[:id]); err != nil {
+			return 0, err
+		}
+		m.buf = m.buf[id+1:]
+	}
+
+	if len(m.buf) > taskLogsMaxLineSize {
+		if err := m.Flush(); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush appends the incomplete line if any.
+func (m *taskLogLineWriter) Flush() error {
+	if len(m.buf) == 0 {
+		return nil
+	}
+
+	m.offset += int64(len(m.buf))
+	err := m.appendLine(m.buf)
+	m.buf = nil
+
+	return err
+}
+
+func (m *taskLogLineWriter) appendLine(line []byte) error {
+	timestamp := time.Now()
+	text := string(line)
+	if id := strings.IndexByte(text, ' '); id > 0 {
+		if t, err := time.Parse(time.RFC3339Nano, text[:id]); err == nil {
+			timestamp = t
+			text = text[id+1:]
+		}
+	}
+
+	if !m.after.IsZero() && !timestamp.After(m.after) {
+		return nil
+	}
+
+	return m.log.Append(m.stream, timestamp, text, m.offset)
+}
+
+// startTaskLogs starts shipping the task's output to the persistent log
+// storage.
+func (m *Worker) startTaskLogs(taskID string, info ContainerInfo) {
+	dealID, err := pb.NewBigIntFromString(info.DealID)
+	if err != nil {
+		log.S(m.ctx).Warnf("failed to start collecting logs of task %s: %s", taskID, err)
+		return
+	}
+
+	if deal, err := m.salesman.Deal(dealID); err == nil {
+		if err := m.taskLogs.SetConsumer(info.DealID, deal.GetConsumerID().Unwrap().Hex()); err != nil {
+			log.S(m.ctx).Warnf("failed to save consumer for logs of task %s: %s", taskID, err)
+		}
+	}
+
+	go m.collectTaskLogs(taskID, info.DealID, info.ID)
+}
+
+// collectTaskLogs follows the container's output until the task finishes.
+//
+// The output stream is reopened when interrupted, for example when the
+// container is restarted, continuing after the last stored line.
+func (m *Worker) collectTaskLogs(taskID, dealID, containerID string) {
+	taskLog, err := m.taskLogs.Open(dealID, taskID)
+	if err != nil {
+		log.S(m.ctx).Warnf("failed to open logs of task %s: %s", taskID, err)
+		return
+	}
+	defer taskLog.Close()
+
+	for {
+		since := taskLog.LastTimestamp()
+		opts := LogOptions{
+			ShowStdout:   true,
+			ShowStderr:   true,
+			Timestamps:   true,
+			Follow:       true,
+			StdoutOffset: taskLog.Offset(pb.TaskLogLine_STDOUT),
+			StderrOffset: taskLog.Offset(pb.TaskLogLine_STDERR),
+		}
+		if !since.IsZero() {
+			opts.Since = fmt.Sprintf("%d.%09d", since.Unix(), since.Nanosecond())
+		}
+
+		if err := m.copyTaskLogs(taskLog, containerID, opts, since); err != nil {
+			log.S(m.ctx).Debugf("logs stream of task %s is interrupted: %s", taskID, err)
+		}
+
+		if !m.isTaskRunningIn(taskID, containerID) {
+			return
+		}
+
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (m *Worker) copyTaskLogs(taskLog *taskLog, containerID string, opts LogOptions, since time.Time) error {
+	reader, err := m.ovs.Logs(m.ctx, containerID, opts)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	stdout := newTaskLogLineWriter(taskLog, pb.TaskLogLine_STDOUT, since, opts.StdoutOffset)
+	stderr := newTaskLogLineWriter(taskLog, pb.TaskLogLine_STDERR, since, opts.StderrOffset)
+	_, err = stdcopy.StdCopy(stdout, stderr, reader)
+
+	result := multierror.NewMultiError()
+	result = multierror.Append(result, err, stdout.Flush(), stderr.Flush())
+
+	return result.ErrorOrNil()
+}
+
+func (m *Worker) isTaskRunningIn(taskID, containerID string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info, ok := m.containers[taskID]
+	return ok && info.ID == containerID && isTaskAlive(info.status)
+}
+
+// sendTaskLogs sends stored logs of the task to the client.
+func (m *Worker) sendTaskLogs(request *pb.TaskLogsRequest, dealID string, server pb.Worker_TaskLogsServer) error {
+	filter, err := newTaskLogFilter(request, time.Now())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return m.taskLogs.Read(server.Context(), dealID, request.GetId(), filter, func(records []*taskLogRecord) error {
+		chunk := &pb.TaskLogsChunk{}
+		if request.GetStructured() {
+			for _, record := range records {
+				chunk.Lines = append(chunk.Lines, record.IntoProto())
+			}
+			return server.Send(chunk)
+		}
+
+		buf := &bytes.Buffer{}
+		stdout := stdcopy.NewStdWriter(buf, stdcopy.Stdout)
+		stderr := stdcopy.NewStdWriter(buf, stdcopy.Stderr)
+		for _, record := range records {
+			wr := stdout
+			if record.Stream == pb.TaskLogLine_STDERR {
+				wr = stderr
+			}
+
+			line := record.Line + "\n"
+			if request.GetAddTimestamps() {
+				line = record.Timestamp.Format(time.RFC3339Nano) + " " + line
+			}
+			if _, err := wr.Write([]byte(line)); err != nil {
+				return err
+			}
+		}
+
+		chunk.Data = buf.Bytes()
+		return server.Send(chunk)
+	})
+}
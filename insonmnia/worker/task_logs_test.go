@@ -0,0 +1,253 @@
+package worker
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTaskLogStore(t *testing.T, cfg LogsConfig) (*taskLogStore, func()) {
+	dir, err := ioutil.TempDir("", "task-logs")
+	require.NoError(t, err)
+
+	cfg.Root = dir
+	store, err := newTaskLogStore(cfg)
+	require.NoError(t, err)
+
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func readTaskLogLines(t *testing.T, store *taskLogStore, dealID, taskID string, filter *taskLogFilter) []string {
+	var lines []string
+	err := store.Read(context.Background(), dealID, taskID, filter, func(records []*taskLogRecord) error {
+		for _, record := range records {
+			lines = append(lines, record.Line)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	return lines
+}
+
+func TestParseLogTime(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+
+	value, err := parseLogTime("", now)
+	require.NoError(t, err)
+	assert.True(t, value.IsZero())
+
+	value, err = parseLogTime("10m", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-10*time.Minute), value)
+
+	value, err = parseLogTime("2017-07-14T02:40:00Z", now)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(value))
+
+	value, err = parseLogTime("1500000000.5", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1500000000, 500000000), value)
+
+	_, err = parseLogTime("yesterday", now)
+	assert.Error(t, err)
+}
+
+func TestTaskLogFilter(t *testing.T) {
+	now := time.Now()
+	filter, err := newTaskLogFilter(&pb.TaskLogsRequest{
+		Type:  pb.TaskLogsRequest_STDERR,
+		Since: "1h",
+		Grep:  "^error",
+		Tail:  "all",
+	}, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, -1, filter.tail)
+	assert.True(t, filter.Match(&taskLogRecord{Stream: pb.TaskLogLine_STDERR, Timestamp: now, Line: "error: failed"}))
+	assert.False(t, filter.Match(&taskLogRecord{Stream: pb.TaskLogLine_STDOUT, Timestamp: now, Line: "error: failed"}))
+	assert.False(t, filter.Match(&taskLogRecord{Stream: pb.TaskLogLine_STDERR, Timestamp: now, Line: "warning"}))
+	assert.False(t, filter.Match(&taskLogRecord{Stream: pb.TaskLogLine_STDERR, Timestamp: now.Add(-2 * time.Hour), Line: "error: failed"}))
+
+	_, err = newTaskLogFilter(&pb.TaskLogsRequest{Grep: "("}, now)
+	assert.Error(t, err)
+	_, err = newTaskLogFilter(&pb.TaskLogsRequest{Tail: "-1"}, now)
+	assert.Error(t, err)
+}
+
+func TestTaskLogStoreRotation(t *testing.T) {
+	store, cleanup := newTestTaskLogStore(t, LogsConfig{MaxFileSize: 256, MaxFiles: 3})
+	defer cleanup()
+
+	taskLog, err := store.Open("42", "task")
+	require.NoError(t, err)
+
+	now := time.Now()
+	for id := 0; id < 20; id++ {
+		require.NoError(t, taskLog.Append(pb.TaskLogLine_STDOUT, now.Add(time.Duration(id)*time.Second), "line", 0))
+	}
+	require.NoError(t, taskLog.Close())
+
+	matches, err := filepath.Glob(filepath.Join(store.cfg.Root, "42", "task", taskLogFileName+"*"))
+	require.NoError(t, err)
+	assert.Len(t, matches, 3)
+
+	var seq []uint64
+	err = store.Read(context.Background(), "42", "task", &taskLogFilter{stdout: true, tail: -1}, func(records []*taskLogRecord) error {
+		for _, record := range records {
+			seq = append(seq, record.Seq)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, seq)
+	assert.Equal(t, uint64(20), seq[len(seq)-1])
+	for id := 1; id < len(seq); id++ {
+		assert.Equal(t, seq[id-1]+1, seq[id])
+	}
+
+	// Numbering continues after reopening.
+	taskLog, err = store.Open("42", "task")
+	require.NoError(t, err)
+	assert.True(t, now.Add(19*time.Second).Equal(taskLog.LastTimestamp()))
+	require.NoError(t, taskLog.Append(pb.TaskLogLine_STDOUT, now, "last", 0))
+	require.NoError(t, taskLog.Close())
+
+	lines := readTaskLogLines(t, store, "42", "task", &taskLogFilter{stdout: true, tail: 1})
+	assert.Equal(t, []string{"last"}, lines)
+}
+
+func TestTaskLogStoreFollow(t *testing.T) {
+	store, cleanup := newTestTaskLogStore(t, LogsConfig{MaxFileSize: 1024 * 1024, MaxFiles: 2})
+	defer cleanup()
+
+	taskLog, err := store.Open("42", "task")
+	require.NoError(t, err)
+	require.NoError(t, taskLog.Append(pb.TaskLogLine_STDOUT, time.Now(), "first", 0))
+
+	lines := make(chan string, 10)
+	done := make(chan error)
+	go func() {
+		done <- store.Read(context.Background(), "42", "task", &taskLogFilter{stdout: true, tail: -1, follow: true}, func(records []*taskLogRecord) error {
+			for _, record := range records {
+				lines <- record.Line
+			}
+			return nil
+		})
+	}()
+
+	assert.Equal(t, "first", <-lines)
+	require.NoError(t, taskLog.Append(pb.TaskLogLine_STDERR, time.Now(), "skipped", 0))
+	require.NoError(t, taskLog.Append(pb.TaskLogLine_STDOUT, time.Now(), "second", 0))
+	assert.Equal(t, "second", <-lines)
+
+	// Closing the log finishes following.
+	require.NoError(t, taskLog.Close())
+	require.NoError(t, <-done)
+}
+
+func TestTaskLogStoreNotFound(t *testing.T) {
+	store, cleanup := newTestTaskLogStore(t, LogsConfig{})
+	defer cleanup()
+
+	err := store.Read(context.Background(), "42", "task", &taskLogFilter{}, func([]*taskLogRecord) error { return nil })
+	assert.Error(t, err)
+
+	_, err = store.Open("42", "../task")
+	assert.Error(t, err)
+}
+
+func TestTaskLogStoreRetention(t *testing.T) {
+	store, cleanup := newTestTaskLogStore(t, LogsConfig{MaxFileSize: 1024, MaxFiles: 1, Retention: time.Hour})
+	defer cleanup()
+
+	for _, dealID := range []string{"1", "2", "3"} {
+		taskLog, err := store.Open(dealID, "task")
+		require.NoError(t, err)
+		require.NoError(t, taskLog.Append(pb.TaskLogLine_STDOUT, time.Now(), "line", 0))
+		require.NoError(t, taskLog.Close())
+		require.NoError(t, store.SetConsumer(dealID, "0x8125721C2413d99a33E351e1F6Bb4e56b6b633FD"))
+	}
+
+	now := time.Now()
+	require.NoError(t, store.Finish("1", now.Add(-2*time.Hour)))
+	require.NoError(t, store.Finish("2", now.Add(-time.Minute)))
+	require.NoError(t, store.collectGarbage(now))
+
+	_, err := store.Consumer("1")
+	assert.Error(t, err)
+
+	consumer, err := store.Consumer("2")
+	require.NoError(t, err)
+	assert.Equal(t, "0x8125721C2413d99a33E351e1F6Bb4e56b6b633FD", consumer)
+
+	assert.Equal(t, []string{"line"}, readTaskLogLines(t, store, "3", "task", &taskLogFilter{stdout: true, tail: -1}))
+}
+
+func TestTaskLogLineWriter(t *testing.T) {
+	store, cleanup := newTestTaskLogStore(t, LogsConfig{MaxFileSize: 1024 * 1024, MaxFiles: 1})
+	defer cleanup()
+
+	taskLog, err := store.Open("42", "task")
+	require.NoError(t, err)
+
+	after := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	wr := newTaskLogLineWriter(taskLog, pb.TaskLogLine_STDERR, after, 0)
+	_, err = wr.Write([]byte("2017-12-31T23:59:59.000000000Z already stored\n2018-01-01T00:00:01.000000000Z first\n2018-01-01T00:00:02.0"))
+	require.NoError(t, err)
+	_, err = wr.Write([]byte("00000000Z second\nno timestamp"))
+	require.NoError(t, err)
+	require.NoError(t, wr.Flush())
+	require.NoError(t, taskLog.Close())
+
+	var records []*taskLogRecord
+	err = store.Read(context.Background(), "42", "task", &taskLogFilter{stderr: true, tail: -1}, func(batch []*taskLogRecord) error {
+		records = append(records, batch...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	assert.Equal(t, "first", records[0].Line)
+	assert.True(t, after.Add(time.Second).Equal(records[0].Timestamp))
+	assert.Equal(t, "second", records[1].Line)
+	assert.Equal(t, pb.TaskLogLine_STDERR, records[1].Stream)
+	assert.Equal(t, "no timestamp", records[2].Line)
+	assert.Equal(t, int64(133), records[2].Offset)
+}
+
+func TestTaskLogLineWriterOffset(t *testing.T) {
+	store, cleanup := newTestTaskLogStore(t, LogsConfig{MaxFileSize: 1024 * 1024, MaxFiles: 1})
+	defer cleanup()
+
+	taskLog, err := store.Open("42", "task")
+	require.NoError(t, err)
+
+	wr := newTaskLogLineWriter(taskLog, pb.TaskLogLine_STDOUT, time.Time{}, 0)
+	_, err = wr.Write([]byte("first\nsecond\n"))
+	require.NoError(t, err)
+	require.NoError(t, taskLog.Close())
+
+	// Streams without timestamps are continued after the stored lines, even
+	// after reopening.
+	taskLog, err = store.Open("42", "task")
+	require.NoError(t, err)
+	assert.Equal(t, int64(13), taskLog.Offset(pb.TaskLogLine_STDOUT))
+	assert.Zero(t, taskLog.Offset(pb.TaskLogLine_STDERR))
+
+	wr = newTaskLogLineWriter(taskLog, pb.TaskLogLine_STDOUT, time.Time{}, taskLog.Offset(pb.TaskLogLine_STDOUT))
+	_, err = wr.Write([]byte("third\n"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(19), taskLog.Offset(pb.TaskLogLine_STDOUT))
+	require.NoError(t, taskLog.Close())
+
+	lines := readTaskLogLines(t, store, "42", "task", &taskLogFilter{stdout: true, tail: -1})
+	assert.Equal(t, []string{"first", "second", "third"}, lines)
+}
@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/sonm-io/core/insonmnia/worker/gpu"
+	"github.com/sonm-io/core/insonmnia/worker/volume"
+	pb "github.com/sonm-io/core/proto"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+const tasksStorageKey = "tasks"
+
+// taskRecord is a persistent representation of the task bookkeeping, which
+// allows to restore tasks after the Worker restart.
+//
+// Registry credentials are deliberately not persisted, so committed images
+// of restored tasks are pushed anonymously. Such images are still kept on the
+// Worker for the deal's consumer to pull. Volume options, which may contain
+// credentials too, like CIFS passwords, are not persisted either, because
+// they are required only to create volumes, while restored tasks use the
+// existing ones.
+type taskRecord struct {
+	Status       pb.TaskStatusReply_Status `json:"status"`
+	ContainerID  string                    `json:"container_id"`
+	DealID       string                    `json:"deal_id"`
+	AskID        string                    `json:"ask_id"`
+	ImageName    string                    `json:"image_name"`
+	StartAt      time.Time                 `json:"start_at"`
+	Ports        nat.PortMap               `json:"ports"`
+	SSHKey       string                    `json:"ssh_key"`
+	Cgroup       string                    `json:"cgroup"`
+	CgroupParent string                    `json:"cgroup_parent"`
+	NetworkIDs   []string                  `json:"network_ids"`
+	Resources    *pb.AskPlanResources      `json:"resources"`
+	GPUDevices   []gpu.GPUID               `json:"gpu_devices"`
+	CommitOnStop bool                      `json:"commit_on_stop"`
+	Volumes      map[string]*pb.Volume     `json:"volumes"`
+	Mounts       []volume.Mount            `json:"mounts"`
+	GroupID      string                    `json:"group_id"`
+	GroupVolumes map[string]string         `json:"group_volumes"`
+	Preemption   *pb.ContainerPreemption   `json:"preemption"`
+}
+
+func newTaskRecord(info *ContainerInfo) *taskRecord {
+	record := &taskRecord{
+		Status:       info.status,
+		ContainerID:  info.ID,
+		DealID:       info.DealID,
+		AskID:        info.AskID,
+		ImageName:    info.ImageName,
+		StartAt:      info.StartAt,
+		Ports:        info.Ports,
+		Cgroup:       info.Cgroup,
+		CgroupParent: info.CgroupParent,
+		NetworkIDs:   info.NetworkIDs,
+		Resources:    info.Resources,
+		GPUDevices:   info.GPUDevices,
+		CommitOnStop: info.CommitOnStop,
+		Volumes:      map[string]*pb.Volume{},
+		Mounts:       info.Mounts,
+		GroupID:      info.GroupID,
+		GroupVolumes: info.GroupVolumes,
+		Preemption:   info.Preemption,
+	}
+
+	for name, v := range info.Volumes {
+		record.Volumes[name] = &pb.Volume{Type: v.GetType()}
+	}
+
+	if info.PublicKey != nil {
+		record.SSHKey = string(gossh.MarshalAuthorizedKey(info.PublicKey))
+	}
+
+	return record
+}
+
+func (m *taskRecord) IntoContainerInfo() (*ContainerInfo, error) {
+	publicKey, err := parsePublicKey(m.SSHKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerInfo{
+		status:       m.Status,
+		ID:           m.ContainerID,
+		ImageName:    m.ImageName,
+		StartAt:      m.StartAt,
+		Ports:        m.Ports,
+		PublicKey:    publicKey,
+		Cgroup:       m.Cgroup,
+		CgroupParent: m.CgroupParent,
+		NetworkIDs:   m.NetworkIDs,
+		DealID:       m.DealID,
+		AskID:        m.AskID,
+		Resources:    m.Resources,
+		GPUDevices:   m.GPUDevices,
+		CommitOnStop: m.CommitOnStop,
+		Volumes:      m.Volumes,
+		Mounts:       m.Mounts,
+		GroupID:      m.GroupID,
+		GroupVolumes: m.GroupVolumes,
+		Preemption:   m.Preemption,
+	}, nil
+}
+
+// isTaskAlive returns true if the task is either running or preparing to
+// run.
+func isTaskAlive(status pb.TaskStatusReply_Status) bool {
+	return status == pb.TaskStatusReply_SPOOLING ||
+		status == pb.TaskStatusReply_SPAWNING ||
+		status == pb.TaskStatusReply_RUNNING
+}
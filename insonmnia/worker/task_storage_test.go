@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/gliderlabs/ssh"
+	"github.com/sonm-io/core/insonmnia/worker/volume"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func TestTaskRecordRoundTrip(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := gossh.NewPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	info := &ContainerInfo{
+		status:    pb.TaskStatusReply_RUNNING,
+		ID:        "container-id",
+		ImageName: "docker.io/library/alpine:latest",
+		StartAt:   time.Unix(1500000000, 0).UTC(),
+		Ports: nat.PortMap{
+			"22/tcp": []nat.PortBinding{{HostIP: "1.2.3.4", HostPort: "32768"}},
+		},
+		PublicKey:    publicKey,
+		NetworkIDs:   []string{"net-1", "net-2"},
+		DealID:       "42",
+		AskID:        "ask-id",
+		Resources:    &pb.AskPlanResources{RAM: &pb.AskPlanRAM{Size: &pb.DataSize{Bytes: 1024}}},
+		CommitOnStop: true,
+		RegistryAuth: "secret",
+		Volumes: map[string]*pb.Volume{"data": {
+			Type:    "cifs",
+			Options: map[string]string{"share": "1.2.3.4/share", "username": "user", "password": "cifs-secret"},
+		}},
+		Mounts: []volume.Mount{{Source: "data", Target: "/data", Permission: volume.RO}},
+	}
+
+	data, err := json.Marshal(newTaskRecord(info))
+	require.NoError(t, err)
+	// Registry and volume credentials must never hit the disk.
+	assert.NotContains(t, string(data), info.RegistryAuth)
+	assert.NotContains(t, string(data), "cifs-secret")
+
+	record := &taskRecord{}
+	require.NoError(t, json.Unmarshal(data, record))
+
+	restored, err := record.IntoContainerInfo()
+	require.NoError(t, err)
+
+	assert.Equal(t, info.status, restored.status)
+	assert.Equal(t, info.ID, restored.ID)
+	assert.Equal(t, info.ImageName, restored.ImageName)
+	assert.True(t, info.StartAt.Equal(restored.StartAt))
+	assert.Equal(t, info.Ports, restored.Ports)
+	assert.True(t, ssh.KeysEqual(info.PublicKey, restored.PublicKey))
+	assert.Equal(t, info.NetworkIDs, restored.NetworkIDs)
+	assert.Equal(t, info.DealID, restored.DealID)
+	assert.Equal(t, info.AskID, restored.AskID)
+	assert.Equal(t, info.Resources.GetRAM().GetSize().GetBytes(), restored.Resources.GetRAM().GetSize().GetBytes())
+	assert.True(t, restored.CommitOnStop)
+	assert.Empty(t, restored.RegistryAuth)
+	assert.Equal(t, "cifs", restored.Volumes["data"].GetType())
+	assert.Empty(t, restored.Volumes["data"].GetOptions())
+	// Volumes of the running task are left intact.
+	assert.Equal(t, "cifs-secret", info.Volumes["data"].GetOptions()["password"])
+	assert.Equal(t, info.Mounts, restored.Mounts)
+}
+
+func TestTaskRecordWithoutSSHKey(t *testing.T) {
+	record := newTaskRecord(&ContainerInfo{status: pb.TaskStatusReply_SPOOLING})
+	assert.Empty(t, record.SSHKey)
+
+	restored, err := record.IntoContainerInfo()
+	require.NoError(t, err)
+	assert.Nil(t, restored.PublicKey)
+	assert.True(t, isTaskAlive(restored.status))
+}
@@ -35,6 +35,19 @@ type VolumeDriver interface {
 	Close() error
 }
 
+// DealVolumeDriver describes volume drivers whose volumes are bound to the
+// deal rather than to the task, i.e. volumes keep their data across task
+// restarts and are removed only after the deal is finished.
+type DealVolumeDriver interface {
+	VolumeDriver
+	// CreateDealVolume creates a new volume or opens an existing one within
+	// the deal. The total size of deal's volumes is limited by the given
+	// quota in bytes.
+	CreateDealVolume(dealID, name string, quota uint64, options map[string]string) (Volume, error)
+	// RemoveDealVolumes removes all volumes of the deal, wiping their data.
+	RemoveDealVolumes(dealID string) error
+}
+
 type nilVolumeDriver struct{}
 
 func (nilVolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
@@ -64,6 +77,8 @@ func NewVolumeDriver(ctx context.Context, ty string, options ...Option) (VolumeD
 	switch ty {
 	case drivers.CIFS.String():
 		return NewCIFSVolumeDriver(ctx, options...)
+	case localDriverName:
+		return NewLocalVolumeDriver(ctx, options...)
 	default:
 		return nil, fmt.Errorf("unknown volume driver: %s", ty)
 	}
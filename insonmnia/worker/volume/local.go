@@ -0,0 +1,352 @@
+// Local storage volumes, whose data lives on the Worker's disk until the deal
+// is finished.
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/mitchellh/mapstructure"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+const (
+	localDriverName = "local"
+
+	// LocalQuotaLoop limits volumes of a deal by placing them on a loopback
+	// mounted file system image of the bought storage size.
+	LocalQuotaLoop = "loop"
+	// LocalQuotaNone disables disk quotas, volumes are plain directories.
+	LocalQuotaNone = "none"
+)
+
+type localOptions struct {
+	Root  string
+	Quota string `mapstructure:"quota"`
+}
+
+func (o *localOptions) decode(options map[string]string) error {
+	return mapstructure.WeakDecode(options, o)
+}
+
+type localVolumeDriver struct {
+	storage *DealStorage
+	logger  *zap.Logger
+}
+
+// NewLocalVolumeDriver constructs a new volume driver that keeps volumes in
+// per-deal directories under the configured root directory.
+//
+// Volumes are bound to the deal instead of the task, i.e. their data is kept
+// across task restarts and is wiped only after the deal is finished. The
+// total size of deal's volumes is limited by the storage bought in the ask
+// plan unless quotas are disabled.
+func NewLocalVolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts := &localOptions{
+		Quota: LocalQuotaLoop,
+	}
+
+	for _, option := range options {
+		if err := option(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(opts.Root) == 0 {
+		return nil, errors.New("root directory is required for local volumes")
+	}
+
+	root := filepath.Join(opts.Root, localDriverName)
+	storage, err := NewDealStorage(root, opts.Quota)
+	if err != nil {
+		return nil, err
+	}
+
+	log.G(ctx).Info("local volume plugin has been initialized", zap.String("root", root), zap.String("quota", opts.Quota))
+
+	return &localVolumeDriver{
+		storage: storage,
+		logger:  log.G(ctx),
+	}, nil
+}
+
+// LocalDealStorage returns the storage of the local volume driver, false if
+// the driver is not the local one.
+func LocalDealStorage(driver VolumeDriver) (*DealStorage, bool) {
+	local, ok := driver.(*localVolumeDriver)
+	if !ok {
+		return nil, false
+	}
+
+	return local.storage, true
+}
+
+func (d *localVolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
+	return nil, errors.New("local volumes can be created only within a deal")
+}
+
+func (d *localVolumeDriver) CreateDealVolume(dealID, name string, quota uint64, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("deal", dealID), zap.String("name", name), zap.Uint64("quota", quota))
+
+	if !isValidLocalName(dealID) || !isValidLocalName(name) || name == sharedVolumesDir {
+		return nil, fmt.Errorf("invalid volume name: %s/%s", dealID, name)
+	}
+
+	path, err := d.storage.prepare(dealID, name, quota, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localVolume{path: path}, nil
+}
+
+func (d *localVolumeDriver) RemoveVolume(name string) error {
+	d.logger.Info("removing volume", zap.String("name", name))
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) != 2 || !isValidLocalName(parts[0]) || !isValidLocalName(parts[1]) {
+		return fmt.Errorf("invalid volume name: %s", name)
+	}
+
+	return os.RemoveAll(filepath.Join(d.storage.root, parts[0], parts[1]))
+}
+
+func (d *localVolumeDriver) RemoveDealVolumes(dealID string) error {
+	d.logger.Info("removing deal volumes", zap.String("deal", dealID))
+
+	if !isValidLocalName(dealID) {
+		return fmt.Errorf("invalid deal ID: %s", dealID)
+	}
+
+	return d.storage.remove(dealID)
+}
+
+func (d *localVolumeDriver) Close() error {
+	// Volumes must survive the Worker restart, because there still can be
+	// containers using them.
+	return nil
+}
+
+type localVolume struct {
+	path string
+}
+
+func (v *localVolume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeBind,
+		Source:      v.path,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly(),
+		Consistency: mount.ConsistencyDefault,
+	})
+
+	return nil
+}
+
+// DealStorage keeps data of deals in per-deal directories, whose total size
+// is limited by the storage bought in the deal unless quotas are disabled.
+//
+// The storage is shared by local volumes and volumes shared between tasks of
+// task groups, the latter are placed into the reserved subdirectory.
+type DealStorage struct {
+	root  string
+	quota string
+
+	// Serializes preparing and removing deals' directories.
+	mu sync.Mutex
+}
+
+// NewDealStorage constructs the storage living under the given root
+// directory with the given quota type.
+func NewDealStorage(root, quota string) (*DealStorage, error) {
+	switch quota {
+	case LocalQuotaLoop, LocalQuotaNone:
+	default:
+		return nil, fmt.Errorf("unknown quota type: %s", quota)
+	}
+
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+
+	return &DealStorage{root: root, quota: quota}, nil
+}
+
+// prepare creates the directory at the given path within the deal's one,
+// mounting the file system image limited to the given size over the latter
+// if quotas are enabled. Unless the quota is required, deals without bought
+// storage get a plain directory.
+func (m *DealStorage) prepare(dealID, path string, quota uint64, requireQuota bool) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dir := filepath.Join(m.root, dealID)
+	if m.quota == LocalQuotaLoop && (quota != 0 || requireQuota) {
+		if err := mountQuotaDir(dir, quota); err != nil {
+			return "", fmt.Errorf("failed to prepare storage for deal %s: %v", dealID, err)
+		}
+	}
+
+	path = filepath.Join(dir, path)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// release removes the deal's directory if it contains no data.
+func (m *DealStorage) release(dealID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dir := filepath.Join(m.root, dealID)
+	for _, path := range []string{filepath.Join(dir, sharedVolumesDir), dir} {
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+
+		for _, entry := range entries {
+			if entry.Name() != lostAndFound && entry.Name() != sharedVolumesDir {
+				return nil
+			}
+		}
+	}
+
+	return removeQuotaDir(dir)
+}
+
+// remove wipes the deal's directory.
+func (m *DealStorage) remove(dealID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return removeQuotaDir(filepath.Join(m.root, dealID))
+}
+
+func isValidLocalName(name string) bool {
+	return len(name) > 0 && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// mountQuotaDir creates the directory, mounting the file system image of the
+// given size over it unless it is already mounted.
+func mountQuotaDir(dir string, quota uint64) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	if quota == 0 {
+		return errors.New("no storage has been bought")
+	}
+
+	mounted, err := isMountPoint(dir)
+	if err != nil {
+		return err
+	}
+	if mounted {
+		return nil
+	}
+
+	image := dir + ".img"
+	if _, err := os.Stat(image); os.IsNotExist(err) {
+		if err := createImage(image, quota); err != nil {
+			os.Remove(image)
+			return err
+		}
+	}
+
+	return runCommand("mount", "-o", "loop", image, dir)
+}
+
+// removeQuotaDir unmounts and removes the directory along with its file
+// system image.
+func removeQuotaDir(dir string) error {
+	mounted, err := isMountPoint(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if mounted {
+		if err := runCommand("umount", dir); err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	if err := os.Remove(dir + ".img"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// createImage creates a sparse file system image of the given size.
+func createImage(path string, size uint64) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := file.Truncate(int64(size)); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return runCommand("mkfs.ext4", "-q", "-F", "-m", "0", path)
+}
+
+// isMountPoint checks whether the given directory is a mount point by
+// comparing its device with the parent's one.
+func isMountPoint(path string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+
+	parent, err := os.Stat(filepath.Dir(path))
+	if err != nil {
+		return false, err
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return false, errors.New("unsupported platform")
+	}
+	parentStat, ok := parent.Sys().(*syscall.Stat_t)
+	if !ok {
+		return false, errors.New("unsupported platform")
+	}
+
+	return stat.Dev != parentStat.Dev, nil
+}
+
+func runCommand(name string, args ...string) error {
+	output, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %v: %s", name, err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package volume
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocalDriver(t *testing.T, options map[string]string) (DealVolumeDriver, string, func()) {
+	dir, err := ioutil.TempDir("", "local-volumes")
+	require.NoError(t, err)
+
+	driver, err := NewVolumeDriver(context.Background(), localDriverName,
+		WithPluginSocketDir(dir),
+		WithRootDir(dir),
+		WithOptions(options),
+	)
+	require.NoError(t, err)
+
+	return driver.(DealVolumeDriver), dir, func() { os.RemoveAll(dir) }
+}
+
+func TestLocalVolumeDriverKeepsData(t *testing.T) {
+	driver, root, cleanup := newTestLocalDriver(t, map[string]string{"quota": LocalQuotaNone})
+	defer cleanup()
+
+	v, err := driver.CreateDealVolume("42", "data", 0, nil)
+	require.NoError(t, err)
+
+	cfg := &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "42/data", Target: "/data", Permission: RO}, cfg))
+	require.Len(t, cfg.Mounts, 1)
+	assert.Equal(t, mount.TypeBind, cfg.Mounts[0].Type)
+	assert.Equal(t, "/data", cfg.Mounts[0].Target)
+	assert.True(t, cfg.Mounts[0].ReadOnly)
+
+	path := filepath.Join(cfg.Mounts[0].Source, "file")
+	require.NoError(t, ioutil.WriteFile(path, []byte("data"), 0600))
+
+	// Volume is reopened by the restarted task with its data preserved.
+	_, err = driver.CreateDealVolume("42", "data", 0, nil)
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	require.NoError(t, driver.RemoveDealVolumes("42"))
+	_, err = os.Stat(filepath.Join(root, localDriverName, "42"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Removing volumes of unknown deals is not an error.
+	assert.NoError(t, driver.RemoveDealVolumes("43"))
+}
+
+func TestLocalVolumeDriverInvalidNames(t *testing.T) {
+	driver, _, cleanup := newTestLocalDriver(t, map[string]string{"quota": LocalQuotaNone})
+	defer cleanup()
+
+	_, err := driver.CreateVolume("task/data", nil)
+	assert.Error(t, err)
+	_, err = driver.CreateDealVolume("42", "..", 0, nil)
+	assert.Error(t, err)
+	_, err = driver.CreateDealVolume("../42", "data", 0, nil)
+	assert.Error(t, err)
+	assert.Error(t, driver.RemoveDealVolumes(".."))
+	assert.Error(t, driver.RemoveVolume("42/../data"))
+}
+
+func TestLocalVolumeDriverRequiresQuota(t *testing.T) {
+	driver, _, cleanup := newTestLocalDriver(t, map[string]string{})
+	defer cleanup()
+
+	_, err := driver.CreateDealVolume("42", "data", 0, nil)
+	assert.Error(t, err)
+}
+
+func TestLocalVolumeDriverUnknownQuota(t *testing.T) {
+	_, err := NewLocalVolumeDriver(context.Background(), WithRootDir(os.TempDir()), WithOptions(map[string]string{"quota": "magic"}))
+	assert.Error(t, err)
+
+	_, err = NewLocalVolumeDriver(context.Background())
+	assert.Error(t, err)
+}
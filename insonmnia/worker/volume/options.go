@@ -16,13 +16,33 @@ type Options struct {
 // directory where Unix sockets live.
 func WithPluginSocketDir(path string) Option {
 	return func(o interface{}) error {
-		option, ok := o.(*Options)
-		if !ok {
+		switch option := o.(type) {
+		case *Options:
+			option.SocketDir = path
+			return nil
+		case *localOptions:
+			// Local volumes are managed by the Worker itself without
+			// plugin sockets.
+			return nil
+		default:
 			return fmt.Errorf("invalid option type: %T", o)
 		}
+	}
+}
 
-		option.SocketDir = path
-		return nil
+// WithRootDir constructs an option that specifies the directory where
+// volumes that are managed by the Worker itself are stored.
+func WithRootDir(path string) Option {
+	return func(o interface{}) error {
+		switch option := o.(type) {
+		case *Options:
+			return nil
+		case *localOptions:
+			option.Root = path
+			return nil
+		default:
+			return fmt.Errorf("invalid option type: %T", o)
+		}
 	}
 }
 
@@ -30,9 +50,11 @@ func WithPluginSocketDir(path string) Option {
 // to the plugin.
 func WithOptions(options map[string]string) Option {
 	return func(o interface{}) error {
-		switch o.(type) {
+		switch option := o.(type) {
 		case *Options:
 			return nil
+		case *localOptions:
+			return option.decode(options)
 		default:
 			return fmt.Errorf("invalid option type: %T", o)
 		}
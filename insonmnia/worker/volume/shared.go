@@ -0,0 +1,62 @@
+package volume
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// lostAndFound is created by mkfs in the root of every file system
+	// image, it is not a volume.
+	lostAndFound = "lost+found"
+	// sharedVolumesDir is the subdirectory of the deal's storage, where
+	// shared volumes are placed.
+	sharedVolumesDir = ".shared"
+)
+
+// SharedVolumes keeps volumes shared between tasks of task groups.
+//
+// Volumes are placed in the deal's storage, which is shared with local
+// volumes, so the total size of both is limited by the storage bought in the
+// deal. Shared volumes of deals without bought storage are not limited. The
+// deal's storage is released along with its last volume.
+type SharedVolumes struct {
+	storage *DealStorage
+	mu      sync.Mutex
+}
+
+// NewSharedVolumes constructs shared volumes living in the given storage.
+func NewSharedVolumes(storage *DealStorage) *SharedVolumes {
+	return &SharedVolumes{storage: storage}
+}
+
+// Create creates a new volume or opens an existing one within the deal,
+// returning the path to its directory.
+func (m *SharedVolumes) Create(dealID, name string, quota uint64) (string, error) {
+	if !isValidLocalName(dealID) || !isValidLocalName(name) || name == lostAndFound {
+		return "", fmt.Errorf("invalid volume name: %s/%s", dealID, name)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.storage.prepare(dealID, filepath.Join(sharedVolumesDir, name), quota, false)
+}
+
+// Remove removes the volume wiping its data.
+func (m *SharedVolumes) Remove(dealID, name string) error {
+	if !isValidLocalName(dealID) || !isValidLocalName(name) || name == lostAndFound {
+		return fmt.Errorf("invalid volume name: %s/%s", dealID, name)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := os.RemoveAll(filepath.Join(m.storage.root, dealID, sharedVolumesDir, name)); err != nil {
+		return err
+	}
+
+	return m.storage.release(dealID)
+}
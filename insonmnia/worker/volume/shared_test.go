@@ -0,0 +1,75 @@
+package volume
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSharedVolumes(t *testing.T, quota string) (*SharedVolumes, string, func()) {
+	dir, err := ioutil.TempDir("", "shared-volumes")
+	require.NoError(t, err)
+
+	storage, err := NewDealStorage(dir, quota)
+	require.NoError(t, err)
+
+	return NewSharedVolumes(storage), dir, func() { os.RemoveAll(dir) }
+}
+
+func TestSharedVolumesWithoutStorage(t *testing.T) {
+	volumes, root, cleanup := newTestSharedVolumes(t, LocalQuotaLoop)
+	defer cleanup()
+
+	// Deals without bought storage get unlimited volumes.
+	path, err := volumes.Create("42", "data", 0)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "42", sharedVolumesDir, "data"), path)
+
+	require.NoError(t, volumes.Remove("42", "data"))
+	_, err = os.Stat(filepath.Join(root, "42"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Removing volumes of unknown deals is not an error.
+	assert.NoError(t, volumes.Remove("43", "data"))
+}
+
+func TestSharedVolumesShareLocalStorage(t *testing.T) {
+	driver, root, cleanup := newTestLocalDriver(t, map[string]string{"quota": LocalQuotaNone})
+	defer cleanup()
+
+	storage, ok := LocalDealStorage(driver)
+	require.True(t, ok)
+	volumes := NewSharedVolumes(storage)
+
+	_, err := driver.CreateDealVolume("42", "local", 0, nil)
+	require.NoError(t, err)
+	path, err := volumes.Create("42", "data", 0)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, localDriverName, "42", sharedVolumesDir, "data"), path)
+
+	// The deal's storage is kept while local volumes are there.
+	require.NoError(t, volumes.Remove("42", "data"))
+	_, err = os.Stat(filepath.Join(root, localDriverName, "42", "local"))
+	assert.NoError(t, err)
+
+	// Shared volumes directory is not a local volume.
+	_, err = driver.CreateDealVolume("42", sharedVolumesDir, 0, nil)
+	assert.Error(t, err)
+}
+
+func TestSharedVolumesInvalidNames(t *testing.T) {
+	volumes, _, cleanup := newTestSharedVolumes(t, LocalQuotaLoop)
+	defer cleanup()
+
+	_, err := volumes.Create("42", "..", 1<<20)
+	assert.Error(t, err)
+	_, err = volumes.Create("../42", "data", 1<<20)
+	assert.Error(t, err)
+	_, err = volumes.Create("42", lostAndFound, 1<<20)
+	assert.Error(t, err)
+	assert.Error(t, volumes.Remove("42", "../data"))
+}
@@ -3,6 +3,7 @@ package sonm
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/docker/docker/api/types/container"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -54,7 +55,42 @@ func (m *AskPlan) Validate() error {
 		return errors.New("storage size is too low")
 	}
 
-	return m.GetResources().GetGPU().Validate()
+	if err := m.GetResources().GetGPU().Validate(); err != nil {
+		return err
+	}
+
+	return m.GetPricing().Validate()
+}
+
+func (m *PricingStrategy_Type) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	strategy, ok := PricingStrategy_Type_value[strings.ToUpper(v)]
+	if !ok {
+		return fmt.Errorf("unknown pricing strategy: %s", v)
+	}
+
+	*m = PricingStrategy_Type(strategy)
+	return nil
+}
+
+func (m *PricingStrategy) Validate() error {
+	if m.GetType() != PricingStrategy_DECAY {
+		return nil
+	}
+
+	if m.GetDecayStep() == 0 || m.GetDecayStep() > 100 {
+		return errors.New("decay step must be in range from 1 to 100 percents")
+	}
+
+	if m.GetDecayPeriod().Unwrap() <= 0 {
+		return errors.New("decay period is required")
+	}
+
+	return nil
 }
 
 func NewEmptyAskPlanResources() *AskPlanResources {
@@ -11,6 +11,7 @@ It is generated from these files:
 	capabilities.proto
 	container.proto
 	dwh.proto
+	dwh_changes.proto
 	insonmnia.proto
 	marketplace.proto
 	net.proto
@@ -29,6 +30,9 @@ It has these top-level messages:
 	AskPlanNetwork
 	AskPlanResources
 	AskPlan
+	PricingStrategy
+	ChangeRequestRules
+	ChangeRequestDecision
 	Benchmark
 	BigInt
 	CPUDevice
@@ -42,6 +46,8 @@ It has these top-level messages:
 	Storage
 	Registry
 	ContainerRestartPolicy
+	ContainerHealthcheck
+	ContainerPreemption
 	NetworkSpec
 	Container
 	SortingOption
@@ -75,6 +81,34 @@ It has these top-level messages:
 	MaxMinTimestamp
 	CmpUint64
 	BlacklistQuery
+	FailedEvent
+	FailedEventsRequest
+	FailedEventsReply
+	ReplayFailedEventsRequest
+	ReplayFailedEventsReply
+	ResyncRequest
+	ResyncReply
+	AnalyticsPeriod
+	PriceHistoryRequest
+	PriceStats
+	PriceHistoryReply
+	SupplyDemandRequest
+	DeviceClass
+	SupplyDemandStats
+	SupplyDemandReply
+	MatchStatsRequest
+	MatchStats
+	MatchStatsReply
+	PayoutStatsRequest
+	PayoutStats
+	PayoutStatsReply
+	Payment
+	PaymentsRequest
+	PaymentsReply
+	OrdersSubscribeRequest
+	DWHOrderEvent
+	DealsSubscribeRequest
+	DWHDealEvent
 	Empty
 	ID
 	EthID
@@ -82,9 +116,12 @@ It has these top-level messages:
 	Count
 	CPUUsage
 	MemoryUsage
+	BlockIOUsage
+	GPUUsage
 	NetworkUsage
 	ResourceUsage
 	TaskLogsRequest
+	TaskLogLine
 	TaskLogsChunk
 	Chunk
 	Progress
@@ -100,17 +137,27 @@ It has these top-level messages:
 	BidNetwork
 	BidResources
 	BidOrder
+	BidPlan
+	BidPlansReply
+	ReplaceBidPlanRequest
 	Addr
 	SocketAddr
 	Endpoints
 	JoinNetworkRequest
 	TaskListRequest
+	TaskDeploymentRequest
+	TaskDeployment
+	TaskDeploymentsReply
 	DealFinishRequest
 	DealsReply
 	OpenDealRequest
 	WorkerRemoveRequest
 	WorkerListReply
 	BalanceReply
+	TokenTransferRequest
+	TokenTransaction
+	ProfileReply
+	CertificateRequest
 	HandshakeRequest
 	DiscoverResponse
 	HandshakeResponse
@@ -127,15 +174,23 @@ It has these top-level messages:
 	Volume
 	TaskSpec
 	StartTaskRequest
+	TaskGroupSpec
+	StartTaskGroupRequest
+	StartTaskGroupReply
 	WorkerJoinNetworkRequest
 	StartTaskReply
 	StatusReply
+	MaintenanceRequest
+	MaintenanceStatusReply
 	AskPlansReply
 	TaskListReply
 	DevicesReply
 	PullTaskRequest
 	DealInfoReply
 	TaskStatusReply
+	TaskGroupStatusReply
+	TaskEventsRequest
+	TaskEvent
 */
 package sonm
 
@@ -175,6 +230,40 @@ func (x AskPlan_Status) String() string {
 }
 func (AskPlan_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6, 0} }
 
+type PricingStrategy_Type int32
+
+const (
+	// FIXED uses the plan's price as is.
+	PricingStrategy_FIXED PricingStrategy_Type = 0
+	// MARKET follows the highest price of active comparable BIDs on the
+	// market, falling back to the plan's price if there are none.
+	PricingStrategy_MARKET PricingStrategy_Type = 1
+	// DECAY decreases the plan's price by the decay step every decay
+	// period while the plan is waiting for a deal.
+	PricingStrategy_DECAY PricingStrategy_Type = 2
+	// USD_PEGGED treats the plan's price as the income in SNM tokens,
+	// converting it to the USD price with the current oracle rate.
+	PricingStrategy_USD_PEGGED PricingStrategy_Type = 3
+)
+
+var PricingStrategy_Type_name = map[int32]string{
+	0: "FIXED",
+	1: "MARKET",
+	2: "DECAY",
+	3: "USD_PEGGED",
+}
+var PricingStrategy_Type_value = map[string]int32{
+	"FIXED":      0,
+	"MARKET":     1,
+	"DECAY":      2,
+	"USD_PEGGED": 3,
+}
+
+func (x PricingStrategy_Type) String() string {
+	return proto.EnumName(PricingStrategy_Type_name, int32(x))
+}
+func (PricingStrategy_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{7, 0} }
+
 type AskPlanCPU struct {
 	CorePercents uint64 `protobuf:"varint,1,opt,name=core_percents,json=corePercents" json:"core_percents,omitempty"`
 }
@@ -344,17 +433,24 @@ func (m *AskPlanResources) GetNetwork() *AskPlanNetwork {
 }
 
 type AskPlan struct {
-	ID           string            `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
-	OrderID      *BigInt           `protobuf:"bytes,2,opt,name=orderID" json:"orderID,omitempty"`
-	DealID       *BigInt           `protobuf:"bytes,3,opt,name=dealID" json:"dealID,omitempty"`
-	Duration     *Duration         `protobuf:"bytes,4,opt,name=duration" json:"duration,omitempty"`
-	Price        *Price            `protobuf:"bytes,5,opt,name=price" json:"price,omitempty"`
-	Blacklist    *EthAddress       `protobuf:"bytes,6,opt,name=blacklist" json:"blacklist,omitempty"`
-	Counterparty *EthAddress       `protobuf:"bytes,7,opt,name=counterparty" json:"counterparty,omitempty"`
-	Identity     IdentityLevel     `protobuf:"varint,8,opt,name=identity,enum=sonm.IdentityLevel" json:"identity,omitempty"`
-	Tag          []byte            `protobuf:"bytes,9,opt,name=tag,proto3" json:"tag,omitempty"`
-	Resources    *AskPlanResources `protobuf:"bytes,10,opt,name=resources" json:"resources,omitempty"`
-	Status       AskPlan_Status    `protobuf:"varint,11,opt,name=status,enum=sonm.AskPlan_Status" json:"status,omitempty"`
+	ID             string              `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+	OrderID        *BigInt             `protobuf:"bytes,2,opt,name=orderID" json:"orderID,omitempty"`
+	DealID         *BigInt             `protobuf:"bytes,3,opt,name=dealID" json:"dealID,omitempty"`
+	Duration       *Duration           `protobuf:"bytes,4,opt,name=duration" json:"duration,omitempty"`
+	Price          *Price              `protobuf:"bytes,5,opt,name=price" json:"price,omitempty"`
+	Blacklist      *EthAddress         `protobuf:"bytes,6,opt,name=blacklist" json:"blacklist,omitempty"`
+	Counterparty   *EthAddress         `protobuf:"bytes,7,opt,name=counterparty" json:"counterparty,omitempty"`
+	Identity       IdentityLevel       `protobuf:"varint,8,opt,name=identity,enum=sonm.IdentityLevel" json:"identity,omitempty"`
+	Tag            []byte              `protobuf:"bytes,9,opt,name=tag,proto3" json:"tag,omitempty"`
+	Resources      *AskPlanResources   `protobuf:"bytes,10,opt,name=resources" json:"resources,omitempty"`
+	Status         AskPlan_Status      `protobuf:"varint,11,opt,name=status,enum=sonm.AskPlan_Status" json:"status,omitempty"`
+	ChangeRequests *ChangeRequestRules `protobuf:"bytes,12,opt,name=changeRequests" json:"changeRequests,omitempty"`
+	// ChangeRequestDecisions are the latest decisions made automatically on
+	// change requests of the plan's deals.
+	ChangeRequestDecisions []*ChangeRequestDecision `protobuf:"bytes,13,rep,name=changeRequestDecisions" json:"changeRequestDecisions,omitempty"`
+	Pricing                *PricingStrategy         `protobuf:"bytes,14,opt,name=pricing" json:"pricing,omitempty"`
+	// UnmatchedSince is the time the plan has started to wait for a deal.
+	UnmatchedSince *Timestamp `protobuf:"bytes,15,opt,name=unmatchedSince" json:"unmatchedSince,omitempty"`
 }
 
 func (m *AskPlan) Reset()                    { *m = AskPlan{} }
@@ -439,6 +535,208 @@ func (m *AskPlan) GetStatus() AskPlan_Status {
 	return AskPlan_ACTIVE
 }
 
+func (m *AskPlan) GetChangeRequests() *ChangeRequestRules {
+	if m != nil {
+		return m.ChangeRequests
+	}
+	return nil
+}
+
+func (m *AskPlan) GetChangeRequestDecisions() []*ChangeRequestDecision {
+	if m != nil {
+		return m.ChangeRequestDecisions
+	}
+	return nil
+}
+
+func (m *AskPlan) GetPricing() *PricingStrategy {
+	if m != nil {
+		return m.Pricing
+	}
+	return nil
+}
+
+func (m *AskPlan) GetUnmatchedSince() *Timestamp {
+	if m != nil {
+		return m.UnmatchedSince
+	}
+	return nil
+}
+
+// PricingStrategy defines how the price of the plan's orders is calculated
+// from the plan's price.
+type PricingStrategy struct {
+	Type PricingStrategy_Type `protobuf:"varint,1,opt,name=type,enum=sonm.PricingStrategy_Type" json:"type,omitempty"`
+	// Floor is the minimum price of orders, zero means no limit.
+	Floor *Price `protobuf:"bytes,2,opt,name=floor" json:"floor,omitempty"`
+	// DecayStep is the price decrease in percents.
+	DecayStep   uint64    `protobuf:"varint,3,opt,name=decayStep" json:"decayStep,omitempty"`
+	DecayPeriod *Duration `protobuf:"bytes,4,opt,name=decayPeriod" json:"decayPeriod,omitempty"`
+	// Threshold is the drift of the strategy's price from the price of the
+	// placed order in percents, above which the order is re-placed.
+	Threshold uint64 `protobuf:"varint,5,opt,name=threshold" json:"threshold,omitempty"`
+}
+
+func (m *PricingStrategy) Reset()                    { *m = PricingStrategy{} }
+func (m *PricingStrategy) String() string            { return proto.CompactTextString(m) }
+func (*PricingStrategy) ProtoMessage()               {}
+func (*PricingStrategy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *PricingStrategy) GetType() PricingStrategy_Type {
+	if m != nil {
+		return m.Type
+	}
+	return PricingStrategy_FIXED
+}
+
+func (m *PricingStrategy) GetFloor() *Price {
+	if m != nil {
+		return m.Floor
+	}
+	return nil
+}
+
+func (m *PricingStrategy) GetDecayStep() uint64 {
+	if m != nil {
+		return m.DecayStep
+	}
+	return 0
+}
+
+func (m *PricingStrategy) GetDecayPeriod() *Duration {
+	if m != nil {
+		return m.DecayPeriod
+	}
+	return nil
+}
+
+func (m *PricingStrategy) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// ChangeRequestRules configures automatic processing of deal change requests
+// sent by consumers. Requests are left for manual processing unless enabled.
+type ChangeRequestRules struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	// MinPrice is the minimum price of the changed deal.
+	MinPrice *Price `protobuf:"bytes,2,opt,name=minPrice" json:"minPrice,omitempty"`
+	// MaxDuration is the maximum duration of the changed deal, zero means no
+	// limit.
+	MaxDuration *Duration `protobuf:"bytes,3,opt,name=maxDuration" json:"maxDuration,omitempty"`
+	// Counterparties allowed to change deals, empty means anyone.
+	Counterparties []*EthAddress `protobuf:"bytes,4,rep,name=counterparties" json:"counterparties,omitempty"`
+}
+
+func (m *ChangeRequestRules) Reset()                    { *m = ChangeRequestRules{} }
+func (m *ChangeRequestRules) String() string            { return proto.CompactTextString(m) }
+func (*ChangeRequestRules) ProtoMessage()               {}
+func (*ChangeRequestRules) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ChangeRequestRules) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ChangeRequestRules) GetMinPrice() *Price {
+	if m != nil {
+		return m.MinPrice
+	}
+	return nil
+}
+
+func (m *ChangeRequestRules) GetMaxDuration() *Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return nil
+}
+
+func (m *ChangeRequestRules) GetCounterparties() []*EthAddress {
+	if m != nil {
+		return m.Counterparties
+	}
+	return nil
+}
+
+type ChangeRequestDecision struct {
+	Id       *BigInt   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	DealID   *BigInt   `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	Price    *Price    `protobuf:"bytes,3,opt,name=price" json:"price,omitempty"`
+	Duration *Duration `protobuf:"bytes,4,opt,name=duration" json:"duration,omitempty"`
+	Approved bool      `protobuf:"varint,5,opt,name=approved" json:"approved,omitempty"`
+	// Reason is the rule the rejected request violates.
+	Reason string `protobuf:"bytes,6,opt,name=reason" json:"reason,omitempty"`
+	// Error is set if the decision could not be sent to the blockchain.
+	Error     string     `protobuf:"bytes,7,opt,name=error" json:"error,omitempty"`
+	Timestamp *Timestamp `protobuf:"bytes,8,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *ChangeRequestDecision) Reset()                    { *m = ChangeRequestDecision{} }
+func (m *ChangeRequestDecision) String() string            { return proto.CompactTextString(m) }
+func (*ChangeRequestDecision) ProtoMessage()               {}
+func (*ChangeRequestDecision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *ChangeRequestDecision) GetId() *BigInt {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ChangeRequestDecision) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *ChangeRequestDecision) GetPrice() *Price {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ChangeRequestDecision) GetDuration() *Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *ChangeRequestDecision) GetApproved() bool {
+	if m != nil {
+		return m.Approved
+	}
+	return false
+}
+
+func (m *ChangeRequestDecision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ChangeRequestDecision) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ChangeRequestDecision) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AskPlanCPU)(nil), "sonm.AskPlanCPU")
 	proto.RegisterType((*AskPlanGPU)(nil), "sonm.AskPlanGPU")
@@ -447,51 +745,79 @@ func init() {
 	proto.RegisterType((*AskPlanNetwork)(nil), "sonm.AskPlanNetwork")
 	proto.RegisterType((*AskPlanResources)(nil), "sonm.AskPlanResources")
 	proto.RegisterType((*AskPlan)(nil), "sonm.AskPlan")
+	proto.RegisterType((*PricingStrategy)(nil), "sonm.PricingStrategy")
+	proto.RegisterType((*ChangeRequestRules)(nil), "sonm.ChangeRequestRules")
+	proto.RegisterType((*ChangeRequestDecision)(nil), "sonm.ChangeRequestDecision")
 	proto.RegisterEnum("sonm.AskPlan_Status", AskPlan_Status_name, AskPlan_Status_value)
+	proto.RegisterEnum("sonm.PricingStrategy_Type", PricingStrategy_Type_name, PricingStrategy_Type_value)
 }
 
 func init() { proto.RegisterFile("ask_plan.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6a, 0xdb, 0x30,
-	0x14, 0x9e, 0x93, 0x34, 0x3f, 0x27, 0x69, 0x96, 0x69, 0xa5, 0x98, 0x5e, 0x65, 0xde, 0x18, 0xa1,
-	0x8c, 0x6c, 0xeb, 0xca, 0xd8, 0xd5, 0x20, 0xab, 0x43, 0x30, 0xb4, 0xa9, 0x51, 0x9b, 0xdd, 0x16,
-	0xc5, 0x16, 0x89, 0x88, 0x2b, 0x19, 0x49, 0xee, 0xd6, 0x3e, 0xe7, 0xae, 0xf7, 0x0a, 0x7b, 0x85,
-	0x21, 0x5b, 0x4e, 0xe6, 0x42, 0x61, 0x77, 0x3e, 0xdf, 0xcf, 0xf9, 0xd3, 0xc1, 0xd0, 0x27, 0x6a,
-	0x73, 0x93, 0x26, 0x84, 0x8f, 0x53, 0x29, 0xb4, 0x40, 0x0d, 0x25, 0xf8, 0xed, 0x51, 0x6f, 0xc9,
-	0x56, 0x8c, 0xeb, 0x02, 0x3b, 0x7a, 0xce, 0xb8, 0x41, 0x39, 0x23, 0x16, 0x78, 0x71, 0x4b, 0xe4,
-	0x86, 0xea, 0x34, 0x21, 0x11, 0x2d, 0x20, 0xef, 0x23, 0xc0, 0x44, 0x6d, 0xc2, 0x84, 0xf0, 0xb3,
-	0x70, 0x81, 0x5e, 0xc3, 0x7e, 0x24, 0x24, 0xbd, 0x49, 0xa9, 0x8c, 0x28, 0xd7, 0xca, 0x75, 0x86,
-	0xce, 0xa8, 0x81, 0x7b, 0x06, 0x0c, 0x2d, 0xe6, 0x7d, 0xdd, 0x5a, 0x66, 0xe1, 0x02, 0xb9, 0xd0,
-	0x62, 0x3c, 0xa6, 0x3f, 0xa9, 0x11, 0xd7, 0x47, 0x0d, 0x5c, 0x86, 0xe8, 0x10, 0x9a, 0x6b, 0xa2,
-	0xd6, 0x54, 0xb9, 0xb5, 0x61, 0x7d, 0xd4, 0xc1, 0x36, 0xf2, 0x3e, 0x6c, 0xfd, 0x78, 0x72, 0x81,
-	0x3c, 0x68, 0x28, 0xf6, 0x40, 0xf3, 0x4a, 0xdd, 0x93, 0xfe, 0xd8, 0x74, 0x3c, 0xf6, 0x89, 0x26,
-	0x57, 0xec, 0x81, 0xe2, 0x9c, 0xf3, 0x4e, 0xa1, 0x6f, 0x1d, 0x57, 0x5a, 0x48, 0xb2, 0xa2, 0xff,
-	0xe5, 0xfa, 0xe5, 0x6c, 0x6d, 0x73, 0xaa, 0x7f, 0x08, 0xb9, 0x41, 0x9f, 0xa1, 0xa7, 0xd7, 0x52,
-	0x64, 0xab, 0x75, 0x9a, 0xe9, 0x80, 0x5b, 0x3b, 0x7a, 0x64, 0x27, 0x9a, 0xe2, 0x8a, 0x0e, 0x7d,
-	0x81, 0xfd, 0x5d, 0x7c, 0x99, 0x69, 0xb7, 0xf6, 0xa4, 0xb1, 0x2a, 0x34, 0xeb, 0x11, 0x77, 0x54,
-	0x26, 0xe4, 0xde, 0xad, 0x0f, 0x9d, 0x51, 0x1b, 0x97, 0x21, 0x3a, 0x82, 0xb6, 0xc8, 0xf4, 0x52,
-	0x64, 0x3c, 0x76, 0x1b, 0x39, 0xb5, 0x8d, 0x0d, 0xc7, 0x78, 0x24, 0x6e, 0x19, 0x5f, 0xb9, 0x7b,
-	0x05, 0x57, 0xc6, 0xde, 0x6f, 0x07, 0x06, 0xe5, 0xfe, 0xa8, 0x12, 0x99, 0x8c, 0xa8, 0x42, 0x1e,
-	0xd4, 0xcf, 0xc2, 0x85, 0x9d, 0x67, 0x50, 0xb4, 0xb5, 0x7b, 0x57, 0x6c, 0x48, 0xa3, 0xc1, 0x93,
-	0x0b, 0xdb, 0x7a, 0x55, 0x83, 0x27, 0x17, 0xd8, 0x90, 0x68, 0x0c, 0x2d, 0x55, 0xac, 0x38, 0x6f,
-	0xb7, 0x7b, 0x72, 0x50, 0xd1, 0xd9, 0xf5, 0xe3, 0x52, 0x64, 0x72, 0xce, 0xc2, 0x45, 0xde, 0xff,
-	0xe3, 0x9c, 0x33, 0x53, 0xd7, 0x5c, 0xc8, 0x18, 0x5a, 0xbc, 0xd8, 0x7f, 0x3e, 0xcb, 0xe3, 0x9c,
-	0xf6, 0x6d, 0x70, 0x29, 0xf2, 0xfe, 0xd4, 0xa1, 0x65, 0x39, 0xd4, 0x87, 0x5a, 0xe0, 0xe7, 0x63,
-	0x75, 0x70, 0x2d, 0xf0, 0xd1, 0x5b, 0x68, 0x09, 0x19, 0x53, 0x19, 0xf8, 0x76, 0x8e, 0x5e, 0x91,
-	0xeb, 0x1b, 0x5b, 0x05, 0x5c, 0xe3, 0x92, 0x44, 0x6f, 0xa0, 0x19, 0x53, 0x92, 0x04, 0xbe, 0x1d,
-	0xa3, 0x2a, 0xb3, 0x1c, 0x3a, 0x86, 0x76, 0x9c, 0x49, 0xa2, 0x99, 0xe0, 0x76, 0x84, 0xf2, 0x92,
-	0x2c, 0x8a, 0xb7, 0x3c, 0x7a, 0x05, 0x7b, 0xa9, 0x64, 0x11, 0xb5, 0x33, 0x74, 0x0b, 0x61, 0x68,
-	0x20, 0x5c, 0x30, 0x68, 0x0c, 0x9d, 0x65, 0x42, 0xa2, 0x4d, 0xc2, 0x94, 0x76, 0x9b, 0xff, 0xae,
-	0x64, 0xaa, 0xd7, 0x93, 0x38, 0x96, 0x54, 0x29, 0xbc, 0x93, 0xa0, 0x53, 0xe8, 0x45, 0x22, 0xe3,
-	0x9a, 0xca, 0x94, 0x48, 0x7d, 0xef, 0xb6, 0x9e, 0xb0, 0x54, 0x54, 0xe8, 0x3d, 0xb4, 0x59, 0x4c,
-	0xb9, 0x66, 0xfa, 0xde, 0x6d, 0x0f, 0x9d, 0x51, 0xff, 0xe4, 0x65, 0xe1, 0x08, 0x2c, 0x7a, 0x4e,
-	0xef, 0x68, 0x82, 0xb7, 0x22, 0x34, 0x80, 0xba, 0x26, 0x2b, 0xb7, 0x33, 0x74, 0x46, 0x3d, 0x6c,
-	0x3e, 0xd1, 0x29, 0x74, 0x64, 0x79, 0x3a, 0x2e, 0xe4, 0x55, 0x0f, 0xab, 0xf7, 0x50, 0xb2, 0x78,
-	0x27, 0x44, 0xef, 0xa0, 0xa9, 0x34, 0xd1, 0x99, 0x72, 0xbb, 0x79, 0xd9, 0xea, 0x33, 0x8e, 0xaf,
-	0x72, 0x0e, 0x5b, 0x8d, 0x77, 0x0c, 0xcd, 0x02, 0x41, 0x00, 0xcd, 0xc9, 0xd9, 0x75, 0xf0, 0x7d,
-	0x3a, 0x78, 0x86, 0x0e, 0x60, 0x10, 0x4e, 0xe7, 0x7e, 0x30, 0x9f, 0xdd, 0xf8, 0xd3, 0xf3, 0xe9,
-	0x75, 0x70, 0x39, 0x1f, 0x38, 0xcb, 0x66, 0xfe, 0x2f, 0xfa, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0xd9, 0x4e, 0xf0, 0xd5, 0x04, 0x00, 0x00,
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0x23, 0x35,
+	0x14, 0xde, 0x4c, 0x26, 0x7f, 0x27, 0xd9, 0x34, 0x98, 0xdd, 0xca, 0x2a, 0x7b, 0x11, 0x06, 0x04,
+	0xd1, 0x0a, 0xb2, 0x4b, 0xa9, 0xa0, 0x57, 0x88, 0xd0, 0x09, 0x51, 0xc4, 0xb6, 0x1b, 0x39, 0x2d,
+	0x82, 0xab, 0xca, 0x9d, 0x31, 0x89, 0xd5, 0x89, 0x3d, 0xd8, 0x9e, 0xb2, 0xd9, 0x67, 0xe0, 0x2d,
+	0x78, 0x14, 0x5e, 0x81, 0x6b, 0x9e, 0x81, 0x47, 0x40, 0x9e, 0x9f, 0xfc, 0x94, 0x29, 0x82, 0xbb,
+	0x9c, 0xef, 0xc7, 0xf6, 0x39, 0x3e, 0x3e, 0x19, 0xe8, 0x52, 0x7d, 0x7b, 0x1d, 0x47, 0x54, 0x0c,
+	0x63, 0x25, 0x8d, 0x44, 0xae, 0x96, 0x62, 0x75, 0xd4, 0xb9, 0xe1, 0x0b, 0x2e, 0x4c, 0x86, 0x1d,
+	0x1d, 0x70, 0x61, 0x51, 0xc1, 0x69, 0x0e, 0xbc, 0xb3, 0xa2, 0xea, 0x96, 0x99, 0x38, 0xa2, 0x01,
+	0x2b, 0x34, 0x86, 0xaf, 0x98, 0x36, 0x74, 0x15, 0x67, 0x80, 0xf7, 0x19, 0xc0, 0x48, 0xdf, 0xce,
+	0x22, 0x2a, 0xce, 0x66, 0x57, 0xe8, 0x03, 0x78, 0x1c, 0x48, 0xc5, 0xae, 0x63, 0xa6, 0x02, 0x26,
+	0x8c, 0xc6, 0x95, 0x7e, 0x65, 0xe0, 0x92, 0x8e, 0x05, 0x67, 0x39, 0xe6, 0x7d, 0xb5, 0xb1, 0x4c,
+	0x66, 0x57, 0x08, 0x43, 0x83, 0x8b, 0x90, 0xbd, 0x61, 0x56, 0x5c, 0x1d, 0xb8, 0xa4, 0x08, 0xd1,
+	0x21, 0xd4, 0x97, 0x54, 0x2f, 0x99, 0xc6, 0x4e, 0xbf, 0x3a, 0x68, 0x91, 0x3c, 0xf2, 0x5e, 0x6e,
+	0xfc, 0x64, 0x74, 0x8e, 0x3c, 0x70, 0x35, 0x7f, 0xcb, 0xd2, 0x9d, 0xda, 0xc7, 0xdd, 0xa1, 0x4d,
+	0x61, 0xe8, 0x53, 0x43, 0xe7, 0xfc, 0x2d, 0x23, 0x29, 0xe7, 0x9d, 0x40, 0x37, 0x77, 0xcc, 0x8d,
+	0x54, 0x74, 0xc1, 0xfe, 0x93, 0xeb, 0x8f, 0xca, 0xc6, 0x76, 0xc1, 0xcc, 0x2f, 0x52, 0xdd, 0xa2,
+	0x2f, 0xa0, 0x63, 0x96, 0x4a, 0x26, 0x8b, 0x65, 0x9c, 0x98, 0xa9, 0xc8, 0xed, 0xe8, 0x9e, 0x9d,
+	0x1a, 0x46, 0xf6, 0x74, 0xe8, 0x14, 0x1e, 0x6f, 0xe3, 0xd7, 0x89, 0xc1, 0xce, 0x83, 0xc6, 0x7d,
+	0xa1, 0x2d, 0x8f, 0xbc, 0x63, 0x2a, 0xa2, 0x6b, 0x5c, 0xed, 0x57, 0x06, 0x4d, 0x52, 0x84, 0xe8,
+	0x08, 0x9a, 0x32, 0x31, 0x37, 0x32, 0x11, 0x21, 0x76, 0x53, 0x6a, 0x13, 0x5b, 0x8e, 0x8b, 0x40,
+	0xae, 0xb8, 0x58, 0xe0, 0x5a, 0xc6, 0x15, 0xb1, 0xf7, 0x67, 0x05, 0x7a, 0x45, 0xfd, 0x98, 0x96,
+	0x89, 0x0a, 0x98, 0x46, 0x1e, 0x54, 0xcf, 0x66, 0x57, 0x79, 0x3e, 0xbd, 0xec, 0x58, 0xdb, 0x7b,
+	0x25, 0x96, 0xb4, 0x1a, 0x32, 0x3a, 0xc7, 0x4e, 0x89, 0x86, 0x8c, 0xce, 0x89, 0x25, 0xd1, 0x10,
+	0x1a, 0x3a, 0x2b, 0x71, 0x7a, 0xdc, 0xf6, 0xf1, 0x93, 0x3d, 0x5d, 0x5e, 0x7e, 0x52, 0x88, 0xec,
+	0x9a, 0x93, 0xd9, 0x15, 0x76, 0x4b, 0xd6, 0x9c, 0xd8, 0x7d, 0x6d, 0x87, 0x0c, 0xa1, 0x21, 0xb2,
+	0xfa, 0xe3, 0x5a, 0xc9, 0x9a, 0xf9, 0xdd, 0x90, 0x42, 0xe4, 0xfd, 0x55, 0x83, 0x46, 0xce, 0xa1,
+	0x2e, 0x38, 0x53, 0x3f, 0x4d, 0xab, 0x45, 0x9c, 0xa9, 0x8f, 0x3e, 0x82, 0x86, 0x54, 0x21, 0x53,
+	0x53, 0x3f, 0xcf, 0xa3, 0x93, 0xad, 0xf5, 0x0d, 0x5f, 0x4c, 0x85, 0x21, 0x05, 0x89, 0x3e, 0x84,
+	0x7a, 0xc8, 0x68, 0x34, 0xf5, 0x71, 0xb5, 0x44, 0x96, 0x73, 0xe8, 0x39, 0x34, 0xc3, 0x44, 0x51,
+	0xc3, 0xa5, 0xc0, 0xee, 0x5e, 0x27, 0xe5, 0x28, 0xd9, 0xf0, 0xe8, 0x7d, 0xa8, 0xc5, 0x8a, 0x07,
+	0x2c, 0xcf, 0xa1, 0x9d, 0x09, 0x67, 0x16, 0x22, 0x19, 0x83, 0x86, 0xd0, 0xba, 0x89, 0x68, 0x70,
+	0x1b, 0x71, 0x6d, 0x70, 0x7d, 0xb7, 0x24, 0x63, 0xb3, 0x1c, 0x85, 0xa1, 0x62, 0x5a, 0x93, 0xad,
+	0x04, 0x9d, 0x40, 0x27, 0x90, 0x89, 0x30, 0x4c, 0xc5, 0x54, 0x99, 0x35, 0x6e, 0x3c, 0x60, 0xd9,
+	0x53, 0xa1, 0x17, 0xd0, 0xe4, 0x21, 0x13, 0x86, 0x9b, 0x35, 0x6e, 0xf6, 0x2b, 0x83, 0xee, 0xf1,
+	0xbb, 0x99, 0x63, 0x9a, 0xa3, 0xaf, 0xd8, 0x1d, 0x8b, 0xc8, 0x46, 0x84, 0x7a, 0x50, 0x35, 0x74,
+	0x81, 0x5b, 0xfd, 0xca, 0xa0, 0x43, 0xec, 0x4f, 0x74, 0x02, 0x2d, 0x55, 0xb4, 0x0e, 0x86, 0x74,
+	0xd7, 0xc3, 0xfd, 0x7e, 0x28, 0x58, 0xb2, 0x15, 0xa2, 0x4f, 0xa0, 0xae, 0x0d, 0x35, 0x89, 0xc6,
+	0xed, 0x74, 0xdb, 0xfd, 0x6b, 0x1c, 0xce, 0x53, 0x8e, 0xe4, 0x1a, 0xf4, 0x35, 0x74, 0x83, 0x25,
+	0x15, 0x0b, 0x46, 0xd8, 0xcf, 0x09, 0xd3, 0x46, 0xe3, 0x4e, 0xba, 0x11, 0xce, 0x5c, 0x67, 0xbb,
+	0x1c, 0x49, 0x22, 0xa6, 0xc9, 0x3d, 0x3d, 0x9a, 0xc3, 0xe1, 0x1e, 0xe2, 0xb3, 0x80, 0x6b, 0x2e,
+	0x85, 0xc6, 0x8f, 0xfb, 0xd5, 0x41, 0xfb, 0xf8, 0xbd, 0x92, 0x95, 0x0a, 0x0d, 0x79, 0xc0, 0x8a,
+	0x5e, 0x40, 0xc3, 0x5e, 0x96, 0x7d, 0x58, 0xdd, 0xf4, 0x3c, 0x4f, 0xb7, 0x17, 0xc9, 0xc5, 0x62,
+	0x6e, 0x14, 0x35, 0x6c, 0xb1, 0x26, 0x85, 0x0a, 0x7d, 0x09, 0xdd, 0x44, 0xac, 0xa8, 0x09, 0x96,
+	0x2c, 0x9c, 0x73, 0x11, 0x30, 0x7c, 0x90, 0xfa, 0x0e, 0x32, 0xdf, 0x65, 0x31, 0x4f, 0xc9, 0x3d,
+	0x99, 0xf7, 0x1c, 0xea, 0x59, 0x49, 0x10, 0x40, 0x7d, 0x74, 0x76, 0x39, 0xfd, 0x7e, 0xdc, 0x7b,
+	0x84, 0x9e, 0x40, 0x6f, 0x36, 0xbe, 0xf0, 0xa7, 0x17, 0x93, 0x6b, 0x7f, 0xfc, 0x6a, 0x7c, 0x39,
+	0x7d, 0x7d, 0xd1, 0xab, 0x78, 0xbf, 0x3a, 0x70, 0x70, 0xef, 0x04, 0x68, 0x08, 0xae, 0x59, 0xc7,
+	0xd9, 0x88, 0xeb, 0x1e, 0x1f, 0x95, 0x1e, 0x73, 0x78, 0xb9, 0x8e, 0x19, 0x49, 0x75, 0xb6, 0x41,
+	0x7f, 0x8a, 0xa4, 0x54, 0xd8, 0x29, 0x69, 0xd0, 0x94, 0x41, 0xcf, 0xa0, 0x15, 0xb2, 0x80, 0xae,
+	0xe7, 0x86, 0xc5, 0xe9, 0xc3, 0x70, 0xc9, 0x16, 0x40, 0x2f, 0xa1, 0x9d, 0x06, 0x33, 0xa6, 0xb8,
+	0x0c, 0x1f, 0x78, 0x10, 0xbb, 0x12, 0xbb, 0x9e, 0x59, 0x2a, 0xa6, 0x97, 0x32, 0x0a, 0xd3, 0x77,
+	0xe1, 0x92, 0x2d, 0xe0, 0x9d, 0x82, 0x6b, 0x8f, 0x87, 0x5a, 0x50, 0xfb, 0x76, 0xfa, 0xc3, 0xd8,
+	0xef, 0x3d, 0xb2, 0x95, 0x38, 0x1f, 0x91, 0xef, 0xc6, 0x97, 0xbd, 0x8a, 0x85, 0xfd, 0xf1, 0xd9,
+	0xe8, 0xc7, 0x9e, 0x83, 0xba, 0x00, 0x57, 0x73, 0xff, 0x7a, 0x36, 0x9e, 0x4c, 0xc6, 0x7e, 0xaf,
+	0xea, 0xfd, 0x5e, 0x01, 0xf4, 0xcf, 0x06, 0xb1, 0xb3, 0x94, 0x09, 0x7a, 0x13, 0xb1, 0x30, 0x2d,
+	0x4a, 0x93, 0x14, 0x21, 0xfa, 0x18, 0x9a, 0x2b, 0x2e, 0xd2, 0x5c, 0xcb, 0xd2, 0xdf, 0x90, 0x36,
+	0xc7, 0x15, 0x7d, 0x53, 0x64, 0x83, 0xab, 0xe5, 0x39, 0xee, 0x48, 0xd0, 0x29, 0x74, 0x77, 0x9e,
+	0x1f, 0x67, 0x1a, 0xbb, 0xfd, 0x6a, 0xe9, 0x33, 0xbd, 0xa7, 0xf3, 0x7e, 0x73, 0xe0, 0x69, 0x69,
+	0x73, 0xa2, 0x67, 0xe0, 0xf0, 0x10, 0x57, 0x4a, 0x26, 0x93, 0xc3, 0xc3, 0x9d, 0xd9, 0xe5, 0xfc,
+	0xcb, 0xec, 0xda, 0xcc, 0xa3, 0xea, 0x83, 0xf3, 0xe8, 0xff, 0x8c, 0xb7, 0x23, 0x68, 0xd2, 0x38,
+	0x56, 0xf2, 0x8e, 0x85, 0xc5, 0x3f, 0x4e, 0x11, 0xdb, 0x3f, 0x72, 0xc5, 0xa8, 0x96, 0x22, 0x1d,
+	0x6a, 0x2d, 0x92, 0x47, 0xe8, 0x09, 0xd4, 0x98, 0x52, 0x52, 0xa5, 0x83, 0xab, 0x45, 0xb2, 0x00,
+	0x7d, 0x0a, 0xad, 0xcd, 0x47, 0x06, 0x6e, 0x96, 0xbf, 0x95, 0xad, 0xe2, 0xa6, 0x9e, 0x7e, 0x87,
+	0x7c, 0xfe, 0xf7, 0x00, 0x02, 0x0e, 0x07, 0xb2, 0xe2, 0x08, 0x00, 0x00,
 }
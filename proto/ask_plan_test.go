@@ -33,6 +33,13 @@ resources:
     overlay: true
     outbound: true
     incoming: true
+
+pricing:
+  type: decay
+  floor: 10 USD/h
+  decaystep: 5
+  decayperiod: 1h
+  threshold: 10
 `)
 	ask := &AskPlan{}
 	err := yaml.Unmarshal(data, ask)
@@ -46,6 +53,10 @@ resources:
 	assert.Equal(t, expectedPrice, ask.GetPrice().GetPerSecond().Unwrap())
 
 	assert.Equal(t, time.Duration(time.Hour*8), ask.Duration.Unwrap())
+	assert.Equal(t, PricingStrategy_DECAY, ask.GetPricing().GetType())
+	assert.Equal(t, uint64(5), ask.GetPricing().GetDecayStep())
+	assert.Equal(t, time.Hour, ask.GetPricing().GetDecayPeriod().Unwrap())
+	assert.Equal(t, uint64(10), ask.GetPricing().GetThreshold())
 	assert.Equal(t, common.HexToAddress("0x8125721c2413d99a33e351e1f6bb4e56b6b633fd").Bytes(),
 		ask.GetBlacklist().GetAddress())
 
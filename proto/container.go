@@ -4,11 +4,29 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 )
 
+const (
+	// ReservedLabelPrefix is a prefix of container labels that are used
+	// internally by the Worker and can not be specified by users.
+	ReservedLabelPrefix = "sonm."
+)
+
+var (
+	hostnameRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	userRe     = regexp.MustCompile(`^[a-zA-Z0-9_.-]+(:[a-zA-Z0-9_.-]+)?$`)
+	// Docker's restrictions for named volumes.
+	volumeNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	signalRe     = regexp.MustCompile(`^(SIG)?[A-Z0-9]+$`)
+)
+
 func (m *Registry) Auth() string {
 	if m == nil {
 		return ""
@@ -39,10 +57,179 @@ func (m *ContainerRestartPolicy) Unwrap() container.RestartPolicy {
 	return restartPolicy
 }
 
+func (m *ContainerHealthcheck_Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	action, ok := ContainerHealthcheck_Action_value[strings.ToUpper(v)]
+	if !ok {
+		return fmt.Errorf("unknown healthcheck action: %s", v)
+	}
+
+	*m = ContainerHealthcheck_Action(action)
+	return nil
+}
+
+func (m *ContainerHealthcheck) Validate() error {
+	probes := 0
+	if len(m.GetCommand()) > 0 {
+		probes++
+	}
+	if m.GetTcpPort() != 0 {
+		probes++
+	}
+	if m.GetHttpPort() != 0 {
+		probes++
+	}
+
+	if probes != 1 {
+		return fmt.Errorf("exactly one healthcheck probe must be specified: command, TCP or HTTP")
+	}
+	if m.GetCommand() != nil && m.GetCommand()[0] == "" {
+		return fmt.Errorf("healthcheck command must not be empty")
+	}
+	if m.GetTcpPort() > 65535 || m.GetHttpPort() > 65535 {
+		return fmt.Errorf("healthcheck port must be in [1; 65535] range")
+	}
+	if m.GetHttpPath() != "" && m.GetHttpPort() == 0 {
+		return fmt.Errorf("healthcheck HTTP path requires HTTP port to be specified")
+	}
+	if strings.ContainsAny(m.GetHttpPath(), " '\"\\") {
+		return fmt.Errorf("healthcheck HTTP path contains forbidden characters")
+	}
+
+	for name, duration := range map[string]*Duration{
+		"interval":     m.GetInterval(),
+		"timeout":      m.GetTimeout(),
+		"start period": m.GetStartPeriod(),
+	} {
+		if duration.Unwrap() < 0 {
+			return fmt.Errorf("healthcheck %s must not be negative", name)
+		}
+		if duration.Unwrap() > 0 && duration.Unwrap() < time.Millisecond {
+			return fmt.Errorf("healthcheck %s must be at least 1ms", name)
+		}
+	}
+
+	return nil
+}
+
+// Unwrap converts the health check into Docker's health check config.
+//
+// TCP and HTTP probes are converted into shell commands.
+func (m *ContainerHealthcheck) Unwrap() *container.HealthConfig {
+	if m == nil {
+		return nil
+	}
+
+	healthConfig := &container.HealthConfig{
+		Interval:    m.GetInterval().Unwrap(),
+		Timeout:     m.GetTimeout().Unwrap(),
+		StartPeriod: m.GetStartPeriod().Unwrap(),
+		Retries:     int(m.GetRetries()),
+	}
+
+	switch {
+	case len(m.GetCommand()) > 0:
+		healthConfig.Test = append([]string{"CMD"}, m.GetCommand()...)
+	case m.GetTcpPort() != 0:
+		healthConfig.Test = []string{"CMD-SHELL", fmt.Sprintf("nc -z 127.0.0.1 %d || exit 1", m.GetTcpPort())}
+	case m.GetHttpPort() != 0:
+		url := fmt.Sprintf("http://127.0.0.1:%d/%s", m.GetHttpPort(), strings.TrimPrefix(m.GetHttpPath(), "/"))
+		healthConfig.Test = []string{"CMD-SHELL", fmt.Sprintf("curl -fsS -o /dev/null '%s' || wget -q -O /dev/null '%s' || exit 1", url, url)}
+	}
+
+	return healthConfig
+}
+
+func (m *ContainerPreemption) Validate() error {
+	if m.GetSignal() != "" && !signalRe.MatchString(m.GetSignal()) {
+		return fmt.Errorf("invalid preemption signal %s", m.GetSignal())
+	}
+	if m.GetFile() != "" && !path.IsAbs(m.GetFile()) {
+		return fmt.Errorf("preemption file must be an absolute path, got %s", m.GetFile())
+	}
+	if strings.ContainsAny(m.GetFile(), "'\"\\") {
+		return fmt.Errorf("preemption file path contains forbidden characters")
+	}
+	if m.GetHttpPort() > 65535 {
+		return fmt.Errorf("preemption HTTP port must be in [1; 65535] range")
+	}
+	if m.GetHttpPath() != "" && m.GetHttpPort() == 0 {
+		return fmt.Errorf("preemption HTTP path requires HTTP port to be specified")
+	}
+	if strings.ContainsAny(m.GetHttpPath(), " '\"\\") {
+		return fmt.Errorf("preemption HTTP path contains forbidden characters")
+	}
+
+	return nil
+}
+
+// Script returns a shell script delivering the given notice to the file and
+// HTTP endpoint from inside the container. Empty script is returned if none
+// of them is specified.
+func (m *ContainerPreemption) Script(notice []byte) string {
+	quoted := strings.Replace(string(notice), "'", `'\''`, -1)
+
+	var commands []string
+	if m.GetFile() != "" {
+		commands = append(commands, fmt.Sprintf("{ mkdir -p '%s' && printf '%%s' '%s' > '%s'; }",
+			path.Dir(m.GetFile()), quoted, m.GetFile()))
+	}
+	if m.GetHttpPort() != 0 {
+		url := fmt.Sprintf("http://127.0.0.1:%d/%s", m.GetHttpPort(), strings.TrimPrefix(m.GetHttpPath(), "/"))
+		commands = append(commands, fmt.Sprintf("{ curl -fsS -o /dev/null -H 'Content-Type: application/json' -d '%s' '%s' || "+
+			"wget -q -O /dev/null --header='Content-Type: application/json' --post-data='%s' '%s'; }", quoted, url, quoted, url))
+	}
+
+	if len(commands) == 0 {
+		return ""
+	}
+
+	return "status=0; " + strings.Join(commands, " || status=1; ") + " || status=1; exit $status"
+}
+
 func (m *Container) Validate() error {
 	if m.GetImage() == "" {
 		return fmt.Errorf("container image name is required")
 	}
 
+	if len(m.GetEntrypoint()) > 0 && m.GetEntrypoint()[0] == "" {
+		return fmt.Errorf("container entrypoint executable must not be empty")
+	}
+
+	if m.GetWorkingDir() != "" && !path.IsAbs(m.GetWorkingDir()) {
+		return fmt.Errorf("container working directory must be an absolute path, got %s", m.GetWorkingDir())
+	}
+
+	if m.GetUser() != "" && !userRe.MatchString(m.GetUser()) {
+		return fmt.Errorf("container user must be in \"user[:group]\" format, got %s", m.GetUser())
+	}
+
+	if m.GetHostname() != "" && !hostnameRe.MatchString(m.GetHostname()) {
+		return fmt.Errorf("invalid container hostname %s", m.GetHostname())
+	}
+
+	if m.GetHealthcheck() != nil {
+		if err := m.GetHealthcheck().Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := m.GetPreemption().Validate(); err != nil {
+		return err
+	}
+
+	for key := range m.GetLabels() {
+		if key == "" {
+			return fmt.Errorf("container label key must not be empty")
+		}
+		if strings.HasPrefix(key, ReservedLabelPrefix) {
+			return fmt.Errorf("container label %s uses reserved %q prefix", key, ReservedLabelPrefix)
+		}
+	}
+
 	return nil
 }
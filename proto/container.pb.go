@@ -12,6 +12,37 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// Action describes what to do with the container when it becomes
+// unhealthy.
+type ContainerHealthcheck_Action int32
+
+const (
+	// NOTHING only reports the unhealthy state.
+	ContainerHealthcheck_NOTHING ContainerHealthcheck_Action = 0
+	// RESTART restarts the container.
+	ContainerHealthcheck_RESTART ContainerHealthcheck_Action = 1
+	// STOP stops the container, marking the task as broken.
+	ContainerHealthcheck_STOP ContainerHealthcheck_Action = 2
+)
+
+var ContainerHealthcheck_Action_name = map[int32]string{
+	0: "NOTHING",
+	1: "RESTART",
+	2: "STOP",
+}
+var ContainerHealthcheck_Action_value = map[string]int32{
+	"NOTHING": 0,
+	"RESTART": 1,
+	"STOP":    2,
+}
+
+func (x ContainerHealthcheck_Action) String() string {
+	return proto.EnumName(ContainerHealthcheck_Action_name, int32(x))
+}
+func (ContainerHealthcheck_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor4, []int{2, 0}
+}
+
 type Registry struct {
 	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
@@ -65,6 +96,156 @@ func (m *ContainerRestartPolicy) GetMaximumRetryCount() uint32 {
 	return 0
 }
 
+// ContainerHealthcheck describes how to check that the container works
+// properly. Exactly one probe (command, TCP or HTTP) must be specified.
+//
+// TCP and HTTP probes are performed from inside the container, so the image
+// must provide either "nc" (TCP) or "curl"/"wget" (HTTP) utility.
+type ContainerHealthcheck struct {
+	// Command is executed inside the container, zero exit code means healthy.
+	Command []string `protobuf:"bytes,1,rep,name=command" json:"command,omitempty"`
+	// TCPPort is a container port that must accept TCP connections.
+	TcpPort uint32 `protobuf:"varint,2,opt,name=tcpPort" json:"tcpPort,omitempty"`
+	// HTTPPort is a container port that must respond with a successful
+	// status to HTTP GET requests at the HTTPPath.
+	HttpPort uint32 `protobuf:"varint,3,opt,name=httpPort" json:"httpPort,omitempty"`
+	HttpPath string `protobuf:"bytes,4,opt,name=httpPath" json:"httpPath,omitempty"`
+	// Interval between checks. Docker's default of 30s is used when omitted.
+	Interval *Duration `protobuf:"bytes,5,opt,name=interval" json:"interval,omitempty"`
+	// Timeout of a single check. Docker's default of 30s is used when omitted.
+	Timeout *Duration `protobuf:"bytes,6,opt,name=timeout" json:"timeout,omitempty"`
+	// StartPeriod is a time for the container to initialize, during which
+	// failed checks are not counted.
+	StartPeriod *Duration `protobuf:"bytes,7,opt,name=startPeriod" json:"startPeriod,omitempty"`
+	// Retries is the number of consecutive failed checks after which the
+	// container is considered unhealthy. Docker's default of 3 is used when
+	// omitted.
+	Retries     uint32                      `protobuf:"varint,8,opt,name=retries" json:"retries,omitempty"`
+	OnUnhealthy ContainerHealthcheck_Action `protobuf:"varint,9,opt,name=onUnhealthy,enum=sonm.ContainerHealthcheck_Action" json:"onUnhealthy,omitempty"`
+}
+
+func (m *ContainerHealthcheck) Reset()                    { *m = ContainerHealthcheck{} }
+func (m *ContainerHealthcheck) String() string            { return proto.CompactTextString(m) }
+func (*ContainerHealthcheck) ProtoMessage()               {}
+func (*ContainerHealthcheck) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
+
+func (m *ContainerHealthcheck) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ContainerHealthcheck) GetTcpPort() uint32 {
+	if m != nil {
+		return m.TcpPort
+	}
+	return 0
+}
+
+func (m *ContainerHealthcheck) GetHttpPort() uint32 {
+	if m != nil {
+		return m.HttpPort
+	}
+	return 0
+}
+
+func (m *ContainerHealthcheck) GetHttpPath() string {
+	if m != nil {
+		return m.HttpPath
+	}
+	return ""
+}
+
+func (m *ContainerHealthcheck) GetInterval() *Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *ContainerHealthcheck) GetTimeout() *Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *ContainerHealthcheck) GetStartPeriod() *Duration {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return nil
+}
+
+func (m *ContainerHealthcheck) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *ContainerHealthcheck) GetOnUnhealthy() ContainerHealthcheck_Action {
+	if m != nil {
+		return m.OnUnhealthy
+	}
+	return ContainerHealthcheck_NOTHING
+}
+
+// ContainerPreemption describes how the container is notified that its deal
+// is going to be closed by the supplier, so the workload can save its state.
+// Any combination of notices can be specified, all of them are delivered.
+//
+// The notice is a JSON object with "dealID", "deadline" (RFC 3339) and
+// "reason" fields. File and HTTP notices are delivered from inside the
+// container, so the image must provide "sh" and, for HTTP, either "curl" or
+// "wget" utility.
+type ContainerPreemption struct {
+	// Signal is sent to the container's main process, for example "SIGTERM",
+	// after the other notices are delivered, so the process can rely on them.
+	Signal string `protobuf:"bytes,1,opt,name=signal" json:"signal,omitempty"`
+	// File is an absolute path inside the container the notice is written
+	// to, usually in a mounted volume.
+	File string `protobuf:"bytes,2,opt,name=file" json:"file,omitempty"`
+	// HTTPPort is a container port that receives the notice as a POST
+	// request at the HTTPPath.
+	HttpPort uint32 `protobuf:"varint,3,opt,name=httpPort" json:"httpPort,omitempty"`
+	HttpPath string `protobuf:"bytes,4,opt,name=httpPath" json:"httpPath,omitempty"`
+}
+
+func (m *ContainerPreemption) Reset()                    { *m = ContainerPreemption{} }
+func (m *ContainerPreemption) String() string            { return proto.CompactTextString(m) }
+func (*ContainerPreemption) ProtoMessage()               {}
+func (*ContainerPreemption) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+
+func (m *ContainerPreemption) GetSignal() string {
+	if m != nil {
+		return m.Signal
+	}
+	return ""
+}
+
+func (m *ContainerPreemption) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *ContainerPreemption) GetHttpPort() uint32 {
+	if m != nil {
+		return m.HttpPort
+	}
+	return 0
+}
+
+func (m *ContainerPreemption) GetHttpPath() string {
+	if m != nil {
+		return m.HttpPath
+	}
+	return ""
+}
+
 type NetworkSpec struct {
 	Type    string            `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
 	Options map[string]string `protobuf:"bytes,2,rep,name=options" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -75,7 +256,7 @@ type NetworkSpec struct {
 func (m *NetworkSpec) Reset()                    { *m = NetworkSpec{} }
 func (m *NetworkSpec) String() string            { return proto.CompactTextString(m) }
 func (*NetworkSpec) ProtoMessage()               {}
-func (*NetworkSpec) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
+func (*NetworkSpec) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4} }
 
 func (m *NetworkSpec) GetType() string {
 	if m != nil {
@@ -127,12 +308,32 @@ type Container struct {
 	RestartPolicy *ContainerRestartPolicy `protobuf:"bytes,8,opt,name=restartPolicy" json:"restartPolicy,omitempty"`
 	// Expose controls how container ports are exposed.
 	Expose []string `protobuf:"bytes,10,rep,name=expose" json:"expose,omitempty"`
+	// Command overrides the default command (CMD) of the image.
+	Command []string `protobuf:"bytes,11,rep,name=command" json:"command,omitempty"`
+	// Entrypoint overrides the default entrypoint (ENTRYPOINT) of the image.
+	Entrypoint []string `protobuf:"bytes,12,rep,name=entrypoint" json:"entrypoint,omitempty"`
+	// WorkingDir overrides the working directory of the container's main
+	// process. Must be an absolute path.
+	WorkingDir string `protobuf:"bytes,13,opt,name=workingDir" json:"workingDir,omitempty"`
+	// User overrides the user the container's main process runs as. Both
+	// "user" and "user:group" forms are accepted, names or numeric IDs.
+	User string `protobuf:"bytes,14,opt,name=user" json:"user,omitempty"`
+	// Labels describes additional metadata attached to the container. Labels
+	// with "sonm." prefix are reserved.
+	Labels map[string]string `protobuf:"bytes,15,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Hostname of the container.
+	Hostname string `protobuf:"bytes,16,opt,name=hostname" json:"hostname,omitempty"`
+	// Healthcheck describes how to check that the container works properly.
+	Healthcheck *ContainerHealthcheck `protobuf:"bytes,17,opt,name=healthcheck" json:"healthcheck,omitempty"`
+	// Preemption describes how the container is notified before its deal is
+	// closed by the supplier.
+	Preemption *ContainerPreemption `protobuf:"bytes,18,opt,name=preemption" json:"preemption,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5} }
 
 func (m *Container) GetImage() string {
 	if m != nil {
@@ -197,43 +398,124 @@ func (m *Container) GetExpose() []string {
 	return nil
 }
 
+func (m *Container) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *Container) GetEntrypoint() []string {
+	if m != nil {
+		return m.Entrypoint
+	}
+	return nil
+}
+
+func (m *Container) GetWorkingDir() string {
+	if m != nil {
+		return m.WorkingDir
+	}
+	return ""
+}
+
+func (m *Container) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Container) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Container) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *Container) GetHealthcheck() *ContainerHealthcheck {
+	if m != nil {
+		return m.Healthcheck
+	}
+	return nil
+}
+
+func (m *Container) GetPreemption() *ContainerPreemption {
+	if m != nil {
+		return m.Preemption
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Registry)(nil), "sonm.Registry")
 	proto.RegisterType((*ContainerRestartPolicy)(nil), "sonm.ContainerRestartPolicy")
+	proto.RegisterType((*ContainerHealthcheck)(nil), "sonm.ContainerHealthcheck")
+	proto.RegisterType((*ContainerPreemption)(nil), "sonm.ContainerPreemption")
 	proto.RegisterType((*NetworkSpec)(nil), "sonm.NetworkSpec")
 	proto.RegisterType((*Container)(nil), "sonm.Container")
+	proto.RegisterEnum("sonm.ContainerHealthcheck_Action", ContainerHealthcheck_Action_name, ContainerHealthcheck_Action_value)
 }
 
 func init() { proto.RegisterFile("container.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6a, 0xdc, 0x30,
-	0x10, 0xc5, 0xeb, 0xcd, 0xae, 0x33, 0xeb, 0xd0, 0x46, 0x94, 0x20, 0x4c, 0x29, 0x8b, 0x4f, 0xa1,
-	0xb4, 0x3e, 0xa4, 0x10, 0x42, 0x8e, 0x09, 0x81, 0x42, 0xa1, 0x29, 0x0a, 0xf4, 0xd0, 0x9b, 0xb3,
-	0x3b, 0xa4, 0x26, 0x2b, 0xc9, 0x48, 0xf2, 0x26, 0xfe, 0xbe, 0xfe, 0x4b, 0xbf, 0xa3, 0x48, 0x63,
-	0x1b, 0x6f, 0xb3, 0x97, 0xdc, 0xf4, 0x3c, 0x6f, 0xde, 0xbc, 0x37, 0x92, 0xe1, 0xcd, 0x4a, 0x2b,
-	0x57, 0x56, 0x0a, 0x4d, 0x51, 0x1b, 0xed, 0x34, 0x9b, 0x5a, 0xad, 0x64, 0x96, 0x6e, 0xf5, 0xa6,
-	0x91, 0x48, 0xdf, 0xf2, 0x2b, 0x48, 0x04, 0x3e, 0x54, 0xd6, 0x99, 0x96, 0x65, 0x90, 0x34, 0x16,
-	0x8d, 0x2a, 0x25, 0xf2, 0x68, 0x19, 0x9d, 0x1e, 0x8a, 0x01, 0xfb, 0x5a, 0x5d, 0x5a, 0xfb, 0xa4,
-	0xcd, 0x9a, 0x4f, 0xa8, 0xd6, 0xe3, 0xfc, 0x17, 0x9c, 0x5c, 0xf7, 0xa3, 0x04, 0x5a, 0x57, 0x1a,
-	0xf7, 0x43, 0x6f, 0xaa, 0x55, 0xcb, 0x18, 0x4c, 0x47, 0x6a, 0xe1, 0xcc, 0x3e, 0xc1, 0xb1, 0x2c,
-	0x9f, 0x2b, 0xd9, 0x48, 0x81, 0xce, 0xb4, 0xd7, 0xba, 0x51, 0x2e, 0x48, 0x1e, 0x89, 0x97, 0x85,
-	0xfc, 0x4f, 0x04, 0x8b, 0xef, 0xe8, 0x9e, 0xb4, 0x79, 0xbc, 0xab, 0x71, 0xe5, 0x15, 0x5d, 0x5b,
-	0x0f, 0x8a, 0xfe, 0xcc, 0x2e, 0x60, 0xae, 0x6b, 0x57, 0x69, 0x65, 0xf9, 0x64, 0x19, 0x9f, 0x2e,
-	0xce, 0x3e, 0x14, 0x3e, 0x69, 0x31, 0xea, 0x2b, 0x6e, 0x89, 0x70, 0xa3, 0x9c, 0x69, 0x45, 0x4f,
-	0x67, 0x27, 0x30, 0xb3, 0xcd, 0xbd, 0x42, 0xc7, 0xe3, 0xa0, 0xd7, 0x21, 0x3f, 0xa5, 0x5c, 0xaf,
-	0x0d, 0x9f, 0xd2, 0x14, 0x7f, 0xce, 0x2e, 0x21, 0x1d, 0x8b, 0xb0, 0xb7, 0x10, 0x3f, 0x62, 0xdb,
-	0x19, 0xf1, 0x47, 0xf6, 0x0e, 0x0e, 0xb6, 0xe5, 0xa6, 0xc1, 0x6e, 0x41, 0x04, 0x2e, 0x27, 0x17,
-	0x51, 0xfe, 0x37, 0x86, 0xc3, 0x61, 0x45, 0x9e, 0x57, 0xc9, 0xf2, 0xa1, 0x0f, 0x41, 0x20, 0x78,
-	0xb1, 0xbf, 0xbf, 0x61, 0xdb, 0xb5, 0x77, 0x88, 0xe5, 0x90, 0xae, 0xb4, 0x94, 0x95, 0xbb, 0x55,
-	0x77, 0x4e, 0xd7, 0xc1, 0x69, 0x22, 0x76, 0xbe, 0xb1, 0x8f, 0x10, 0xa3, 0xda, 0xf2, 0x69, 0x48,
-	0xcf, 0x29, 0xfd, 0x30, 0xaf, 0xb8, 0x51, 0x5b, 0xca, 0xed, 0x49, 0xec, 0x1c, 0xe6, 0xf4, 0x02,
-	0x2c, 0x3f, 0x08, 0xfc, 0xf7, 0xff, 0xf3, 0x7f, 0x52, 0xb9, 0xdb, 0x55, 0x47, 0xf6, 0xfe, 0xa4,
-	0xbf, 0x12, 0xcb, 0x67, 0xcb, 0xd8, 0xfb, 0x23, 0xc4, 0x3e, 0x43, 0xa2, 0x68, 0xd1, 0x96, 0xcf,
-	0x83, 0xe0, 0xf1, 0x8b, 0xf5, 0x8b, 0x81, 0xc2, 0xae, 0xe0, 0xc8, 0x8c, 0xdf, 0x08, 0x4f, 0x96,
-	0xd1, 0x1e, 0x13, 0x3b, 0xef, 0x48, 0xec, 0xb6, 0x78, 0x2b, 0xf8, 0x5c, 0x6b, 0x8b, 0x1c, 0xc8,
-	0x0a, 0xa1, 0xec, 0x1c, 0x92, 0x3e, 0xeb, 0x6b, 0xae, 0x27, 0xfb, 0x0a, 0xe9, 0x38, 0xf3, 0x9e,
-	0xde, 0x7c, 0xdc, 0xbb, 0x38, 0x4b, 0xc9, 0x2d, 0x35, 0x8d, 0x94, 0xee, 0x67, 0xe1, 0xaf, 0xfa,
-	0xf2, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x82, 0xcc, 0x8a, 0x7c, 0x03, 0x00, 0x00,
+	// 786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xd1, 0x6e, 0xdb, 0x36,
+	0x14, 0x9d, 0x62, 0xc7, 0x96, 0xaf, 0x9c, 0xc4, 0xe1, 0x8a, 0x82, 0xf3, 0x86, 0xc2, 0xd3, 0x93,
+	0x51, 0x74, 0xc6, 0x90, 0x02, 0x45, 0x5b, 0xec, 0xa5, 0x4d, 0x83, 0x65, 0xd8, 0x90, 0x18, 0xb4,
+	0xb7, 0x87, 0xbd, 0x31, 0x32, 0x67, 0x13, 0x91, 0x48, 0x81, 0xa4, 0xdc, 0xea, 0x4b, 0xf6, 0x41,
+	0xfb, 0x8a, 0xfd, 0xcd, 0x40, 0x52, 0x52, 0x65, 0xc7, 0x7b, 0x48, 0x9f, 0xc2, 0xc3, 0x7b, 0xee,
+	0xf5, 0xbd, 0x47, 0x87, 0x37, 0x70, 0x96, 0x48, 0x61, 0x28, 0x17, 0x4c, 0xcd, 0x72, 0x25, 0x8d,
+	0x44, 0x5d, 0x2d, 0x45, 0x36, 0x3e, 0xe3, 0xc2, 0xfe, 0x15, 0x9c, 0xfa, 0xeb, 0xf1, 0x70, 0x2b,
+	0xd3, 0x22, 0x63, 0x1e, 0xc5, 0xef, 0x21, 0x24, 0x6c, 0xcd, 0xb5, 0x51, 0x25, 0x1a, 0x43, 0x58,
+	0x68, 0xa6, 0x04, 0xcd, 0x18, 0x0e, 0x26, 0xc1, 0x74, 0x40, 0x1a, 0x6c, 0x63, 0x39, 0xd5, 0xfa,
+	0xa3, 0x54, 0x2b, 0x7c, 0xe4, 0x63, 0x35, 0x8e, 0xff, 0x84, 0xa7, 0x97, 0xf5, 0x6f, 0x13, 0xa6,
+	0x0d, 0x55, 0x66, 0x2e, 0x53, 0x9e, 0x94, 0x08, 0x41, 0xb7, 0x55, 0xcd, 0x9d, 0xd1, 0x0b, 0x38,
+	0xcf, 0xe8, 0x27, 0x9e, 0x15, 0x19, 0x61, 0x46, 0x95, 0x97, 0xb2, 0x10, 0xc6, 0x95, 0x3c, 0x21,
+	0x0f, 0x03, 0xf1, 0xdf, 0x1d, 0x78, 0xd2, 0x14, 0xbf, 0x66, 0x34, 0x35, 0x9b, 0x64, 0xc3, 0x92,
+	0x7b, 0x84, 0xa1, 0x9f, 0xc8, 0x2c, 0xa3, 0x62, 0x85, 0x83, 0x49, 0x67, 0x3a, 0x20, 0x35, 0xb4,
+	0x11, 0x93, 0xe4, 0x73, 0xa9, 0xea, 0xb2, 0x35, 0xb4, 0x43, 0x6c, 0x8c, 0xf1, 0xa1, 0x8e, 0x0b,
+	0x35, 0xb8, 0x89, 0x51, 0xb3, 0xc1, 0x5d, 0x3f, 0x60, 0x8d, 0xd1, 0x73, 0x08, 0xb9, 0x30, 0x4c,
+	0x6d, 0x69, 0x8a, 0x8f, 0x27, 0xc1, 0x34, 0xba, 0x38, 0x9d, 0x59, 0x51, 0x67, 0x1f, 0x0a, 0x45,
+	0x0d, 0x97, 0x82, 0x34, 0x71, 0x34, 0x85, 0xbe, 0xe1, 0x19, 0x93, 0x85, 0xc1, 0xbd, 0x83, 0xd4,
+	0x3a, 0x8c, 0x7e, 0x84, 0xc8, 0x6b, 0xc5, 0x14, 0x97, 0x2b, 0xdc, 0x3f, 0xc8, 0x6e, 0x53, 0xec,
+	0x64, 0x8a, 0x19, 0xc5, 0x99, 0xc6, 0xa1, 0x9f, 0xac, 0x82, 0xe8, 0x12, 0x22, 0x29, 0x7e, 0x17,
+	0x1b, 0x27, 0x50, 0x89, 0x07, 0x93, 0x60, 0x7a, 0x7a, 0xf1, 0xbd, 0xaf, 0x75, 0x48, 0xbe, 0xd9,
+	0xbb, 0xc4, 0x97, 0x6f, 0x65, 0xc5, 0x2f, 0xa0, 0xe7, 0xaf, 0x51, 0x04, 0xfd, 0x9b, 0xdb, 0xe5,
+	0xf5, 0x2f, 0x37, 0x3f, 0x8f, 0xbe, 0xb2, 0x80, 0x5c, 0x2d, 0x96, 0xef, 0xc8, 0x72, 0x14, 0xa0,
+	0x10, 0xba, 0x8b, 0xe5, 0xed, 0x7c, 0x74, 0x14, 0x97, 0xf0, 0x75, 0x53, 0x79, 0xae, 0x18, 0xcb,
+	0x72, 0x97, 0xfa, 0x14, 0x7a, 0x9a, 0xaf, 0x05, 0x4d, 0xab, 0x8f, 0x5e, 0x21, 0x6b, 0x85, 0xbf,
+	0x78, 0xca, 0x2a, 0xf3, 0xb8, 0xf3, 0x97, 0x7e, 0x8f, 0xf8, 0x9f, 0x00, 0xa2, 0x1b, 0x66, 0x3e,
+	0x4a, 0x75, 0xbf, 0xc8, 0x59, 0x62, 0x6b, 0x9b, 0x32, 0x6f, 0x6c, 0x66, 0xcf, 0xe8, 0x35, 0xf4,
+	0xa5, 0xeb, 0x48, 0xe3, 0xa3, 0x49, 0x67, 0x1a, 0x5d, 0x3c, 0xf3, 0x6a, 0xb4, 0xf2, 0x66, 0xb7,
+	0x9e, 0x70, 0x25, 0x8c, 0x2a, 0x49, 0x4d, 0x77, 0x13, 0x14, 0x77, 0x82, 0xf9, 0x9e, 0x06, 0xa4,
+	0x42, 0xf6, 0x57, 0xe8, 0x6a, 0xa5, 0xaa, 0x6e, 0xdc, 0x79, 0xfc, 0x16, 0x86, 0xed, 0x22, 0x68,
+	0x04, 0x9d, 0x7b, 0x56, 0x56, 0x8d, 0xd8, 0x23, 0x7a, 0x02, 0xc7, 0x5b, 0x9a, 0x16, 0xf5, 0xe0,
+	0x1e, 0xbc, 0x3d, 0x7a, 0x1d, 0xc4, 0xff, 0xf6, 0x60, 0xd0, 0x28, 0x68, 0x79, 0x3c, 0xa3, 0xeb,
+	0x7a, 0x08, 0x0f, 0x5c, 0x2f, 0x7a, 0xf3, 0x2b, 0x2b, 0xab, 0xf4, 0x0a, 0xa1, 0x18, 0x86, 0xd6,
+	0xee, 0xdc, 0xdc, 0x8a, 0x85, 0x91, 0xb9, 0xeb, 0x34, 0x24, 0x3b, 0x77, 0xe8, 0x39, 0x74, 0x98,
+	0xd8, 0xe2, 0xae, 0x9b, 0x1e, 0xef, 0x79, 0x61, 0x76, 0x25, 0xb6, 0x7e, 0x6e, 0x4b, 0x42, 0xaf,
+	0xa0, 0xef, 0xd7, 0x82, 0xc6, 0xc7, 0x8e, 0xff, 0xdd, 0x3e, 0xff, 0x0f, 0x1f, 0xae, 0xb4, 0xaa,
+	0xc8, 0xb6, 0xbf, 0xcc, 0xbe, 0x53, 0x8d, 0x7b, 0xee, 0x11, 0x56, 0x08, 0xfd, 0x00, 0xa1, 0xf0,
+	0x42, 0x6b, 0xdc, 0x77, 0x05, 0xcf, 0x1f, 0xc8, 0x4f, 0x1a, 0x0a, 0x7a, 0x0f, 0x27, 0xaa, 0xbd,
+	0x38, 0x9c, 0xbd, 0x1f, 0x36, 0xb1, 0xb3, 0x5c, 0xc8, 0x6e, 0x8a, 0x6d, 0x85, 0x7d, 0xca, 0xa5,
+	0x66, 0x18, 0x7c, 0x2b, 0x1e, 0xb5, 0x17, 0x45, 0xb4, 0xbb, 0x28, 0x9e, 0x01, 0x30, 0x3b, 0x4e,
+	0x2e, 0xb9, 0x30, 0x78, 0xe8, 0x82, 0xad, 0x1b, 0x1b, 0xb7, 0xed, 0x71, 0xb1, 0xfe, 0xc0, 0x15,
+	0x3e, 0x71, 0x1f, 0xa0, 0x75, 0x63, 0x0d, 0x61, 0xf7, 0x23, 0x3e, 0xf5, 0x86, 0xb0, 0x67, 0xf4,
+	0x12, 0x7a, 0x29, 0xbd, 0x63, 0xa9, 0xc6, 0x67, 0x6e, 0xec, 0x6f, 0xf7, 0x75, 0xfc, 0xcd, 0x45,
+	0xbd, 0x8c, 0x15, 0xd5, 0x79, 0x5d, 0x6a, 0xe3, 0x56, 0xe5, 0xa8, 0xf2, 0x7a, 0x85, 0xd1, 0x4f,
+	0x10, 0x6d, 0x3e, 0xbf, 0x5b, 0x7c, 0xee, 0x84, 0x19, 0xff, 0xff, 0xcb, 0x26, 0x6d, 0x3a, 0x7a,
+	0x03, 0x90, 0x37, 0x6f, 0x13, 0x23, 0x97, 0xfc, 0xcd, 0x5e, 0xf2, 0xe7, 0xc7, 0x4b, 0x5a, 0xe4,
+	0xf1, 0x2b, 0x08, 0x6b, 0x8f, 0x3c, 0xc6, 0xd6, 0xe3, 0x6b, 0x18, 0xb6, 0xbd, 0x72, 0x20, 0x37,
+	0x6e, 0xe7, 0x46, 0x17, 0x43, 0xdf, 0x8f, 0x4f, 0x6a, 0x57, 0x7a, 0x03, 0x51, 0x4b, 0xad, 0xc7,
+	0x34, 0x71, 0xd7, 0x73, 0xff, 0xdd, 0x5e, 0xfe, 0x37, 0x00, 0x68, 0x40, 0x2d, 0x69, 0x15, 0x07,
+	0x00, 0x00,
 }
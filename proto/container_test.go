@@ -0,0 +1,74 @@
+package sonm
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerHealthcheckUnwrap(t *testing.T) {
+	healthcheck := &ContainerHealthcheck{
+		TcpPort:  8080,
+		Interval: &Duration{Nanoseconds: int64(10 * time.Second)},
+		Retries:  2,
+	}
+
+	config := healthcheck.Unwrap()
+	assert.Equal(t, []string{"CMD-SHELL", "nc -z 127.0.0.1 8080 || exit 1"}, config.Test)
+	assert.Equal(t, 10*time.Second, config.Interval)
+	assert.Equal(t, time.Duration(0), config.Timeout)
+	assert.Equal(t, 2, config.Retries)
+
+	healthcheck = &ContainerHealthcheck{Command: []string{"/bin/check", "-v"}}
+	assert.Equal(t, []string{"CMD", "/bin/check", "-v"}, healthcheck.Unwrap().Test)
+
+	healthcheck = &ContainerHealthcheck{HttpPort: 80, HttpPath: "/health"}
+	assert.Equal(t, []string{"CMD-SHELL", "curl -fsS -o /dev/null 'http://127.0.0.1:80/health' || wget -q -O /dev/null 'http://127.0.0.1:80/health' || exit 1"}, healthcheck.Unwrap().Test)
+
+	assert.Nil(t, (*ContainerHealthcheck)(nil).Unwrap())
+}
+
+func TestContainerHealthcheckValidate(t *testing.T) {
+	assert.Error(t, (&ContainerHealthcheck{}).Validate())
+	assert.Error(t, (&ContainerHealthcheck{TcpPort: 80, HttpPort: 80}).Validate())
+	assert.Error(t, (&ContainerHealthcheck{TcpPort: 70000}).Validate())
+	assert.Error(t, (&ContainerHealthcheck{TcpPort: 80, HttpPath: "/"}).Validate())
+	assert.Error(t, (&ContainerHealthcheck{HttpPort: 80, HttpPath: "/'; rm -rf /"}).Validate())
+	assert.Error(t, (&ContainerHealthcheck{Command: []string{""}}).Validate())
+	assert.Error(t, (&ContainerHealthcheck{TcpPort: 80, Timeout: &Duration{Nanoseconds: -1}}).Validate())
+	assert.NoError(t, (&ContainerHealthcheck{HttpPort: 80, HttpPath: "/health?full=1"}).Validate())
+}
+
+func TestContainerPreemptionValidate(t *testing.T) {
+	assert.NoError(t, (*ContainerPreemption)(nil).Validate())
+	assert.NoError(t, (&ContainerPreemption{Signal: "SIGUSR1", File: "/data/preempted", HttpPort: 80, HttpPath: "/preempt"}).Validate())
+	assert.NoError(t, (&ContainerPreemption{Signal: "TERM"}).Validate())
+	assert.Error(t, (&ContainerPreemption{Signal: "SIGTERM; reboot"}).Validate())
+	assert.Error(t, (&ContainerPreemption{File: "data/preempted"}).Validate())
+	assert.Error(t, (&ContainerPreemption{File: "/data/'preempted"}).Validate())
+	assert.Error(t, (&ContainerPreemption{HttpPath: "/preempt"}).Validate())
+	assert.Error(t, (&ContainerPreemption{HttpPort: 70000}).Validate())
+}
+
+func TestContainerPreemptionScript(t *testing.T) {
+	assert.Empty(t, (&ContainerPreemption{Signal: "SIGTERM"}).Script([]byte(`{}`)))
+
+	dir, err := ioutil.TempDir("", "sonm-preemption-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "notice", "preempted")
+	notice := `{"dealID":"42","reason":"it's time"}`
+	script := (&ContainerPreemption{File: file}).Script([]byte(notice))
+
+	require.NoError(t, exec.Command("sh", "-c", script).Run())
+	data, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, notice, string(data))
+}
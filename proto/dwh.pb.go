@@ -1529,6 +1529,943 @@ func (m *BlacklistQuery) GetOption() BlacklistOption {
 	return BlacklistOption_WithoutMatching
 }
 
+type FailedEvent struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=blockNumber" json:"blockNumber,omitempty"`
+	EventTS     uint64 `protobuf:"varint,3,opt,name=eventTS" json:"eventTS,omitempty"`
+	// Type is the type of the event data.
+	Type string `protobuf:"bytes,4,opt,name=type" json:"type,omitempty"`
+	// Data is the JSON-encoded event data.
+	Data     string     `protobuf:"bytes,5,opt,name=data" json:"data,omitempty"`
+	Error    string     `protobuf:"bytes,6,opt,name=error" json:"error,omitempty"`
+	FailedAt *Timestamp `protobuf:"bytes,7,opt,name=failedAt" json:"failedAt,omitempty"`
+	TxHash   string     `protobuf:"bytes,8,opt,name=txHash" json:"txHash,omitempty"`
+	LogIndex uint64     `protobuf:"varint,9,opt,name=logIndex" json:"logIndex,omitempty"`
+}
+
+func (m *FailedEvent) Reset()                    { *m = FailedEvent{} }
+func (m *FailedEvent) String() string            { return proto.CompactTextString(m) }
+func (*FailedEvent) ProtoMessage()               {}
+func (*FailedEvent) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{31} }
+
+func (m *FailedEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetEventTS() uint64 {
+	if m != nil {
+		return m.EventTS
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetFailedAt() *Timestamp {
+	if m != nil {
+		return m.FailedAt
+	}
+	return nil
+}
+
+func (m *FailedEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+type FailedEventsRequest struct {
+	Limit  uint64 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *FailedEventsRequest) Reset()                    { *m = FailedEventsRequest{} }
+func (m *FailedEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*FailedEventsRequest) ProtoMessage()               {}
+func (*FailedEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{32} }
+
+func (m *FailedEventsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FailedEventsRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type FailedEventsReply struct {
+	Events []*FailedEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	Count  uint64         `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *FailedEventsReply) Reset()                    { *m = FailedEventsReply{} }
+func (m *FailedEventsReply) String() string            { return proto.CompactTextString(m) }
+func (*FailedEventsReply) ProtoMessage()               {}
+func (*FailedEventsReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{33} }
+
+func (m *FailedEventsReply) GetEvents() []*FailedEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *FailedEventsReply) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplayFailedEventsRequest struct {
+	// IDs of events to replay. All failed events are replayed if empty.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+}
+
+func (m *ReplayFailedEventsRequest) Reset()                    { *m = ReplayFailedEventsRequest{} }
+func (m *ReplayFailedEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplayFailedEventsRequest) ProtoMessage()               {}
+func (*ReplayFailedEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{34} }
+
+func (m *ReplayFailedEventsRequest) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type ReplayFailedEventsReply struct {
+	Replayed uint64 `protobuf:"varint,1,opt,name=replayed" json:"replayed,omitempty"`
+	// Failed contains events, that failed again.
+	Failed []*FailedEvent `protobuf:"bytes,2,rep,name=failed" json:"failed,omitempty"`
+}
+
+func (m *ReplayFailedEventsReply) Reset()                    { *m = ReplayFailedEventsReply{} }
+func (m *ReplayFailedEventsReply) String() string            { return proto.CompactTextString(m) }
+func (*ReplayFailedEventsReply) ProtoMessage()               {}
+func (*ReplayFailedEventsReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{35} }
+
+func (m *ReplayFailedEventsReply) GetReplayed() uint64 {
+	if m != nil {
+		return m.Replayed
+	}
+	return 0
+}
+
+func (m *ReplayFailedEventsReply) GetFailed() []*FailedEvent {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+// ResyncRequest specifies exactly one of an order, a deal, a profile or a
+// block range to resync.
+type ResyncRequest struct {
+	OrderID   *BigInt     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
+	DealID    *BigInt     `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	ProfileID *EthAddress `protobuf:"bytes,3,opt,name=profileID" json:"profileID,omitempty"`
+	// FromBlock and ToBlock specify the inclusive range of blocks, whose
+	// events refer to entities to resync.
+	FromBlock uint64 `protobuf:"varint,4,opt,name=fromBlock" json:"fromBlock,omitempty"`
+	ToBlock   uint64 `protobuf:"varint,5,opt,name=toBlock" json:"toBlock,omitempty"`
+}
+
+func (m *ResyncRequest) Reset()                    { *m = ResyncRequest{} }
+func (m *ResyncRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResyncRequest) ProtoMessage()               {}
+func (*ResyncRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{36} }
+
+func (m *ResyncRequest) GetOrderID() *BigInt {
+	if m != nil {
+		return m.OrderID
+	}
+	return nil
+}
+
+func (m *ResyncRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *ResyncRequest) GetProfileID() *EthAddress {
+	if m != nil {
+		return m.ProfileID
+	}
+	return nil
+}
+
+func (m *ResyncRequest) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *ResyncRequest) GetToBlock() uint64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+type ResyncReply struct {
+	Orders   uint64 `protobuf:"varint,1,opt,name=orders" json:"orders,omitempty"`
+	Deals    uint64 `protobuf:"varint,2,opt,name=deals" json:"deals,omitempty"`
+	Profiles uint64 `protobuf:"varint,3,opt,name=profiles" json:"profiles,omitempty"`
+}
+
+func (m *ResyncReply) Reset()                    { *m = ResyncReply{} }
+func (m *ResyncReply) String() string            { return proto.CompactTextString(m) }
+func (*ResyncReply) ProtoMessage()               {}
+func (*ResyncReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{37} }
+
+func (m *ResyncReply) GetOrders() uint64 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+func (m *ResyncReply) GetDeals() uint64 {
+	if m != nil {
+		return m.Deals
+	}
+	return 0
+}
+
+func (m *ResyncReply) GetProfiles() uint64 {
+	if m != nil {
+		return m.Profiles
+	}
+	return 0
+}
+
+// AnalyticsPeriod splits the time range into buckets, statistics are
+// calculated for each of them.
+type AnalyticsPeriod struct {
+	// From defaults to a day before the To timestamp.
+	From *Timestamp `protobuf:"bytes,1,opt,name=from" json:"from,omitempty"`
+	// To defaults to the current time.
+	To *Timestamp `protobuf:"bytes,2,opt,name=to" json:"to,omitempty"`
+	// BucketSize is the bucket duration in seconds. It must be a multiple of
+	// an hour, defaults to an hour.
+	BucketSize uint64 `protobuf:"varint,3,opt,name=bucketSize" json:"bucketSize,omitempty"`
+}
+
+func (m *AnalyticsPeriod) Reset()                    { *m = AnalyticsPeriod{} }
+func (m *AnalyticsPeriod) String() string            { return proto.CompactTextString(m) }
+func (*AnalyticsPeriod) ProtoMessage()               {}
+func (*AnalyticsPeriod) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{38} }
+
+func (m *AnalyticsPeriod) GetFrom() *Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *AnalyticsPeriod) GetTo() *Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *AnalyticsPeriod) GetBucketSize() uint64 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
+}
+
+type PriceHistoryRequest struct {
+	Period *AnalyticsPeriod `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	// Type must be either ASK or BID.
+	Type        OrderType `protobuf:"varint,2,opt,name=type,enum=sonm.OrderType" json:"type,omitempty"`
+	BenchmarkID uint64    `protobuf:"varint,3,opt,name=benchmarkID" json:"benchmarkID,omitempty"`
+	// IdentityLevel limits the orders by the identity level of their
+	// creators. Any level is allowed if empty.
+	IdentityLevel []IdentityLevel `protobuf:"varint,4,rep,packed,name=identityLevel,enum=sonm.IdentityLevel" json:"identityLevel,omitempty"`
+	// Percentiles to calculate, from 0 to 100.
+	Percentiles []float64 `protobuf:"fixed64,5,rep,packed,name=percentiles" json:"percentiles,omitempty"`
+}
+
+func (m *PriceHistoryRequest) Reset()                    { *m = PriceHistoryRequest{} }
+func (m *PriceHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*PriceHistoryRequest) ProtoMessage()               {}
+func (*PriceHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{39} }
+
+func (m *PriceHistoryRequest) GetPeriod() *AnalyticsPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *PriceHistoryRequest) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return OrderType_ANY
+}
+
+func (m *PriceHistoryRequest) GetBenchmarkID() uint64 {
+	if m != nil {
+		return m.BenchmarkID
+	}
+	return 0
+}
+
+func (m *PriceHistoryRequest) GetIdentityLevel() []IdentityLevel {
+	if m != nil {
+		return m.IdentityLevel
+	}
+	return nil
+}
+
+func (m *PriceHistoryRequest) GetPercentiles() []float64 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// PriceStats describes prices per benchmark unit in wei per second.
+// Percentiles are approximated with the relative error within 3%, while
+// the other values are exact.
+type PriceStats struct {
+	Ts     *Timestamp `protobuf:"bytes,1,opt,name=ts" json:"ts,omitempty"`
+	Count  uint64     `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Min    float64    `protobuf:"fixed64,3,opt,name=min" json:"min,omitempty"`
+	Max    float64    `protobuf:"fixed64,4,opt,name=max" json:"max,omitempty"`
+	Median float64    `protobuf:"fixed64,5,opt,name=median" json:"median,omitempty"`
+	// Percentiles are listed in the order they are requested in.
+	Percentiles []float64 `protobuf:"fixed64,6,rep,packed,name=percentiles" json:"percentiles,omitempty"`
+}
+
+func (m *PriceStats) Reset()                    { *m = PriceStats{} }
+func (m *PriceStats) String() string            { return proto.CompactTextString(m) }
+func (*PriceStats) ProtoMessage()               {}
+func (*PriceStats) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{40} }
+
+func (m *PriceStats) GetTs() *Timestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *PriceStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PriceStats) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *PriceStats) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *PriceStats) GetMedian() float64 {
+	if m != nil {
+		return m.Median
+	}
+	return 0
+}
+
+func (m *PriceStats) GetPercentiles() []float64 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+type PriceHistoryReply struct {
+	Buckets []*PriceStats `protobuf:"bytes,1,rep,name=buckets" json:"buckets,omitempty"`
+}
+
+func (m *PriceHistoryReply) Reset()                    { *m = PriceHistoryReply{} }
+func (m *PriceHistoryReply) String() string            { return proto.CompactTextString(m) }
+func (*PriceHistoryReply) ProtoMessage()               {}
+func (*PriceHistoryReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{41} }
+
+func (m *PriceHistoryReply) GetBuckets() []*PriceStats {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type SupplyDemandRequest struct {
+	Period *AnalyticsPeriod `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	// Type limits the orders by their type, ANY means both.
+	Type          OrderType       `protobuf:"varint,2,opt,name=type,enum=sonm.OrderType" json:"type,omitempty"`
+	IdentityLevel []IdentityLevel `protobuf:"varint,3,rep,packed,name=identityLevel,enum=sonm.IdentityLevel" json:"identityLevel,omitempty"`
+}
+
+func (m *SupplyDemandRequest) Reset()                    { *m = SupplyDemandRequest{} }
+func (m *SupplyDemandRequest) String() string            { return proto.CompactTextString(m) }
+func (*SupplyDemandRequest) ProtoMessage()               {}
+func (*SupplyDemandRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{42} }
+
+func (m *SupplyDemandRequest) GetPeriod() *AnalyticsPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *SupplyDemandRequest) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return OrderType_ANY
+}
+
+func (m *SupplyDemandRequest) GetIdentityLevel() []IdentityLevel {
+	if m != nil {
+		return m.IdentityLevel
+	}
+	return nil
+}
+
+// DeviceClass groups orders by the hardware they require or provide.
+type DeviceClass struct {
+	// GPUMem is the GPU memory benchmark rounded down to a power of two.
+	GPUMem   uint64 `protobuf:"varint,1,opt,name=GPUMem" json:"GPUMem,omitempty"`
+	CPUCores uint64 `protobuf:"varint,2,opt,name=CPUCores" json:"CPUCores,omitempty"`
+	Netflags uint64 `protobuf:"varint,3,opt,name=netflags" json:"netflags,omitempty"`
+}
+
+func (m *DeviceClass) Reset()                    { *m = DeviceClass{} }
+func (m *DeviceClass) String() string            { return proto.CompactTextString(m) }
+func (*DeviceClass) ProtoMessage()               {}
+func (*DeviceClass) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{43} }
+
+func (m *DeviceClass) GetGPUMem() uint64 {
+	if m != nil {
+		return m.GPUMem
+	}
+	return 0
+}
+
+func (m *DeviceClass) GetCPUCores() uint64 {
+	if m != nil {
+		return m.CPUCores
+	}
+	return 0
+}
+
+func (m *DeviceClass) GetNetflags() uint64 {
+	if m != nil {
+		return m.Netflags
+	}
+	return 0
+}
+
+type SupplyDemandStats struct {
+	Ts          *Timestamp   `protobuf:"bytes,1,opt,name=ts" json:"ts,omitempty"`
+	Type        OrderType    `protobuf:"varint,2,opt,name=type,enum=sonm.OrderType" json:"type,omitempty"`
+	DeviceClass *DeviceClass `protobuf:"bytes,3,opt,name=deviceClass" json:"deviceClass,omitempty"`
+	// Active is the number of active orders at the end of the bucket.
+	Active uint64 `protobuf:"varint,4,opt,name=active" json:"active,omitempty"`
+	// ActiveVolume is the total price of active orders at the end of the
+	// bucket in wei per second.
+	ActiveVolume *BigInt `protobuf:"bytes,5,opt,name=activeVolume" json:"activeVolume,omitempty"`
+	// Placed is the number of orders placed within the bucket.
+	Placed uint64 `protobuf:"varint,6,opt,name=placed" json:"placed,omitempty"`
+}
+
+func (m *SupplyDemandStats) Reset()                    { *m = SupplyDemandStats{} }
+func (m *SupplyDemandStats) String() string            { return proto.CompactTextString(m) }
+func (*SupplyDemandStats) ProtoMessage()               {}
+func (*SupplyDemandStats) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{44} }
+
+func (m *SupplyDemandStats) GetTs() *Timestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *SupplyDemandStats) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return OrderType_ANY
+}
+
+func (m *SupplyDemandStats) GetDeviceClass() *DeviceClass {
+	if m != nil {
+		return m.DeviceClass
+	}
+	return nil
+}
+
+func (m *SupplyDemandStats) GetActive() uint64 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *SupplyDemandStats) GetActiveVolume() *BigInt {
+	if m != nil {
+		return m.ActiveVolume
+	}
+	return nil
+}
+
+func (m *SupplyDemandStats) GetPlaced() uint64 {
+	if m != nil {
+		return m.Placed
+	}
+	return 0
+}
+
+type SupplyDemandReply struct {
+	Stats []*SupplyDemandStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *SupplyDemandReply) Reset()                    { *m = SupplyDemandReply{} }
+func (m *SupplyDemandReply) String() string            { return proto.CompactTextString(m) }
+func (*SupplyDemandReply) ProtoMessage()               {}
+func (*SupplyDemandReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{45} }
+
+func (m *SupplyDemandReply) GetStats() []*SupplyDemandStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type MatchStatsRequest struct {
+	Period        *AnalyticsPeriod `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	Type          OrderType        `protobuf:"varint,2,opt,name=type,enum=sonm.OrderType" json:"type,omitempty"`
+	IdentityLevel []IdentityLevel  `protobuf:"varint,3,rep,packed,name=identityLevel,enum=sonm.IdentityLevel" json:"identityLevel,omitempty"`
+}
+
+func (m *MatchStatsRequest) Reset()                    { *m = MatchStatsRequest{} }
+func (m *MatchStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*MatchStatsRequest) ProtoMessage()               {}
+func (*MatchStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{46} }
+
+func (m *MatchStatsRequest) GetPeriod() *AnalyticsPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *MatchStatsRequest) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return OrderType_ANY
+}
+
+func (m *MatchStatsRequest) GetIdentityLevel() []IdentityLevel {
+	if m != nil {
+		return m.IdentityLevel
+	}
+	return nil
+}
+
+type MatchStats struct {
+	Ts        *Timestamp `protobuf:"bytes,1,opt,name=ts" json:"ts,omitempty"`
+	Placed    uint64     `protobuf:"varint,2,opt,name=placed" json:"placed,omitempty"`
+	Matched   uint64     `protobuf:"varint,3,opt,name=matched" json:"matched,omitempty"`
+	Cancelled uint64     `protobuf:"varint,4,opt,name=cancelled" json:"cancelled,omitempty"`
+	// FillRate is the share of matched orders among the ones removed from
+	// the market within the bucket.
+	FillRate float64 `protobuf:"fixed64,5,opt,name=fillRate" json:"fillRate,omitempty"`
+	// TimeToMatch is the average number of seconds orders matched within the
+	// bucket spent in the market.
+	TimeToMatch uint64 `protobuf:"varint,6,opt,name=timeToMatch" json:"timeToMatch,omitempty"`
+}
+
+func (m *MatchStats) Reset()                    { *m = MatchStats{} }
+func (m *MatchStats) String() string            { return proto.CompactTextString(m) }
+func (*MatchStats) ProtoMessage()               {}
+func (*MatchStats) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{47} }
+
+func (m *MatchStats) GetTs() *Timestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *MatchStats) GetPlaced() uint64 {
+	if m != nil {
+		return m.Placed
+	}
+	return 0
+}
+
+func (m *MatchStats) GetMatched() uint64 {
+	if m != nil {
+		return m.Matched
+	}
+	return 0
+}
+
+func (m *MatchStats) GetCancelled() uint64 {
+	if m != nil {
+		return m.Cancelled
+	}
+	return 0
+}
+
+func (m *MatchStats) GetFillRate() float64 {
+	if m != nil {
+		return m.FillRate
+	}
+	return 0
+}
+
+func (m *MatchStats) GetTimeToMatch() uint64 {
+	if m != nil {
+		return m.TimeToMatch
+	}
+	return 0
+}
+
+type MatchStatsReply struct {
+	Buckets []*MatchStats `protobuf:"bytes,1,rep,name=buckets" json:"buckets,omitempty"`
+}
+
+func (m *MatchStatsReply) Reset()                    { *m = MatchStatsReply{} }
+func (m *MatchStatsReply) String() string            { return proto.CompactTextString(m) }
+func (*MatchStatsReply) ProtoMessage()               {}
+func (*MatchStatsReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{48} }
+
+func (m *MatchStatsReply) GetBuckets() []*MatchStats {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type PayoutStatsRequest struct {
+	Period *AnalyticsPeriod `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	// IdentityLevel limits the deals by the identity level of their
+	// suppliers.
+	IdentityLevel []IdentityLevel `protobuf:"varint,2,rep,packed,name=identityLevel,enum=sonm.IdentityLevel" json:"identityLevel,omitempty"`
+}
+
+func (m *PayoutStatsRequest) Reset()                    { *m = PayoutStatsRequest{} }
+func (m *PayoutStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PayoutStatsRequest) ProtoMessage()               {}
+func (*PayoutStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{49} }
+
+func (m *PayoutStatsRequest) GetPeriod() *AnalyticsPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *PayoutStatsRequest) GetIdentityLevel() []IdentityLevel {
+	if m != nil {
+		return m.IdentityLevel
+	}
+	return nil
+}
+
+type PayoutStats struct {
+	Ts     *Timestamp `protobuf:"bytes,1,opt,name=ts" json:"ts,omitempty"`
+	Bills  uint64     `protobuf:"varint,2,opt,name=bills" json:"bills,omitempty"`
+	Payout *BigInt    `protobuf:"bytes,3,opt,name=payout" json:"payout,omitempty"`
+}
+
+func (m *PayoutStats) Reset()                    { *m = PayoutStats{} }
+func (m *PayoutStats) String() string            { return proto.CompactTextString(m) }
+func (*PayoutStats) ProtoMessage()               {}
+func (*PayoutStats) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{50} }
+
+func (m *PayoutStats) GetTs() *Timestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *PayoutStats) GetBills() uint64 {
+	if m != nil {
+		return m.Bills
+	}
+	return 0
+}
+
+func (m *PayoutStats) GetPayout() *BigInt {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+type PayoutStatsReply struct {
+	Buckets []*PayoutStats `protobuf:"bytes,1,rep,name=buckets" json:"buckets,omitempty"`
+	Total   *BigInt        `protobuf:"bytes,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (m *PayoutStatsReply) Reset()                    { *m = PayoutStatsReply{} }
+func (m *PayoutStatsReply) String() string            { return proto.CompactTextString(m) }
+func (*PayoutStatsReply) ProtoMessage()               {}
+func (*PayoutStatsReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{51} }
+
+func (m *PayoutStatsReply) GetBuckets() []*PayoutStats {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *PayoutStatsReply) GetTotal() *BigInt {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+type Payment struct {
+	Id     uint64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	DealID *BigInt `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	// PayerID is the consumer of the deal.
+	PayerID *EthAddress `protobuf:"bytes,3,opt,name=payerID" json:"payerID,omitempty"`
+	// PayeeID is the master of the deal supplier, that receives the payment.
+	PayeeID     *EthAddress `protobuf:"bytes,4,opt,name=payeeID" json:"payeeID,omitempty"`
+	Amount      *BigInt     `protobuf:"bytes,5,opt,name=amount" json:"amount,omitempty"`
+	BlockNumber uint64      `protobuf:"varint,6,opt,name=blockNumber" json:"blockNumber,omitempty"`
+	Ts          *Timestamp  `protobuf:"bytes,7,opt,name=ts" json:"ts,omitempty"`
+	TxHash      string      `protobuf:"bytes,8,opt,name=txHash" json:"txHash,omitempty"`
+	// LogIndex is the index of the "Billed" event within the block, a single
+	// transaction may pay for the deal several times.
+	LogIndex uint64 `protobuf:"varint,9,opt,name=logIndex" json:"logIndex,omitempty"`
+}
+
+func (m *Payment) Reset()                    { *m = Payment{} }
+func (m *Payment) String() string            { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()               {}
+func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{52} }
+
+func (m *Payment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Payment) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *Payment) GetPayerID() *EthAddress {
+	if m != nil {
+		return m.PayerID
+	}
+	return nil
+}
+
+func (m *Payment) GetPayeeID() *EthAddress {
+	if m != nil {
+		return m.PayeeID
+	}
+	return nil
+}
+
+func (m *Payment) GetAmount() *BigInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Payment) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Payment) GetTs() *Timestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *Payment) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Payment) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+type PaymentsRequest struct {
+	// Address limits the payments to the ones, where the given address is
+	// either the payer or the payee.
+	Address   *EthAddress      `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	PayerID   *EthAddress      `protobuf:"bytes,2,opt,name=payerID" json:"payerID,omitempty"`
+	PayeeID   *EthAddress      `protobuf:"bytes,3,opt,name=payeeID" json:"payeeID,omitempty"`
+	DealID    *BigInt          `protobuf:"bytes,4,opt,name=dealID" json:"dealID,omitempty"`
+	Ts        *MaxMinTimestamp `protobuf:"bytes,5,opt,name=ts" json:"ts,omitempty"`
+	Limit     uint64           `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`
+	Offset    uint64           `protobuf:"varint,7,opt,name=offset" json:"offset,omitempty"`
+	WithCount bool             `protobuf:"varint,8,opt,name=withCount" json:"withCount,omitempty"`
+}
+
+func (m *PaymentsRequest) Reset()                    { *m = PaymentsRequest{} }
+func (m *PaymentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PaymentsRequest) ProtoMessage()               {}
+func (*PaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{53} }
+
+func (m *PaymentsRequest) GetAddress() *EthAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *PaymentsRequest) GetPayerID() *EthAddress {
+	if m != nil {
+		return m.PayerID
+	}
+	return nil
+}
+
+func (m *PaymentsRequest) GetPayeeID() *EthAddress {
+	if m != nil {
+		return m.PayeeID
+	}
+	return nil
+}
+
+func (m *PaymentsRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *PaymentsRequest) GetTs() *MaxMinTimestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *PaymentsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *PaymentsRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PaymentsRequest) GetWithCount() bool {
+	if m != nil {
+		return m.WithCount
+	}
+	return false
+}
+
+type PaymentsReply struct {
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+	Count    uint64     `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *PaymentsReply) Reset()                    { *m = PaymentsReply{} }
+func (m *PaymentsReply) String() string            { return proto.CompactTextString(m) }
+func (*PaymentsReply) ProtoMessage()               {}
+func (*PaymentsReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{54} }
+
+func (m *PaymentsReply) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *PaymentsReply) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SortingOption)(nil), "sonm.SortingOption")
 	proto.RegisterType((*DealsRequest)(nil), "sonm.DealsRequest")
@@ -1561,6 +2498,30 @@ func init() {
 	proto.RegisterType((*MaxMinTimestamp)(nil), "sonm.MaxMinTimestamp")
 	proto.RegisterType((*CmpUint64)(nil), "sonm.CmpUint64")
 	proto.RegisterType((*BlacklistQuery)(nil), "sonm.BlacklistQuery")
+	proto.RegisterType((*FailedEvent)(nil), "sonm.FailedEvent")
+	proto.RegisterType((*FailedEventsRequest)(nil), "sonm.FailedEventsRequest")
+	proto.RegisterType((*FailedEventsReply)(nil), "sonm.FailedEventsReply")
+	proto.RegisterType((*ReplayFailedEventsRequest)(nil), "sonm.ReplayFailedEventsRequest")
+	proto.RegisterType((*ReplayFailedEventsReply)(nil), "sonm.ReplayFailedEventsReply")
+	proto.RegisterType((*ResyncRequest)(nil), "sonm.ResyncRequest")
+	proto.RegisterType((*ResyncReply)(nil), "sonm.ResyncReply")
+	proto.RegisterType((*AnalyticsPeriod)(nil), "sonm.AnalyticsPeriod")
+	proto.RegisterType((*PriceHistoryRequest)(nil), "sonm.PriceHistoryRequest")
+	proto.RegisterType((*PriceStats)(nil), "sonm.PriceStats")
+	proto.RegisterType((*PriceHistoryReply)(nil), "sonm.PriceHistoryReply")
+	proto.RegisterType((*SupplyDemandRequest)(nil), "sonm.SupplyDemandRequest")
+	proto.RegisterType((*DeviceClass)(nil), "sonm.DeviceClass")
+	proto.RegisterType((*SupplyDemandStats)(nil), "sonm.SupplyDemandStats")
+	proto.RegisterType((*SupplyDemandReply)(nil), "sonm.SupplyDemandReply")
+	proto.RegisterType((*MatchStatsRequest)(nil), "sonm.MatchStatsRequest")
+	proto.RegisterType((*MatchStats)(nil), "sonm.MatchStats")
+	proto.RegisterType((*MatchStatsReply)(nil), "sonm.MatchStatsReply")
+	proto.RegisterType((*PayoutStatsRequest)(nil), "sonm.PayoutStatsRequest")
+	proto.RegisterType((*PayoutStats)(nil), "sonm.PayoutStats")
+	proto.RegisterType((*PayoutStatsReply)(nil), "sonm.PayoutStatsReply")
+	proto.RegisterType((*Payment)(nil), "sonm.Payment")
+	proto.RegisterType((*PaymentsRequest)(nil), "sonm.PaymentsRequest")
+	proto.RegisterType((*PaymentsReply)(nil), "sonm.PaymentsReply")
 	proto.RegisterEnum("sonm.CmpOp", CmpOp_name, CmpOp_value)
 	proto.RegisterEnum("sonm.SortingOrder", SortingOrder_name, SortingOrder_value)
 	proto.RegisterEnum("sonm.ProfileRole", ProfileRole_name, ProfileRole_value)
@@ -1590,6 +2551,18 @@ type DWHClient interface {
 	GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsReply, error)
 	GetDealChangeRequests(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*DealChangeRequestsReply, error)
 	GetWorkers(ctx context.Context, in *WorkersRequest, opts ...grpc.CallOption) (*WorkersReply, error)
+	// GetPriceHistory returns statistics of the price per benchmark unit of
+	// placed orders.
+	GetPriceHistory(ctx context.Context, in *PriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryReply, error)
+	// GetSupplyDemand returns the number and the volume of active orders by
+	// device class.
+	GetSupplyDemand(ctx context.Context, in *SupplyDemandRequest, opts ...grpc.CallOption) (*SupplyDemandReply, error)
+	// GetMatchStats returns the fill rate and the time-to-match of orders.
+	GetMatchStats(ctx context.Context, in *MatchStatsRequest, opts ...grpc.CallOption) (*MatchStatsReply, error)
+	// GetPayoutStats returns the total payouts of deals.
+	GetPayoutStats(ctx context.Context, in *PayoutStatsRequest, opts ...grpc.CallOption) (*PayoutStatsReply, error)
+	// GetPayments returns payments of deals, one per bill.
+	GetPayments(ctx context.Context, in *PaymentsRequest, opts ...grpc.CallOption) (*PaymentsReply, error)
 }
 
 type dWHClient struct {
@@ -1663,45 +2636,90 @@ func (c *dWHClient) GetProfiles(ctx context.Context, in *ProfilesRequest, opts .
 	return out, nil
 }
 
-func (c *dWHClient) GetProfileInfo(ctx context.Context, in *EthID, opts ...grpc.CallOption) (*Profile, error) {
-	out := new(Profile)
-	err := grpc.Invoke(ctx, "/sonm.DWH/GetProfileInfo", in, out, c.cc, opts...)
+func (c *dWHClient) GetProfileInfo(ctx context.Context, in *EthID, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetProfileInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) GetBlacklist(ctx context.Context, in *BlacklistRequest, opts ...grpc.CallOption) (*BlacklistReply, error) {
+	out := new(BlacklistReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetBlacklist", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsReply, error) {
+	out := new(ValidatorsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetValidators", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) GetDealChangeRequests(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*DealChangeRequestsReply, error) {
+	out := new(DealChangeRequestsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetDealChangeRequests", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) GetWorkers(ctx context.Context, in *WorkersRequest, opts ...grpc.CallOption) (*WorkersReply, error) {
+	out := new(WorkersReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetWorkers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) GetPriceHistory(ctx context.Context, in *PriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryReply, error) {
+	out := new(PriceHistoryReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetPriceHistory", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dWHClient) GetBlacklist(ctx context.Context, in *BlacklistRequest, opts ...grpc.CallOption) (*BlacklistReply, error) {
-	out := new(BlacklistReply)
-	err := grpc.Invoke(ctx, "/sonm.DWH/GetBlacklist", in, out, c.cc, opts...)
+func (c *dWHClient) GetSupplyDemand(ctx context.Context, in *SupplyDemandRequest, opts ...grpc.CallOption) (*SupplyDemandReply, error) {
+	out := new(SupplyDemandReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetSupplyDemand", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dWHClient) GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsReply, error) {
-	out := new(ValidatorsReply)
-	err := grpc.Invoke(ctx, "/sonm.DWH/GetValidators", in, out, c.cc, opts...)
+func (c *dWHClient) GetMatchStats(ctx context.Context, in *MatchStatsRequest, opts ...grpc.CallOption) (*MatchStatsReply, error) {
+	out := new(MatchStatsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetMatchStats", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dWHClient) GetDealChangeRequests(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*DealChangeRequestsReply, error) {
-	out := new(DealChangeRequestsReply)
-	err := grpc.Invoke(ctx, "/sonm.DWH/GetDealChangeRequests", in, out, c.cc, opts...)
+func (c *dWHClient) GetPayoutStats(ctx context.Context, in *PayoutStatsRequest, opts ...grpc.CallOption) (*PayoutStatsReply, error) {
+	out := new(PayoutStatsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetPayoutStats", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dWHClient) GetWorkers(ctx context.Context, in *WorkersRequest, opts ...grpc.CallOption) (*WorkersReply, error) {
-	out := new(WorkersReply)
-	err := grpc.Invoke(ctx, "/sonm.DWH/GetWorkers", in, out, c.cc, opts...)
+func (c *dWHClient) GetPayments(ctx context.Context, in *PaymentsRequest, opts ...grpc.CallOption) (*PaymentsReply, error) {
+	out := new(PaymentsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetPayments", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1723,6 +2741,18 @@ type DWHServer interface {
 	GetValidators(context.Context, *ValidatorsRequest) (*ValidatorsReply, error)
 	GetDealChangeRequests(context.Context, *BigInt) (*DealChangeRequestsReply, error)
 	GetWorkers(context.Context, *WorkersRequest) (*WorkersReply, error)
+	// GetPriceHistory returns statistics of the price per benchmark unit of
+	// placed orders.
+	GetPriceHistory(context.Context, *PriceHistoryRequest) (*PriceHistoryReply, error)
+	// GetSupplyDemand returns the number and the volume of active orders by
+	// device class.
+	GetSupplyDemand(context.Context, *SupplyDemandRequest) (*SupplyDemandReply, error)
+	// GetMatchStats returns the fill rate and the time-to-match of orders.
+	GetMatchStats(context.Context, *MatchStatsRequest) (*MatchStatsReply, error)
+	// GetPayoutStats returns the total payouts of deals.
+	GetPayoutStats(context.Context, *PayoutStatsRequest) (*PayoutStatsReply, error)
+	// GetPayments returns payments of deals, one per bill.
+	GetPayments(context.Context, *PaymentsRequest) (*PaymentsReply, error)
 }
 
 func RegisterDWHServer(s *grpc.Server, srv DWHServer) {
@@ -1945,6 +2975,96 @@ func _DWH_GetWorkers_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DWH_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetPriceHistory(ctx, req.(*PriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWH_GetSupplyDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplyDemandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetSupplyDemand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetSupplyDemand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetSupplyDemand(ctx, req.(*SupplyDemandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWH_GetMatchStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetMatchStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetMatchStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetMatchStats(ctx, req.(*MatchStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWH_GetPayoutStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetPayoutStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetPayoutStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetPayoutStats(ctx, req.(*PayoutStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWH_GetPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetPayments(ctx, req.(*PaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DWH_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.DWH",
 	HandlerType: (*DWHServer)(nil),
@@ -1997,6 +3117,166 @@ var _DWH_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkers",
 			Handler:    _DWH_GetWorkers_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _DWH_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetSupplyDemand",
+			Handler:    _DWH_GetSupplyDemand_Handler,
+		},
+		{
+			MethodName: "GetMatchStats",
+			Handler:    _DWH_GetMatchStats_Handler,
+		},
+		{
+			MethodName: "GetPayoutStats",
+			Handler:    _DWH_GetPayoutStats_Handler,
+		},
+		{
+			MethodName: "GetPayments",
+			Handler:    _DWH_GetPayments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dwh.proto",
+}
+
+// Client API for DWHAdmin service
+
+type DWHAdminClient interface {
+	// FailedEvents returns events, that failed to be processed.
+	FailedEvents(ctx context.Context, in *FailedEventsRequest, opts ...grpc.CallOption) (*FailedEventsReply, error)
+	// ReplayFailedEvents processes failed events again, removing them on
+	// success.
+	ReplayFailedEvents(ctx context.Context, in *ReplayFailedEventsRequest, opts ...grpc.CallOption) (*ReplayFailedEventsReply, error)
+	// Resync re-reads the specified entities from the blockchain and
+	// reconciles the stored state with it.
+	Resync(ctx context.Context, in *ResyncRequest, opts ...grpc.CallOption) (*ResyncReply, error)
+}
+
+type dWHAdminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDWHAdminClient(cc *grpc.ClientConn) DWHAdminClient {
+	return &dWHAdminClient{cc}
+}
+
+func (c *dWHAdminClient) FailedEvents(ctx context.Context, in *FailedEventsRequest, opts ...grpc.CallOption) (*FailedEventsReply, error) {
+	out := new(FailedEventsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWHAdmin/FailedEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHAdminClient) ReplayFailedEvents(ctx context.Context, in *ReplayFailedEventsRequest, opts ...grpc.CallOption) (*ReplayFailedEventsReply, error) {
+	out := new(ReplayFailedEventsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWHAdmin/ReplayFailedEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHAdminClient) Resync(ctx context.Context, in *ResyncRequest, opts ...grpc.CallOption) (*ResyncReply, error) {
+	out := new(ResyncReply)
+	err := grpc.Invoke(ctx, "/sonm.DWHAdmin/Resync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DWHAdmin service
+
+type DWHAdminServer interface {
+	// FailedEvents returns events, that failed to be processed.
+	FailedEvents(context.Context, *FailedEventsRequest) (*FailedEventsReply, error)
+	// ReplayFailedEvents processes failed events again, removing them on
+	// success.
+	ReplayFailedEvents(context.Context, *ReplayFailedEventsRequest) (*ReplayFailedEventsReply, error)
+	// Resync re-reads the specified entities from the blockchain and
+	// reconciles the stored state with it.
+	Resync(context.Context, *ResyncRequest) (*ResyncReply, error)
+}
+
+func RegisterDWHAdminServer(s *grpc.Server, srv DWHAdminServer) {
+	s.RegisterService(&_DWHAdmin_serviceDesc, srv)
+}
+
+func _DWHAdmin_FailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHAdminServer).FailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWHAdmin/FailedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHAdminServer).FailedEvents(ctx, req.(*FailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWHAdmin_ReplayFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHAdminServer).ReplayFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWHAdmin/ReplayFailedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHAdminServer).ReplayFailedEvents(ctx, req.(*ReplayFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWHAdmin_Resync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHAdminServer).Resync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWHAdmin/Resync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHAdminServer).Resync(ctx, req.(*ResyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DWHAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sonm.DWHAdmin",
+	HandlerType: (*DWHAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FailedEvents",
+			Handler:    _DWHAdmin_FailedEvents_Handler,
+		},
+		{
+			MethodName: "ReplayFailedEvents",
+			Handler:    _DWHAdmin_ReplayFailedEvents_Handler,
+		},
+		{
+			MethodName: "Resync",
+			Handler:    _DWHAdmin_Resync_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dwh.proto",
@@ -2239,6 +3519,101 @@ var _DWH_GetWorkersCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.WorkersRequest"),
 }
 
+var _DWH_GetPriceHistoryCmd = &cobra.Command{
+	Use:   "getPriceHistory",
+	Short: "Make the GetPriceHistory method call, input-type: sonm.PriceHistoryRequest output-type: sonm.PriceHistoryReply",
+	RunE: grpccmd.RunE(
+		"GetPriceHistory",
+		"sonm.PriceHistoryRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetPriceHistoryCmd_gen = &cobra.Command{
+	Use:   "getPriceHistory-gen",
+	Short: "Generate JSON for method call of GetPriceHistory (input-type: sonm.PriceHistoryRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.PriceHistoryRequest"),
+}
+
+var _DWH_GetSupplyDemandCmd = &cobra.Command{
+	Use:   "getSupplyDemand",
+	Short: "Make the GetSupplyDemand method call, input-type: sonm.SupplyDemandRequest output-type: sonm.SupplyDemandReply",
+	RunE: grpccmd.RunE(
+		"GetSupplyDemand",
+		"sonm.SupplyDemandRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetSupplyDemandCmd_gen = &cobra.Command{
+	Use:   "getSupplyDemand-gen",
+	Short: "Generate JSON for method call of GetSupplyDemand (input-type: sonm.SupplyDemandRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.SupplyDemandRequest"),
+}
+
+var _DWH_GetMatchStatsCmd = &cobra.Command{
+	Use:   "getMatchStats",
+	Short: "Make the GetMatchStats method call, input-type: sonm.MatchStatsRequest output-type: sonm.MatchStatsReply",
+	RunE: grpccmd.RunE(
+		"GetMatchStats",
+		"sonm.MatchStatsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetMatchStatsCmd_gen = &cobra.Command{
+	Use:   "getMatchStats-gen",
+	Short: "Generate JSON for method call of GetMatchStats (input-type: sonm.MatchStatsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.MatchStatsRequest"),
+}
+
+var _DWH_GetPayoutStatsCmd = &cobra.Command{
+	Use:   "getPayoutStats",
+	Short: "Make the GetPayoutStats method call, input-type: sonm.PayoutStatsRequest output-type: sonm.PayoutStatsReply",
+	RunE: grpccmd.RunE(
+		"GetPayoutStats",
+		"sonm.PayoutStatsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetPayoutStatsCmd_gen = &cobra.Command{
+	Use:   "getPayoutStats-gen",
+	Short: "Generate JSON for method call of GetPayoutStats (input-type: sonm.PayoutStatsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.PayoutStatsRequest"),
+}
+
+var _DWH_GetPaymentsCmd = &cobra.Command{
+	Use:   "getPayments",
+	Short: "Make the GetPayments method call, input-type: sonm.PaymentsRequest output-type: sonm.PaymentsReply",
+	RunE: grpccmd.RunE(
+		"GetPayments",
+		"sonm.PaymentsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetPaymentsCmd_gen = &cobra.Command{
+	Use:   "getPayments-gen",
+	Short: "Generate JSON for method call of GetPayments (input-type: sonm.PaymentsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.PaymentsRequest"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_DWHCmd)
@@ -2267,6 +3642,92 @@ func init() {
 		_DWH_GetDealChangeRequestsCmd_gen,
 		_DWH_GetWorkersCmd,
 		_DWH_GetWorkersCmd_gen,
+		_DWH_GetPriceHistoryCmd,
+		_DWH_GetPriceHistoryCmd_gen,
+		_DWH_GetSupplyDemandCmd,
+		_DWH_GetSupplyDemandCmd_gen,
+		_DWH_GetMatchStatsCmd,
+		_DWH_GetMatchStatsCmd_gen,
+		_DWH_GetPayoutStatsCmd,
+		_DWH_GetPayoutStatsCmd_gen,
+		_DWH_GetPaymentsCmd,
+		_DWH_GetPaymentsCmd_gen,
+	)
+}
+
+// DWHAdmin
+var _DWHAdminCmd = &cobra.Command{
+	Use:   "dWHAdmin [method]",
+	Short: "Subcommand for the DWHAdmin service.",
+}
+
+var _DWHAdmin_FailedEventsCmd = &cobra.Command{
+	Use:   "failedEvents",
+	Short: "Make the FailedEvents method call, input-type: sonm.FailedEventsRequest output-type: sonm.FailedEventsReply",
+	RunE: grpccmd.RunE(
+		"FailedEvents",
+		"sonm.FailedEventsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHAdminClient(cc)
+		},
+	),
+}
+
+var _DWHAdmin_FailedEventsCmd_gen = &cobra.Command{
+	Use:   "failedEvents-gen",
+	Short: "Generate JSON for method call of FailedEvents (input-type: sonm.FailedEventsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.FailedEventsRequest"),
+}
+
+var _DWHAdmin_ReplayFailedEventsCmd = &cobra.Command{
+	Use:   "replayFailedEvents",
+	Short: "Make the ReplayFailedEvents method call, input-type: sonm.ReplayFailedEventsRequest output-type: sonm.ReplayFailedEventsReply",
+	RunE: grpccmd.RunE(
+		"ReplayFailedEvents",
+		"sonm.ReplayFailedEventsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHAdminClient(cc)
+		},
+	),
+}
+
+var _DWHAdmin_ReplayFailedEventsCmd_gen = &cobra.Command{
+	Use:   "replayFailedEvents-gen",
+	Short: "Generate JSON for method call of ReplayFailedEvents (input-type: sonm.ReplayFailedEventsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ReplayFailedEventsRequest"),
+}
+
+var _DWHAdmin_ResyncCmd = &cobra.Command{
+	Use:   "resync",
+	Short: "Make the Resync method call, input-type: sonm.ResyncRequest output-type: sonm.ResyncReply",
+	RunE: grpccmd.RunE(
+		"Resync",
+		"sonm.ResyncRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHAdminClient(cc)
+		},
+	),
+}
+
+var _DWHAdmin_ResyncCmd_gen = &cobra.Command{
+	Use:   "resync-gen",
+	Short: "Generate JSON for method call of Resync (input-type: sonm.ResyncRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ResyncRequest"),
+}
+
+// Register commands with the root command and service command
+func init() {
+	grpccmd.RegisterServiceCmd(_DWHAdminCmd)
+	_DWHAdminCmd.AddCommand(
+		_DWHAdmin_FailedEventsCmd,
+		_DWHAdmin_FailedEventsCmd_gen,
+		_DWHAdmin_ReplayFailedEventsCmd,
+		_DWHAdmin_ReplayFailedEventsCmd_gen,
+		_DWHAdmin_ResyncCmd,
+		_DWHAdmin_ResyncCmd_gen,
 	)
 }
 
@@ -2275,142 +3736,206 @@ func init() {
 func init() { proto.RegisterFile("dwh.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 2183 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x72, 0xdb, 0xc8,
-	0x11, 0x26, 0xf8, 0x0b, 0x36, 0x7f, 0x35, 0x92, 0xd6, 0x5c, 0xc6, 0x71, 0xc9, 0xf0, 0xee, 0x46,
-	0x56, 0x6c, 0x79, 0x2d, 0x27, 0x1b, 0xe7, 0xa7, 0x92, 0x92, 0x48, 0x45, 0xd2, 0xc6, 0xb2, 0x6c,
-	0x48, 0x1b, 0xe5, 0x18, 0x88, 0x18, 0x49, 0x53, 0x02, 0x01, 0x06, 0x18, 0xca, 0xe6, 0x31, 0x95,
-	0x5b, 0x8e, 0x79, 0x83, 0xdc, 0xf7, 0x94, 0x4b, 0xaa, 0xf6, 0x05, 0x52, 0x79, 0x87, 0x54, 0xe5,
-	0x94, 0xf7, 0x48, 0xcd, 0x0f, 0x06, 0x03, 0x10, 0x94, 0xac, 0xaa, 0x4d, 0x65, 0x6f, 0x9c, 0xee,
-	0x6f, 0x06, 0x33, 0x3d, 0xdd, 0x5f, 0x77, 0x0f, 0xa1, 0xee, 0xbe, 0xbb, 0xdc, 0x9c, 0x84, 0x01,
-	0x0d, 0x50, 0x39, 0x0a, 0xfc, 0x71, 0xbf, 0x79, 0x46, 0x2e, 0x88, 0x4f, 0x85, 0xac, 0xbf, 0x34,
-	0x76, 0xc2, 0x2b, 0x4c, 0x27, 0x9e, 0x33, 0xc2, 0x52, 0xd4, 0x21, 0x3e, 0x03, 0xfa, 0xc4, 0x89,
-	0x05, 0x94, 0x8c, 0x71, 0x44, 0x9d, 0xf1, 0x44, 0x08, 0xac, 0x23, 0x68, 0x1d, 0x07, 0x21, 0x25,
-	0xfe, 0xc5, 0xd1, 0x84, 0x92, 0xc0, 0x47, 0x2b, 0x50, 0x39, 0x27, 0xd8, 0x73, 0x7b, 0xc6, 0x9a,
-	0xb1, 0x5e, 0xb7, 0xc5, 0x00, 0xad, 0x43, 0x25, 0x08, 0x5d, 0x1c, 0xf6, 0x8a, 0x6b, 0xc6, 0x7a,
-	0x7b, 0x0b, 0x6d, 0xb2, 0x65, 0x37, 0xe3, 0x99, 0x4c, 0x63, 0x0b, 0x80, 0xf5, 0x75, 0x15, 0x9a,
-	0x43, 0xec, 0x78, 0x91, 0x8d, 0xff, 0x30, 0xc5, 0x11, 0x45, 0xeb, 0x50, 0x8d, 0xa8, 0x43, 0xa7,
-	0x11, 0x5f, 0xb1, 0xbd, 0xd5, 0x15, 0x73, 0x19, 0xe6, 0x98, 0xcb, 0x6d, 0xa9, 0x47, 0x9f, 0x03,
-	0x44, 0xd3, 0xc9, 0xc4, 0x23, 0x38, 0x3c, 0x18, 0xf2, 0x2f, 0x35, 0x62, 0xf4, 0x2e, 0xbd, 0xdc,
-	0x76, 0xdd, 0x10, 0x47, 0x91, 0xad, 0x61, 0xd8, 0x8c, 0x51, 0xe0, 0x47, 0xd3, 0x31, 0x9f, 0x51,
-	0x5a, 0x34, 0x23, 0xc1, 0xa0, 0x27, 0x60, 0x8e, 0x9d, 0x88, 0x72, 0x7c, 0x79, 0x01, 0x5e, 0x21,
-	0x90, 0x05, 0x15, 0x27, 0xba, 0x3a, 0x18, 0xf6, 0x2a, 0x1c, 0xda, 0x14, 0xd0, 0x1d, 0x72, 0x71,
-	0xe0, 0x53, 0x5b, 0xa8, 0x18, 0xe6, 0x8c, 0xb8, 0x07, 0xc3, 0x5e, 0x35, 0x0f, 0xc3, 0x55, 0x68,
-	0x13, 0x4c, 0x77, 0x1a, 0x3a, 0xcc, 0xc0, 0xbd, 0x1a, 0x87, 0x49, 0x0b, 0x1e, 0x3a, 0xef, 0x0f,
-	0x89, 0xff, 0x15, 0xf1, 0xe9, 0x17, 0x3f, 0xb2, 0x15, 0x06, 0x7d, 0x0a, 0x95, 0x49, 0x48, 0x46,
-	0xb8, 0x67, 0x72, 0x70, 0x47, 0x07, 0xef, 0x90, 0x0b, 0x5b, 0x68, 0xd1, 0x0f, 0xc1, 0xf4, 0x31,
-	0x3d, 0xf7, 0x9c, 0x8b, 0xa8, 0x57, 0xd7, 0x91, 0x83, 0xf1, 0x24, 0x5e, 0x33, 0x06, 0xa0, 0x5f,
-	0x41, 0x97, 0x6d, 0xd8, 0xc5, 0x3e, 0x25, 0x74, 0xf6, 0x0a, 0x5f, 0x63, 0xaf, 0x07, 0xfc, 0x46,
-	0x96, 0xc5, 0xa4, 0x94, 0xca, 0x9e, 0x03, 0xb3, 0x05, 0xd8, 0x69, 0x52, 0x0b, 0x34, 0x6e, 0x58,
-	0x20, 0x0b, 0x46, 0x3b, 0x00, 0x67, 0xd8, 0x1f, 0x5d, 0x32, 0x3f, 0x8d, 0x7a, 0xcd, 0xb5, 0xd2,
-	0x7a, 0x63, 0xcb, 0x4a, 0xbc, 0x21, 0xf6, 0x98, 0xcd, 0x1d, 0x05, 0xda, 0xf5, 0x69, 0x38, 0xb3,
-	0xb5, 0x59, 0xcc, 0x3d, 0x3d, 0x32, 0x26, 0xb4, 0xd7, 0x5a, 0x33, 0xd6, 0xcb, 0xb6, 0x18, 0xa0,
-	0x8f, 0xa0, 0x1a, 0x9c, 0x9f, 0x47, 0x98, 0xf6, 0xda, 0x5c, 0x2c, 0x47, 0xe8, 0x19, 0x98, 0x91,
-	0xf0, 0xd1, 0xa8, 0xd7, 0xe1, 0xdf, 0x5b, 0x4e, 0x7b, 0x2e, 0xf7, 0x79, 0x5b, 0x81, 0xd0, 0x7d,
-	0xa8, 0xbf, 0x23, 0xf4, 0x72, 0x10, 0x4c, 0x7d, 0xda, 0xeb, 0xae, 0x19, 0xeb, 0xa6, 0x9d, 0x08,
-	0xfa, 0x6f, 0xa1, 0x93, 0xd9, 0x1b, 0xea, 0x42, 0xe9, 0x0a, 0xcf, 0xb8, 0x6b, 0x97, 0x6d, 0xf6,
-	0x93, 0x85, 0xca, 0xb5, 0xe3, 0x4d, 0xb1, 0x74, 0xe0, 0xbc, 0x8b, 0x16, 0x80, 0x9f, 0x15, 0x5f,
-	0x1a, 0xd6, 0x97, 0xd0, 0x1a, 0x9e, 0xee, 0xcb, 0xe3, 0x4f, 0xbc, 0x19, 0x7a, 0x04, 0x15, 0x97,
-	0x8d, 0x7a, 0x06, 0xdf, 0x6f, 0x4b, 0xda, 0x47, 0x60, 0x6c, 0xa1, 0x63, 0x56, 0x18, 0xf1, 0x2d,
-	0x16, 0x85, 0x15, 0xf8, 0xc0, 0xfa, 0x7b, 0x11, 0x6a, 0x12, 0x88, 0x1e, 0x40, 0x99, 0x41, 0xf9,
-	0xc6, 0x1a, 0x5b, 0x90, 0x58, 0xd9, 0xe6, 0x72, 0xd4, 0xd7, 0x5c, 0x47, 0x2c, 0x92, 0x78, 0xca,
-	0x46, 0x8e, 0xa7, 0x94, 0x38, 0x66, 0xde, 0x29, 0x36, 0x72, 0x9c, 0xa2, 0x2c, 0xb0, 0x73, 0xf7,
-	0xbf, 0x05, 0x2b, 0x71, 0xec, 0x0e, 0x70, 0x48, 0xc9, 0x39, 0x19, 0x39, 0x14, 0x47, 0x3c, 0xb8,
-	0x9a, 0x76, 0xae, 0x8e, 0xcd, 0x89, 0xa3, 0x37, 0x35, 0xa7, 0x2a, 0xe6, 0xe4, 0xe9, 0xd0, 0xe7,
-	0xb0, 0xec, 0x8c, 0x28, 0xb9, 0xc6, 0x83, 0x4b, 0xc7, 0xbf, 0xc0, 0xd2, 0xad, 0x78, 0xe0, 0x99,
-	0x76, 0x9e, 0xca, 0xfa, 0xc6, 0x80, 0x55, 0x66, 0x9c, 0x41, 0xe0, 0xbb, 0x84, 0xb9, 0x84, 0x62,
-	0xaf, 0x4f, 0xa0, 0xca, 0xec, 0x75, 0x30, 0x94, 0x96, 0x4c, 0x87, 0xb7, 0xd4, 0x25, 0x5e, 0x59,
-	0xcc, 0xf7, 0xca, 0xd2, 0x42, 0xaf, 0x2c, 0xdf, 0xd9, 0x2b, 0x2b, 0x19, 0xaf, 0xb4, 0x7e, 0x0f,
-	0xcb, 0xd9, 0xbd, 0x33, 0x47, 0x7a, 0xc1, 0xb9, 0x51, 0x8a, 0xa4, 0x37, 0x2d, 0x27, 0x7e, 0xa0,
-	0xe0, 0xb6, 0x06, 0x5b, 0xe0, 0x58, 0x7f, 0xab, 0x42, 0x8b, 0x93, 0xfc, 0x1d, 0xcd, 0xf2, 0x08,
-	0xca, 0x74, 0x36, 0xc1, 0x32, 0x69, 0x48, 0x6e, 0xe2, 0x0b, 0x9d, 0xcc, 0x26, 0xd8, 0xe6, 0x4a,
-	0xf4, 0x58, 0xe5, 0x87, 0x12, 0x87, 0x2d, 0x69, 0xb0, 0x4c, 0x82, 0x78, 0x02, 0xa6, 0x33, 0xa5,
-	0x97, 0xc1, 0x8d, 0xe4, 0x1d, 0x23, 0xd0, 0x4b, 0x68, 0xf3, 0xed, 0xe3, 0x70, 0xe2, 0x84, 0x74,
-	0xc6, 0x59, 0xbc, 0x94, 0x3b, 0x27, 0x83, 0x4b, 0xd1, 0x75, 0xf5, 0x2e, 0x74, 0x5d, 0xff, 0x60,
-	0xba, 0x6e, 0xdc, 0x46, 0xd7, 0x7b, 0xb0, 0x32, 0x0a, 0xb1, 0x43, 0x83, 0x30, 0x1d, 0x5c, 0x8c,
-	0x36, 0x17, 0x30, 0x6e, 0xee, 0x04, 0x34, 0x48, 0xb1, 0x6e, 0x8b, 0x9b, 0xe0, 0x91, 0x66, 0xe3,
-	0x0f, 0xa2, 0xdd, 0x17, 0x50, 0xe7, 0x8b, 0x63, 0xf7, 0xe4, 0x98, 0x73, 0x6c, 0x63, 0x6b, 0x55,
-	0x3f, 0xe5, 0x49, 0x5c, 0x56, 0xd8, 0x09, 0x2e, 0x89, 0x8a, 0x4e, 0x7e, 0x54, 0x74, 0x17, 0x46,
-	0xc5, 0xd2, 0x9d, 0xa3, 0x02, 0x65, 0xa2, 0x22, 0x95, 0xe8, 0x97, 0x6f, 0x4b, 0xf4, 0xff, 0x0b,
-	0x66, 0xff, 0xa3, 0x01, 0xab, 0x87, 0x0e, 0x1d, 0x5d, 0xc6, 0x15, 0x92, 0x0a, 0x9e, 0xfb, 0x50,
-	0x24, 0x6e, 0x6e, 0xe0, 0x14, 0x89, 0x7b, 0x47, 0x2e, 0x49, 0x19, 0xa1, 0x9c, 0xa5, 0x86, 0xd7,
-	0xd0, 0x1e, 0x9e, 0xee, 0xc7, 0x5f, 0x67, 0xac, 0xf0, 0x19, 0x54, 0x79, 0x9d, 0x16, 0x33, 0x42,
-	0x5b, 0xe5, 0x17, 0x51, 0xc5, 0x49, 0xed, 0x02, 0x22, 0xf8, 0xa6, 0x08, 0x66, 0x0c, 0x45, 0x0f,
-	0xe3, 0x9a, 0x50, 0x9c, 0xa4, 0xa1, 0xf9, 0x94, 0x2c, 0x06, 0x39, 0x7b, 0xe7, 0x39, 0xb1, 0x58,
-	0x34, 0xdf, 0x5f, 0xd7, 0xa0, 0x21, 0xe5, 0xaf, 0x9d, 0x31, 0xe6, 0xc7, 0xad, 0xdb, 0xba, 0x08,
-	0x7d, 0x06, 0x6d, 0x39, 0xe4, 0xa7, 0x0c, 0x67, 0xfc, 0xe0, 0x75, 0x3b, 0x23, 0x65, 0x79, 0x20,
-	0x96, 0xcc, 0xa7, 0x9b, 0x3c, 0x15, 0x7a, 0x0a, 0xf5, 0x81, 0x72, 0xf3, 0xaa, 0x1e, 0xa2, 0x9a,
-	0x83, 0x2b, 0x44, 0xca, 0xc7, 0x6a, 0xb7, 0xf9, 0x98, 0xf5, 0xd7, 0x12, 0xb4, 0x52, 0xcc, 0x8b,
-	0xda, 0xca, 0x11, 0xca, 0xfc, 0xea, 0xbf, 0x7b, 0x05, 0x70, 0x5f, 0x63, 0xc2, 0x8a, 0x28, 0x13,
-	0x14, 0xeb, 0x59, 0x31, 0xeb, 0xe5, 0x16, 0xbe, 0x82, 0xf2, 0x9e, 0x42, 0x3d, 0xa2, 0x4e, 0x48,
-	0x99, 0xf9, 0xa4, 0x89, 0xe6, 0x0d, 0xaa, 0x10, 0xe8, 0x31, 0xd4, 0xb0, 0xef, 0x72, 0xb0, 0x99,
-	0x0f, 0x8e, 0xf5, 0x68, 0x13, 0x1a, 0x34, 0xa0, 0x8e, 0xf7, 0xc6, 0x99, 0x05, 0x53, 0x2a, 0x99,
-	0x37, 0xbd, 0x07, 0x1d, 0xa0, 0x65, 0x2c, 0x58, 0x9c, 0xb1, 0xac, 0x3f, 0x19, 0x50, 0x1f, 0x9e,
-	0xee, 0x9f, 0x06, 0xe1, 0x15, 0x0e, 0x53, 0xb6, 0x32, 0x6e, 0xb5, 0xd5, 0x06, 0xd4, 0x22, 0xcf,
-	0xb9, 0xc6, 0x37, 0x5c, 0x5d, 0x0c, 0x60, 0x61, 0x3b, 0x0a, 0xfc, 0x73, 0x12, 0x8e, 0xb1, 0xcb,
-	0xaf, 0xcd, 0xb4, 0x13, 0x81, 0xf5, 0xaf, 0x22, 0x74, 0xde, 0x84, 0xc1, 0x39, 0xf1, 0xb0, 0x22,
-	0x8d, 0x4f, 0xa1, 0x1c, 0x06, 0x1e, 0x96, 0x4d, 0x94, 0x4c, 0x92, 0x12, 0x64, 0x07, 0x1e, 0xb6,
-	0xb9, 0x1a, 0xfd, 0x14, 0x5a, 0x64, 0x2e, 0xd4, 0x16, 0xe4, 0x8b, 0x34, 0x12, 0xf5, 0xa0, 0x36,
-	0x92, 0xf1, 0x54, 0x5a, 0x2b, 0xad, 0xd7, 0xed, 0x78, 0x88, 0x10, 0x94, 0x7d, 0x16, 0x8b, 0x22,
-	0xcc, 0xf8, 0x6f, 0xf4, 0x0b, 0x68, 0x9f, 0x79, 0xce, 0xe8, 0xca, 0x23, 0x11, 0x7d, 0x3b, 0xc5,
-	0xe1, 0x4c, 0xf6, 0x48, 0x2b, 0xd2, 0xae, 0x29, 0x9d, 0x9d, 0xc1, 0x26, 0x24, 0x57, 0xcd, 0x27,
-	0xb9, 0xda, 0xc2, 0xd4, 0x60, 0xde, 0x39, 0x35, 0xd4, 0xb3, 0xac, 0xf8, 0x06, 0x5a, 0x89, 0x75,
-	0x19, 0x29, 0x3e, 0x06, 0x73, 0x22, 0x05, 0xe9, 0xb2, 0x3b, 0xb6, 0xaf, 0x52, 0x2f, 0xe0, 0xc5,
-	0x7f, 0x17, 0xa1, 0x26, 0xb1, 0xac, 0xdf, 0xfd, 0x2a, 0xba, 0xd1, 0x65, 0xa4, 0x1e, 0x7d, 0x02,
-	0xad, 0x3c, 0x5a, 0x4c, 0x0b, 0x99, 0xf1, 0x35, 0x22, 0xe4, 0xbf, 0xd9, 0x55, 0xa5, 0xa9, 0x2f,
-	0x1e, 0xf2, 0x35, 0xa3, 0x41, 0x10, 0x4e, 0x02, 0x2d, 0x6a, 0x4d, 0x3b, 0x2d, 0x64, 0x0c, 0x7a,
-	0x10, 0xb1, 0x0d, 0xe3, 0x28, 0x22, 0x81, 0xef, 0x78, 0xfc, 0x1e, 0x4c, 0x3b, 0x23, 0x45, 0x16,
-	0x34, 0x53, 0xd4, 0x59, 0xe3, 0x1f, 0x4b, 0xc9, 0xd0, 0x03, 0x00, 0x51, 0x52, 0x6f, 0x47, 0x57,
-	0x11, 0x0f, 0xdb, 0xb2, 0xad, 0x49, 0x12, 0xfd, 0x0e, 0x71, 0x45, 0x9b, 0xaa, 0xf4, 0x4c, 0xc2,
-	0x76, 0x4c, 0x22, 0xe5, 0x2e, 0xd8, 0xe5, 0xf1, 0x69, 0xda, 0x69, 0xa1, 0xf5, 0x67, 0x03, 0xba,
-	0x6a, 0x1c, 0xc7, 0xc4, 0x06, 0xd4, 0x82, 0x77, 0xfe, 0x8d, 0xb6, 0x8e, 0x01, 0xdf, 0x6a, 0x5a,
-	0x9d, 0x40, 0x5b, 0xdb, 0x0b, 0xf3, 0xa0, 0xbb, 0xec, 0xe4, 0x3e, 0xd4, 0x1d, 0x21, 0xc3, 0xac,
-	0xf7, 0x62, 0x91, 0x96, 0x08, 0x12, 0x07, 0x2b, 0xe9, 0x0e, 0xf6, 0x4f, 0x03, 0x96, 0x7e, 0xeb,
-	0x78, 0xc4, 0x65, 0x29, 0x4b, 0x71, 0xc2, 0x4f, 0xa0, 0x7d, 0x1d, 0x0b, 0x85, 0x07, 0x19, 0xf9,
-	0x65, 0x65, 0x06, 0xf6, 0xff, 0xed, 0x57, 0x7e, 0x07, 0x1d, 0xfd, 0x28, 0xcc, 0x7c, 0xcf, 0x00,
-	0xd4, 0x0e, 0xe3, 0x10, 0x94, 0x87, 0x50, 0x50, 0x5b, 0x83, 0x2c, 0x08, 0xc3, 0x01, 0xd4, 0x15,
-	0x1c, 0xad, 0x69, 0x55, 0xd6, 0xfc, 0x6d, 0xc4, 0x95, 0x96, 0x16, 0x77, 0x62, 0x60, 0xbd, 0x86,
-	0x7b, 0x3c, 0x4b, 0xeb, 0x0d, 0xa2, 0x6a, 0xa9, 0xcc, 0x50, 0x0a, 0xe4, 0x26, 0xef, 0x69, 0x0d,
-	0x95, 0x3e, 0xc1, 0x56, 0x40, 0xeb, 0xeb, 0x22, 0x2c, 0xcd, 0xe9, 0x6f, 0xa9, 0x01, 0x93, 0x64,
-	0x55, 0xbc, 0xa1, 0xbd, 0x7a, 0x0e, 0x0d, 0xf9, 0x15, 0xd6, 0x4e, 0xc9, 0xf6, 0x69, 0xae, 0xcb,
-	0xd2, 0x31, 0xa9, 0x7c, 0x5e, 0x5e, 0x94, 0xcf, 0x2b, 0x8b, 0xf3, 0xf9, 0x73, 0xd5, 0xac, 0x55,
-	0xf9, 0xd7, 0x3e, 0x96, 0x9e, 0xa6, 0x9f, 0x2d, 0xd3, 0xb4, 0x3d, 0xd5, 0x5b, 0x87, 0x45, 0x25,
-	0x80, 0x42, 0x58, 0x7f, 0x31, 0xa0, 0xc1, 0xcc, 0xf5, 0xc6, 0x99, 0x8d, 0xb1, 0xff, 0xa1, 0x9d,
-	0xe6, 0x26, 0x34, 0x26, 0xce, 0x0c, 0xbb, 0xdb, 0x63, 0xe5, 0x15, 0x73, 0xd5, 0x80, 0x06, 0x60,
-	0x9b, 0x9a, 0x88, 0x0f, 0x9c, 0x1c, 0xcb, 0xb2, 0x69, 0x7e, 0x53, 0x0a, 0xc1, 0xd8, 0xa7, 0x2d,
-	0x6a, 0x02, 0x15, 0x7b, 0x4f, 0xc0, 0x3c, 0xbc, 0xb5, 0x36, 0x88, 0x11, 0xdf, 0x2a, 0xfb, 0x1c,
-	0x41, 0x53, 0xed, 0x45, 0x64, 0xaf, 0xda, 0x3b, 0x31, 0x4e, 0x47, 0x8e, 0xaa, 0x63, 0xec, 0x58,
-	0xbf, 0x20, 0x6c, 0xfe, 0x61, 0x40, 0x43, 0xa3, 0xf4, 0x3b, 0x91, 0xd9, 0x16, 0x34, 0x54, 0x58,
-	0xde, 0x50, 0xf8, 0xe8, 0x20, 0x4e, 0x80, 0x94, 0x86, 0xe4, 0x6c, 0x4a, 0xb1, 0x3c, 0x79, 0x22,
-	0xe0, 0xf9, 0x20, 0xe7, 0x39, 0x29, 0x53, 0xac, 0xac, 0xc4, 0xbd, 0x98, 0xa8, 0xe6, 0xc5, 0xc0,
-	0xda, 0x82, 0xa6, 0xde, 0x8e, 0xb1, 0x1e, 0x6e, 0xec, 0xbc, 0x8f, 0x7b, 0xb8, 0xb1, 0xf3, 0x9e,
-	0x4b, 0x88, 0x2f, 0xcf, 0xcf, 0x7e, 0x5a, 0xbf, 0x81, 0xba, 0xea, 0xd4, 0xd1, 0x83, 0x64, 0x42,
-	0xd6, 0x7f, 0xf8, 0xf4, 0x07, 0xc9, 0xf4, 0x79, 0x3d, 0xf1, 0xad, 0x53, 0xe8, 0x64, 0x1a, 0x62,
-	0xf4, 0x50, 0x5f, 0x72, 0xce, 0xc9, 0xf8, 0xaa, 0x0f, 0xf5, 0x55, 0x73, 0x20, 0xc4, 0xb7, 0xbe,
-	0x84, 0xba, 0xa2, 0xf3, 0xe4, 0xf0, 0xe2, 0x60, 0x62, 0x80, 0x7e, 0x00, 0x66, 0x30, 0xc1, 0x21,
-	0x33, 0xb2, 0xac, 0xfa, 0x1a, 0x2a, 0x0f, 0x1c, 0x4d, 0x6c, 0xa5, 0xb4, 0xae, 0xb4, 0xf4, 0x25,
-	0xca, 0xb1, 0xbb, 0xdc, 0xf8, 0x53, 0xa8, 0x06, 0x9c, 0xf0, 0xe5, 0x47, 0x56, 0x33, 0x05, 0x9f,
-	0xcc, 0x06, 0x12, 0xb4, 0xf1, 0x10, 0x2a, 0xfc, 0xfb, 0xa8, 0x0a, 0xc5, 0xdd, 0xb7, 0xdd, 0x02,
-	0xaa, 0x41, 0x69, 0xef, 0x64, 0xb7, 0x6b, 0xb0, 0x1f, 0xaf, 0x4e, 0x76, 0xbb, 0xc5, 0x8d, 0x87,
-	0xd0, 0xd4, 0xff, 0x49, 0x60, 0x8a, 0xed, 0x68, 0xd4, 0x2d, 0x20, 0x13, 0xca, 0x43, 0x1c, 0x8d,
-	0xba, 0xc6, 0xc6, 0x17, 0xd0, 0xd0, 0x6a, 0x5d, 0xd4, 0x80, 0xda, 0xb6, 0x3f, 0x63, 0x3f, 0xbb,
-	0x05, 0xd4, 0x04, 0xf3, 0x58, 0x76, 0x44, 0x5d, 0x83, 0x8d, 0x06, 0xb2, 0xdb, 0xe9, 0x16, 0x37,
-	0x5e, 0x41, 0x27, 0xb3, 0x31, 0xb4, 0x0c, 0x9d, 0x53, 0x42, 0x2f, 0x83, 0x29, 0x8d, 0xbb, 0xf3,
-	0x6e, 0x01, 0x21, 0x68, 0x1f, 0xf8, 0x23, 0x6f, 0xea, 0xe2, 0x6d, 0xdf, 0x3d, 0x74, 0xc2, 0xab,
-	0xae, 0x81, 0xba, 0xd0, 0x3c, 0xf2, 0xbd, 0x99, 0x42, 0x15, 0xb7, 0xfe, 0x53, 0x81, 0xd2, 0xf0,
-	0x74, 0x1f, 0xfd, 0x18, 0xcc, 0x3d, 0x4c, 0xf9, 0xa3, 0x2d, 0x42, 0xf3, 0x0f, 0xd8, 0xfd, 0xe5,
-	0xd4, 0xa3, 0xad, 0x08, 0x53, 0xab, 0x80, 0x9e, 0x41, 0x5b, 0x4e, 0x1b, 0x62, 0xea, 0x10, 0x2f,
-	0x42, 0x29, 0x0f, 0xea, 0xa7, 0xdf, 0x7a, 0xad, 0x02, 0x3a, 0x84, 0x25, 0x39, 0x21, 0x79, 0xdc,
-	0x43, 0xdf, 0xcb, 0x79, 0xc3, 0x53, 0x5f, 0xfe, 0x38, 0x5f, 0x29, 0xbe, 0xff, 0x12, 0xea, 0x7b,
-	0x98, 0x8a, 0xd7, 0x00, 0xb4, 0x9c, 0xf3, 0x04, 0xd4, 0x5f, 0x49, 0xbf, 0x06, 0xa8, 0x99, 0xfb,
-	0x7c, 0x23, 0xe9, 0xd7, 0x8c, 0x78, 0x23, 0xb9, 0x6f, 0x1c, 0x0b, 0x57, 0x7a, 0x0e, 0x9d, 0x78,
-	0x0f, 0xf9, 0x46, 0xc8, 0x3c, 0x48, 0x58, 0x05, 0xf4, 0x73, 0x68, 0xec, 0x61, 0x1a, 0x57, 0xec,
-	0x68, 0x35, 0x55, 0x9a, 0x67, 0x6d, 0x9e, 0x2a, 0xec, 0xad, 0x02, 0xda, 0xe4, 0x36, 0x97, 0xd2,
-	0x03, 0xff, 0x3c, 0x40, 0x0d, 0xe5, 0xda, 0x07, 0xc3, 0x7e, 0xba, 0xce, 0xb7, 0x0a, 0xe8, 0x97,
-	0xd0, 0xdc, 0xc3, 0x54, 0xf9, 0x0c, 0xfa, 0x28, 0xe3, 0xdd, 0x99, 0xf3, 0xa5, 0xcb, 0x40, 0xab,
-	0x80, 0xb6, 0xa1, 0xb5, 0x87, 0x69, 0x52, 0xdf, 0xa0, 0x7b, 0x99, 0x32, 0x46, 0x6d, 0x78, 0x75,
-	0x5e, 0x21, 0x96, 0xf8, 0x35, 0xac, 0xc6, 0xb7, 0x9e, 0xaa, 0x41, 0x32, 0x86, 0xfa, 0xfe, 0x82,
-	0xd2, 0x43, 0xbb, 0x6e, 0xd8, 0xc3, 0xf4, 0x34, 0xa6, 0x7e, 0x01, 0x4f, 0x67, 0xb1, 0x3e, 0xca,
-	0x48, 0xf9, 0xcc, 0xb3, 0x2a, 0xff, 0x6f, 0xf0, 0xc5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6c,
-	0x98, 0x6c, 0x9f, 0x71, 0x1c, 0x00, 0x00,
+	// 3204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x23, 0xc7,
+	0xd1, 0xd7, 0x90, 0x14, 0x1f, 0x45, 0x8a, 0xa2, 0x5a, 0xab, 0x15, 0x97, 0x9f, 0xbd, 0xd6, 0x8e,
+	0x1f, 0x9f, 0x2c, 0x7b, 0x65, 0x5b, 0xfb, 0x7d, 0x8e, 0xf3, 0x34, 0xb4, 0xe4, 0x5a, 0x2b, 0xc7,
+	0xbb, 0x2b, 0xf7, 0x6a, 0xad, 0x5c, 0x02, 0x78, 0xc4, 0x69, 0xad, 0x26, 0x1a, 0xce, 0x30, 0x33,
+	0x4d, 0xed, 0x32, 0x87, 0x00, 0x41, 0x6e, 0x39, 0xe6, 0x3f, 0x70, 0x90, 0xa3, 0x91, 0x43, 0x10,
+	0x20, 0x80, 0x83, 0x1c, 0x83, 0x20, 0xff, 0x83, 0x81, 0x5c, 0x73, 0xcc, 0x31, 0xc7, 0xa0, 0x9f,
+	0xd3, 0x33, 0x9c, 0xa1, 0x24, 0xc4, 0x46, 0x7c, 0x9b, 0xae, 0xfa, 0x75, 0x4f, 0x75, 0x75, 0x55,
+	0x75, 0x55, 0xcd, 0x40, 0xc3, 0x7d, 0x76, 0xba, 0x3d, 0x8e, 0x42, 0x1a, 0xa2, 0x4a, 0x1c, 0x06,
+	0xa3, 0x5e, 0xeb, 0xd8, 0x7b, 0xea, 0x05, 0x54, 0xd0, 0x7a, 0x2b, 0x23, 0x27, 0x3a, 0x23, 0x74,
+	0xec, 0x3b, 0x43, 0x22, 0x49, 0xcb, 0x5e, 0xc0, 0x80, 0x81, 0xe7, 0x28, 0x02, 0xf5, 0x46, 0x24,
+	0xa6, 0xce, 0x68, 0x2c, 0x08, 0xf6, 0x23, 0x58, 0x7a, 0x1c, 0x46, 0xd4, 0x0b, 0x9e, 0x3e, 0x1a,
+	0x53, 0x2f, 0x0c, 0xd0, 0x35, 0x58, 0x3c, 0xf1, 0x88, 0xef, 0x76, 0xad, 0x0d, 0x6b, 0xb3, 0x81,
+	0xc5, 0x00, 0x6d, 0xc2, 0x62, 0x18, 0xb9, 0x24, 0xea, 0x96, 0x36, 0xac, 0xcd, 0xf6, 0x0e, 0xda,
+	0x66, 0xcb, 0x6e, 0xab, 0x99, 0x8c, 0x83, 0x05, 0xc0, 0xfe, 0xbc, 0x0a, 0xad, 0x01, 0x71, 0xfc,
+	0x18, 0x93, 0x9f, 0x4e, 0x48, 0x4c, 0xd1, 0x26, 0x54, 0x63, 0xea, 0xd0, 0x49, 0xcc, 0x57, 0x6c,
+	0xef, 0x74, 0xc4, 0x5c, 0x86, 0x79, 0xcc, 0xe9, 0x58, 0xf2, 0xd1, 0xdb, 0x00, 0xf1, 0x64, 0x3c,
+	0xf6, 0x3d, 0x12, 0xed, 0x0f, 0xf8, 0x9b, 0x9a, 0x0a, 0x7d, 0x8f, 0x9e, 0xee, 0xba, 0x6e, 0x44,
+	0xe2, 0x18, 0x1b, 0x18, 0x36, 0x63, 0x18, 0x06, 0xf1, 0x64, 0xc4, 0x67, 0x94, 0x8b, 0x66, 0x24,
+	0x18, 0xf4, 0x26, 0xd4, 0x47, 0x4e, 0x4c, 0x39, 0xbe, 0x52, 0x80, 0xd7, 0x08, 0x64, 0xc3, 0xa2,
+	0x13, 0x9f, 0xed, 0x0f, 0xba, 0x8b, 0x1c, 0xda, 0x12, 0xd0, 0xbb, 0xde, 0xd3, 0xfd, 0x80, 0x62,
+	0xc1, 0x62, 0x98, 0x63, 0xcf, 0xdd, 0x1f, 0x74, 0xab, 0x79, 0x18, 0xce, 0x42, 0xdb, 0x50, 0x77,
+	0x27, 0x91, 0xc3, 0x14, 0xdc, 0xad, 0x71, 0x98, 0xd4, 0xe0, 0x03, 0xe7, 0xf9, 0x03, 0x2f, 0x78,
+	0xe2, 0x05, 0xf4, 0xdd, 0xff, 0xc3, 0x1a, 0x83, 0x5e, 0x85, 0xc5, 0x71, 0xe4, 0x0d, 0x49, 0xb7,
+	0xce, 0xc1, 0xcb, 0x26, 0xf8, 0xae, 0xf7, 0x14, 0x0b, 0x2e, 0x7a, 0x03, 0xea, 0x01, 0xa1, 0x27,
+	0xbe, 0xf3, 0x34, 0xee, 0x36, 0x4c, 0x64, 0x7f, 0x34, 0x56, 0x6b, 0x2a, 0x00, 0x7a, 0x1f, 0x3a,
+	0x4c, 0x60, 0x97, 0x04, 0xd4, 0xa3, 0xd3, 0x8f, 0xc8, 0x39, 0xf1, 0xbb, 0xc0, 0x4f, 0x64, 0x55,
+	0x4c, 0x4a, 0xb1, 0xf0, 0x0c, 0x98, 0x2d, 0xc0, 0x76, 0x93, 0x5a, 0xa0, 0x39, 0x67, 0x81, 0x2c,
+	0x18, 0xdd, 0x05, 0x38, 0x26, 0xc1, 0xf0, 0x94, 0xd9, 0x69, 0xdc, 0x6d, 0x6d, 0x94, 0x37, 0x9b,
+	0x3b, 0x76, 0x62, 0x0d, 0xca, 0x62, 0xb6, 0xef, 0x6a, 0xd0, 0xbd, 0x80, 0x46, 0x53, 0x6c, 0xcc,
+	0x62, 0xe6, 0xe9, 0x7b, 0x23, 0x8f, 0x76, 0x97, 0x36, 0xac, 0xcd, 0x0a, 0x16, 0x03, 0x74, 0x1d,
+	0xaa, 0xe1, 0xc9, 0x49, 0x4c, 0x68, 0xb7, 0xcd, 0xc9, 0x72, 0x84, 0xde, 0x82, 0x7a, 0x2c, 0x6c,
+	0x34, 0xee, 0x2e, 0xf3, 0xf7, 0xad, 0xa6, 0x2d, 0x97, 0xdb, 0x3c, 0xd6, 0x20, 0xf4, 0x02, 0x34,
+	0x9e, 0x79, 0xf4, 0xb4, 0x1f, 0x4e, 0x02, 0xda, 0xed, 0x6c, 0x58, 0x9b, 0x75, 0x9c, 0x10, 0x7a,
+	0x1f, 0xc3, 0x72, 0x46, 0x36, 0xd4, 0x81, 0xf2, 0x19, 0x99, 0x72, 0xd3, 0xae, 0x60, 0xf6, 0xc8,
+	0x5c, 0xe5, 0xdc, 0xf1, 0x27, 0xa4, 0x5b, 0x2a, 0x3c, 0x68, 0x01, 0xf8, 0x4e, 0xe9, 0x3d, 0xcb,
+	0xfe, 0x10, 0x96, 0x06, 0x47, 0xf7, 0xe5, 0xf6, 0xc7, 0xfe, 0x14, 0xbd, 0x0c, 0x8b, 0x2e, 0x1b,
+	0x75, 0x2d, 0x2e, 0xef, 0x92, 0xd4, 0x8f, 0xc0, 0x60, 0xc1, 0x63, 0x5a, 0x18, 0x72, 0x11, 0x4b,
+	0x42, 0x0b, 0x7c, 0x60, 0xff, 0xb1, 0x04, 0x35, 0x09, 0x44, 0x37, 0xa1, 0xc2, 0xa0, 0x5c, 0xb0,
+	0xe6, 0x0e, 0x24, 0x5a, 0xc6, 0x9c, 0x8e, 0x7a, 0x86, 0xe9, 0x88, 0x45, 0xf4, 0x18, 0x6d, 0xe5,
+	0x58, 0x4a, 0x99, 0x63, 0x66, 0xe8, 0x0c, 0x3b, 0x63, 0x14, 0x15, 0x81, 0xcd, 0xd2, 0xd1, 0x0e,
+	0x5c, 0x53, 0xbe, 0xdb, 0x27, 0x11, 0xf5, 0x4e, 0xbc, 0xa1, 0x43, 0x49, 0xcc, 0x9d, 0xab, 0x85,
+	0x73, 0x79, 0x6c, 0x8e, 0xf2, 0xde, 0xd4, 0x9c, 0xaa, 0x98, 0x93, 0xc7, 0x43, 0x6f, 0xc3, 0xaa,
+	0x33, 0xa4, 0xde, 0x39, 0xe9, 0x9f, 0x3a, 0xc1, 0x53, 0x22, 0xcd, 0x8a, 0x3b, 0x5e, 0x1d, 0xe7,
+	0xb1, 0xec, 0x2f, 0x2c, 0x58, 0x63, 0xca, 0xe9, 0x87, 0x81, 0xeb, 0x31, 0x93, 0xd0, 0xd1, 0xeb,
+	0x15, 0xa8, 0x32, 0x7d, 0xed, 0x0f, 0xba, 0x56, 0x8e, 0x7b, 0x4b, 0x5e, 0x62, 0x95, 0xa5, 0x7c,
+	0xab, 0x2c, 0x17, 0x5a, 0x65, 0xe5, 0xca, 0x56, 0xb9, 0x98, 0xb1, 0x4a, 0xfb, 0x53, 0x58, 0xcd,
+	0xca, 0xce, 0x0c, 0xe9, 0x0e, 0x8f, 0x8d, 0x92, 0xd4, 0xb5, 0xcc, 0xf7, 0xa4, 0xe0, 0xd8, 0x80,
+	0x15, 0x18, 0xd6, 0xef, 0xab, 0xb0, 0xc4, 0x83, 0xfc, 0x15, 0xd5, 0xf2, 0x32, 0x54, 0xe8, 0x74,
+	0x4c, 0xe4, 0xa5, 0x21, 0x63, 0x13, 0x5f, 0xe8, 0x70, 0x3a, 0x26, 0x98, 0x33, 0xd1, 0xeb, 0xfa,
+	0x7e, 0x28, 0x73, 0xd8, 0x8a, 0x01, 0xcb, 0x5c, 0x10, 0x6f, 0x42, 0xdd, 0x99, 0xd0, 0xd3, 0x70,
+	0x6e, 0xf0, 0x56, 0x08, 0xf4, 0x1e, 0xb4, 0xb9, 0xf8, 0x24, 0x1a, 0x3b, 0x11, 0x9d, 0xf2, 0x28,
+	0x5e, 0xce, 0x9d, 0x93, 0xc1, 0xa5, 0xc2, 0x75, 0xf5, 0x2a, 0xe1, 0xba, 0x71, 0xe9, 0x70, 0xdd,
+	0xbc, 0x28, 0x5c, 0xef, 0xc1, 0xb5, 0x61, 0x44, 0x1c, 0x1a, 0x46, 0x69, 0xe7, 0x62, 0x61, 0xb3,
+	0x20, 0xe2, 0xe6, 0x4e, 0x40, 0xfd, 0x54, 0xd4, 0x5d, 0xe2, 0x2a, 0x78, 0xd9, 0xd0, 0xf1, 0xa5,
+	0xc2, 0xee, 0x1d, 0x68, 0xf0, 0xc5, 0x89, 0x7b, 0xf8, 0x98, 0xc7, 0xd8, 0xe6, 0xce, 0x9a, 0xb9,
+	0xcb, 0x43, 0x95, 0x56, 0xe0, 0x04, 0x97, 0x78, 0xc5, 0x72, 0xbe, 0x57, 0x74, 0x0a, 0xbd, 0x62,
+	0xe5, 0xca, 0x5e, 0x81, 0x32, 0x5e, 0x91, 0xba, 0xe8, 0x57, 0x2f, 0xba, 0xe8, 0xbf, 0x8e, 0xc8,
+	0xfe, 0x0b, 0x0b, 0xd6, 0x1e, 0x38, 0x74, 0x78, 0xaa, 0x32, 0x24, 0xed, 0x3c, 0x2f, 0x40, 0xc9,
+	0x73, 0x73, 0x1d, 0xa7, 0xe4, 0xb9, 0x57, 0x8c, 0x25, 0x29, 0x25, 0x54, 0xb2, 0xa1, 0xe1, 0x21,
+	0xb4, 0x07, 0x47, 0xf7, 0xd5, 0xdb, 0x59, 0x54, 0x78, 0x0d, 0xaa, 0x3c, 0x4f, 0x53, 0x11, 0xa1,
+	0xad, 0xef, 0x17, 0x8e, 0xc2, 0x92, 0x5b, 0x10, 0x08, 0xbe, 0x28, 0x41, 0x5d, 0x41, 0xd1, 0x2d,
+	0x95, 0x13, 0x8a, 0x9d, 0x34, 0x0d, 0x9b, 0x92, 0xc9, 0x20, 0x8f, 0xde, 0x79, 0x46, 0x2c, 0x16,
+	0xcd, 0xe5, 0xa1, 0x0d, 0x68, 0x4a, 0xfa, 0x43, 0x67, 0x44, 0xf8, 0x76, 0x1b, 0xd8, 0x24, 0xa1,
+	0xd7, 0xa0, 0x2d, 0x87, 0x7c, 0x97, 0xd1, 0x94, 0x6f, 0xbc, 0x81, 0x33, 0x54, 0x76, 0x0f, 0x28,
+	0xca, 0xec, 0x75, 0x93, 0xc7, 0x42, 0xb7, 0xa1, 0xd1, 0xd7, 0x66, 0x5e, 0x35, 0x5d, 0xd4, 0x30,
+	0x70, 0x8d, 0x48, 0xd9, 0x58, 0xed, 0x22, 0x1b, 0xb3, 0x3f, 0x2b, 0xc3, 0x52, 0x2a, 0xf2, 0xa2,
+	0xb6, 0x36, 0x84, 0x0a, 0x3f, 0xfa, 0x6f, 0x5e, 0x02, 0xdc, 0x33, 0x22, 0xe1, 0xa2, 0x48, 0x13,
+	0xd4, 0x98, 0x25, 0xbe, 0x22, 0xea, 0xe5, 0x26, 0xbe, 0x9c, 0xc5, 0x14, 0x1a, 0x53, 0x27, 0xa2,
+	0x4c, 0x7d, 0xdd, 0x5a, 0x81, 0x42, 0x35, 0x02, 0xbd, 0x0e, 0x35, 0x12, 0xb8, 0x1c, 0x5c, 0xcf,
+	0x07, 0x2b, 0x3e, 0xda, 0x86, 0x26, 0x0d, 0xa9, 0xe3, 0x1f, 0x38, 0xd3, 0x70, 0x42, 0xbb, 0x8d,
+	0x1c, 0x19, 0x4c, 0x80, 0x71, 0x63, 0x41, 0xf1, 0x8d, 0x65, 0xff, 0xd2, 0x82, 0xc6, 0xe0, 0xe8,
+	0xfe, 0x51, 0x18, 0x9d, 0x91, 0x28, 0xa5, 0x2b, 0xeb, 0x42, 0x5d, 0x6d, 0x41, 0x2d, 0xf6, 0x9d,
+	0x73, 0x32, 0xe7, 0xe8, 0x14, 0x80, 0xb9, 0xed, 0x30, 0x0c, 0x4e, 0xbc, 0x68, 0x44, 0x5c, 0x7e,
+	0x6c, 0x75, 0x9c, 0x10, 0xec, 0x2f, 0x4b, 0xb0, 0x7c, 0x10, 0x85, 0x27, 0x9e, 0x4f, 0x74, 0xd0,
+	0x78, 0x15, 0x2a, 0x51, 0xe8, 0x93, 0xae, 0x65, 0x5e, 0x92, 0x12, 0x84, 0x43, 0x9f, 0x60, 0xce,
+	0x46, 0xdf, 0x86, 0x25, 0x6f, 0xc6, 0xd5, 0x0a, 0xee, 0x8b, 0x34, 0x12, 0x75, 0xa1, 0x36, 0x94,
+	0xfe, 0x54, 0xde, 0x28, 0x6f, 0x36, 0xb0, 0x1a, 0x22, 0x04, 0x95, 0x80, 0xf9, 0xa2, 0x70, 0x33,
+	0xfe, 0x8c, 0xbe, 0x07, 0xed, 0x63, 0xdf, 0x19, 0x9e, 0xf9, 0x5e, 0x4c, 0x3f, 0x9e, 0x90, 0x68,
+	0x2a, 0x6b, 0xa4, 0x6b, 0x52, 0xaf, 0x29, 0x1e, 0xce, 0x60, 0x93, 0x20, 0x57, 0xcd, 0x0f, 0x72,
+	0xb5, 0xc2, 0xab, 0xa1, 0x7e, 0xe5, 0xab, 0xa1, 0x91, 0x8d, 0x8a, 0x07, 0xb0, 0x94, 0x68, 0x97,
+	0x05, 0xc5, 0xd7, 0xa1, 0x3e, 0x96, 0x84, 0x74, 0xda, 0xad, 0xf4, 0xab, 0xd9, 0x05, 0x71, 0xf1,
+	0xef, 0x25, 0xa8, 0x49, 0x2c, 0xab, 0x77, 0x9f, 0xc4, 0x73, 0x4d, 0x46, 0xf2, 0xd1, 0x2b, 0xb0,
+	0x94, 0x17, 0x16, 0xd3, 0x44, 0xa6, 0x7c, 0x23, 0x10, 0xf2, 0x67, 0x76, 0x54, 0xe9, 0xd0, 0xa7,
+	0x86, 0x7c, 0xcd, 0xb8, 0x1f, 0x46, 0xe3, 0xd0, 0xf0, 0xda, 0x3a, 0x4e, 0x13, 0x59, 0x04, 0xdd,
+	0x8f, 0x99, 0xc0, 0x24, 0x8e, 0xbd, 0x30, 0x70, 0x7c, 0x7e, 0x0e, 0x75, 0x9c, 0xa1, 0x22, 0x1b,
+	0x5a, 0xa9, 0xd0, 0x59, 0xe3, 0x2f, 0x4b, 0xd1, 0xd0, 0x4d, 0x00, 0x91, 0x52, 0xef, 0xc6, 0x67,
+	0x31, 0x77, 0xdb, 0x0a, 0x36, 0x28, 0x09, 0xff, 0xae, 0xe7, 0x8a, 0x32, 0xb5, 0x82, 0x0d, 0x0a,
+	0x93, 0xd8, 0x8b, 0xb5, 0xb9, 0x10, 0x97, 0xfb, 0x67, 0x1d, 0xa7, 0x89, 0xf6, 0xaf, 0x2c, 0xe8,
+	0xe8, 0xb1, 0xf2, 0x89, 0x2d, 0xa8, 0x85, 0xcf, 0x82, 0xb9, 0xba, 0x56, 0x80, 0xaf, 0xf4, 0x5a,
+	0x1d, 0x43, 0xdb, 0x90, 0x85, 0x59, 0xd0, 0x55, 0x24, 0x79, 0x01, 0x1a, 0x8e, 0xa0, 0x11, 0x56,
+	0x7b, 0x31, 0x4f, 0x4b, 0x08, 0x89, 0x81, 0x95, 0x4d, 0x03, 0xfb, 0x9b, 0x05, 0x2b, 0x9f, 0x38,
+	0xbe, 0xe7, 0xb2, 0x2b, 0x4b, 0xc7, 0x84, 0x6f, 0x41, 0xfb, 0x5c, 0x11, 0x85, 0x05, 0x59, 0xf9,
+	0x69, 0x65, 0x06, 0xf6, 0xdf, 0xad, 0x57, 0x7e, 0x04, 0xcb, 0xe6, 0x56, 0x98, 0xfa, 0xde, 0x02,
+	0xd0, 0x12, 0x2a, 0x17, 0x94, 0x9b, 0xd0, 0x50, 0x6c, 0x40, 0x0a, 0xdc, 0xb0, 0x0f, 0x0d, 0x0d,
+	0x47, 0x1b, 0x46, 0x96, 0x35, 0x7b, 0x1a, 0x2a, 0xd3, 0x32, 0xfc, 0x4e, 0x0c, 0xec, 0x87, 0xb0,
+	0xce, 0x6f, 0x69, 0xb3, 0x40, 0xd4, 0x25, 0x55, 0x3d, 0x92, 0x04, 0x29, 0xe4, 0xba, 0x51, 0x50,
+	0x99, 0x13, 0xb0, 0x06, 0xda, 0x9f, 0x97, 0x60, 0x65, 0x86, 0x7f, 0x41, 0x0e, 0x98, 0x5c, 0x56,
+	0xa5, 0x39, 0xe5, 0xd5, 0x3b, 0xd0, 0x94, 0x6f, 0x61, 0xe5, 0x94, 0x2c, 0x9f, 0x66, 0xaa, 0x2c,
+	0x13, 0x93, 0xba, 0xcf, 0x2b, 0x45, 0xf7, 0xf9, 0x62, 0xf1, 0x7d, 0xfe, 0x8e, 0x2e, 0xd6, 0xaa,
+	0xfc, 0x6d, 0x37, 0xa4, 0xa5, 0x99, 0x7b, 0xcb, 0x14, 0x6d, 0xb7, 0xcd, 0xd2, 0xa1, 0x28, 0x05,
+	0xd0, 0x08, 0xfb, 0xd7, 0x16, 0x34, 0x99, 0xba, 0x0e, 0x9c, 0xe9, 0x88, 0x04, 0x97, 0xad, 0x34,
+	0xb7, 0xa1, 0x39, 0x76, 0xa6, 0xc4, 0xdd, 0x1d, 0x69, 0xab, 0xc8, 0x42, 0x4d, 0x00, 0x13, 0x6a,
+	0x2c, 0x5e, 0x70, 0xf8, 0xb8, 0x5b, 0x2e, 0x10, 0x4a, 0x23, 0x58, 0xf4, 0x69, 0x8b, 0x9c, 0x40,
+	0xfb, 0xde, 0x9b, 0x50, 0x7f, 0x70, 0x61, 0x6e, 0xa0, 0x10, 0x5f, 0x69, 0xf4, 0x79, 0x04, 0x2d,
+	0x2d, 0x8b, 0xb8, 0xbd, 0x6a, 0xcf, 0xc4, 0x38, 0xed, 0x39, 0x3a, 0x8f, 0xc1, 0x8a, 0x5f, 0xe0,
+	0x36, 0x7f, 0xb5, 0xa0, 0x69, 0x84, 0xf4, 0x2b, 0x05, 0xb3, 0x1d, 0x68, 0x6a, 0xb7, 0x9c, 0x93,
+	0xf8, 0x98, 0x20, 0x1e, 0x00, 0x29, 0x8d, 0xbc, 0xe3, 0x09, 0x25, 0x72, 0xe7, 0x09, 0x81, 0xdf,
+	0x07, 0x39, 0xed, 0xa4, 0x34, 0x91, 0xed, 0x44, 0xd4, 0x62, 0x22, 0x9b, 0x17, 0x03, 0x7b, 0x07,
+	0x5a, 0x66, 0x39, 0xc6, 0x6a, 0xb8, 0x91, 0xf3, 0x5c, 0xd5, 0x70, 0x23, 0xe7, 0x39, 0xa7, 0x78,
+	0x81, 0xdc, 0x3f, 0x7b, 0xb4, 0x7f, 0x08, 0x0d, 0x5d, 0xa9, 0xa3, 0x9b, 0xc9, 0x84, 0xac, 0xfd,
+	0xf0, 0xe9, 0x37, 0x93, 0xe9, 0xb3, 0x7c, 0x2f, 0xb0, 0x8f, 0x60, 0x39, 0x53, 0x10, 0xa3, 0x5b,
+	0xe6, 0x92, 0x33, 0x46, 0xc6, 0x57, 0xbd, 0x65, 0xae, 0x9a, 0x03, 0xf1, 0x02, 0xfb, 0x43, 0x68,
+	0xe8, 0x70, 0x9e, 0x6c, 0x5e, 0x6c, 0x4c, 0x0c, 0xd0, 0xff, 0x42, 0x3d, 0x1c, 0x93, 0x88, 0x29,
+	0x59, 0x66, 0x7d, 0x4d, 0x7d, 0x0f, 0x3c, 0x1a, 0x63, 0xcd, 0xb4, 0xcf, 0x8c, 0xeb, 0x4b, 0xa4,
+	0x63, 0x57, 0x39, 0xf1, 0xdb, 0x50, 0x0d, 0x79, 0xc0, 0x97, 0x2f, 0x59, 0xcb, 0x24, 0x7c, 0xf2,
+	0x36, 0x90, 0x20, 0xfb, 0x5f, 0x16, 0x34, 0x3f, 0x70, 0x3c, 0x9f, 0xb8, 0xf7, 0xce, 0x99, 0x3f,
+	0x67, 0x6b, 0x9e, 0x0d, 0x68, 0x1e, 0xfb, 0xe1, 0xf0, 0xec, 0xe1, 0x64, 0x74, 0x2c, 0xbf, 0x2f,
+	0x54, 0xb0, 0x49, 0x62, 0xc9, 0x0e, 0x39, 0x4f, 0x3c, 0xb5, 0x82, 0xd5, 0x90, 0xa5, 0x46, 0xbc,
+	0xbf, 0x24, 0xf3, 0x52, 0xf6, 0xcc, 0x68, 0xae, 0x43, 0x1d, 0x6e, 0x17, 0x0d, 0xcc, 0x9f, 0x99,
+	0xbe, 0x48, 0x14, 0x85, 0x11, 0x0f, 0x5a, 0x0d, 0x2c, 0x06, 0xac, 0x1d, 0x73, 0xc2, 0x05, 0xdb,
+	0xa5, 0x45, 0x71, 0x49, 0x03, 0x98, 0xab, 0xd2, 0xe7, 0xf7, 0x9d, 0xf8, 0x94, 0x67, 0x38, 0x0d,
+	0x2c, 0x47, 0x2c, 0xa0, 0xfa, 0xe1, 0xd3, 0xfd, 0xc0, 0x25, 0xcf, 0x65, 0x6e, 0xa3, 0xc7, 0x76,
+	0x1f, 0x56, 0x8d, 0x9d, 0xeb, 0xc8, 0xa1, 0x63, 0x81, 0x95, 0x1f, 0x0b, 0x4a, 0x66, 0x2c, 0xb0,
+	0x0f, 0x61, 0x25, 0xbd, 0x88, 0x70, 0xf9, 0x2a, 0xd7, 0x81, 0xf2, 0x78, 0x59, 0x0e, 0x18, 0x40,
+	0x2c, 0x01, 0x05, 0x2e, 0x7f, 0x1b, 0x6e, 0xb0, 0x95, 0x9c, 0x69, 0x9e, 0x80, 0x1d, 0x28, 0x7b,
+	0xae, 0x58, 0xba, 0x82, 0xd9, 0xa3, 0xfd, 0x29, 0xac, 0xe7, 0xc1, 0x99, 0x28, 0x3d, 0x76, 0x27,
+	0x32, 0x16, 0x51, 0xa7, 0xaa, 0xc7, 0x4c, 0x4c, 0xa1, 0xc0, 0x6e, 0xa9, 0x50, 0x4c, 0x01, 0xb0,
+	0xff, 0x62, 0xc1, 0x12, 0x26, 0xf1, 0x34, 0x18, 0x2a, 0x29, 0x5e, 0x83, 0x1a, 0x6f, 0x22, 0x14,
+	0x44, 0x7e, 0xc5, 0xbc, 0xe4, 0x5d, 0xb9, 0x0d, 0x0d, 0x99, 0xc3, 0xcf, 0xa9, 0x93, 0x13, 0x08,
+	0x8b, 0x51, 0x27, 0x51, 0x38, 0xba, 0xcb, 0xec, 0x50, 0x46, 0xa0, 0x84, 0xc0, 0x4c, 0x92, 0x86,
+	0x82, 0x27, 0xaa, 0x62, 0x35, 0xb4, 0x8f, 0xa0, 0xa9, 0xb6, 0xc1, 0xb4, 0x73, 0xdd, 0x68, 0xb7,
+	0x88, 0x53, 0xd5, 0xed, 0x15, 0xd1, 0xe5, 0x97, 0xa7, 0xc2, 0x07, 0x4c, 0x97, 0xba, 0x0e, 0x11,
+	0xa6, 0xae, 0xc7, 0xf6, 0x33, 0x58, 0xde, 0x0d, 0x1c, 0x7f, 0x4a, 0xbd, 0x61, 0x7c, 0x40, 0x22,
+	0x2f, 0x74, 0x59, 0x7b, 0x95, 0x89, 0x54, 0x14, 0x5a, 0x38, 0x13, 0xbd, 0x04, 0x25, 0x1a, 0x16,
+	0x85, 0x96, 0x12, 0x0d, 0x59, 0x7e, 0x7e, 0x3c, 0x19, 0x9e, 0x11, 0xfa, 0xd8, 0xfb, 0x99, 0x0a,
+	0xc7, 0x06, 0xc5, 0xfe, 0x87, 0x05, 0xab, 0x07, 0xec, 0xf2, 0xbf, 0xef, 0xc5, 0x34, 0x8c, 0xa6,
+	0xea, 0x7c, 0x6e, 0x43, 0x75, 0xcc, 0xe5, 0x90, 0xef, 0x97, 0x71, 0x20, 0x23, 0x24, 0xae, 0x8e,
+	0xb5, 0xb0, 0x17, 0xf7, 0x82, 0x59, 0x30, 0x50, 0x6d, 0x38, 0x79, 0x4e, 0x15, 0x6c, 0x92, 0x66,
+	0xeb, 0xdb, 0x4a, 0x71, 0x3f, 0x34, 0x8d, 0x64, 0x8b, 0x8f, 0x49, 0x34, 0x64, 0x24, 0x9f, 0xb7,
+	0x81, 0xca, 0x9b, 0x16, 0x36, 0x49, 0xf6, 0x67, 0x16, 0x00, 0xdf, 0x2a, 0x4b, 0x61, 0x62, 0xae,
+	0xba, 0xb8, 0x48, 0xbb, 0xa5, 0x22, 0xdf, 0x52, 0x57, 0x0c, 0x13, 0xde, 0xe2, 0xc1, 0x5b, 0x5d,
+	0x43, 0x15, 0x49, 0x71, 0x9e, 0x33, 0xbb, 0x18, 0x11, 0xd7, 0x73, 0x44, 0x7d, 0x66, 0x61, 0x39,
+	0xca, 0xca, 0x58, 0x9d, 0x95, 0xf1, 0x7d, 0x58, 0x49, 0x9f, 0x86, 0x2c, 0x3f, 0xc4, 0x89, 0xa9,
+	0x80, 0xd0, 0x51, 0xf5, 0xab, 0xda, 0x0c, 0x56, 0x00, 0xfb, 0xb7, 0x16, 0xac, 0x3e, 0x66, 0x1d,
+	0xa4, 0xe9, 0x80, 0x8c, 0x9c, 0xc0, 0xfd, 0x3a, 0xcf, 0x73, 0xe6, 0xb4, 0xca, 0x97, 0x3d, 0x2d,
+	0xfb, 0xc7, 0x2c, 0x0d, 0x3c, 0xf7, 0x86, 0xa4, 0xef, 0x3b, 0x71, 0xcc, 0x14, 0xb6, 0x77, 0xf0,
+	0xe4, 0x01, 0x19, 0x29, 0x47, 0x12, 0x23, 0xe6, 0x32, 0xfd, 0x83, 0x27, 0xfd, 0x30, 0x22, 0xfa,
+	0x3b, 0x96, 0x1a, 0xa7, 0xbe, 0x71, 0x95, 0xd3, 0xdf, 0xb8, 0xec, 0x7f, 0x5a, 0xb0, 0x62, 0x6a,
+	0xe1, 0x92, 0x27, 0x7e, 0xa9, 0x5d, 0xdf, 0x81, 0xa6, 0x9b, 0x88, 0x2e, 0xa3, 0xcd, 0x8a, 0xaa,
+	0x14, 0x34, 0x03, 0x37, 0xdd, 0xf4, 0x06, 0x45, 0x51, 0x2c, 0xa3, 0x8d, 0x1c, 0xa1, 0xb7, 0xa1,
+	0x25, 0x9e, 0x3e, 0x09, 0xfd, 0xc9, 0x28, 0x3f, 0x39, 0x4f, 0x21, 0xd8, 0x4a, 0xfc, 0x1f, 0x00,
+	0x57, 0x36, 0x57, 0xe4, 0xc8, 0xbe, 0x9b, 0xde, 0xb1, 0xb0, 0x9c, 0xdb, 0xb0, 0x18, 0x53, 0x47,
+	0xdb, 0x8d, 0xac, 0x67, 0x66, 0x34, 0x83, 0x05, 0xca, 0xfe, 0x8d, 0x05, 0x2b, 0xbc, 0xa9, 0x2d,
+	0xa8, 0xdf, 0x4c, 0xd3, 0xf9, 0x93, 0x05, 0x90, 0x08, 0x79, 0xf1, 0xa1, 0x26, 0x0a, 0x2b, 0x99,
+	0x0a, 0x63, 0x51, 0x7e, 0xc4, 0x96, 0x91, 0x2d, 0xba, 0x0a, 0x56, 0x43, 0xde, 0xbe, 0x73, 0x82,
+	0x21, 0xf1, 0xd9, 0xdd, 0x26, 0x6f, 0x07, 0x4d, 0x60, 0x76, 0x77, 0xe2, 0xf9, 0x3e, 0x76, 0x28,
+	0x91, 0xee, 0xad, 0xc7, 0xcc, 0xc1, 0xa9, 0x37, 0x22, 0x87, 0x21, 0x17, 0x50, 0x9e, 0x90, 0x49,
+	0xb2, 0xbf, 0x0f, 0xcb, 0x89, 0xf0, 0xf3, 0xdd, 0xdb, 0xc0, 0x69, 0xf7, 0xfe, 0x39, 0x20, 0xd1,
+	0xf1, 0xfc, 0x4f, 0x4e, 0x28, 0xa7, 0x8b, 0x78, 0x59, 0xe5, 0xff, 0x04, 0x9a, 0xc6, 0xfb, 0x2f,
+	0x15, 0x43, 0x8f, 0x3d, 0x3f, 0xb9, 0x09, 0xf9, 0x80, 0x5d, 0xea, 0x63, 0xbe, 0x4a, 0xb7, 0x9c,
+	0x63, 0xef, 0x92, 0x67, 0x0f, 0xa1, 0x93, 0xda, 0x2b, 0xd3, 0xd5, 0x1b, 0x59, 0x5d, 0xa9, 0x56,
+	0xa9, 0x01, 0x54, 0x08, 0x56, 0xf2, 0xf2, 0x1e, 0x71, 0x6e, 0xea, 0x20, 0x58, 0xf6, 0x1f, 0x58,
+	0x6f, 0x4f, 0x16, 0xa3, 0xd9, 0xe4, 0xf5, 0x72, 0xb9, 0xc7, 0x16, 0xd4, 0x58, 0xed, 0x39, 0xaf,
+	0x43, 0xaf, 0x00, 0x0a, 0x4b, 0xe6, 0x74, 0xe7, 0x15, 0x80, 0xbd, 0xdd, 0x19, 0xe9, 0x1e, 0xcb,
+	0xcc, 0xdb, 0x05, 0x2f, 0x9b, 0x60, 0x57, 0x67, 0x13, 0x6c, 0x71, 0x46, 0xb5, 0xb9, 0x0e, 0x72,
+	0xe5, 0xe4, 0xf7, 0x77, 0xac, 0x87, 0x2d, 0xd4, 0x16, 0x1b, 0xfd, 0x3a, 0xd9, 0xe8, 0x2a, 0x2e,
+	0x33, 0x24, 0xc0, 0x54, 0x5a, 0xe9, 0x0a, 0x4a, 0x2b, 0x5f, 0x42, 0x69, 0xf2, 0xc8, 0x2a, 0x73,
+	0x8e, 0xec, 0x55, 0xae, 0x92, 0xc5, 0x79, 0x1f, 0x3a, 0xa5, 0xf1, 0x5e, 0xa1, 0x8d, 0x9d, 0x2a,
+	0xeb, 0xeb, 0x79, 0x5d, 0x69, 0xad, 0x2f, 0xd5, 0x95, 0x96, 0x84, 0x4c, 0x57, 0x5a, 0x50, 0xb1,
+	0x66, 0xe7, 0x27, 0x22, 0x5b, 0xb7, 0x60, 0x91, 0x97, 0x7e, 0xa8, 0x0a, 0xa5, 0x7b, 0x1f, 0x77,
+	0x16, 0x50, 0x0d, 0xca, 0x7b, 0x87, 0xf7, 0x3a, 0x16, 0x7b, 0xf8, 0xe8, 0xf0, 0x5e, 0xa7, 0xb4,
+	0x75, 0x0b, 0x5a, 0xe6, 0x4f, 0x5c, 0x8c, 0xb1, 0x1b, 0x0f, 0x3b, 0x0b, 0xa8, 0x0e, 0x95, 0x01,
+	0x89, 0x87, 0x1d, 0x6b, 0xeb, 0x5d, 0x68, 0x1a, 0x9f, 0x19, 0x50, 0x13, 0x6a, 0xbb, 0xc1, 0x94,
+	0x3d, 0x76, 0x16, 0x50, 0x0b, 0xea, 0x8f, 0xe5, 0xc7, 0xa8, 0x8e, 0xc5, 0x46, 0x7d, 0xf9, 0xa1,
+	0xa9, 0x53, 0xda, 0xfa, 0x08, 0x96, 0x33, 0x35, 0x21, 0x5a, 0x85, 0xe5, 0x23, 0x8f, 0x9e, 0x86,
+	0x13, 0xaa, 0x3e, 0x8c, 0x76, 0x16, 0x10, 0x82, 0xf6, 0x7e, 0x30, 0xf4, 0x27, 0x2e, 0xd9, 0x0d,
+	0xdc, 0x07, 0x4e, 0x74, 0xd6, 0xb1, 0x50, 0x07, 0x5a, 0x8f, 0x02, 0x7f, 0xaa, 0x51, 0xa5, 0x9d,
+	0x3f, 0xd7, 0xa1, 0x3c, 0x38, 0xba, 0x8f, 0xfe, 0x1f, 0xea, 0x7b, 0x84, 0xf2, 0xff, 0x65, 0x10,
+	0x9a, 0xfd, 0x77, 0xa8, 0xb7, 0x9a, 0xfa, 0x5f, 0x46, 0x68, 0xd2, 0x5e, 0x40, 0x6f, 0x41, 0x5b,
+	0x4e, 0x1b, 0x10, 0xea, 0x78, 0x7e, 0x8c, 0x52, 0xe7, 0xde, 0x4b, 0xff, 0x66, 0x63, 0x2f, 0xa0,
+	0x07, 0xb0, 0x22, 0x27, 0x24, 0xff, 0x55, 0xa0, 0xff, 0xc9, 0xf9, 0x7d, 0x42, 0xbf, 0xf9, 0x46,
+	0x3e, 0x53, 0xbc, 0xff, 0x3d, 0x68, 0xec, 0x11, 0xfa, 0x48, 0x24, 0xff, 0xab, 0x39, 0x5f, 0xdf,
+	0x7b, 0xd7, 0xd2, 0x1f, 0x62, 0xf5, 0xcc, 0xfb, 0x5c, 0x90, 0xf4, 0x87, 0x64, 0x25, 0x48, 0xee,
+	0xe7, 0xe5, 0xc2, 0x95, 0xde, 0x81, 0x65, 0x25, 0x43, 0xbe, 0x12, 0x32, 0xdf, 0x82, 0xed, 0x05,
+	0xf4, 0x5d, 0x68, 0xee, 0x11, 0x7a, 0xa0, 0x3e, 0x7e, 0xac, 0xa5, 0xbe, 0x8a, 0x64, 0x75, 0x9e,
+	0xfa, 0xa6, 0x62, 0x2f, 0xa0, 0x6d, 0xae, 0x73, 0x49, 0xdd, 0x0f, 0x4e, 0x42, 0xd4, 0xd4, 0x6e,
+	0xb9, 0x3f, 0xe8, 0xa5, 0x3f, 0xb1, 0xd8, 0x0b, 0xe8, 0x07, 0xd0, 0xda, 0x23, 0x54, 0xdb, 0x0c,
+	0xba, 0x9e, 0x69, 0x2c, 0x64, 0xf6, 0x97, 0xee, 0xc0, 0xdb, 0x0b, 0x68, 0x17, 0x96, 0xf6, 0x08,
+	0x4d, 0x5a, 0xcb, 0x68, 0x3d, 0xd3, 0x41, 0xd6, 0x02, 0xaf, 0xcd, 0x32, 0xc4, 0x12, 0x1f, 0xc0,
+	0x9a, 0x3a, 0xf5, 0x54, 0xfb, 0x37, 0xa3, 0xa8, 0x17, 0x0b, 0xba, 0xbe, 0xc6, 0x71, 0xc3, 0x1e,
+	0xa1, 0x47, 0xaa, 0xeb, 0x26, 0xe0, 0xe9, 0x06, 0x62, 0x0f, 0x65, 0xa8, 0x62, 0xe6, 0x1e, 0x3f,
+	0x24, 0x33, 0xc3, 0x47, 0x37, 0x8c, 0x5c, 0x3e, 0x5d, 0x83, 0xf5, 0xd6, 0xf3, 0x58, 0xe6, 0x42,
+	0x66, 0x22, 0xa7, 0x16, 0xca, 0x49, 0xfe, 0x7b, 0xeb, 0x79, 0x2c, 0x53, 0xad, 0x46, 0x3e, 0xb5,
+	0x3e, 0x93, 0x7c, 0xa4, 0xd5, 0x9a, 0xc9, 0x5e, 0xec, 0x05, 0x34, 0x10, 0x96, 0x60, 0xa4, 0x05,
+	0xdd, 0xd9, 0x4b, 0x59, 0x2e, 0x72, 0x3d, 0x87, 0x23, 0x56, 0x91, 0xc6, 0xa8, 0x62, 0xde, 0x5a,
+	0x2a, 0x18, 0xce, 0x18, 0xa3, 0x19, 0x4a, 0xed, 0x85, 0x9d, 0x2f, 0x2d, 0xfe, 0xe7, 0xc2, 0xae,
+	0xcb, 0xea, 0xb1, 0x01, 0xb4, 0xcc, 0x46, 0x86, 0x52, 0x4c, 0x4e, 0x2f, 0xa4, 0xb7, 0x9e, 0xc7,
+	0x12, 0xf2, 0x7c, 0x02, 0x68, 0xb6, 0x29, 0x82, 0x5e, 0x12, 0x13, 0x0a, 0xbb, 0x2b, 0xbd, 0x17,
+	0x8b, 0x01, 0x62, 0xdd, 0x1d, 0xa8, 0x8a, 0x16, 0x82, 0x0a, 0x14, 0xa9, 0xbe, 0x48, 0x6f, 0x25,
+	0x4d, 0xe4, 0x73, 0x8e, 0xab, 0xfc, 0x6f, 0xde, 0x3b, 0xff, 0x1e, 0x00, 0x5a, 0x72, 0x9a, 0x0b,
+	0x23, 0x2c, 0x00, 0x00,
 }
@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: dwh_changes.proto
+
+package sonm
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// grpccmd imports
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/sshaman1101/grpccmd"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type DWHOrderEvent_Type int32
+
+const (
+	DWHOrderEvent_PLACED  DWHOrderEvent_Type = 0
+	DWHOrderEvent_UPDATED DWHOrderEvent_Type = 1
+	// REVERTED means that the order is restored to the state it had
+	// before the chain reorganization.
+	DWHOrderEvent_REVERTED DWHOrderEvent_Type = 2
+)
+
+var DWHOrderEvent_Type_name = map[int32]string{
+	0: "PLACED",
+	1: "UPDATED",
+	2: "REVERTED",
+}
+var DWHOrderEvent_Type_value = map[string]int32{
+	"PLACED":   0,
+	"UPDATED":  1,
+	"REVERTED": 2,
+}
+
+func (x DWHOrderEvent_Type) String() string {
+	return proto.EnumName(DWHOrderEvent_Type_name, int32(x))
+}
+func (DWHOrderEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{1, 0} }
+
+type DWHDealEvent_Type int32
+
+const (
+	DWHDealEvent_OPENED                 DWHDealEvent_Type = 0
+	DWHDealEvent_UPDATED                DWHDealEvent_Type = 1
+	DWHDealEvent_BILLED                 DWHDealEvent_Type = 2
+	DWHDealEvent_CHANGE_REQUEST_SENT    DWHDealEvent_Type = 3
+	DWHDealEvent_CHANGE_REQUEST_UPDATED DWHDealEvent_Type = 4
+	// REVERTED means that the deal is restored to the state it had
+	// before the chain reorganization.
+	DWHDealEvent_REVERTED DWHDealEvent_Type = 5
+)
+
+var DWHDealEvent_Type_name = map[int32]string{
+	0: "OPENED",
+	1: "UPDATED",
+	2: "BILLED",
+	3: "CHANGE_REQUEST_SENT",
+	4: "CHANGE_REQUEST_UPDATED",
+	5: "REVERTED",
+}
+var DWHDealEvent_Type_value = map[string]int32{
+	"OPENED":                 0,
+	"UPDATED":                1,
+	"BILLED":                 2,
+	"CHANGE_REQUEST_SENT":    3,
+	"CHANGE_REQUEST_UPDATED": 4,
+	"REVERTED":               5,
+}
+
+func (x DWHDealEvent_Type) String() string {
+	return proto.EnumName(DWHDealEvent_Type_name, int32(x))
+}
+func (DWHDealEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{3, 0} }
+
+type OrdersSubscribeRequest struct {
+	// Filter specifies orders to watch. Its status, limit, offset, sortings
+	// and withCount fields are ignored.
+	Filter *OrdersRequest `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	// FromBlock allows to resume the subscription. If specified, changes
+	// made in this and later blocks are sent first. Clients should pass the
+	// block of the last received event and skip the events already seen by
+	// their IDs.
+	FromBlock uint64 `protobuf:"varint,2,opt,name=fromBlock" json:"fromBlock,omitempty"`
+}
+
+func (m *OrdersSubscribeRequest) Reset()                    { *m = OrdersSubscribeRequest{} }
+func (m *OrdersSubscribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*OrdersSubscribeRequest) ProtoMessage()               {}
+func (*OrdersSubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+
+func (m *OrdersSubscribeRequest) GetFilter() *OrdersRequest {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *OrdersSubscribeRequest) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+type DWHOrderEvent struct {
+	// ID increases monotonically with each stored change.
+	Id          uint64             `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	BlockNumber uint64             `protobuf:"varint,2,opt,name=blockNumber" json:"blockNumber,omitempty"`
+	Type        DWHOrderEvent_Type `protobuf:"varint,3,opt,name=type,enum=sonm.DWHOrderEvent_Type" json:"type,omitempty"`
+	OrderID     *BigInt            `protobuf:"bytes,4,opt,name=orderID" json:"orderID,omitempty"`
+	// Order is the state of the order after the change. It is empty if the
+	// order is removed by a revert. Orders removed from the DWH are sent in
+	// their last state with the inactive status.
+	Order *DWHOrder `protobuf:"bytes,5,opt,name=order" json:"order,omitempty"`
+}
+
+func (m *DWHOrderEvent) Reset()                    { *m = DWHOrderEvent{} }
+func (m *DWHOrderEvent) String() string            { return proto.CompactTextString(m) }
+func (*DWHOrderEvent) ProtoMessage()               {}
+func (*DWHOrderEvent) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
+
+func (m *DWHOrderEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DWHOrderEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *DWHOrderEvent) GetType() DWHOrderEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return DWHOrderEvent_PLACED
+}
+
+func (m *DWHOrderEvent) GetOrderID() *BigInt {
+	if m != nil {
+		return m.OrderID
+	}
+	return nil
+}
+
+func (m *DWHOrderEvent) GetOrder() *DWHOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+type DealsSubscribeRequest struct {
+	// Filter specifies deals to watch. Its limit, offset, sortings and
+	// withCount fields are ignored.
+	Filter *DealsRequest `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	// FromBlock has the same meaning as in OrdersSubscribeRequest.
+	FromBlock uint64 `protobuf:"varint,2,opt,name=fromBlock" json:"fromBlock,omitempty"`
+}
+
+func (m *DealsSubscribeRequest) Reset()                    { *m = DealsSubscribeRequest{} }
+func (m *DealsSubscribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*DealsSubscribeRequest) ProtoMessage()               {}
+func (*DealsSubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+
+func (m *DealsSubscribeRequest) GetFilter() *DealsRequest {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *DealsSubscribeRequest) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+type DWHDealEvent struct {
+	// ID increases monotonically with each stored change.
+	Id          uint64            `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	BlockNumber uint64            `protobuf:"varint,2,opt,name=blockNumber" json:"blockNumber,omitempty"`
+	Type        DWHDealEvent_Type `protobuf:"varint,3,opt,name=type,enum=sonm.DWHDealEvent_Type" json:"type,omitempty"`
+	DealID      *BigInt           `protobuf:"bytes,4,opt,name=dealID" json:"dealID,omitempty"`
+	// Deal is the state of the deal after the change. It is empty if the deal
+	// is removed by a revert. Deals removed from the DWH are sent in their
+	// last state with the closed status.
+	Deal *DWHDeal `protobuf:"bytes,5,opt,name=deal" json:"deal,omitempty"`
+	// ChangeRequest is set for change request events only.
+	ChangeRequest *DealChangeRequest `protobuf:"bytes,6,opt,name=changeRequest" json:"changeRequest,omitempty"`
+}
+
+func (m *DWHDealEvent) Reset()                    { *m = DWHDealEvent{} }
+func (m *DWHDealEvent) String() string            { return proto.CompactTextString(m) }
+func (*DWHDealEvent) ProtoMessage()               {}
+func (*DWHDealEvent) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+
+func (m *DWHDealEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DWHDealEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *DWHDealEvent) GetType() DWHDealEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return DWHDealEvent_OPENED
+}
+
+func (m *DWHDealEvent) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *DWHDealEvent) GetDeal() *DWHDeal {
+	if m != nil {
+		return m.Deal
+	}
+	return nil
+}
+
+func (m *DWHDealEvent) GetChangeRequest() *DealChangeRequest {
+	if m != nil {
+		return m.ChangeRequest
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OrdersSubscribeRequest)(nil), "sonm.OrdersSubscribeRequest")
+	proto.RegisterType((*DWHOrderEvent)(nil), "sonm.DWHOrderEvent")
+	proto.RegisterType((*DealsSubscribeRequest)(nil), "sonm.DealsSubscribeRequest")
+	proto.RegisterType((*DWHDealEvent)(nil), "sonm.DWHDealEvent")
+	proto.RegisterEnum("sonm.DWHOrderEvent_Type", DWHOrderEvent_Type_name, DWHOrderEvent_Type_value)
+	proto.RegisterEnum("sonm.DWHDealEvent_Type", DWHDealEvent_Type_name, DWHDealEvent_Type_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DWHChanges service
+
+type DWHChangesClient interface {
+	// SubscribeOrders streams changes of orders matching the filter.
+	SubscribeOrders(ctx context.Context, in *OrdersSubscribeRequest, opts ...grpc.CallOption) (DWHChanges_SubscribeOrdersClient, error)
+	// SubscribeDeals streams changes of deals matching the filter.
+	SubscribeDeals(ctx context.Context, in *DealsSubscribeRequest, opts ...grpc.CallOption) (DWHChanges_SubscribeDealsClient, error)
+}
+
+type dWHChangesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDWHChangesClient(cc *grpc.ClientConn) DWHChangesClient {
+	return &dWHChangesClient{cc}
+}
+
+func (c *dWHChangesClient) SubscribeOrders(ctx context.Context, in *OrdersSubscribeRequest, opts ...grpc.CallOption) (DWHChanges_SubscribeOrdersClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DWHChanges_serviceDesc.Streams[0], c.cc, "/sonm.DWHChanges/SubscribeOrders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dWHChangesSubscribeOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DWHChanges_SubscribeOrdersClient interface {
+	Recv() (*DWHOrderEvent, error)
+	grpc.ClientStream
+}
+
+type dWHChangesSubscribeOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *dWHChangesSubscribeOrdersClient) Recv() (*DWHOrderEvent, error) {
+	m := new(DWHOrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dWHChangesClient) SubscribeDeals(ctx context.Context, in *DealsSubscribeRequest, opts ...grpc.CallOption) (DWHChanges_SubscribeDealsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DWHChanges_serviceDesc.Streams[1], c.cc, "/sonm.DWHChanges/SubscribeDeals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dWHChangesSubscribeDealsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DWHChanges_SubscribeDealsClient interface {
+	Recv() (*DWHDealEvent, error)
+	grpc.ClientStream
+}
+
+type dWHChangesSubscribeDealsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dWHChangesSubscribeDealsClient) Recv() (*DWHDealEvent, error) {
+	m := new(DWHDealEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for DWHChanges service
+
+type DWHChangesServer interface {
+	// SubscribeOrders streams changes of orders matching the filter.
+	SubscribeOrders(*OrdersSubscribeRequest, DWHChanges_SubscribeOrdersServer) error
+	// SubscribeDeals streams changes of deals matching the filter.
+	SubscribeDeals(*DealsSubscribeRequest, DWHChanges_SubscribeDealsServer) error
+}
+
+func RegisterDWHChangesServer(s *grpc.Server, srv DWHChangesServer) {
+	s.RegisterService(&_DWHChanges_serviceDesc, srv)
+}
+
+func _DWHChanges_SubscribeOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OrdersSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DWHChangesServer).SubscribeOrders(m, &dWHChangesSubscribeOrdersServer{stream})
+}
+
+type DWHChanges_SubscribeOrdersServer interface {
+	Send(*DWHOrderEvent) error
+	grpc.ServerStream
+}
+
+type dWHChangesSubscribeOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *dWHChangesSubscribeOrdersServer) Send(m *DWHOrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DWHChanges_SubscribeDeals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DealsSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DWHChangesServer).SubscribeDeals(m, &dWHChangesSubscribeDealsServer{stream})
+}
+
+type DWHChanges_SubscribeDealsServer interface {
+	Send(*DWHDealEvent) error
+	grpc.ServerStream
+}
+
+type dWHChangesSubscribeDealsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dWHChangesSubscribeDealsServer) Send(m *DWHDealEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _DWHChanges_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sonm.DWHChanges",
+	HandlerType: (*DWHChangesServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeOrders",
+			Handler:       _DWHChanges_SubscribeOrders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeDeals",
+			Handler:       _DWHChanges_SubscribeDeals_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dwh_changes.proto",
+}
+
+// Begin grpccmd
+var _ = grpccmd.RunE
+
+// DWHChanges
+var _DWHChangesCmd = &cobra.Command{
+	Use:   "dWHChanges [method]",
+	Short: "Subcommand for the DWHChanges service.",
+}
+
+var _DWHChanges_SubscribeOrdersCmd = &cobra.Command{
+	Use:   "subscribeOrders",
+	Short: "Make the SubscribeOrders method call, input-type: sonm.OrdersSubscribeRequest output-type: sonm.DWHOrderEvent",
+	RunE: grpccmd.RunE(
+		"SubscribeOrders",
+		"sonm.OrdersSubscribeRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHChangesClient(cc)
+		},
+	),
+}
+
+var _DWHChanges_SubscribeOrdersCmd_gen = &cobra.Command{
+	Use:   "subscribeOrders-gen",
+	Short: "Generate JSON for method call of SubscribeOrders (input-type: sonm.OrdersSubscribeRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.OrdersSubscribeRequest"),
+}
+
+var _DWHChanges_SubscribeDealsCmd = &cobra.Command{
+	Use:   "subscribeDeals",
+	Short: "Make the SubscribeDeals method call, input-type: sonm.DealsSubscribeRequest output-type: sonm.DWHDealEvent",
+	RunE: grpccmd.RunE(
+		"SubscribeDeals",
+		"sonm.DealsSubscribeRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHChangesClient(cc)
+		},
+	),
+}
+
+var _DWHChanges_SubscribeDealsCmd_gen = &cobra.Command{
+	Use:   "subscribeDeals-gen",
+	Short: "Generate JSON for method call of SubscribeDeals (input-type: sonm.DealsSubscribeRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.DealsSubscribeRequest"),
+}
+
+// Register commands with the root command and service command
+func init() {
+	grpccmd.RegisterServiceCmd(_DWHChangesCmd)
+	_DWHChangesCmd.AddCommand(
+		_DWHChanges_SubscribeOrdersCmd,
+		_DWHChanges_SubscribeOrdersCmd_gen,
+		_DWHChanges_SubscribeDealsCmd,
+		_DWHChanges_SubscribeDealsCmd_gen,
+	)
+}
+
+// End grpccmd
+
+func init() { proto.RegisterFile("dwh_changes.proto", fileDescriptor6) }
+
+var fileDescriptor6 = []byte{
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0x8d, 0x89, 0x71, 0xbe, 0x5c, 0x7e, 0x3e, 0x72, 0x51, 0x83, 0x45, 0xb3, 0xa0, 0x56, 0x54,
+	0xa1, 0xa6, 0x45, 0x15, 0x5d, 0x77, 0x01, 0x78, 0x54, 0x23, 0x21, 0x42, 0x07, 0x52, 0x96, 0xc8,
+	0x3f, 0x13, 0xb0, 0x62, 0x6c, 0x6a, 0x9b, 0x46, 0x79, 0x98, 0x3e, 0x5e, 0x77, 0x7d, 0x88, 0x6a,
+	0xc6, 0x0e, 0xd8, 0xd0, 0x2e, 0xda, 0xdd, 0xf8, 0x9e, 0x33, 0xc7, 0x77, 0xce, 0xb9, 0x17, 0x2e,
+	0x9c, 0xc7, 0xd5, 0xc2, 0x5e, 0x99, 0xfe, 0x92, 0x45, 0x9d, 0x4d, 0x18, 0xc4, 0x01, 0xca, 0x51,
+	0xe0, 0xaf, 0x9b, 0x65, 0xcb, 0x5d, 0xba, 0x7e, 0x9c, 0xd4, 0x9a, 0xe7, 0xce, 0xe3, 0x2a, 0x3d,
+	0x5e, 0xac, 0xcd, 0xf0, 0x81, 0xc5, 0x1b, 0xcf, 0xb4, 0x59, 0x52, 0xd2, 0x6c, 0xb8, 0xbc, 0x0d,
+	0x1d, 0x16, 0x46, 0xd3, 0xad, 0x15, 0xd9, 0xa1, 0x6b, 0x31, 0xca, 0xbe, 0x6e, 0x59, 0x14, 0xe3,
+	0x0d, 0x28, 0xf7, 0xae, 0x17, 0xb3, 0x50, 0x95, 0x5a, 0x52, 0xbb, 0xd4, 0xad, 0x77, 0xb8, 0x78,
+	0x27, 0x61, 0xa7, 0x24, 0x9a, 0x52, 0xf0, 0x0a, 0xce, 0xef, 0xc3, 0x60, 0xdd, 0xf7, 0x02, 0xfb,
+	0x41, 0x2d, 0xb4, 0xa4, 0xb6, 0x4c, 0xf7, 0x05, 0xed, 0xa7, 0x04, 0x15, 0x7d, 0x6e, 0x88, 0xab,
+	0xe4, 0x1b, 0xf3, 0x63, 0xac, 0x42, 0xc1, 0x75, 0x84, 0xb0, 0x4c, 0x0b, 0xae, 0x83, 0x2d, 0x28,
+	0x59, 0x9c, 0x3a, 0xde, 0xae, 0x2d, 0x16, 0xa6, 0x0a, 0xd9, 0x12, 0xbe, 0x05, 0x39, 0x7e, 0xda,
+	0x30, 0xf5, 0xb4, 0x25, 0xb5, 0xab, 0x5d, 0x35, 0x69, 0x26, 0x27, 0xda, 0x99, 0x3d, 0x6d, 0x18,
+	0x15, 0x2c, 0x7c, 0x0d, 0x67, 0x01, 0x07, 0x86, 0xba, 0x2a, 0x8b, 0xee, 0xcb, 0xc9, 0x85, 0xbe,
+	0xbb, 0x1c, 0xfa, 0x31, 0x7d, 0x06, 0xf1, 0x1a, 0x8a, 0xe2, 0xa8, 0x16, 0x05, 0xab, 0x9a, 0x97,
+	0xa5, 0x09, 0xa8, 0xbd, 0x03, 0x99, 0x6b, 0x23, 0x80, 0x32, 0x19, 0xf5, 0x06, 0x44, 0xaf, 0x9d,
+	0x60, 0x09, 0xce, 0xee, 0x26, 0x7a, 0x6f, 0x46, 0xf4, 0x9a, 0x84, 0x65, 0xf8, 0x8f, 0x92, 0x2f,
+	0x84, 0xf2, 0xaf, 0x82, 0x66, 0xc2, 0x0b, 0x9d, 0x99, 0xde, 0xb1, 0xa5, 0x6f, 0x0e, 0x2c, 0xc5,
+	0xf4, 0x77, 0x9c, 0xfc, 0x77, 0x8e, 0xfe, 0x28, 0x40, 0x59, 0x9f, 0x1b, 0xfc, 0xe6, 0xbf, 0x1a,
+	0x7a, 0x93, 0x33, 0xb4, 0xb1, 0x7b, 0xf9, 0x4e, 0x33, 0xeb, 0xe7, 0x35, 0x28, 0x0e, 0x33, 0xbd,
+	0x3f, 0xd8, 0x99, 0x62, 0xf8, 0x0a, 0x64, 0x7e, 0x4a, 0xcd, 0xac, 0xe4, 0x24, 0xa9, 0x80, 0xf0,
+	0x23, 0x54, 0x92, 0x91, 0x4d, 0xdf, 0xab, 0x2a, 0x82, 0xdb, 0xd8, 0x3b, 0x31, 0xc8, 0xc2, 0x34,
+	0xcf, 0xd6, 0xfc, 0x7d, 0x12, 0xb7, 0x13, 0x32, 0x3e, 0x4e, 0x02, 0x40, 0xe9, 0x0f, 0x47, 0x23,
+	0x9e, 0x03, 0x36, 0xa0, 0x3e, 0x30, 0x7a, 0xe3, 0x4f, 0x64, 0x41, 0xc9, 0xe7, 0x3b, 0x32, 0x9d,
+	0x2d, 0xa6, 0x64, 0x3c, 0xab, 0x9d, 0x62, 0x13, 0x2e, 0x0f, 0x80, 0x67, 0x01, 0x39, 0x17, 0x65,
+	0xb1, 0xfb, 0x5d, 0x02, 0xd0, 0xe7, 0x46, 0xd2, 0x53, 0x84, 0x06, 0xfc, 0xbf, 0x0b, 0x35, 0x59,
+	0x04, 0xbc, 0xca, 0xae, 0xc5, 0x61, 0xe2, 0xcd, 0xfa, 0x6f, 0xe6, 0x54, 0x3b, 0x79, 0x2f, 0x21,
+	0x81, 0xea, 0x8e, 0x2c, 0xf2, 0xc7, 0x97, 0x99, 0x61, 0x38, 0xd2, 0xc1, 0xe3, 0x78, 0xb8, 0x8c,
+	0xa5, 0x88, 0x2d, 0xfe, 0xf0, 0x6b, 0x00, 0x1d, 0x91, 0x13, 0xec, 0x0c, 0x04, 0x00, 0x00,
+}
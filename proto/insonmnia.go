@@ -199,3 +199,50 @@ func (m *StartTaskRequest) Validate() error {
 func (m *TaskSpec) Validate() error {
 	return m.GetContainer().Validate()
 }
+
+func (m *StartTaskGroupRequest) Validate() error {
+	if m.GetDealID().IsZero() {
+		return errors.New("non-zero deal id is required for start task group request")
+	}
+	return m.GetSpec().Validate()
+}
+
+func (m *TaskGroupSpec) Validate() error {
+	if len(m.GetTasks()) == 0 {
+		return errors.New("task group must contain at least one task")
+	}
+
+	volumes := map[string]struct{}{}
+	for _, name := range m.GetVolumes() {
+		if !volumeNameRe.MatchString(name) {
+			return fmt.Errorf("invalid task group volume name: %s", name)
+		}
+		if _, ok := volumes[name]; ok {
+			return fmt.Errorf("duplicate task group volume: %s", name)
+		}
+		volumes[name] = struct{}{}
+	}
+
+	for id, task := range m.GetTasks() {
+		if err := task.Validate(); err != nil {
+			return fmt.Errorf("task #%d: %s", id, err)
+		}
+
+		for name := range task.GetContainer().GetVolumes() {
+			if _, ok := volumes[name]; ok {
+				return fmt.Errorf("task #%d: volume %s conflicts with the task group volume", id, name)
+			}
+		}
+
+		// Sidecars share the network namespace of the main container, so
+		// they can't have their own network settings.
+		if id > 0 {
+			container := task.GetContainer()
+			if len(container.GetExpose()) > 0 || len(container.GetNetworks()) > 0 || container.GetHostname() != "" {
+				return fmt.Errorf("task #%d: only the first task in the group can specify exposed ports, networks and hostname", id)
+			}
+		}
+	}
+
+	return nil
+}
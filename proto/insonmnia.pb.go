@@ -34,7 +34,28 @@ var TaskLogsRequest_Type_value = map[string]int32{
 func (x TaskLogsRequest_Type) String() string {
 	return proto.EnumName(TaskLogsRequest_Type_name, int32(x))
 }
-func (TaskLogsRequest_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{9, 0} }
+func (TaskLogsRequest_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor7, []int{11, 0} }
+
+type TaskLogLine_Stream int32
+
+const (
+	TaskLogLine_STDOUT TaskLogLine_Stream = 0
+	TaskLogLine_STDERR TaskLogLine_Stream = 1
+)
+
+var TaskLogLine_Stream_name = map[int32]string{
+	0: "STDOUT",
+	1: "STDERR",
+}
+var TaskLogLine_Stream_value = map[string]int32{
+	"STDOUT": 0,
+	"STDERR": 1,
+}
+
+func (x TaskLogLine_Stream) String() string {
+	return proto.EnumName(TaskLogLine_Stream_name, int32(x))
+}
+func (TaskLogLine_Stream) EnumDescriptor() ([]byte, []int) { return fileDescriptor7, []int{12, 0} }
 
 type Empty struct {
 }
@@ -42,7 +63,7 @@ type Empty struct {
 func (m *Empty) Reset()                    { *m = Empty{} }
 func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
 
 type ID struct {
 	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -51,7 +72,7 @@ type ID struct {
 func (m *ID) Reset()                    { *m = ID{} }
 func (m *ID) String() string            { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()               {}
-func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
+func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
 
 func (m *ID) GetId() string {
 	if m != nil {
@@ -67,7 +88,7 @@ type EthID struct {
 func (m *EthID) Reset()                    { *m = EthID{} }
 func (m *EthID) String() string            { return proto.CompactTextString(m) }
 func (*EthID) ProtoMessage()               {}
-func (*EthID) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+func (*EthID) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
 
 func (m *EthID) GetId() *EthAddress {
 	if m != nil {
@@ -85,7 +106,7 @@ type TaskID struct {
 func (m *TaskID) Reset()                    { *m = TaskID{} }
 func (m *TaskID) String() string            { return proto.CompactTextString(m) }
 func (*TaskID) ProtoMessage()               {}
-func (*TaskID) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+func (*TaskID) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
 
 func (m *TaskID) GetId() string {
 	if m != nil {
@@ -108,7 +129,7 @@ type Count struct {
 func (m *Count) Reset()                    { *m = Count{} }
 func (m *Count) String() string            { return proto.CompactTextString(m) }
 func (*Count) ProtoMessage()               {}
-func (*Count) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+func (*Count) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
 
 func (m *Count) GetCount() uint64 {
 	if m != nil {
@@ -118,13 +139,26 @@ func (m *Count) GetCount() uint64 {
 }
 
 type CPUUsage struct {
+	// Total CPU time consumed in nanoseconds.
 	Total uint64 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	// CPU time consumed per core in nanoseconds.
+	PerCore []uint64 `protobuf:"varint,2,rep,packed,name=perCore" json:"perCore,omitempty"`
+	// Time spent in kernel mode in nanoseconds.
+	KernelMode uint64 `protobuf:"varint,3,opt,name=kernelMode" json:"kernelMode,omitempty"`
+	// Time spent in user mode in nanoseconds.
+	UserMode uint64 `protobuf:"varint,4,opt,name=userMode" json:"userMode,omitempty"`
+	// Number of periods with throttling active.
+	ThrottledPeriods uint64 `protobuf:"varint,5,opt,name=throttledPeriods" json:"throttledPeriods,omitempty"`
+	// Aggregate time the container was throttled for in nanoseconds.
+	ThrottledTime uint64 `protobuf:"varint,6,opt,name=throttledTime" json:"throttledTime,omitempty"`
+	// Number of enforcement periods elapsed.
+	Periods uint64 `protobuf:"varint,7,opt,name=periods" json:"periods,omitempty"`
 }
 
 func (m *CPUUsage) Reset()                    { *m = CPUUsage{} }
 func (m *CPUUsage) String() string            { return proto.CompactTextString(m) }
 func (*CPUUsage) ProtoMessage()               {}
-func (*CPUUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
+func (*CPUUsage) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
 
 func (m *CPUUsage) GetTotal() uint64 {
 	if m != nil {
@@ -133,14 +167,58 @@ func (m *CPUUsage) GetTotal() uint64 {
 	return 0
 }
 
+func (m *CPUUsage) GetPerCore() []uint64 {
+	if m != nil {
+		return m.PerCore
+	}
+	return nil
+}
+
+func (m *CPUUsage) GetKernelMode() uint64 {
+	if m != nil {
+		return m.KernelMode
+	}
+	return 0
+}
+
+func (m *CPUUsage) GetUserMode() uint64 {
+	if m != nil {
+		return m.UserMode
+	}
+	return 0
+}
+
+func (m *CPUUsage) GetThrottledPeriods() uint64 {
+	if m != nil {
+		return m.ThrottledPeriods
+	}
+	return 0
+}
+
+func (m *CPUUsage) GetThrottledTime() uint64 {
+	if m != nil {
+		return m.ThrottledTime
+	}
+	return 0
+}
+
+func (m *CPUUsage) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
 type MemoryUsage struct {
 	MaxUsage uint64 `protobuf:"varint,1,opt,name=maxUsage" json:"maxUsage,omitempty"`
+	Usage    uint64 `protobuf:"varint,2,opt,name=usage" json:"usage,omitempty"`
+	Limit    uint64 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
 }
 
 func (m *MemoryUsage) Reset()                    { *m = MemoryUsage{} }
 func (m *MemoryUsage) String() string            { return proto.CompactTextString(m) }
 func (*MemoryUsage) ProtoMessage()               {}
-func (*MemoryUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{6} }
+func (*MemoryUsage) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
 
 func (m *MemoryUsage) GetMaxUsage() uint64 {
 	if m != nil {
@@ -149,6 +227,93 @@ func (m *MemoryUsage) GetMaxUsage() uint64 {
 	return 0
 }
 
+func (m *MemoryUsage) GetUsage() uint64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
+}
+
+func (m *MemoryUsage) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type BlockIOUsage struct {
+	ReadBytes  uint64 `protobuf:"varint,1,opt,name=readBytes" json:"readBytes,omitempty"`
+	WriteBytes uint64 `protobuf:"varint,2,opt,name=writeBytes" json:"writeBytes,omitempty"`
+	ReadOps    uint64 `protobuf:"varint,3,opt,name=readOps" json:"readOps,omitempty"`
+	WriteOps   uint64 `protobuf:"varint,4,opt,name=writeOps" json:"writeOps,omitempty"`
+}
+
+func (m *BlockIOUsage) Reset()                    { *m = BlockIOUsage{} }
+func (m *BlockIOUsage) String() string            { return proto.CompactTextString(m) }
+func (*BlockIOUsage) ProtoMessage()               {}
+func (*BlockIOUsage) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{7} }
+
+func (m *BlockIOUsage) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *BlockIOUsage) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *BlockIOUsage) GetReadOps() uint64 {
+	if m != nil {
+		return m.ReadOps
+	}
+	return 0
+}
+
+func (m *BlockIOUsage) GetWriteOps() uint64 {
+	if m != nil {
+		return m.WriteOps
+	}
+	return 0
+}
+
+type GPUUsage struct {
+	// Utilization is a GPU utilization in percents.
+	Utilization uint64 `protobuf:"varint,1,opt,name=utilization" json:"utilization,omitempty"`
+	MemoryUsed  uint64 `protobuf:"varint,2,opt,name=memoryUsed" json:"memoryUsed,omitempty"`
+	MemoryTotal uint64 `protobuf:"varint,3,opt,name=memoryTotal" json:"memoryTotal,omitempty"`
+}
+
+func (m *GPUUsage) Reset()                    { *m = GPUUsage{} }
+func (m *GPUUsage) String() string            { return proto.CompactTextString(m) }
+func (*GPUUsage) ProtoMessage()               {}
+func (*GPUUsage) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{8} }
+
+func (m *GPUUsage) GetUtilization() uint64 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+func (m *GPUUsage) GetMemoryUsed() uint64 {
+	if m != nil {
+		return m.MemoryUsed
+	}
+	return 0
+}
+
+func (m *GPUUsage) GetMemoryTotal() uint64 {
+	if m != nil {
+		return m.MemoryTotal
+	}
+	return 0
+}
+
 type NetworkUsage struct {
 	TxBytes   uint64 `protobuf:"varint,1,opt,name=txBytes" json:"txBytes,omitempty"`
 	RxBytes   uint64 `protobuf:"varint,2,opt,name=rxBytes" json:"rxBytes,omitempty"`
@@ -163,7 +328,7 @@ type NetworkUsage struct {
 func (m *NetworkUsage) Reset()                    { *m = NetworkUsage{} }
 func (m *NetworkUsage) String() string            { return proto.CompactTextString(m) }
 func (*NetworkUsage) ProtoMessage()               {}
-func (*NetworkUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{7} }
+func (*NetworkUsage) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{9} }
 
 func (m *NetworkUsage) GetTxBytes() uint64 {
 	if m != nil {
@@ -225,12 +390,15 @@ type ResourceUsage struct {
 	Cpu     *CPUUsage                `protobuf:"bytes,1,opt,name=cpu" json:"cpu,omitempty"`
 	Memory  *MemoryUsage             `protobuf:"bytes,2,opt,name=memory" json:"memory,omitempty"`
 	Network map[string]*NetworkUsage `protobuf:"bytes,3,rep,name=network" json:"network,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BlockIO *BlockIOUsage            `protobuf:"bytes,4,opt,name=blockIO" json:"blockIO,omitempty"`
+	// GPU usage mapped by the device ID.
+	Gpu map[string]*GPUUsage `protobuf:"bytes,5,rep,name=gpu" json:"gpu,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *ResourceUsage) Reset()                    { *m = ResourceUsage{} }
 func (m *ResourceUsage) String() string            { return proto.CompactTextString(m) }
 func (*ResourceUsage) ProtoMessage()               {}
-func (*ResourceUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
+func (*ResourceUsage) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{10} }
 
 func (m *ResourceUsage) GetCpu() *CPUUsage {
 	if m != nil {
@@ -253,6 +421,20 @@ func (m *ResourceUsage) GetNetwork() map[string]*NetworkUsage {
 	return nil
 }
 
+func (m *ResourceUsage) GetBlockIO() *BlockIOUsage {
+	if m != nil {
+		return m.BlockIO
+	}
+	return nil
+}
+
+func (m *ResourceUsage) GetGpu() map[string]*GPUUsage {
+	if m != nil {
+		return m.Gpu
+	}
+	return nil
+}
+
 type TaskLogsRequest struct {
 	Type          TaskLogsRequest_Type `protobuf:"varint,1,opt,name=type,enum=sonm.TaskLogsRequest_Type" json:"type,omitempty"`
 	Id            string               `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
@@ -262,12 +444,21 @@ type TaskLogsRequest struct {
 	Tail          string               `protobuf:"bytes,6,opt,name=Tail" json:"Tail,omitempty"`
 	Details       bool                 `protobuf:"varint,7,opt,name=Details" json:"Details,omitempty"`
 	DealID        *BigInt              `protobuf:"bytes,8,opt,name=dealID" json:"dealID,omitempty"`
+	// Until limits logs to lines produced before the given time. Accepts the
+	// same formats as "since": RFC3339 time, unix timestamp or a duration
+	// relative to the current time, for example "10m".
+	Until string `protobuf:"bytes,9,opt,name=until" json:"until,omitempty"`
+	// Grep is a regular expression that log lines must match.
+	Grep string `protobuf:"bytes,10,opt,name=grep" json:"grep,omitempty"`
+	// Structured enables line framing, i.e. log lines are sent in "lines"
+	// field of chunks instead of raw multiplexed output in "data" one.
+	Structured bool `protobuf:"varint,11,opt,name=structured" json:"structured,omitempty"`
 }
 
 func (m *TaskLogsRequest) Reset()                    { *m = TaskLogsRequest{} }
 func (m *TaskLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*TaskLogsRequest) ProtoMessage()               {}
-func (*TaskLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
+func (*TaskLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{11} }
 
 func (m *TaskLogsRequest) GetType() TaskLogsRequest_Type {
 	if m != nil {
@@ -325,14 +516,70 @@ func (m *TaskLogsRequest) GetDealID() *BigInt {
 	return nil
 }
 
+func (m *TaskLogsRequest) GetUntil() string {
+	if m != nil {
+		return m.Until
+	}
+	return ""
+}
+
+func (m *TaskLogsRequest) GetGrep() string {
+	if m != nil {
+		return m.Grep
+	}
+	return ""
+}
+
+func (m *TaskLogsRequest) GetStructured() bool {
+	if m != nil {
+		return m.Structured
+	}
+	return false
+}
+
+type TaskLogLine struct {
+	Stream    TaskLogLine_Stream `protobuf:"varint,1,opt,name=stream,enum=sonm.TaskLogLine_Stream" json:"stream,omitempty"`
+	Timestamp *Timestamp         `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	Line      string             `protobuf:"bytes,3,opt,name=line" json:"line,omitempty"`
+}
+
+func (m *TaskLogLine) Reset()                    { *m = TaskLogLine{} }
+func (m *TaskLogLine) String() string            { return proto.CompactTextString(m) }
+func (*TaskLogLine) ProtoMessage()               {}
+func (*TaskLogLine) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{12} }
+
+func (m *TaskLogLine) GetStream() TaskLogLine_Stream {
+	if m != nil {
+		return m.Stream
+	}
+	return TaskLogLine_STDOUT
+}
+
+func (m *TaskLogLine) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *TaskLogLine) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
 type TaskLogsChunk struct {
+	// Data contains raw output multiplexed in the Docker's format.
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Lines contains log lines if structured output was requested.
+	Lines []*TaskLogLine `protobuf:"bytes,2,rep,name=lines" json:"lines,omitempty"`
 }
 
 func (m *TaskLogsChunk) Reset()                    { *m = TaskLogsChunk{} }
 func (m *TaskLogsChunk) String() string            { return proto.CompactTextString(m) }
 func (*TaskLogsChunk) ProtoMessage()               {}
-func (*TaskLogsChunk) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+func (*TaskLogsChunk) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{13} }
 
 func (m *TaskLogsChunk) GetData() []byte {
 	if m != nil {
@@ -341,6 +588,13 @@ func (m *TaskLogsChunk) GetData() []byte {
 	return nil
 }
 
+func (m *TaskLogsChunk) GetLines() []*TaskLogLine {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
 type Chunk struct {
 	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
@@ -348,7 +602,7 @@ type Chunk struct {
 func (m *Chunk) Reset()                    { *m = Chunk{} }
 func (m *Chunk) String() string            { return proto.CompactTextString(m) }
 func (*Chunk) ProtoMessage()               {}
-func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{14} }
 
 func (m *Chunk) GetChunk() []byte {
 	if m != nil {
@@ -364,7 +618,7 @@ type Progress struct {
 func (m *Progress) Reset()                    { *m = Progress{} }
 func (m *Progress) String() string            { return proto.CompactTextString(m) }
 func (*Progress) ProtoMessage()               {}
-func (*Progress) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{12} }
+func (*Progress) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{15} }
 
 func (m *Progress) GetSize() int64 {
 	if m != nil {
@@ -380,7 +634,7 @@ type Duration struct {
 func (m *Duration) Reset()                    { *m = Duration{} }
 func (m *Duration) String() string            { return proto.CompactTextString(m) }
 func (*Duration) ProtoMessage()               {}
-func (*Duration) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{13} }
+func (*Duration) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{16} }
 
 func (m *Duration) GetNanoseconds() int64 {
 	if m != nil {
@@ -396,7 +650,7 @@ type EthAddress struct {
 func (m *EthAddress) Reset()                    { *m = EthAddress{} }
 func (m *EthAddress) String() string            { return proto.CompactTextString(m) }
 func (*EthAddress) ProtoMessage()               {}
-func (*EthAddress) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{14} }
+func (*EthAddress) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{17} }
 
 func (m *EthAddress) GetAddress() []byte {
 	if m != nil {
@@ -412,7 +666,7 @@ type DataSize struct {
 func (m *DataSize) Reset()                    { *m = DataSize{} }
 func (m *DataSize) String() string            { return proto.CompactTextString(m) }
 func (*DataSize) ProtoMessage()               {}
-func (*DataSize) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
+func (*DataSize) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{18} }
 
 func (m *DataSize) GetBytes() uint64 {
 	if m != nil {
@@ -428,7 +682,7 @@ type DataSizeRate struct {
 func (m *DataSizeRate) Reset()                    { *m = DataSizeRate{} }
 func (m *DataSizeRate) String() string            { return proto.CompactTextString(m) }
 func (*DataSizeRate) ProtoMessage()               {}
-func (*DataSizeRate) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{16} }
+func (*DataSizeRate) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{19} }
 
 func (m *DataSizeRate) GetBitsPerSecond() uint64 {
 	if m != nil {
@@ -444,7 +698,7 @@ type Price struct {
 func (m *Price) Reset()                    { *m = Price{} }
 func (m *Price) String() string            { return proto.CompactTextString(m) }
 func (*Price) ProtoMessage()               {}
-func (*Price) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{17} }
+func (*Price) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{20} }
 
 func (m *Price) GetPerSecond() *BigInt {
 	if m != nil {
@@ -461,9 +715,12 @@ func init() {
 	proto.RegisterType((*Count)(nil), "sonm.Count")
 	proto.RegisterType((*CPUUsage)(nil), "sonm.CPUUsage")
 	proto.RegisterType((*MemoryUsage)(nil), "sonm.MemoryUsage")
+	proto.RegisterType((*BlockIOUsage)(nil), "sonm.BlockIOUsage")
+	proto.RegisterType((*GPUUsage)(nil), "sonm.GPUUsage")
 	proto.RegisterType((*NetworkUsage)(nil), "sonm.NetworkUsage")
 	proto.RegisterType((*ResourceUsage)(nil), "sonm.ResourceUsage")
 	proto.RegisterType((*TaskLogsRequest)(nil), "sonm.TaskLogsRequest")
+	proto.RegisterType((*TaskLogLine)(nil), "sonm.TaskLogLine")
 	proto.RegisterType((*TaskLogsChunk)(nil), "sonm.TaskLogsChunk")
 	proto.RegisterType((*Chunk)(nil), "sonm.Chunk")
 	proto.RegisterType((*Progress)(nil), "sonm.Progress")
@@ -473,56 +730,78 @@ func init() {
 	proto.RegisterType((*DataSizeRate)(nil), "sonm.DataSizeRate")
 	proto.RegisterType((*Price)(nil), "sonm.Price")
 	proto.RegisterEnum("sonm.TaskLogsRequest_Type", TaskLogsRequest_Type_name, TaskLogsRequest_Type_value)
-}
-
-func init() { proto.RegisterFile("insonmnia.proto", fileDescriptor6) }
-
-var fileDescriptor6 = []byte{
-	// 723 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0x1a, 0x3b,
-	0x10, 0x3e, 0x2c, 0x2c, 0x2c, 0x03, 0x49, 0x38, 0x56, 0x74, 0x84, 0xd0, 0x69, 0x85, 0xb6, 0x51,
-	0x45, 0xaa, 0x8a, 0x8b, 0xa4, 0x17, 0x55, 0x2e, 0x2a, 0x35, 0x59, 0xaa, 0x22, 0xb5, 0x09, 0x72,
-	0xc8, 0x03, 0x98, 0x5d, 0x8b, 0x58, 0x2c, 0xeb, 0xad, 0x6d, 0x1a, 0xc8, 0xeb, 0xf4, 0xe5, 0x7a,
-	0xdd, 0x27, 0xa8, 0xfc, 0x47, 0x20, 0x95, 0x7a, 0x37, 0xdf, 0x7c, 0x9f, 0x3d, 0xf6, 0x7c, 0x1e,
-	0xc3, 0x11, 0x2b, 0x24, 0x2f, 0x96, 0x05, 0x23, 0xc3, 0x52, 0x70, 0xc5, 0x51, 0x4d, 0xc3, 0x5e,
-	0x7b, 0xc6, 0xe6, 0xac, 0x50, 0x36, 0xd7, 0x43, 0x29, 0x29, 0xc9, 0x8c, 0xe5, 0x4c, 0x31, 0x2a,
-	0x5d, 0xee, 0x48, 0xb1, 0x25, 0x95, 0x8a, 0x2c, 0x4b, 0x9b, 0x88, 0x1b, 0x10, 0x8e, 0x96, 0xa5,
-	0xda, 0xc4, 0xc7, 0x10, 0x8c, 0x13, 0x74, 0x08, 0x01, 0xcb, 0xba, 0x95, 0x7e, 0x65, 0xd0, 0xc4,
-	0x01, 0xcb, 0xe2, 0x53, 0x08, 0x47, 0xea, 0x7e, 0x9c, 0xa0, 0xfe, 0x96, 0x68, 0x9d, 0x75, 0x86,
-	0xba, 0xda, 0x70, 0xa4, 0xee, 0x3f, 0x66, 0x99, 0xa0, 0x52, 0x1a, 0xe9, 0x07, 0xa8, 0x4f, 0x89,
-	0x5c, 0xfc, 0xb9, 0x09, 0x3a, 0x81, 0x7a, 0x46, 0x49, 0x3e, 0x4e, 0xba, 0x81, 0x59, 0xdf, 0xb6,
-	0xeb, 0x2f, 0xd9, 0x7c, 0x5c, 0x28, 0xec, 0xb8, 0xf8, 0x05, 0x84, 0x57, 0x7c, 0x55, 0x28, 0x74,
-	0x0c, 0x61, 0xaa, 0x03, 0xb3, 0x43, 0x0d, 0x5b, 0x10, 0xf7, 0x21, 0xba, 0x9a, 0xdc, 0xdd, 0x49,
-	0x32, 0xa7, 0x5a, 0xa1, 0xb8, 0x22, 0xb9, 0x57, 0x18, 0x10, 0x9f, 0x42, 0xeb, 0x2b, 0x5d, 0x72,
-	0xb1, 0xb1, 0xa2, 0x1e, 0x44, 0x4b, 0xb2, 0x36, 0xb1, 0xd3, 0x6d, 0x71, 0xfc, 0xab, 0x02, 0xed,
-	0x6b, 0xaa, 0x1e, 0xb8, 0x58, 0x58, 0x71, 0x17, 0x1a, 0x6a, 0x7d, 0xb9, 0x51, 0x54, 0x3a, 0xad,
-	0x87, 0x9a, 0x11, 0x8e, 0x09, 0x2c, 0xe3, 0x20, 0xfa, 0x1f, 0x9a, 0x6a, 0x3d, 0x21, 0xe9, 0x82,
-	0x2a, 0xd9, 0xad, 0x1a, 0xee, 0x29, 0xa1, 0x59, 0xb1, 0x65, 0x6b, 0x96, 0xdd, 0x26, 0xf4, 0xe1,
-	0xd4, 0x7a, 0x24, 0x04, 0x17, 0xb2, 0x1b, 0xda, 0xc3, 0x79, 0xac, 0x39, 0xe1, 0xb9, 0xba, 0xe5,
-	0x3c, 0xb6, 0x35, 0x13, 0xc1, 0xcb, 0x92, 0x66, 0xdd, 0x86, 0xaf, 0xe9, 0x12, 0xb6, 0xa6, 0x67,
-	0x23, 0x5f, 0xd3, 0x25, 0xe2, 0x9f, 0x15, 0x38, 0xc0, 0x54, 0xf2, 0x95, 0x48, 0xa9, 0xbd, 0x75,
-	0x1f, 0xaa, 0x69, 0xb9, 0x72, 0xae, 0x1e, 0x5a, 0x57, 0x7c, 0x93, 0xb1, 0xa6, 0xd0, 0x29, 0xd4,
-	0x97, 0xa6, 0xa7, 0xce, 0xba, 0x7f, 0xad, 0x68, 0xa7, 0xcf, 0xd8, 0x09, 0xd0, 0x05, 0x34, 0x0a,
-	0xdb, 0xd2, 0x6e, 0xb5, 0x5f, 0x1d, 0xb4, 0xce, 0xfa, 0x56, 0xbb, 0x57, 0x72, 0xe8, 0xba, 0x3e,
-	0x2a, 0x94, 0xd8, 0x60, 0xbf, 0xa0, 0x77, 0xbd, 0xb5, 0xc3, 0x10, 0xa8, 0x03, 0xd5, 0x05, 0xdd,
-	0xb8, 0x27, 0xa4, 0x43, 0x34, 0x80, 0xf0, 0x3b, 0xc9, 0x57, 0xd4, 0x9d, 0x03, 0xd9, 0xbd, 0x77,
-	0x3d, 0xc4, 0x56, 0x70, 0x11, 0xbc, 0xaf, 0xc4, 0x3f, 0x02, 0x38, 0xd2, 0x8f, 0xf1, 0x0b, 0x9f,
-	0x4b, 0x4c, 0xbf, 0xad, 0xa8, 0x54, 0x68, 0x08, 0x35, 0xb5, 0x29, 0xed, 0x5b, 0x38, 0x3c, 0xeb,
-	0xd9, 0x0d, 0x9e, 0x89, 0x86, 0xd3, 0x4d, 0x49, 0xb1, 0xd1, 0xb9, 0x57, 0x1c, 0x6c, 0x5f, 0xf1,
-	0x31, 0x84, 0x92, 0x15, 0x29, 0x35, 0x56, 0x37, 0xb1, 0x05, 0xe8, 0x04, 0x0e, 0x48, 0x96, 0x4d,
-	0xfd, 0x54, 0x59, 0xab, 0x23, 0xbc, 0x9f, 0x44, 0xff, 0x41, 0xfd, 0x13, 0xcf, 0x73, 0xfe, 0x60,
-	0xcc, 0x8e, 0xb0, 0x43, 0x08, 0x41, 0x6d, 0x4a, 0x58, 0x6e, 0x6c, 0x6e, 0x62, 0x13, 0xeb, 0x07,
-	0x97, 0x50, 0x45, 0x58, 0x2e, 0x8d, 0xc1, 0x11, 0xf6, 0x70, 0x67, 0x8e, 0xa2, 0xbf, 0xcc, 0xd1,
-	0x00, 0x6a, 0xfa, 0x16, 0x08, 0xa0, 0x7e, 0x3b, 0x4d, 0x6e, 0xee, 0xa6, 0x9d, 0x7f, 0x5c, 0x3c,
-	0xc2, 0xb8, 0x53, 0x41, 0x11, 0xd4, 0x2e, 0x6f, 0xa6, 0x9f, 0x3b, 0x41, 0xfc, 0x0a, 0x0e, 0xfc,
-	0xfd, 0xaf, 0xee, 0x57, 0xc5, 0x42, 0x1f, 0x27, 0x23, 0x8a, 0x98, 0x16, 0xb5, 0xb1, 0x89, 0xcd,
-	0x58, 0x1a, 0x52, 0x8f, 0xa5, 0x0e, 0x1c, 0x6b, 0x41, 0xfc, 0x12, 0xa2, 0x89, 0xe0, 0x73, 0xfd,
-	0x0b, 0xe8, 0xe5, 0x92, 0x3d, 0xda, 0x0e, 0x57, 0xb1, 0x89, 0xe3, 0xb7, 0x10, 0x25, 0x2b, 0x41,
-	0x14, 0xe3, 0x05, 0xea, 0x43, 0xab, 0x20, 0x05, 0x97, 0x34, 0xe5, 0x45, 0x26, 0x9d, 0x6c, 0x37,
-	0x15, 0xbf, 0x06, 0x78, 0xfa, 0x55, 0x74, 0x27, 0x88, 0x0d, 0x5d, 0x4d, 0x0f, 0xf5, 0x67, 0x90,
-	0x10, 0x45, 0x6e, 0xd9, 0xa3, 0xf9, 0x0c, 0x66, 0x3b, 0x83, 0x6b, 0x41, 0xfc, 0x0e, 0xda, 0x5e,
-	0x81, 0x89, 0x32, 0x3e, 0xcd, 0x98, 0x92, 0x13, 0x2a, 0x6e, 0x4d, 0x2d, 0xa7, 0xde, 0x4f, 0xc6,
-	0xe7, 0x10, 0x4e, 0x04, 0x4b, 0x29, 0x7a, 0x03, 0xcd, 0x72, 0x4f, 0xfa, 0xbc, 0xdb, 0x4f, 0xf4,
-	0xac, 0x6e, 0x7e, 0xd2, 0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0x56, 0x11, 0x37, 0x95,
-	0x05, 0x00, 0x00,
+	proto.RegisterEnum("sonm.TaskLogLine_Stream", TaskLogLine_Stream_name, TaskLogLine_Stream_value)
+}
+
+func init() { proto.RegisterFile("insonmnia.proto", fileDescriptor7) }
+
+var fileDescriptor7 = []byte{
+	// 1067 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xdb, 0x6e, 0x1b, 0x37,
+	0x13, 0xfe, 0xb5, 0x3a, 0xad, 0x46, 0x8a, 0xad, 0x9f, 0x30, 0x8a, 0x85, 0x90, 0x06, 0x02, 0x61,
+	0xb4, 0x4e, 0x91, 0x0a, 0x85, 0xd3, 0x8b, 0x22, 0x17, 0x05, 0x6a, 0x4b, 0x49, 0x0d, 0x38, 0xb1,
+	0x40, 0xcb, 0x0f, 0x40, 0x69, 0x09, 0x99, 0xd0, 0x6a, 0xb9, 0x25, 0xb9, 0xb5, 0xe5, 0xcb, 0x3e,
+	0x40, 0x9f, 0xa2, 0x4f, 0xd6, 0xfb, 0xde, 0xf4, 0x09, 0x0a, 0x9e, 0x56, 0xab, 0x1c, 0xee, 0xf8,
+	0x7d, 0xf3, 0x71, 0x66, 0x38, 0x3b, 0x1c, 0x2e, 0x1c, 0xf3, 0x5c, 0x89, 0x7c, 0x9b, 0x73, 0x3a,
+	0x29, 0xa4, 0xd0, 0x02, 0xb5, 0x0c, 0x1c, 0x0d, 0x96, 0x7c, 0xcd, 0x73, 0xed, 0xb8, 0x11, 0x5a,
+	0xd1, 0x82, 0x2e, 0x79, 0xc6, 0x35, 0x67, 0xca, 0x73, 0xc7, 0x9a, 0x6f, 0x99, 0xd2, 0x74, 0x5b,
+	0x38, 0x02, 0x77, 0xa1, 0x3d, 0xdb, 0x16, 0x7a, 0x87, 0x4f, 0x20, 0xba, 0x9a, 0xa2, 0x23, 0x88,
+	0x78, 0x9a, 0x34, 0xc6, 0x8d, 0xb3, 0x1e, 0x89, 0x78, 0x8a, 0x5f, 0x42, 0x7b, 0xa6, 0xef, 0xaf,
+	0xa6, 0x68, 0x5c, 0x19, 0xfa, 0xe7, 0xc3, 0x89, 0x89, 0x36, 0x99, 0xe9, 0xfb, 0x5f, 0xd2, 0x54,
+	0x32, 0xa5, 0xac, 0xf4, 0x67, 0xe8, 0x2c, 0xa8, 0xda, 0x7c, 0xea, 0x04, 0x9d, 0x42, 0x27, 0x65,
+	0x34, 0xbb, 0x9a, 0x26, 0x91, 0xdd, 0x3f, 0x70, 0xfb, 0x2f, 0xf8, 0xfa, 0x2a, 0xd7, 0xc4, 0xdb,
+	0xf0, 0xd7, 0xd0, 0xbe, 0x14, 0x65, 0xae, 0xd1, 0x09, 0xb4, 0x57, 0x66, 0x61, 0x3d, 0xb4, 0x88,
+	0x03, 0xf8, 0xef, 0x06, 0xc4, 0x97, 0xf3, 0xbb, 0x3b, 0x45, 0xd7, 0xcc, 0x48, 0xb4, 0xd0, 0x34,
+	0x0b, 0x12, 0x0b, 0x50, 0x02, 0xdd, 0x82, 0xc9, 0x4b, 0x21, 0x59, 0x12, 0x8d, 0x9b, 0x67, 0x2d,
+	0x12, 0x20, 0x7a, 0x01, 0xb0, 0x61, 0x32, 0x67, 0xd9, 0x7b, 0x91, 0xb2, 0xa4, 0x69, 0x37, 0xd5,
+	0x18, 0x34, 0x82, 0xb8, 0x54, 0x4c, 0x5a, 0x6b, 0xcb, 0x5a, 0x2b, 0x8c, 0xbe, 0x83, 0xa1, 0xbe,
+	0x97, 0x42, 0xeb, 0x8c, 0xa5, 0x73, 0x26, 0xb9, 0x48, 0x55, 0xd2, 0xb6, 0x9a, 0x4f, 0x78, 0x74,
+	0x0a, 0xcf, 0x2a, 0x6e, 0xc1, 0xb7, 0x2c, 0xe9, 0x58, 0xe1, 0x21, 0xe9, 0xf3, 0xb4, 0x8e, 0xba,
+	0xd6, 0x1e, 0x20, 0xbe, 0x83, 0xfe, 0x7b, 0xb6, 0x15, 0x72, 0xe7, 0x8e, 0x39, 0x82, 0x78, 0x4b,
+	0x1f, 0xed, 0xda, 0x9f, 0xb4, 0xc2, 0xa6, 0x04, 0xa5, 0x35, 0x44, 0xae, 0x04, 0x65, 0x60, 0x33,
+	0xbe, 0xe5, 0xda, 0x9f, 0xd1, 0x01, 0xfc, 0x47, 0x03, 0x06, 0x17, 0x99, 0x58, 0x6d, 0xae, 0x6e,
+	0xdc, 0xe6, 0xe7, 0xd0, 0x93, 0x8c, 0xa6, 0x17, 0x3b, 0xcd, 0x94, 0xf7, 0xbc, 0x27, 0x4c, 0xb5,
+	0x1e, 0x24, 0xd7, 0xcc, 0x99, 0x9d, 0xff, 0x1a, 0x63, 0xf2, 0x37, 0xe2, 0x9b, 0x42, 0xf9, 0x30,
+	0x01, 0x9a, 0x84, 0xad, 0xce, 0x98, 0x7c, 0x1d, 0x03, 0xc6, 0x39, 0xc4, 0xef, 0xc2, 0xf7, 0x1b,
+	0x43, 0xbf, 0xd4, 0x3c, 0xe3, 0x4f, 0x54, 0x73, 0x91, 0xfb, 0x0c, 0xea, 0x94, 0xc9, 0x61, 0xeb,
+	0x2b, 0xc1, 0xd2, 0x90, 0xc3, 0x9e, 0x31, 0x1e, 0x1c, 0x5a, 0xd8, 0x3e, 0x70, 0x79, 0xd4, 0x29,
+	0xfc, 0x6f, 0x03, 0x06, 0x1f, 0x98, 0x7e, 0x10, 0x72, 0xe3, 0x82, 0x26, 0xd0, 0xd5, 0x8f, 0xf5,
+	0x23, 0x07, 0x68, 0x0f, 0xf4, 0x58, 0x3f, 0x6d, 0x80, 0xa6, 0x50, 0xfa, 0x71, 0x4e, 0x57, 0x1b,
+	0xa6, 0xc3, 0x61, 0xf7, 0x84, 0x2d, 0x63, 0x65, 0x6d, 0xf9, 0x32, 0x56, 0xd6, 0x11, 0xc4, 0xfa,
+	0x71, 0x26, 0xa5, 0x90, 0xa1, 0x61, 0x2a, 0x6c, 0x6c, 0x32, 0xd8, 0x5c, 0x8f, 0x54, 0xd8, 0xc5,
+	0x9c, 0x4a, 0x51, 0x14, 0x2c, 0xf5, 0x0d, 0xb2, 0x27, 0x5c, 0xcc, 0x60, 0x8d, 0x43, 0x4c, 0x4f,
+	0xe0, 0x3f, 0x9b, 0xf0, 0x8c, 0x30, 0x25, 0x4a, 0xb9, 0x62, 0xa1, 0xd4, 0xcd, 0x55, 0x51, 0xfa,
+	0x9b, 0x7b, 0xe4, 0x6e, 0x5e, 0xb8, 0x47, 0xc4, 0x98, 0xd0, 0x4b, 0xe8, 0xb8, 0xba, 0xf9, 0xeb,
+	0xf9, 0x7f, 0x27, 0xaa, 0x35, 0x22, 0xf1, 0x02, 0xf4, 0x06, 0xba, 0xb9, 0x2b, 0x69, 0xd2, 0x1c,
+	0x37, 0xcf, 0xfa, 0xe7, 0x63, 0xa7, 0x3d, 0x08, 0x39, 0xf1, 0x55, 0x9f, 0xe5, 0x5a, 0xee, 0x48,
+	0xd8, 0x80, 0x5e, 0x41, 0x77, 0xe9, 0x7a, 0xd0, 0x96, 0xaa, 0x7f, 0x8e, 0xfc, 0x18, 0xa8, 0x35,
+	0x26, 0x09, 0x12, 0x34, 0x81, 0xe6, 0xba, 0x28, 0x93, 0xb6, 0x8d, 0xf2, 0xfc, 0x73, 0x51, 0xde,
+	0x15, 0xa5, 0x8b, 0x60, 0x84, 0xa3, 0x0f, 0xd5, 0xc7, 0xb6, 0x24, 0x1a, 0x42, 0x73, 0xc3, 0x76,
+	0x7e, 0x08, 0x99, 0x25, 0x3a, 0x83, 0xf6, 0xef, 0x34, 0x2b, 0x59, 0x12, 0xd5, 0xa3, 0xd7, 0x3b,
+	0x84, 0x38, 0xc1, 0x9b, 0xe8, 0xa7, 0xc6, 0xe8, 0x2d, 0xc4, 0x21, 0xc0, 0x67, 0x7c, 0x9d, 0x1e,
+	0xfa, 0xf2, 0x65, 0x0d, 0xed, 0x5d, 0xf3, 0x83, 0xff, 0x89, 0xe0, 0xd8, 0x8c, 0xc5, 0x6b, 0xb1,
+	0x56, 0x84, 0xfd, 0x56, 0x32, 0xa5, 0xd1, 0x04, 0x5a, 0x7a, 0x57, 0xb8, 0x2b, 0x7d, 0x74, 0x3e,
+	0x72, 0x9b, 0x3f, 0x12, 0x4d, 0x16, 0xbb, 0x82, 0x11, 0xab, 0xf3, 0xf3, 0x34, 0xaa, 0xe6, 0xe9,
+	0x09, 0xb4, 0x15, 0xcf, 0x57, 0x6e, 0x90, 0xf5, 0x88, 0x03, 0x66, 0xf6, 0xd0, 0x34, 0x5d, 0x84,
+	0xf9, 0xee, 0x1a, 0x32, 0x26, 0x87, 0x24, 0xfa, 0x0a, 0x3a, 0x6f, 0x45, 0x96, 0x89, 0x07, 0xdb,
+	0x92, 0x31, 0xf1, 0x08, 0x21, 0x68, 0x2d, 0x28, 0xcf, 0x6c, 0x33, 0xf6, 0x88, 0x5d, 0x9b, 0x6b,
+	0x31, 0x65, 0x9a, 0xf2, 0xcc, 0xcd, 0xa9, 0x98, 0x04, 0x58, 0x9b, 0xe8, 0xf1, 0x97, 0x27, 0xba,
+	0x1d, 0x51, 0xb9, 0xe6, 0x59, 0xd2, 0x73, 0x79, 0x5a, 0x60, 0x22, 0xad, 0x25, 0x2b, 0x12, 0x70,
+	0x91, 0xcc, 0xda, 0xdc, 0x76, 0xa5, 0x65, 0xb9, 0xd2, 0xa5, 0x64, 0x69, 0xd2, 0xb7, 0xc1, 0x6a,
+	0x0c, 0x3e, 0x83, 0x96, 0xa9, 0x07, 0x02, 0xe8, 0xdc, 0x2e, 0xa6, 0x37, 0x77, 0x8b, 0xe1, 0xff,
+	0xfc, 0x7a, 0x46, 0xc8, 0xb0, 0x81, 0x62, 0x68, 0x5d, 0xdc, 0x2c, 0x7e, 0x1d, 0x46, 0xf8, 0xaf,
+	0x06, 0xf4, 0x7d, 0x29, 0xaf, 0x79, 0xce, 0xd0, 0x0f, 0xd0, 0x51, 0x5a, 0x32, 0xba, 0xf5, 0xd5,
+	0x4e, 0x0e, 0xaa, 0x6d, 0x24, 0x93, 0x5b, 0x6b, 0x27, 0x5e, 0x87, 0xbe, 0x87, 0x5e, 0xf5, 0x48,
+	0xfa, 0xef, 0x7b, 0xec, 0x37, 0x05, 0x9a, 0xec, 0x15, 0xe6, 0x38, 0x19, 0xcf, 0xc3, 0xb7, 0xb0,
+	0x6b, 0x3c, 0x86, 0x8e, 0x73, 0xfa, 0xa5, 0x84, 0xf1, 0x35, 0x3c, 0x0b, 0x1f, 0xfc, 0xf2, 0xbe,
+	0xcc, 0x37, 0xc6, 0x4d, 0x4a, 0x35, 0xb5, 0x59, 0x0e, 0x88, 0x5d, 0xa3, 0x6f, 0xcd, 0x30, 0xcf,
+	0xed, 0x50, 0x6a, 0xee, 0xef, 0x65, 0x2d, 0x75, 0xe2, 0xec, 0xf6, 0xe9, 0xb4, 0x5e, 0xcc, 0xd3,
+	0x69, 0x16, 0xde, 0x8d, 0x03, 0xf8, 0x05, 0xc4, 0x73, 0x29, 0xd6, 0xe6, 0xa5, 0x36, 0x71, 0x14,
+	0x7f, 0x72, 0xbd, 0xd7, 0x24, 0x76, 0x8d, 0x5f, 0x41, 0x3c, 0x2d, 0xa5, 0x9b, 0xbb, 0x63, 0xe8,
+	0xe7, 0x34, 0x17, 0x8a, 0xad, 0x44, 0x9e, 0x2a, 0x2f, 0xab, 0x53, 0xf8, 0x1b, 0x80, 0xfd, 0xcb,
+	0x6f, 0x7a, 0x84, 0xba, 0xa5, 0x8f, 0x19, 0x20, 0x1e, 0x43, 0x3c, 0xa5, 0x9a, 0xde, 0xf2, 0x27,
+	0xfb, 0x2c, 0x2d, 0x6b, 0x83, 0xd7, 0x01, 0xfc, 0x23, 0x0c, 0x82, 0x82, 0x50, 0x6d, 0x3b, 0x78,
+	0xc9, 0xb5, 0x9a, 0x33, 0x79, 0x6b, 0x63, 0x79, 0xf5, 0x21, 0x89, 0x5f, 0x43, 0x7b, 0x2e, 0xf9,
+	0xca, 0x3c, 0xcc, 0xbd, 0xe2, 0x40, 0xfa, 0x71, 0x1f, 0xee, 0xcd, 0xcb, 0x8e, 0xfd, 0xdb, 0x79,
+	0xfd, 0xdf, 0x00, 0xf6, 0xf7, 0xd6, 0x54, 0x39, 0x09, 0x00, 0x00,
 }
@@ -96,3 +96,60 @@ func TestEthAddress_UnmarshalText(t *testing.T) {
 	assert.Equal(t, "0x1234567891011121314151617181920212223242", recv.Addr.Unwrap().Hex(),
 		"JSON marshall")
 }
+
+func TestTaskGroupSpecValidate(t *testing.T) {
+	task := func(image string) *TaskSpec {
+		return &TaskSpec{Container: &Container{Image: image}}
+	}
+
+	valid := &TaskGroupSpec{
+		Tasks:   []*TaskSpec{task("nginx"), task("fluentd")},
+		Volumes: []string{"data", "logs.1"},
+	}
+	valid.Tasks[0].Container.Expose = []string{"80:80"}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name string
+		spec *TaskGroupSpec
+	}{
+		{
+			name: "empty",
+			spec: &TaskGroupSpec{},
+		},
+		{
+			name: "invalid task",
+			spec: &TaskGroupSpec{Tasks: []*TaskSpec{task("nginx"), task("")}},
+		},
+		{
+			name: "invalid volume name",
+			spec: &TaskGroupSpec{Tasks: []*TaskSpec{task("nginx")}, Volumes: []string{"../data"}},
+		},
+		{
+			name: "duplicate volume",
+			spec: &TaskGroupSpec{Tasks: []*TaskSpec{task("nginx")}, Volumes: []string{"data", "data"}},
+		},
+		{
+			name: "volume conflict",
+			spec: &TaskGroupSpec{
+				Tasks: []*TaskSpec{
+					{Container: &Container{Image: "nginx", Volumes: map[string]*Volume{"data": {}}}},
+				},
+				Volumes: []string{"data"},
+			},
+		},
+		{
+			name: "sidecar exposes ports",
+			spec: &TaskGroupSpec{
+				Tasks: []*TaskSpec{
+					task("nginx"),
+					{Container: &Container{Image: "fluentd", Expose: []string{"24224:24224"}}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Error(t, tt.spec.Validate(), tt.name)
+	}
+}
@@ -47,7 +47,7 @@ var OrderType_value = map[string]int32{
 func (x OrderType) String() string {
 	return proto.EnumName(OrderType_name, int32(x))
 }
-func (OrderType) EnumDescriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
+func (OrderType) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
 
 type OrderStatus int32
 
@@ -71,7 +71,7 @@ var OrderStatus_value = map[string]int32{
 func (x OrderStatus) String() string {
 	return proto.EnumName(OrderStatus_name, int32(x))
 }
-func (OrderStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
+func (OrderStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
 
 type IdentityLevel int32
 
@@ -95,7 +95,7 @@ var IdentityLevel_value = map[string]int32{
 func (x IdentityLevel) String() string {
 	return proto.EnumName(IdentityLevel_name, int32(x))
 }
-func (IdentityLevel) EnumDescriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
+func (IdentityLevel) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
 
 type DealStatus int32
 
@@ -119,7 +119,7 @@ var DealStatus_value = map[string]int32{
 func (x DealStatus) String() string {
 	return proto.EnumName(DealStatus_name, int32(x))
 }
-func (DealStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
+func (DealStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
 
 type ChangeRequestStatus int32
 
@@ -149,7 +149,38 @@ var ChangeRequestStatus_value = map[string]int32{
 func (x ChangeRequestStatus) String() string {
 	return proto.EnumName(ChangeRequestStatus_name, int32(x))
 }
-func (ChangeRequestStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
+func (ChangeRequestStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
+
+type BidPlan_Status int32
+
+const (
+	// Order is placed and the Node tries to open a deal with it.
+	BidPlan_MATCHING BidPlan_Status = 0
+	// Deal is opened, see `dealID`.
+	BidPlan_DEAL_OPENED BidPlan_Status = 1
+	// Order has been cancelled or has become inactive without a deal.
+	BidPlan_CANCELLED BidPlan_Status = 2
+	// Order can not be placed, see `lastError`.
+	BidPlan_FAILED BidPlan_Status = 3
+)
+
+var BidPlan_Status_name = map[int32]string{
+	0: "MATCHING",
+	1: "DEAL_OPENED",
+	2: "CANCELLED",
+	3: "FAILED",
+}
+var BidPlan_Status_value = map[string]int32{
+	"MATCHING":    0,
+	"DEAL_OPENED": 1,
+	"CANCELLED":   2,
+	"FAILED":      3,
+}
+
+func (x BidPlan_Status) String() string {
+	return proto.EnumName(BidPlan_Status_name, int32(x))
+}
+func (BidPlan_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{7, 0} }
 
 type GetOrdersReply struct {
 	Orders []*Order `protobuf:"bytes,1,rep,name=orders" json:"orders,omitempty"`
@@ -158,7 +189,7 @@ type GetOrdersReply struct {
 func (m *GetOrdersReply) Reset()                    { *m = GetOrdersReply{} }
 func (m *GetOrdersReply) String() string            { return proto.CompactTextString(m) }
 func (*GetOrdersReply) ProtoMessage()               {}
-func (*GetOrdersReply) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
+func (*GetOrdersReply) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
 
 func (m *GetOrdersReply) GetOrders() []*Order {
 	if m != nil {
@@ -174,7 +205,7 @@ type Benchmarks struct {
 func (m *Benchmarks) Reset()                    { *m = Benchmarks{} }
 func (m *Benchmarks) String() string            { return proto.CompactTextString(m) }
 func (*Benchmarks) ProtoMessage()               {}
-func (*Benchmarks) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
+func (*Benchmarks) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
 
 func (m *Benchmarks) GetValues() []uint64 {
 	if m != nil {
@@ -204,7 +235,7 @@ type Deal struct {
 func (m *Deal) Reset()                    { *m = Deal{} }
 func (m *Deal) String() string            { return proto.CompactTextString(m) }
 func (*Deal) ProtoMessage()               {}
-func (*Deal) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
+func (*Deal) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
 
 func (m *Deal) GetId() *BigInt {
 	if m != nil {
@@ -331,7 +362,7 @@ type Order struct {
 func (m *Order) Reset()                    { *m = Order{} }
 func (m *Order) String() string            { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()               {}
-func (*Order) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
+func (*Order) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
 
 func (m *Order) GetId() *BigInt {
 	if m != nil {
@@ -440,7 +471,7 @@ type BidNetwork struct {
 func (m *BidNetwork) Reset()                    { *m = BidNetwork{} }
 func (m *BidNetwork) String() string            { return proto.CompactTextString(m) }
 func (*BidNetwork) ProtoMessage()               {}
-func (*BidNetwork) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
+func (*BidNetwork) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
 
 func (m *BidNetwork) GetOverlay() bool {
 	if m != nil {
@@ -471,7 +502,7 @@ type BidResources struct {
 func (m *BidResources) Reset()                    { *m = BidResources{} }
 func (m *BidResources) String() string            { return proto.CompactTextString(m) }
 func (*BidResources) ProtoMessage()               {}
-func (*BidResources) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
+func (*BidResources) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5} }
 
 func (m *BidResources) GetNetwork() *BidNetwork {
 	if m != nil {
@@ -501,7 +532,7 @@ type BidOrder struct {
 func (m *BidOrder) Reset()                    { *m = BidOrder{} }
 func (m *BidOrder) String() string            { return proto.CompactTextString(m) }
 func (*BidOrder) ProtoMessage()               {}
-func (*BidOrder) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
+func (*BidOrder) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{6} }
 
 func (m *BidOrder) GetID() string {
 	if m != nil {
@@ -559,6 +590,119 @@ func (m *BidOrder) GetResources() *BidResources {
 	return nil
 }
 
+type BidPlan struct {
+	ID      string         `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+	OrderID *BigInt        `protobuf:"bytes,2,opt,name=orderID" json:"orderID,omitempty"`
+	DealID  *BigInt        `protobuf:"bytes,3,opt,name=dealID" json:"dealID,omitempty"`
+	Bid     *BidOrder      `protobuf:"bytes,4,opt,name=bid" json:"bid,omitempty"`
+	Status  BidPlan_Status `protobuf:"varint,5,opt,name=status,enum=sonm.BidPlan_Status" json:"status,omitempty"`
+	// LastError describes the last matching or placing failure.
+	LastError string     `protobuf:"bytes,6,opt,name=lastError" json:"lastError,omitempty"`
+	CreatedAt *Timestamp `protobuf:"bytes,7,opt,name=createdAt" json:"createdAt,omitempty"`
+	UpdatedAt *Timestamp `protobuf:"bytes,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *BidPlan) Reset()                    { *m = BidPlan{} }
+func (m *BidPlan) String() string            { return proto.CompactTextString(m) }
+func (*BidPlan) ProtoMessage()               {}
+func (*BidPlan) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{7} }
+
+func (m *BidPlan) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *BidPlan) GetOrderID() *BigInt {
+	if m != nil {
+		return m.OrderID
+	}
+	return nil
+}
+
+func (m *BidPlan) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *BidPlan) GetBid() *BidOrder {
+	if m != nil {
+		return m.Bid
+	}
+	return nil
+}
+
+func (m *BidPlan) GetStatus() BidPlan_Status {
+	if m != nil {
+		return m.Status
+	}
+	return BidPlan_MATCHING
+}
+
+func (m *BidPlan) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *BidPlan) GetCreatedAt() *Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *BidPlan) GetUpdatedAt() *Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type BidPlansReply struct {
+	BidPlans map[string]*BidPlan `protobuf:"bytes,1,rep,name=bidPlans" json:"bidPlans,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *BidPlansReply) Reset()                    { *m = BidPlansReply{} }
+func (m *BidPlansReply) String() string            { return proto.CompactTextString(m) }
+func (*BidPlansReply) ProtoMessage()               {}
+func (*BidPlansReply) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{8} }
+
+func (m *BidPlansReply) GetBidPlans() map[string]*BidPlan {
+	if m != nil {
+		return m.BidPlans
+	}
+	return nil
+}
+
+type ReplaceBidPlanRequest struct {
+	ID    string `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+	Price *Price `protobuf:"bytes,2,opt,name=price" json:"price,omitempty"`
+}
+
+func (m *ReplaceBidPlanRequest) Reset()                    { *m = ReplaceBidPlanRequest{} }
+func (m *ReplaceBidPlanRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplaceBidPlanRequest) ProtoMessage()               {}
+func (*ReplaceBidPlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{9} }
+
+func (m *ReplaceBidPlanRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *ReplaceBidPlanRequest) GetPrice() *Price {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetOrdersReply)(nil), "sonm.GetOrdersReply")
 	proto.RegisterType((*Benchmarks)(nil), "sonm.Benchmarks")
@@ -567,11 +711,15 @@ func init() {
 	proto.RegisterType((*BidNetwork)(nil), "sonm.BidNetwork")
 	proto.RegisterType((*BidResources)(nil), "sonm.BidResources")
 	proto.RegisterType((*BidOrder)(nil), "sonm.BidOrder")
+	proto.RegisterType((*BidPlan)(nil), "sonm.BidPlan")
+	proto.RegisterType((*BidPlansReply)(nil), "sonm.BidPlansReply")
+	proto.RegisterType((*ReplaceBidPlanRequest)(nil), "sonm.ReplaceBidPlanRequest")
 	proto.RegisterEnum("sonm.OrderType", OrderType_name, OrderType_value)
 	proto.RegisterEnum("sonm.OrderStatus", OrderStatus_name, OrderStatus_value)
 	proto.RegisterEnum("sonm.IdentityLevel", IdentityLevel_name, IdentityLevel_value)
 	proto.RegisterEnum("sonm.DealStatus", DealStatus_name, DealStatus_value)
 	proto.RegisterEnum("sonm.ChangeRequestStatus", ChangeRequestStatus_name, ChangeRequestStatus_value)
+	proto.RegisterEnum("sonm.BidPlan_Status", BidPlan_Status_name, BidPlan_Status_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -597,6 +745,15 @@ type MarketClient interface {
 	GetOrderByID(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Order, error)
 	// CancelOrder removes active order from the Marketplace.
 	CancelOrder(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+	// BidPlans returns bid plans known by the Node with their matching
+	// state, i.e. every BID order placed via `CreateOrder`.
+	BidPlans(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BidPlansReply, error)
+	// CancelBidPlan stops matching the given bid plan and removes its
+	// order from the Marketplace.
+	CancelBidPlan(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+	// ReplaceBidPlan cancels the current order of the given bid plan and
+	// places a new one with the specified price instead.
+	ReplaceBidPlan(ctx context.Context, in *ReplaceBidPlanRequest, opts ...grpc.CallOption) (*BidPlan, error)
 }
 
 type marketClient struct {
@@ -643,6 +800,33 @@ func (c *marketClient) CancelOrder(ctx context.Context, in *ID, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *marketClient) BidPlans(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BidPlansReply, error) {
+	out := new(BidPlansReply)
+	err := grpc.Invoke(ctx, "/sonm.Market/BidPlans", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) CancelBidPlan(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.Market/CancelBidPlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) ReplaceBidPlan(ctx context.Context, in *ReplaceBidPlanRequest, opts ...grpc.CallOption) (*BidPlan, error) {
+	out := new(BidPlan)
+	err := grpc.Invoke(ctx, "/sonm.Market/ReplaceBidPlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Market service
 
 type MarketServer interface {
@@ -658,6 +842,15 @@ type MarketServer interface {
 	GetOrderByID(context.Context, *ID) (*Order, error)
 	// CancelOrder removes active order from the Marketplace.
 	CancelOrder(context.Context, *ID) (*Empty, error)
+	// BidPlans returns bid plans known by the Node with their matching
+	// state, i.e. every BID order placed via `CreateOrder`.
+	BidPlans(context.Context, *Empty) (*BidPlansReply, error)
+	// CancelBidPlan stops matching the given bid plan and removes its
+	// order from the Marketplace.
+	CancelBidPlan(context.Context, *ID) (*Empty, error)
+	// ReplaceBidPlan cancels the current order of the given bid plan and
+	// places a new one with the specified price instead.
+	ReplaceBidPlan(context.Context, *ReplaceBidPlanRequest) (*BidPlan, error)
 }
 
 func RegisterMarketServer(s *grpc.Server, srv MarketServer) {
@@ -736,6 +929,60 @@ func _Market_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Market_BidPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).BidPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Market/BidPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).BidPlans(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_CancelBidPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).CancelBidPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Market/CancelBidPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).CancelBidPlan(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_ReplaceBidPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceBidPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).ReplaceBidPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Market/ReplaceBidPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).ReplaceBidPlan(ctx, req.(*ReplaceBidPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Market_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.Market",
 	HandlerType: (*MarketServer)(nil),
@@ -756,6 +1003,18 @@ var _Market_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Market_CancelOrder_Handler,
 		},
+		{
+			MethodName: "BidPlans",
+			Handler:    _Market_BidPlans_Handler,
+		},
+		{
+			MethodName: "CancelBidPlan",
+			Handler:    _Market_CancelBidPlan_Handler,
+		},
+		{
+			MethodName: "ReplaceBidPlan",
+			Handler:    _Market_ReplaceBidPlan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "marketplace.proto",
@@ -846,6 +1105,63 @@ var _Market_CancelOrderCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.ID"),
 }
 
+var _Market_BidPlansCmd = &cobra.Command{
+	Use:   "bidPlans",
+	Short: "Make the BidPlans method call, input-type: sonm.Empty output-type: sonm.BidPlansReply",
+	RunE: grpccmd.RunE(
+		"BidPlans",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewMarketClient(cc)
+		},
+	),
+}
+
+var _Market_BidPlansCmd_gen = &cobra.Command{
+	Use:   "bidPlans-gen",
+	Short: "Generate JSON for method call of BidPlans (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
+var _Market_CancelBidPlanCmd = &cobra.Command{
+	Use:   "cancelBidPlan",
+	Short: "Make the CancelBidPlan method call, input-type: sonm.ID output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"CancelBidPlan",
+		"sonm.ID",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewMarketClient(cc)
+		},
+	),
+}
+
+var _Market_CancelBidPlanCmd_gen = &cobra.Command{
+	Use:   "cancelBidPlan-gen",
+	Short: "Generate JSON for method call of CancelBidPlan (input-type: sonm.ID)",
+	RunE:  grpccmd.TypeToJson("sonm.ID"),
+}
+
+var _Market_ReplaceBidPlanCmd = &cobra.Command{
+	Use:   "replaceBidPlan",
+	Short: "Make the ReplaceBidPlan method call, input-type: sonm.ReplaceBidPlanRequest output-type: sonm.BidPlan",
+	RunE: grpccmd.RunE(
+		"ReplaceBidPlan",
+		"sonm.ReplaceBidPlanRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewMarketClient(cc)
+		},
+	),
+}
+
+var _Market_ReplaceBidPlanCmd_gen = &cobra.Command{
+	Use:   "replaceBidPlan-gen",
+	Short: "Generate JSON for method call of ReplaceBidPlan (input-type: sonm.ReplaceBidPlanRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ReplaceBidPlanRequest"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_MarketCmd)
@@ -858,83 +1174,104 @@ func init() {
 		_Market_GetOrderByIDCmd_gen,
 		_Market_CancelOrderCmd,
 		_Market_CancelOrderCmd_gen,
+		_Market_BidPlansCmd,
+		_Market_BidPlansCmd_gen,
+		_Market_CancelBidPlanCmd,
+		_Market_CancelBidPlanCmd_gen,
+		_Market_ReplaceBidPlanCmd,
+		_Market_ReplaceBidPlanCmd_gen,
 	)
 }
 
 // End grpccmd
 
-func init() { proto.RegisterFile("marketplace.proto", fileDescriptor7) }
-
-var fileDescriptor7 = []byte{
-	// 1111 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdf, 0x8f, 0xdb, 0x44,
-	0x10, 0x8e, 0x73, 0xb9, 0xfc, 0x98, 0x24, 0x4e, 0x6e, 0xef, 0x84, 0xac, 0xa8, 0x0f, 0x87, 0xa9,
-	0xca, 0x11, 0x95, 0x6b, 0x75, 0x2d, 0x52, 0x41, 0x42, 0x22, 0x89, 0x5d, 0x64, 0x7a, 0x4d, 0x8e,
-	0x4d, 0x0e, 0xd4, 0x17, 0xd0, 0x26, 0xde, 0xe6, 0x56, 0x71, 0xec, 0x60, 0xaf, 0x0f, 0x85, 0x37,
-	0x9e, 0xf9, 0x8b, 0x90, 0xe0, 0x8d, 0x3f, 0x0c, 0xed, 0xfa, 0x47, 0x36, 0xb9, 0x04, 0xfa, 0xe6,
-	0xf9, 0xe6, 0x9b, 0xf1, 0xec, 0xec, 0xb7, 0xb3, 0x0b, 0x27, 0x4b, 0x12, 0x2e, 0x28, 0x5f, 0x79,
-	0x64, 0x46, 0x2f, 0x57, 0x61, 0xc0, 0x03, 0x54, 0x8a, 0x02, 0x7f, 0xd9, 0x69, 0x4c, 0xd9, 0x9c,
-	0xf9, 0x3c, 0xc1, 0x3a, 0x2d, 0xe6, 0x0b, 0xd4, 0x67, 0x24, 0x03, 0x38, 0x5b, 0xd2, 0x88, 0x93,
-	0xe5, 0x2a, 0x01, 0xcc, 0x2f, 0x40, 0xff, 0x96, 0xf2, 0x51, 0xe8, 0xd2, 0x30, 0xc2, 0x74, 0xe5,
-	0xad, 0xd1, 0x27, 0x50, 0x0e, 0xa4, 0x69, 0x68, 0xe7, 0x47, 0x17, 0xf5, 0xab, 0xfa, 0xa5, 0x48,
-	0x71, 0x29, 0x29, 0x38, 0x75, 0x99, 0x8f, 0x01, 0xfa, 0xd4, 0x9f, 0xdd, 0x89, 0x32, 0x22, 0xf4,
-	0x11, 0x94, 0xef, 0x89, 0x17, 0xd3, 0x24, 0xa4, 0x84, 0x53, 0xcb, 0xfc, 0xe3, 0x18, 0x4a, 0x16,
-	0x25, 0x1e, 0x7a, 0x04, 0x45, 0xe6, 0x1a, 0xda, 0xb9, 0x76, 0x51, 0xbf, 0x6a, 0x24, 0xf9, 0xfa,
-	0x6c, 0xee, 0xf8, 0x1c, 0x17, 0x99, 0x8b, 0x9e, 0x03, 0x4c, 0xf3, 0x64, 0x46, 0x51, 0xb2, 0xda,
-	0x29, 0x2b, 0xc7, 0xb1, 0xc2, 0x11, 0x11, 0x51, 0xbc, 0x5a, 0x79, 0x8c, 0x86, 0x8e, 0x65, 0x1c,
-	0xa9, 0x11, 0x36, 0xbf, 0xeb, 0xb9, 0x6e, 0x48, 0xa3, 0x08, 0x2b, 0x1c, 0x11, 0x31, 0x0b, 0xfc,
-	0x28, 0x5e, 0xca, 0x88, 0xd2, 0xa1, 0x88, 0x0d, 0x07, 0x3d, 0x85, 0xea, 0x92, 0x44, 0x5c, 0xf2,
-	0x8f, 0x0f, 0xf0, 0x73, 0x06, 0x32, 0xe1, 0x98, 0x44, 0x0b, 0xc7, 0x32, 0xca, 0x7b, 0x16, 0x99,
-	0xb8, 0x04, 0x67, 0xca, 0x5c, 0xc7, 0x32, 0x2a, 0xfb, 0x38, 0xd2, 0x85, 0x3a, 0x50, 0x75, 0xe3,
-	0x90, 0x70, 0x16, 0xf8, 0x46, 0xf5, 0x5c, 0xbb, 0x28, 0xe1, 0xdc, 0x16, 0xf1, 0xab, 0x90, 0xcd,
-	0xa8, 0x51, 0xdb, 0x17, 0x2f, 0x5d, 0xe8, 0x73, 0xa8, 0x45, 0x9c, 0x84, 0x7c, 0xc2, 0x96, 0xd4,
-	0x00, 0xc9, 0x6b, 0x25, 0xbc, 0x49, 0xb6, 0xf3, 0x78, 0xc3, 0x40, 0x9f, 0x41, 0x85, 0xfa, 0xae,
-	0x24, 0xd7, 0xf7, 0x93, 0x33, 0x3f, 0xba, 0x80, 0x72, 0xc4, 0x09, 0x8f, 0x23, 0xa3, 0x71, 0xae,
-	0x5d, 0xe8, 0x59, 0x37, 0xc4, 0xfe, 0x8e, 0x25, 0x8e, 0x53, 0x3f, 0x7a, 0x09, 0xfa, 0xd4, 0x0b,
-	0x66, 0x0b, 0xea, 0xf6, 0x89, 0x47, 0xfc, 0x19, 0x35, 0x9a, 0x7b, 0x0a, 0xde, 0xe1, 0xa0, 0x4b,
-	0xa8, 0xf3, 0x80, 0x13, 0xef, 0x86, 0xac, 0x83, 0x98, 0x1b, 0xfa, 0x9e, 0x10, 0x95, 0x80, 0x9e,
-	0x01, 0x78, 0x24, 0xe2, 0x7d, 0xe6, 0x79, 0x93, 0xb1, 0xd1, 0xda, 0x5f, 0xbd, 0x42, 0x31, 0xff,
-	0x2a, 0xc1, 0xb1, 0x54, 0xf1, 0xff, 0xc8, 0xf1, 0x31, 0x94, 0x5d, 0x4a, 0x3c, 0xc7, 0x4a, 0xa5,
-	0xb8, 0xcd, 0x48, 0x7d, 0xa2, 0xd1, 0xf2, 0x2c, 0x4c, 0xd6, 0x2b, 0x2a, 0x15, 0xa8, 0x67, 0x7f,
-	0x1f, 0x65, 0x30, 0xde, 0x30, 0xd0, 0x0b, 0xa8, 0x4b, 0x23, 0x69, 0x95, 0x14, 0xa0, 0x7e, 0x75,
-	0xa2, 0x04, 0xa4, 0x3d, 0x54, 0x59, 0x42, 0x82, 0x24, 0xe6, 0x77, 0xc1, 0x7f, 0x4a, 0x30, 0x63,
-	0xa0, 0x57, 0xa0, 0xcf, 0x82, 0xd8, 0xe7, 0x34, 0x5c, 0x91, 0x90, 0xaf, 0x73, 0x2d, 0x3e, 0x8c,
-	0xd9, 0xe1, 0x6d, 0x89, 0xae, 0x72, 0x48, 0x74, 0xd5, 0xc3, 0xa2, 0xeb, 0x40, 0xd5, 0xa7, 0xfc,
-	0xbd, 0x47, 0xe6, 0x91, 0xd4, 0x66, 0x09, 0xe7, 0x36, 0xfa, 0x12, 0x9a, 0xcc, 0xa5, 0x3e, 0x67,
-	0x7c, 0x7d, 0x4d, 0xef, 0xa9, 0x27, 0x45, 0xa9, 0x5f, 0x9d, 0x26, 0x79, 0x1c, 0xd5, 0x85, 0xb7,
-	0x99, 0xe8, 0x11, 0xd4, 0xa6, 0x1e, 0x99, 0x2d, 0x3c, 0x16, 0x71, 0x29, 0xcf, 0x1a, 0xde, 0x00,
-	0xa8, 0x0d, 0x47, 0x9c, 0xcc, 0xa5, 0x18, 0x1b, 0x58, 0x7c, 0xee, 0xcc, 0x91, 0xe6, 0x07, 0xcc,
-	0x91, 0x2e, 0xd4, 0xde, 0x87, 0xc1, 0x6f, 0xd4, 0x1f, 0xc7, 0xcb, 0xbd, 0x8a, 0xdb, 0xb8, 0xcd,
-	0x9f, 0x00, 0xfa, 0xcc, 0x1d, 0x52, 0xfe, 0x6b, 0x10, 0x2e, 0x90, 0x01, 0x95, 0xe0, 0x9e, 0x86,
-	0x1e, 0x59, 0x4b, 0x1d, 0x55, 0x71, 0x66, 0x8a, 0x66, 0x04, 0x31, 0x9f, 0x06, 0xb1, 0xef, 0x4a,
-	0x01, 0x55, 0x71, 0x6e, 0x0b, 0x1f, 0xf3, 0x67, 0xc1, 0x92, 0xf9, 0x73, 0xa9, 0x99, 0x2a, 0xce,
-	0x6d, 0xf3, 0x6f, 0x0d, 0x1a, 0x7d, 0xe6, 0x62, 0x1a, 0x05, 0x71, 0x38, 0xa3, 0xa2, 0xb8, 0x8a,
-	0x9f, 0xfc, 0x2d, 0x95, 0x6a, 0xb6, 0x96, 0xbc, 0x0a, 0x9c, 0x11, 0x50, 0x7f, 0x67, 0x84, 0x8a,
-	0xc1, 0x6d, 0xe6, 0xf4, 0x3c, 0xa7, 0xd2, 0x07, 0xdb, 0xe7, 0xe1, 0x5a, 0x6d, 0x46, 0xe7, 0x6b,
-	0x68, 0xed, 0xb8, 0x45, 0x8f, 0x17, 0x34, 0x59, 0x61, 0x0d, 0x8b, 0x4f, 0x74, 0x06, 0xc7, 0x72,
-	0xb8, 0xcb, 0xa5, 0x95, 0x70, 0x62, 0x7c, 0x55, 0x7c, 0xa5, 0x99, 0xff, 0x14, 0xa1, 0xda, 0x67,
-	0x6e, 0x72, 0xc2, 0x74, 0x28, 0x3a, 0x56, 0x1a, 0x57, 0x74, 0x2c, 0xd4, 0x55, 0x14, 0x96, 0x9c,
-	0x2a, 0x3d, 0x1d, 0x1f, 0x29, 0xaa, 0x28, 0xee, 0xe3, 0x4c, 0x71, 0xc9, 0x5c, 0x4f, 0xef, 0x9f,
-	0x1b, 0x01, 0x65, 0x82, 0xbb, 0x54, 0x95, 0x71, 0x68, 0x98, 0x2b, 0x5a, 0x79, 0x06, 0xd5, 0x4c,
-	0x5a, 0xf2, 0x20, 0x1d, 0xd0, 0x5f, 0x4e, 0xca, 0xc4, 0x55, 0x4e, 0x16, 0x2e, 0xc4, 0xf5, 0x12,
-	0x1a, 0x03, 0xe5, 0xd4, 0xa4, 0x33, 0xfc, 0xe1, 0x5f, 0xb7, 0x58, 0xe8, 0x39, 0xd4, 0xc2, 0xac,
-	0xf9, 0xe9, 0x09, 0x42, 0x0f, 0xb7, 0x05, 0x6f, 0x48, 0xdd, 0x27, 0x50, 0xcb, 0x07, 0x08, 0xaa,
-	0xc0, 0x51, 0x6f, 0xf8, 0xae, 0x5d, 0x10, 0x1f, 0x7d, 0xc7, 0x6a, 0x6b, 0x12, 0x19, 0xbf, 0x69,
-	0x17, 0xbb, 0xaf, 0xa1, 0xae, 0xcc, 0x0d, 0x74, 0x02, 0xcd, 0x11, 0xb6, 0x6c, 0xfc, 0xf3, 0xed,
-	0xf0, 0xcd, 0x70, 0xf4, 0xe3, 0xb0, 0x5d, 0x40, 0x08, 0xf4, 0x04, 0x72, 0x86, 0xbd, 0xc1, 0xc4,
-	0xf9, 0xc1, 0x6e, 0x6b, 0xa8, 0x0d, 0x8d, 0x04, 0x4b, 0x91, 0x62, 0xf7, 0x1b, 0x68, 0x6e, 0x35,
-	0x01, 0x35, 0xa1, 0xd6, 0x1b, 0x8e, 0x86, 0xef, 0xde, 0x8e, 0x6e, 0xc7, 0xed, 0x82, 0x88, 0xb8,
-	0x19, 0xdb, 0xb7, 0x56, 0x86, 0x68, 0x48, 0x07, 0x70, 0x2c, 0x7b, 0x38, 0x71, 0x5e, 0x3b, 0xb6,
-	0xd5, 0x2e, 0x76, 0xfb, 0x00, 0x9b, 0x4b, 0x40, 0xf0, 0x2d, 0xbb, 0x77, 0xad, 0xd4, 0x71, 0x02,
-	0x4d, 0x89, 0xf4, 0x06, 0x03, 0xfb, 0x66, 0x62, 0x8b, 0x55, 0xb4, 0xa0, 0x2e, 0xa1, 0xc1, 0xf5,
-	0x68, 0x2c, 0x73, 0xfc, 0xae, 0xc1, 0xe9, 0xe0, 0x8e, 0xf8, 0x73, 0x8a, 0xe9, 0x2f, 0x31, 0x8d,
-	0x78, 0x9a, 0xed, 0x14, 0x5a, 0xd8, 0xfe, 0xfe, 0xd6, 0x1e, 0x4f, 0x94, 0x84, 0x0a, 0x38, 0xc0,
-	0x76, 0x2f, 0x49, 0x79, 0x06, 0xed, 0x1c, 0xec, 0x0d, 0x07, 0xf6, 0xb5, 0xc8, 0xab, 0xa2, 0xd8,
-	0xfe, 0xce, 0x1e, 0x08, 0xee, 0x91, 0x8a, 0xe6, 0x45, 0x95, 0xae, 0xfe, 0xd4, 0xa0, 0xfc, 0x56,
-	0x3e, 0xab, 0xc4, 0xb6, 0xe5, 0xaf, 0x22, 0x94, 0x0a, 0x50, 0xee, 0x6b, 0xe7, 0x2c, 0x31, 0xb6,
-	0xdf, 0x4c, 0x66, 0x01, 0x3d, 0x85, 0xfa, 0x20, 0xa4, 0x84, 0xd3, 0x54, 0xff, 0xf9, 0x26, 0x4b,
-	0xbb, 0xa3, 0x3e, 0xa2, 0xcc, 0x02, 0xfa, 0x14, 0x1a, 0x59, 0x86, 0xbe, 0x18, 0xc0, 0xd5, 0x54,
-	0x8d, 0xd6, 0x2e, 0xf1, 0x09, 0xd4, 0x07, 0xe2, 0x76, 0xf4, 0x92, 0xb4, 0x0f, 0x78, 0xf6, 0x72,
-	0xc5, 0xd7, 0x66, 0x61, 0x5a, 0x96, 0xaf, 0xb9, 0x17, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xfd,
-	0xdf, 0x9c, 0x1c, 0x18, 0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("marketplace.proto", fileDescriptor8) }
+
+var fileDescriptor8 = []byte{
+	// 1349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0xb6, 0x65, 0xc7, 0x96, 0x8f, 0x7f, 0xe2, 0x6c, 0x0a, 0xa3, 0x31, 0xbd, 0x48, 0xd5, 0x4e,
+	0x09, 0x99, 0xe2, 0x76, 0xd2, 0x32, 0x53, 0x98, 0x29, 0x83, 0xf5, 0xd3, 0xa2, 0x36, 0xb5, 0xc3,
+	0xda, 0x81, 0xe9, 0x0d, 0x9d, 0xb5, 0xb5, 0x4d, 0x34, 0x91, 0x25, 0x23, 0xad, 0xca, 0x98, 0x3b,
+	0xae, 0x79, 0x03, 0x9e, 0x05, 0xee, 0x78, 0x03, 0x1e, 0x86, 0x5b, 0x66, 0x57, 0x2b, 0x59, 0x76,
+	0xec, 0xc2, 0x9d, 0xf7, 0x3b, 0xdf, 0x39, 0xda, 0x3d, 0xe7, 0x3b, 0x67, 0xd7, 0x70, 0x30, 0x27,
+	0xd1, 0x35, 0x65, 0x0b, 0x9f, 0xcc, 0x68, 0x7f, 0x11, 0x85, 0x2c, 0x44, 0xd5, 0x38, 0x0c, 0xe6,
+	0xbd, 0xd6, 0xd4, 0xbb, 0xf4, 0x02, 0x96, 0x62, 0xbd, 0x7d, 0x2f, 0xe0, 0x68, 0xe0, 0x91, 0x0c,
+	0x60, 0xde, 0x9c, 0xc6, 0x8c, 0xcc, 0x17, 0x29, 0xa0, 0x7f, 0x01, 0x9d, 0x17, 0x94, 0x8d, 0x22,
+	0x97, 0x46, 0x31, 0xa6, 0x0b, 0x7f, 0x89, 0xee, 0x42, 0x2d, 0x14, 0x4b, 0xad, 0x7c, 0x54, 0x39,
+	0x6e, 0x9e, 0x36, 0xfb, 0x3c, 0x44, 0x5f, 0x50, 0xb0, 0x34, 0xe9, 0xf7, 0x00, 0x0c, 0x1a, 0xcc,
+	0xae, 0xf8, 0x36, 0x62, 0xf4, 0x31, 0xd4, 0xde, 0x13, 0x3f, 0xa1, 0xa9, 0x4b, 0x15, 0xcb, 0x95,
+	0xfe, 0xdb, 0x1e, 0x54, 0x2d, 0x4a, 0x7c, 0x74, 0x1b, 0x14, 0xcf, 0xd5, 0xca, 0x47, 0xe5, 0xe3,
+	0xe6, 0x69, 0x2b, 0x8d, 0x67, 0x78, 0x97, 0x4e, 0xc0, 0xb0, 0xe2, 0xb9, 0xe8, 0x11, 0xc0, 0x34,
+	0x0f, 0xa6, 0x29, 0x82, 0xd5, 0x95, 0xac, 0x1c, 0xc7, 0x05, 0x0e, 0xf7, 0x88, 0x93, 0xc5, 0xc2,
+	0xf7, 0x68, 0xe4, 0x58, 0x5a, 0xa5, 0xe8, 0x61, 0xb3, 0xab, 0x81, 0xeb, 0x46, 0x34, 0x8e, 0x71,
+	0x81, 0xc3, 0x3d, 0x66, 0x61, 0x10, 0x27, 0x73, 0xe1, 0x51, 0xdd, 0xe5, 0xb1, 0xe2, 0xa0, 0x07,
+	0xa0, 0xce, 0x49, 0xcc, 0x04, 0x7f, 0x6f, 0x07, 0x3f, 0x67, 0x20, 0x1d, 0xf6, 0x48, 0x7c, 0xed,
+	0x58, 0x5a, 0x6d, 0xcb, 0x21, 0x53, 0x13, 0xe7, 0x4c, 0x3d, 0xd7, 0xb1, 0xb4, 0xfa, 0x36, 0x8e,
+	0x30, 0xa1, 0x1e, 0xa8, 0x6e, 0x12, 0x11, 0xe6, 0x85, 0x81, 0xa6, 0x1e, 0x95, 0x8f, 0xab, 0x38,
+	0x5f, 0x73, 0xff, 0x45, 0xe4, 0xcd, 0xa8, 0xd6, 0xd8, 0xe6, 0x2f, 0x4c, 0xe8, 0x73, 0x68, 0xc4,
+	0x8c, 0x44, 0x6c, 0xe2, 0xcd, 0xa9, 0x06, 0x82, 0xb7, 0x9f, 0xf2, 0x26, 0x59, 0xe5, 0xf1, 0x8a,
+	0x81, 0x3e, 0x83, 0x3a, 0x0d, 0x5c, 0x41, 0x6e, 0x6e, 0x27, 0x67, 0x76, 0x74, 0x0c, 0xb5, 0x98,
+	0x11, 0x96, 0xc4, 0x5a, 0xeb, 0xa8, 0x7c, 0xdc, 0xc9, 0xb2, 0xc1, 0xeb, 0x3b, 0x16, 0x38, 0x96,
+	0x76, 0xf4, 0x04, 0x3a, 0x53, 0x3f, 0x9c, 0x5d, 0x53, 0xd7, 0x20, 0x3e, 0x09, 0x66, 0x54, 0x6b,
+	0x6f, 0xd9, 0xf0, 0x06, 0x07, 0xf5, 0xa1, 0xc9, 0x42, 0x46, 0xfc, 0x73, 0xb2, 0x0c, 0x13, 0xa6,
+	0x75, 0xb6, 0xb8, 0x14, 0x09, 0xe8, 0x21, 0x80, 0x4f, 0x62, 0x66, 0x78, 0xbe, 0x3f, 0x19, 0x6b,
+	0xfb, 0xdb, 0x77, 0x5f, 0xa0, 0xe8, 0x7f, 0x54, 0x61, 0x4f, 0xa8, 0xf8, 0x3f, 0xe4, 0x78, 0x0f,
+	0x6a, 0x2e, 0x25, 0xbe, 0x63, 0x69, 0xca, 0x16, 0x86, 0xb4, 0xf1, 0x44, 0x8b, 0x5e, 0x98, 0x2c,
+	0x17, 0x54, 0x28, 0xb0, 0x93, 0x7d, 0x7d, 0x94, 0xc1, 0x78, 0xc5, 0x40, 0x8f, 0xa1, 0x29, 0x16,
+	0x69, 0xaa, 0x84, 0x00, 0x3b, 0xa7, 0x07, 0x05, 0x07, 0x99, 0xc3, 0x22, 0x8b, 0x4b, 0x90, 0x24,
+	0xec, 0x2a, 0xfc, 0xa0, 0x04, 0x33, 0x06, 0x7a, 0x0a, 0x9d, 0x59, 0x98, 0x04, 0x8c, 0x46, 0x0b,
+	0x12, 0xb1, 0x65, 0xae, 0xc5, 0x9b, 0x3e, 0x1b, 0xbc, 0x35, 0xd1, 0xd5, 0x77, 0x89, 0x4e, 0xdd,
+	0x2d, 0xba, 0x1e, 0xa8, 0x01, 0x65, 0xef, 0x7c, 0x72, 0x19, 0x0b, 0x6d, 0x56, 0x71, 0xbe, 0x46,
+	0x5f, 0x42, 0xdb, 0x73, 0x69, 0xc0, 0x3c, 0xb6, 0x3c, 0xa3, 0xef, 0xa9, 0x2f, 0x44, 0xd9, 0x39,
+	0x3d, 0x4c, 0xe3, 0x38, 0x45, 0x13, 0x5e, 0x67, 0xa2, 0xdb, 0xd0, 0x98, 0xfa, 0x64, 0x76, 0xed,
+	0x7b, 0x31, 0x13, 0xf2, 0x6c, 0xe0, 0x15, 0x80, 0xba, 0x50, 0x61, 0xe4, 0x52, 0x88, 0xb1, 0x85,
+	0xf9, 0xcf, 0x8d, 0x39, 0xd2, 0xfe, 0x1f, 0x73, 0xe4, 0x04, 0x1a, 0xef, 0xa2, 0xf0, 0x17, 0x1a,
+	0x8c, 0x93, 0xf9, 0x56, 0xc5, 0xad, 0xcc, 0xfa, 0x8f, 0x00, 0x86, 0xe7, 0x0e, 0x29, 0xfb, 0x39,
+	0x8c, 0xae, 0x91, 0x06, 0xf5, 0xf0, 0x3d, 0x8d, 0x7c, 0xb2, 0x14, 0x3a, 0x52, 0x71, 0xb6, 0xe4,
+	0xc9, 0x08, 0x13, 0x36, 0x0d, 0x93, 0xc0, 0x15, 0x02, 0x52, 0x71, 0xbe, 0xe6, 0x36, 0x2f, 0x98,
+	0x85, 0x73, 0x2f, 0xb8, 0x14, 0x9a, 0x51, 0x71, 0xbe, 0xd6, 0xff, 0x2c, 0x43, 0xcb, 0xf0, 0x5c,
+	0x4c, 0xe3, 0x30, 0x89, 0x66, 0x94, 0x6f, 0xae, 0x1e, 0xa4, 0x5f, 0xd3, 0xca, 0x6b, 0x67, 0xc9,
+	0x77, 0x81, 0x33, 0x02, 0x32, 0x36, 0x46, 0x28, 0x1f, 0xdc, 0x7a, 0x4e, 0xcf, 0x63, 0x16, 0xf2,
+	0x60, 0x07, 0x2c, 0x5a, 0x16, 0x93, 0xd1, 0x7b, 0x06, 0xfb, 0x1b, 0x66, 0x9e, 0xe3, 0x6b, 0x9a,
+	0x9e, 0xb0, 0x81, 0xf9, 0x4f, 0x74, 0x0b, 0xf6, 0xc4, 0x70, 0x17, 0x47, 0xab, 0xe2, 0x74, 0xf1,
+	0x95, 0xf2, 0xb4, 0xac, 0xff, 0xa5, 0x80, 0x6a, 0x78, 0x6e, 0xda, 0x61, 0x1d, 0x50, 0x1c, 0x4b,
+	0xfa, 0x29, 0x8e, 0x85, 0x4e, 0x0a, 0x0a, 0x4b, 0xbb, 0xaa, 0x23, 0xc7, 0x87, 0x44, 0x0b, 0x8a,
+	0xbb, 0x93, 0x29, 0x2e, 0x9d, 0xeb, 0xf2, 0xfe, 0x39, 0xe7, 0x50, 0x26, 0xb8, 0x7e, 0x51, 0x19,
+	0xbb, 0x86, 0xf9, 0x8a, 0x82, 0x1e, 0x82, 0x9a, 0x49, 0x4b, 0xdb, 0xdb, 0xad, 0xbf, 0x9c, 0x94,
+	0x89, 0xab, 0x96, 0x1e, 0x9c, 0x8b, 0xeb, 0x09, 0xb4, 0xcc, 0x42, 0xd7, 0x68, 0xf5, 0x1d, 0x5f,
+	0x5d, 0x63, 0xa1, 0x47, 0xd0, 0x88, 0xb2, 0xe4, 0xcb, 0x0e, 0x42, 0x37, 0xcb, 0x82, 0x57, 0x24,
+	0xfd, 0x1f, 0x05, 0xea, 0x86, 0xe7, 0x9e, 0xfb, 0x24, 0xb8, 0x91, 0xc5, 0xfb, 0x50, 0x17, 0xe3,
+	0x61, 0xc7, 0x68, 0xca, 0x8c, 0x85, 0x09, 0x56, 0xf9, 0xc0, 0x04, 0x3b, 0x82, 0xca, 0xd4, 0x73,
+	0xb5, 0x6a, 0xb1, 0x1c, 0x59, 0x01, 0x31, 0x37, 0xa1, 0x07, 0xf9, 0xc8, 0x4f, 0x93, 0x76, 0x2b,
+	0x27, 0xf1, 0xed, 0xf5, 0x37, 0xc6, 0xfe, 0x6d, 0x68, 0xf0, 0x69, 0x6b, 0x47, 0x51, 0x18, 0xc9,
+	0xcc, 0xad, 0x00, 0x3e, 0x2f, 0x67, 0x11, 0x25, 0x8c, 0xba, 0x03, 0x26, 0x93, 0x77, 0xf3, 0x62,
+	0xca, 0x19, 0x9c, 0x9e, 0x2c, 0x5c, 0x49, 0x57, 0x77, 0xd0, 0x73, 0x86, 0x6e, 0x40, 0x4d, 0xce,
+	0xcc, 0x16, 0xa8, 0xaf, 0x07, 0x13, 0xf3, 0x5b, 0x67, 0xf8, 0xa2, 0x5b, 0x42, 0xfb, 0xd0, 0xb4,
+	0xec, 0xc1, 0xd9, 0xdb, 0xd1, 0xb9, 0x3d, 0xb4, 0xad, 0x6e, 0x19, 0xb5, 0xa1, 0x61, 0x0e, 0x86,
+	0xa6, 0x7d, 0x76, 0x66, 0x5b, 0x5d, 0x05, 0x01, 0xd4, 0x9e, 0x0f, 0x1c, 0xfe, 0xbb, 0xa2, 0xff,
+	0x5e, 0x86, 0xb6, 0x3c, 0x9a, 0x7c, 0x0a, 0x3d, 0x03, 0x75, 0x2a, 0x01, 0xf9, 0x18, 0xba, 0xb3,
+	0x96, 0x81, 0x94, 0x96, 0xaf, 0xd2, 0x96, 0xca, 0x5d, 0x7a, 0x2f, 0xa1, 0xbd, 0x66, 0xda, 0xd2,
+	0x4e, 0x77, 0x8b, 0xed, 0xd4, 0x3c, 0x6d, 0xaf, 0x85, 0x2f, 0x76, 0xd7, 0x4b, 0xf8, 0x88, 0x7f,
+	0x8c, 0xcc, 0x68, 0x66, 0xa4, 0x3f, 0x25, 0x34, 0x66, 0x37, 0x34, 0x92, 0x77, 0x8f, 0xb2, 0xab,
+	0x7b, 0x4e, 0xee, 0x43, 0x23, 0xbf, 0xa3, 0x50, 0x1d, 0x2a, 0x83, 0xe1, 0x9b, 0x6e, 0x89, 0xff,
+	0x30, 0x1c, 0x9e, 0x22, 0x8e, 0x8c, 0x5f, 0x75, 0x95, 0x93, 0xe7, 0xd0, 0x2c, 0x5c, 0x4d, 0xe8,
+	0x00, 0xda, 0x23, 0x6c, 0xd9, 0xf8, 0xed, 0xc5, 0xf0, 0xd5, 0x70, 0xf4, 0xc3, 0xb0, 0x5b, 0x42,
+	0x08, 0x3a, 0x29, 0xe4, 0x0c, 0x07, 0xe6, 0xc4, 0xf9, 0xde, 0xee, 0x96, 0x51, 0x17, 0x5a, 0x29,
+	0x26, 0x11, 0xe5, 0xe4, 0x1b, 0x68, 0xaf, 0xf5, 0x19, 0x2f, 0xc2, 0x60, 0x38, 0x1a, 0xbe, 0x79,
+	0x3d, 0xba, 0x18, 0x77, 0x4b, 0xdc, 0xe3, 0x7c, 0x6c, 0x5f, 0x58, 0x19, 0x52, 0x46, 0x1d, 0x00,
+	0xc7, 0xb2, 0x87, 0x13, 0xe7, 0xb9, 0xc3, 0xcb, 0x74, 0x62, 0x00, 0xac, 0xde, 0x19, 0x9c, 0x2f,
+	0x8a, 0xba, 0xda, 0xc7, 0x01, 0xb4, 0x05, 0x32, 0x30, 0x4d, 0xfb, 0x7c, 0x22, 0x0a, 0x9d, 0x55,
+	0xde, 0x3c, 0x1b, 0x8d, 0x45, 0x8c, 0x5f, 0xcb, 0x70, 0x68, 0x5e, 0x91, 0xe0, 0x92, 0xca, 0xd4,
+	0xc9, 0x68, 0x87, 0xb0, 0x8f, 0xed, 0xef, 0x2e, 0xec, 0xf1, 0xa4, 0x10, 0xb0, 0x00, 0x9a, 0xd8,
+	0x1e, 0xa4, 0x21, 0x6f, 0x41, 0x37, 0x07, 0x85, 0x86, 0x84, 0x84, 0x0a, 0x28, 0xb6, 0x5f, 0xda,
+	0x26, 0xe7, 0x56, 0x8a, 0x68, 0xbe, 0xa9, 0xea, 0xe9, 0xdf, 0x0a, 0xd4, 0x5e, 0x8b, 0x97, 0x3b,
+	0x9f, 0x0c, 0xf9, 0xc3, 0x1b, 0xc9, 0x2a, 0x89, 0xd1, 0xd1, 0x93, 0x5d, 0xb6, 0xfe, 0x2c, 0xd7,
+	0x4b, 0xe8, 0x01, 0x34, 0x4d, 0xd1, 0x1f, 0x72, 0xc4, 0xae, 0x77, 0x6c, 0xaf, 0xf8, 0x4e, 0xd7,
+	0x4b, 0xe8, 0x53, 0x68, 0x65, 0x11, 0x0c, 0x7e, 0xc7, 0xab, 0xa9, 0xd9, 0xb1, 0x36, 0x89, 0xf7,
+	0xa1, 0x69, 0xf2, 0x07, 0x98, 0x9f, 0x86, 0xbd, 0xc1, 0xb3, 0xe7, 0x0b, 0xc6, 0x3f, 0xdf, 0x07,
+	0x55, 0x4a, 0x2f, 0x46, 0x45, 0x53, 0xef, 0x70, 0x4b, 0x4f, 0xe8, 0x25, 0x74, 0x0c, 0xed, 0x34,
+	0xae, 0x34, 0xec, 0x8e, 0xfc, 0x35, 0x74, 0xd6, 0xb5, 0x8d, 0x3e, 0x49, 0x09, 0x5b, 0x15, 0xdf,
+	0x5b, 0x6f, 0x12, 0xbd, 0x34, 0xad, 0x89, 0xbf, 0x32, 0x8f, 0xff, 0x1d, 0x00, 0x23, 0x33, 0xc4,
+	0x6b, 0x15, 0x0d, 0x00, 0x00,
 }
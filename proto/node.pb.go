@@ -25,6 +25,42 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type TaskDeployment_Status int32
+
+const (
+	// Waiting for the deal to be opened.
+	TaskDeployment_PENDING TaskDeployment_Status = 0
+	// Deal is opened, trying to start the task.
+	TaskDeployment_DEPLOYING TaskDeployment_Status = 1
+	// Task is started, see `taskID`.
+	TaskDeployment_STARTED TaskDeployment_Status = 2
+	// Task can not be started before the deadline or the deal is
+	// closed.
+	TaskDeployment_FAILED TaskDeployment_Status = 3
+	// Deal is lost, placing a replacement BID order.
+	TaskDeployment_MIGRATING TaskDeployment_Status = 4
+)
+
+var TaskDeployment_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "DEPLOYING",
+	2: "STARTED",
+	3: "FAILED",
+	4: "MIGRATING",
+}
+var TaskDeployment_Status_value = map[string]int32{
+	"PENDING":   0,
+	"DEPLOYING": 1,
+	"STARTED":   2,
+	"FAILED":    3,
+	"MIGRATING": 4,
+}
+
+func (x TaskDeployment_Status) String() string {
+	return proto.EnumName(TaskDeployment_Status_name, int32(x))
+}
+func (TaskDeployment_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor10, []int{3, 0} }
+
 type JoinNetworkRequest struct {
 	TaskID    *TaskID `protobuf:"bytes,1,opt,name=taskID" json:"taskID,omitempty"`
 	NetworkID string  `protobuf:"bytes,2,opt,name=NetworkID" json:"NetworkID,omitempty"`
@@ -33,7 +69,7 @@ type JoinNetworkRequest struct {
 func (m *JoinNetworkRequest) Reset()                    { *m = JoinNetworkRequest{} }
 func (m *JoinNetworkRequest) String() string            { return proto.CompactTextString(m) }
 func (*JoinNetworkRequest) ProtoMessage()               {}
-func (*JoinNetworkRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
+func (*JoinNetworkRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
 
 func (m *JoinNetworkRequest) GetTaskID() *TaskID {
 	if m != nil {
@@ -56,7 +92,7 @@ type TaskListRequest struct {
 func (m *TaskListRequest) Reset()                    { *m = TaskListRequest{} }
 func (m *TaskListRequest) String() string            { return proto.CompactTextString(m) }
 func (*TaskListRequest) ProtoMessage()               {}
-func (*TaskListRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{1} }
+func (*TaskListRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{1} }
 
 func (m *TaskListRequest) GetDealID() *BigInt {
 	if m != nil {
@@ -65,6 +101,202 @@ func (m *TaskListRequest) GetDealID() *BigInt {
 	return nil
 }
 
+type TaskDeploymentRequest struct {
+	// OrderID points to the BID order placed via the Node.
+	OrderID *BigInt   `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
+	Spec    *TaskSpec `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	// Deadline limits time to start the task after the deal is opened. The
+	// deal is closed if the task can not be started in time.
+	// Zero value means retrying until the deal is closed.
+	Deadline *Duration `protobuf:"bytes,3,opt,name=deadline" json:"deadline,omitempty"`
+	// KeepAlive enables migration of the task to a new deal when the
+	// current one is closed or its worker becomes unreachable.
+	KeepAlive bool `protobuf:"varint,4,opt,name=keepAlive" json:"keepAlive,omitempty"`
+}
+
+func (m *TaskDeploymentRequest) Reset()                    { *m = TaskDeploymentRequest{} }
+func (m *TaskDeploymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*TaskDeploymentRequest) ProtoMessage()               {}
+func (*TaskDeploymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{2} }
+
+func (m *TaskDeploymentRequest) GetOrderID() *BigInt {
+	if m != nil {
+		return m.OrderID
+	}
+	return nil
+}
+
+func (m *TaskDeploymentRequest) GetSpec() *TaskSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *TaskDeploymentRequest) GetDeadline() *Duration {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *TaskDeploymentRequest) GetKeepAlive() bool {
+	if m != nil {
+		return m.KeepAlive
+	}
+	return false
+}
+
+type TaskDeployment struct {
+	BidPlanID string                `protobuf:"bytes,1,opt,name=bidPlanID" json:"bidPlanID,omitempty"`
+	DealID    *BigInt               `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	Spec      *TaskSpec             `protobuf:"bytes,3,opt,name=spec" json:"spec,omitempty"`
+	Deadline  *Duration             `protobuf:"bytes,4,opt,name=deadline" json:"deadline,omitempty"`
+	Status    TaskDeployment_Status `protobuf:"varint,5,opt,name=status,enum=sonm.TaskDeployment_Status" json:"status,omitempty"`
+	TaskID    string                `protobuf:"bytes,6,opt,name=taskID" json:"taskID,omitempty"`
+	Attempts  uint64                `protobuf:"varint,7,opt,name=attempts" json:"attempts,omitempty"`
+	LastError string                `protobuf:"bytes,8,opt,name=lastError" json:"lastError,omitempty"`
+	// DealOpenedAt is the time the Node has noticed the deal.
+	DealOpenedAt *Timestamp `protobuf:"bytes,9,opt,name=dealOpenedAt" json:"dealOpenedAt,omitempty"`
+	UpdatedAt    *Timestamp `protobuf:"bytes,10,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	KeepAlive    bool       `protobuf:"varint,11,opt,name=keepAlive" json:"keepAlive,omitempty"`
+	// Migrations is the number of times the task has been moved to a new
+	// deal.
+	Migrations uint64 `protobuf:"varint,12,opt,name=migrations" json:"migrations,omitempty"`
+	// Deal and task the workload is being migrated from.
+	PreviousDealID *BigInt `protobuf:"bytes,13,opt,name=previousDealID" json:"previousDealID,omitempty"`
+	PreviousTaskID string  `protobuf:"bytes,14,opt,name=previousTaskID" json:"previousTaskID,omitempty"`
+	// RestoredImage is the committed image of the previous task to be pushed
+	// to the new worker instead of the original one.
+	RestoredImage string `protobuf:"bytes,15,opt,name=restoredImage" json:"restoredImage,omitempty"`
+}
+
+func (m *TaskDeployment) Reset()                    { *m = TaskDeployment{} }
+func (m *TaskDeployment) String() string            { return proto.CompactTextString(m) }
+func (*TaskDeployment) ProtoMessage()               {}
+func (*TaskDeployment) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{3} }
+
+func (m *TaskDeployment) GetBidPlanID() string {
+	if m != nil {
+		return m.BidPlanID
+	}
+	return ""
+}
+
+func (m *TaskDeployment) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *TaskDeployment) GetSpec() *TaskSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *TaskDeployment) GetDeadline() *Duration {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *TaskDeployment) GetStatus() TaskDeployment_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TaskDeployment_PENDING
+}
+
+func (m *TaskDeployment) GetTaskID() string {
+	if m != nil {
+		return m.TaskID
+	}
+	return ""
+}
+
+func (m *TaskDeployment) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *TaskDeployment) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *TaskDeployment) GetDealOpenedAt() *Timestamp {
+	if m != nil {
+		return m.DealOpenedAt
+	}
+	return nil
+}
+
+func (m *TaskDeployment) GetUpdatedAt() *Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *TaskDeployment) GetKeepAlive() bool {
+	if m != nil {
+		return m.KeepAlive
+	}
+	return false
+}
+
+func (m *TaskDeployment) GetMigrations() uint64 {
+	if m != nil {
+		return m.Migrations
+	}
+	return 0
+}
+
+func (m *TaskDeployment) GetPreviousDealID() *BigInt {
+	if m != nil {
+		return m.PreviousDealID
+	}
+	return nil
+}
+
+func (m *TaskDeployment) GetPreviousTaskID() string {
+	if m != nil {
+		return m.PreviousTaskID
+	}
+	return ""
+}
+
+func (m *TaskDeployment) GetRestoredImage() string {
+	if m != nil {
+		return m.RestoredImage
+	}
+	return ""
+}
+
+type TaskDeploymentsReply struct {
+	Deployments map[string]*TaskDeployment `protobuf:"bytes,1,rep,name=deployments" json:"deployments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *TaskDeploymentsReply) Reset()                    { *m = TaskDeploymentsReply{} }
+func (m *TaskDeploymentsReply) String() string            { return proto.CompactTextString(m) }
+func (*TaskDeploymentsReply) ProtoMessage()               {}
+func (*TaskDeploymentsReply) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{4} }
+
+func (m *TaskDeploymentsReply) GetDeployments() map[string]*TaskDeployment {
+	if m != nil {
+		return m.Deployments
+	}
+	return nil
+}
+
 type DealFinishRequest struct {
 	Id             *BigInt `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	AddToBlacklist bool    `protobuf:"varint,2,opt,name=addToBlacklist" json:"addToBlacklist,omitempty"`
@@ -73,7 +305,7 @@ type DealFinishRequest struct {
 func (m *DealFinishRequest) Reset()                    { *m = DealFinishRequest{} }
 func (m *DealFinishRequest) String() string            { return proto.CompactTextString(m) }
 func (*DealFinishRequest) ProtoMessage()               {}
-func (*DealFinishRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{2} }
+func (*DealFinishRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{5} }
 
 func (m *DealFinishRequest) GetId() *BigInt {
 	if m != nil {
@@ -96,7 +328,7 @@ type DealsReply struct {
 func (m *DealsReply) Reset()                    { *m = DealsReply{} }
 func (m *DealsReply) String() string            { return proto.CompactTextString(m) }
 func (*DealsReply) ProtoMessage()               {}
-func (*DealsReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{3} }
+func (*DealsReply) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{6} }
 
 func (m *DealsReply) GetDeal() []*Deal {
 	if m != nil {
@@ -113,7 +345,7 @@ type OpenDealRequest struct {
 func (m *OpenDealRequest) Reset()                    { *m = OpenDealRequest{} }
 func (m *OpenDealRequest) String() string            { return proto.CompactTextString(m) }
 func (*OpenDealRequest) ProtoMessage()               {}
-func (*OpenDealRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+func (*OpenDealRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{7} }
 
 func (m *OpenDealRequest) GetBidID() *BigInt {
 	if m != nil {
@@ -137,7 +369,7 @@ type WorkerRemoveRequest struct {
 func (m *WorkerRemoveRequest) Reset()                    { *m = WorkerRemoveRequest{} }
 func (m *WorkerRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*WorkerRemoveRequest) ProtoMessage()               {}
-func (*WorkerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+func (*WorkerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{8} }
 
 func (m *WorkerRemoveRequest) GetMaster() *EthAddress {
 	if m != nil {
@@ -160,7 +392,7 @@ type WorkerListReply struct {
 func (m *WorkerListReply) Reset()                    { *m = WorkerListReply{} }
 func (m *WorkerListReply) String() string            { return proto.CompactTextString(m) }
 func (*WorkerListReply) ProtoMessage()               {}
-func (*WorkerListReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{6} }
+func (*WorkerListReply) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{9} }
 
 func (m *WorkerListReply) GetWorkers() []*DWHWorker {
 	if m != nil {
@@ -177,7 +409,7 @@ type BalanceReply struct {
 func (m *BalanceReply) Reset()                    { *m = BalanceReply{} }
 func (m *BalanceReply) String() string            { return proto.CompactTextString(m) }
 func (*BalanceReply) ProtoMessage()               {}
-func (*BalanceReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{7} }
+func (*BalanceReply) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{10} }
 
 func (m *BalanceReply) GetLiveBalance() *BigInt {
 	if m != nil {
@@ -193,15 +425,140 @@ func (m *BalanceReply) GetSideBalance() *BigInt {
 	return nil
 }
 
+type TokenTransferRequest struct {
+	To     *EthAddress `protobuf:"bytes,1,opt,name=to" json:"to,omitempty"`
+	Amount *BigInt     `protobuf:"bytes,2,opt,name=amount" json:"amount,omitempty"`
+	// Sidechain means transferring side-chain tokens instead of live-chain
+	// ones.
+	Sidechain bool `protobuf:"varint,3,opt,name=sidechain" json:"sidechain,omitempty"`
+}
+
+func (m *TokenTransferRequest) Reset()                    { *m = TokenTransferRequest{} }
+func (m *TokenTransferRequest) String() string            { return proto.CompactTextString(m) }
+func (*TokenTransferRequest) ProtoMessage()               {}
+func (*TokenTransferRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{11} }
+
+func (m *TokenTransferRequest) GetTo() *EthAddress {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *TokenTransferRequest) GetAmount() *BigInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TokenTransferRequest) GetSidechain() bool {
+	if m != nil {
+		return m.Sidechain
+	}
+	return false
+}
+
+type TokenTransaction struct {
+	// Hash of the confirmed transaction.
+	Hash        string `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=blockNumber" json:"blockNumber,omitempty"`
+}
+
+func (m *TokenTransaction) Reset()                    { *m = TokenTransaction{} }
+func (m *TokenTransaction) String() string            { return proto.CompactTextString(m) }
+func (*TokenTransaction) ProtoMessage()               {}
+func (*TokenTransaction) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{12} }
+
+func (m *TokenTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TokenTransaction) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+type ProfileReply struct {
+	Profile      *Profile       `protobuf:"bytes,1,opt,name=profile" json:"profile,omitempty"`
+	Certificates []*Certificate `protobuf:"bytes,2,rep,name=certificates" json:"certificates,omitempty"`
+}
+
+func (m *ProfileReply) Reset()                    { *m = ProfileReply{} }
+func (m *ProfileReply) String() string            { return proto.CompactTextString(m) }
+func (*ProfileReply) ProtoMessage()               {}
+func (*ProfileReply) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{13} }
+
+func (m *ProfileReply) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *ProfileReply) GetCertificates() []*Certificate {
+	if m != nil {
+		return m.Certificates
+	}
+	return nil
+}
+
+type CertificateRequest struct {
+	// OwnerID is the address the certificate is issued for. The Node's own
+	// address is used if not specified.
+	OwnerID   *EthAddress `protobuf:"bytes,1,opt,name=ownerID" json:"ownerID,omitempty"`
+	Attribute uint64      `protobuf:"varint,2,opt,name=attribute" json:"attribute,omitempty"`
+	Value     []byte      `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *CertificateRequest) Reset()                    { *m = CertificateRequest{} }
+func (m *CertificateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CertificateRequest) ProtoMessage()               {}
+func (*CertificateRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{14} }
+
+func (m *CertificateRequest) GetOwnerID() *EthAddress {
+	if m != nil {
+		return m.OwnerID
+	}
+	return nil
+}
+
+func (m *CertificateRequest) GetAttribute() uint64 {
+	if m != nil {
+		return m.Attribute
+	}
+	return 0
+}
+
+func (m *CertificateRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*JoinNetworkRequest)(nil), "sonm.JoinNetworkRequest")
 	proto.RegisterType((*TaskListRequest)(nil), "sonm.TaskListRequest")
+	proto.RegisterType((*TaskDeploymentRequest)(nil), "sonm.TaskDeploymentRequest")
+	proto.RegisterType((*TaskDeployment)(nil), "sonm.TaskDeployment")
+	proto.RegisterType((*TaskDeploymentsReply)(nil), "sonm.TaskDeploymentsReply")
 	proto.RegisterType((*DealFinishRequest)(nil), "sonm.DealFinishRequest")
 	proto.RegisterType((*DealsReply)(nil), "sonm.DealsReply")
 	proto.RegisterType((*OpenDealRequest)(nil), "sonm.OpenDealRequest")
 	proto.RegisterType((*WorkerRemoveRequest)(nil), "sonm.WorkerRemoveRequest")
 	proto.RegisterType((*WorkerListReply)(nil), "sonm.WorkerListReply")
 	proto.RegisterType((*BalanceReply)(nil), "sonm.BalanceReply")
+	proto.RegisterType((*TokenTransferRequest)(nil), "sonm.TokenTransferRequest")
+	proto.RegisterType((*TokenTransaction)(nil), "sonm.TokenTransaction")
+	proto.RegisterType((*ProfileReply)(nil), "sonm.ProfileReply")
+	proto.RegisterType((*CertificateRequest)(nil), "sonm.CertificateRequest")
+	proto.RegisterEnum("sonm.TaskDeployment_Status", TaskDeployment_Status_name, TaskDeployment_Status_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -231,6 +588,20 @@ type TaskManagementClient interface {
 	Stop(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*Empty, error)
 	// PullTask pulls task image back
 	PullTask(ctx context.Context, in *PullTaskRequest, opts ...grpc.CallOption) (TaskManagement_PullTaskClient, error)
+	// Events streams lifecycle events of tasks running within the given deal.
+	Events(ctx context.Context, in *TaskEventsRequest, opts ...grpc.CallOption) (TaskManagement_EventsClient, error)
+	// StartGroup starts a group of tasks sharing network and volumes.
+	StartGroup(ctx context.Context, in *StartTaskGroupRequest, opts ...grpc.CallOption) (*StartTaskGroupReply, error)
+	// GroupStatus produces a task group status by its ID.
+	GroupStatus(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*TaskGroupStatusReply, error)
+	// StopGroup stops all tasks of the group.
+	StopGroup(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*Empty, error)
+	// Deploy attaches the task to the bid plan of the given order, so it is
+	// started automatically as soon as the deal is opened.
+	Deploy(ctx context.Context, in *TaskDeploymentRequest, opts ...grpc.CallOption) (*TaskDeployment, error)
+	// Deployments produces a list of automatic task deployments with their
+	// state, keyed by bid plan ID.
+	Deployments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TaskDeploymentsReply, error)
 }
 
 type taskManagementClient struct {
@@ -381,6 +752,83 @@ func (x *taskManagementPullTaskClient) Recv() (*Chunk, error) {
 	return m, nil
 }
 
+func (c *taskManagementClient) Events(ctx context.Context, in *TaskEventsRequest, opts ...grpc.CallOption) (TaskManagement_EventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TaskManagement_serviceDesc.Streams[3], c.cc, "/sonm.TaskManagement/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskManagementEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaskManagement_EventsClient interface {
+	Recv() (*TaskEvent, error)
+	grpc.ClientStream
+}
+
+type taskManagementEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskManagementEventsClient) Recv() (*TaskEvent, error) {
+	m := new(TaskEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskManagementClient) StartGroup(ctx context.Context, in *StartTaskGroupRequest, opts ...grpc.CallOption) (*StartTaskGroupReply, error) {
+	out := new(StartTaskGroupReply)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/StartGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagementClient) GroupStatus(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*TaskGroupStatusReply, error) {
+	out := new(TaskGroupStatusReply)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/GroupStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagementClient) StopGroup(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/StopGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagementClient) Deploy(ctx context.Context, in *TaskDeploymentRequest, opts ...grpc.CallOption) (*TaskDeployment, error) {
+	out := new(TaskDeployment)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/Deploy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagementClient) Deployments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TaskDeploymentsReply, error) {
+	out := new(TaskDeploymentsReply)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/Deployments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TaskManagement service
 
 type TaskManagementServer interface {
@@ -400,6 +848,20 @@ type TaskManagementServer interface {
 	Stop(context.Context, *TaskID) (*Empty, error)
 	// PullTask pulls task image back
 	PullTask(*PullTaskRequest, TaskManagement_PullTaskServer) error
+	// Events streams lifecycle events of tasks running within the given deal.
+	Events(*TaskEventsRequest, TaskManagement_EventsServer) error
+	// StartGroup starts a group of tasks sharing network and volumes.
+	StartGroup(context.Context, *StartTaskGroupRequest) (*StartTaskGroupReply, error)
+	// GroupStatus produces a task group status by its ID.
+	GroupStatus(context.Context, *TaskID) (*TaskGroupStatusReply, error)
+	// StopGroup stops all tasks of the group.
+	StopGroup(context.Context, *TaskID) (*Empty, error)
+	// Deploy attaches the task to the bid plan of the given order, so it is
+	// started automatically as soon as the deal is opened.
+	Deploy(context.Context, *TaskDeploymentRequest) (*TaskDeployment, error)
+	// Deployments produces a list of automatic task deployments with their
+	// state, keyed by bid plan ID.
+	Deployments(context.Context, *Empty) (*TaskDeploymentsReply, error)
 }
 
 func RegisterTaskManagementServer(s *grpc.Server, srv TaskManagementServer) {
@@ -525,43 +987,154 @@ func (x *taskManagementLogsServer) Send(m *TaskLogsChunk) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _TaskManagement_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TaskID)
+func _TaskManagement_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagementServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/Stop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).Stop(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagement_PullTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskManagementServer).PullTask(m, &taskManagementPullTaskServer{stream})
+}
+
+type TaskManagement_PullTaskServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type taskManagementPullTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskManagementPullTaskServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaskManagement_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TaskEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskManagementServer).Events(m, &taskManagementEventsServer{stream})
+}
+
+type TaskManagement_EventsServer interface {
+	Send(*TaskEvent) error
+	grpc.ServerStream
+}
+
+type taskManagementEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskManagementEventsServer) Send(m *TaskEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaskManagement_StartGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagementServer).StartGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/StartGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).StartGroup(ctx, req.(*StartTaskGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagement_GroupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagementServer).GroupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/GroupStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).GroupStatus(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagement_StopGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagementServer).StopGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/StopGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).StopGroup(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagement_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskDeploymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskManagementServer).Stop(ctx, in)
+		return srv.(TaskManagementServer).Deploy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.TaskManagement/Stop",
+		FullMethod: "/sonm.TaskManagement/Deploy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskManagementServer).Stop(ctx, req.(*TaskID))
+		return srv.(TaskManagementServer).Deploy(ctx, req.(*TaskDeploymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskManagement_PullTask_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PullTaskRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _TaskManagement_Deployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(TaskManagementServer).PullTask(m, &taskManagementPullTaskServer{stream})
-}
-
-type TaskManagement_PullTaskServer interface {
-	Send(*Chunk) error
-	grpc.ServerStream
-}
-
-type taskManagementPullTaskServer struct {
-	grpc.ServerStream
-}
-
-func (x *taskManagementPullTaskServer) Send(m *Chunk) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(TaskManagementServer).Deployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/Deployments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).Deployments(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 var _TaskManagement_serviceDesc = grpc.ServiceDesc{
@@ -588,6 +1161,26 @@ var _TaskManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Stop",
 			Handler:    _TaskManagement_Stop_Handler,
 		},
+		{
+			MethodName: "StartGroup",
+			Handler:    _TaskManagement_StartGroup_Handler,
+		},
+		{
+			MethodName: "GroupStatus",
+			Handler:    _TaskManagement_GroupStatus_Handler,
+		},
+		{
+			MethodName: "StopGroup",
+			Handler:    _TaskManagement_StopGroup_Handler,
+		},
+		{
+			MethodName: "Deploy",
+			Handler:    _TaskManagement_Deploy_Handler,
+		},
+		{
+			MethodName: "Deployments",
+			Handler:    _TaskManagement_Deployments_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -606,6 +1199,11 @@ var _TaskManagement_serviceDesc = grpc.ServiceDesc{
 			Handler:       _TaskManagement_PullTask_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Events",
+			Handler:       _TaskManagement_Events_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "node.proto",
 }
@@ -1067,6 +1665,21 @@ type TokenManagementClient interface {
 	TestTokens(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// Balance provide account balance for live- and side- chains.
 	Balance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BalanceReply, error)
+	// Transfer sends tokens to the given address, waiting for the
+	// transaction to be confirmed.
+	Transfer(ctx context.Context, in *TokenTransferRequest, opts ...grpc.CallOption) (*TokenTransaction, error)
+	// MarketAllowance returns the amount of side-chain tokens the
+	// marketplace is allowed to spend on behalf of the account.
+	MarketAllowance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BigInt, error)
+	// ApproveMarket sets the marketplace allowance to the given amount.
+	ApproveMarket(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransaction, error)
+	// Deposit moves tokens from the live-chain to the side-chain through
+	// the gatekeeper. Tokens arrive on the side-chain after the gatekeeper
+	// releases the payout.
+	Deposit(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransaction, error)
+	// Withdraw moves tokens from the side-chain back to the live-chain
+	// through the gatekeeper.
+	Withdraw(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransaction, error)
 }
 
 type tokenManagementClient struct {
@@ -1095,6 +1708,51 @@ func (c *tokenManagementClient) Balance(ctx context.Context, in *Empty, opts ...
 	return out, nil
 }
 
+func (c *tokenManagementClient) Transfer(ctx context.Context, in *TokenTransferRequest, opts ...grpc.CallOption) (*TokenTransaction, error) {
+	out := new(TokenTransaction)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Transfer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) MarketAllowance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BigInt, error) {
+	out := new(BigInt)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/MarketAllowance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) ApproveMarket(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransaction, error) {
+	out := new(TokenTransaction)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/ApproveMarket", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Deposit(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransaction, error) {
+	out := new(TokenTransaction)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Deposit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Withdraw(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransaction, error) {
+	out := new(TokenTransaction)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Withdraw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TokenManagement service
 
 type TokenManagementServer interface {
@@ -1103,59 +1761,365 @@ type TokenManagementServer interface {
 	TestTokens(context.Context, *Empty) (*Empty, error)
 	// Balance provide account balance for live- and side- chains.
 	Balance(context.Context, *Empty) (*BalanceReply, error)
+	// Transfer sends tokens to the given address, waiting for the
+	// transaction to be confirmed.
+	Transfer(context.Context, *TokenTransferRequest) (*TokenTransaction, error)
+	// MarketAllowance returns the amount of side-chain tokens the
+	// marketplace is allowed to spend on behalf of the account.
+	MarketAllowance(context.Context, *Empty) (*BigInt, error)
+	// ApproveMarket sets the marketplace allowance to the given amount.
+	ApproveMarket(context.Context, *BigInt) (*TokenTransaction, error)
+	// Deposit moves tokens from the live-chain to the side-chain through
+	// the gatekeeper. Tokens arrive on the side-chain after the gatekeeper
+	// releases the payout.
+	Deposit(context.Context, *BigInt) (*TokenTransaction, error)
+	// Withdraw moves tokens from the side-chain back to the live-chain
+	// through the gatekeeper.
+	Withdraw(context.Context, *BigInt) (*TokenTransaction, error)
+}
+
+func RegisterTokenManagementServer(s *grpc.Server, srv TokenManagementServer) {
+	s.RegisterService(&_TokenManagement_serviceDesc, srv)
+}
+
+func _TokenManagement_TestTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).TestTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/TestTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).TestTokens(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Balance(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Transfer(ctx, req.(*TokenTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_MarketAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).MarketAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/MarketAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).MarketAllowance(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_ApproveMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).ApproveMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/ApproveMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).ApproveMarket(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Deposit(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Withdraw(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TokenManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sonm.TokenManagement",
+	HandlerType: (*TokenManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TestTokens",
+			Handler:    _TokenManagement_TestTokens_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _TokenManagement_Balance_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _TokenManagement_Transfer_Handler,
+		},
+		{
+			MethodName: "MarketAllowance",
+			Handler:    _TokenManagement_MarketAllowance_Handler,
+		},
+		{
+			MethodName: "ApproveMarket",
+			Handler:    _TokenManagement_ApproveMarket_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _TokenManagement_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _TokenManagement_Withdraw_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "node.proto",
+}
+
+// Client API for Profiles service
+
+type ProfilesClient interface {
+	// Profile returns the profile of the given address with its
+	// certificates. The Node's own profile is returned if no address is
+	// specified.
+	Profile(ctx context.Context, in *EthAddress, opts ...grpc.CallOption) (*ProfileReply, error)
+	// List searches profiles using DWH filters.
+	List(ctx context.Context, in *ProfilesRequest, opts ...grpc.CallOption) (*ProfilesReply, error)
+	// CreateCertificate issues the certificate signed with the Node's key,
+	// returning its ID. Attributes below 1100 are self-signed and can be
+	// issued only for the Node's own address, others require the key to be
+	// a validator of the attribute's level.
+	CreateCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*BigInt, error)
+	// RemoveCertificate revokes the certificate with the given ID.
+	RemoveCertificate(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type profilesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProfilesClient(cc *grpc.ClientConn) ProfilesClient {
+	return &profilesClient{cc}
+}
+
+func (c *profilesClient) Profile(ctx context.Context, in *EthAddress, opts ...grpc.CallOption) (*ProfileReply, error) {
+	out := new(ProfileReply)
+	err := grpc.Invoke(ctx, "/sonm.Profiles/Profile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) List(ctx context.Context, in *ProfilesRequest, opts ...grpc.CallOption) (*ProfilesReply, error) {
+	out := new(ProfilesReply)
+	err := grpc.Invoke(ctx, "/sonm.Profiles/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) CreateCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*BigInt, error) {
+	out := new(BigInt)
+	err := grpc.Invoke(ctx, "/sonm.Profiles/CreateCertificate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) RemoveCertificate(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.Profiles/RemoveCertificate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Profiles service
+
+type ProfilesServer interface {
+	// Profile returns the profile of the given address with its
+	// certificates. The Node's own profile is returned if no address is
+	// specified.
+	Profile(context.Context, *EthAddress) (*ProfileReply, error)
+	// List searches profiles using DWH filters.
+	List(context.Context, *ProfilesRequest) (*ProfilesReply, error)
+	// CreateCertificate issues the certificate signed with the Node's key,
+	// returning its ID. Attributes below 1100 are self-signed and can be
+	// issued only for the Node's own address, others require the key to be
+	// a validator of the attribute's level.
+	CreateCertificate(context.Context, *CertificateRequest) (*BigInt, error)
+	// RemoveCertificate revokes the certificate with the given ID.
+	RemoveCertificate(context.Context, *BigInt) (*Empty, error)
+}
+
+func RegisterProfilesServer(s *grpc.Server, srv ProfilesServer) {
+	s.RegisterService(&_Profiles_serviceDesc, srv)
+}
+
+func _Profiles_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Profiles/Profile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).Profile(ctx, req.(*EthAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTokenManagementServer(s *grpc.Server, srv TokenManagementServer) {
-	s.RegisterService(&_TokenManagement_serviceDesc, srv)
+func _Profiles_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Profiles/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).List(ctx, req.(*ProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TokenManagement_TestTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Profiles_CreateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TokenManagementServer).TestTokens(ctx, in)
+		return srv.(ProfilesServer).CreateCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.TokenManagement/TestTokens",
+		FullMethod: "/sonm.Profiles/CreateCertificate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TokenManagementServer).TestTokens(ctx, req.(*Empty))
+		return srv.(ProfilesServer).CreateCertificate(ctx, req.(*CertificateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TokenManagement_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Profiles_RemoveCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TokenManagementServer).Balance(ctx, in)
+		return srv.(ProfilesServer).RemoveCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.TokenManagement/Balance",
+		FullMethod: "/sonm.Profiles/RemoveCertificate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TokenManagementServer).Balance(ctx, req.(*Empty))
+		return srv.(ProfilesServer).RemoveCertificate(ctx, req.(*BigInt))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _TokenManagement_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sonm.TokenManagement",
-	HandlerType: (*TokenManagementServer)(nil),
+var _Profiles_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sonm.Profiles",
+	HandlerType: (*ProfilesServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "TestTokens",
-			Handler:    _TokenManagement_TestTokens_Handler,
+			MethodName: "Profile",
+			Handler:    _Profiles_Profile_Handler,
 		},
 		{
-			MethodName: "Balance",
-			Handler:    _TokenManagement_Balance_Handler,
+			MethodName: "List",
+			Handler:    _Profiles_List_Handler,
+		},
+		{
+			MethodName: "CreateCertificate",
+			Handler:    _Profiles_CreateCertificate_Handler,
+		},
+		{
+			MethodName: "RemoveCertificate",
+			Handler:    _Profiles_RemoveCertificate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1167,6 +2131,9 @@ var _TokenManagement_serviceDesc = grpc.ServiceDesc{
 type BlacklistClient interface {
 	// List addresses into given blacklist
 	List(ctx context.Context, in *EthAddress, opts ...grpc.CallOption) (*BlacklistReply, error)
+	// Add adds given address to blacklist. Only blacklist masters are
+	// allowed to do this, others have to close a deal with blacklisting.
+	Add(ctx context.Context, in *EthAddress, opts ...grpc.CallOption) (*Empty, error)
 	// Remove removes given address from blacklist
 	Remove(ctx context.Context, in *EthAddress, opts ...grpc.CallOption) (*Empty, error)
 }
@@ -1188,6 +2155,15 @@ func (c *blacklistClient) List(ctx context.Context, in *EthAddress, opts ...grpc
 	return out, nil
 }
 
+func (c *blacklistClient) Add(ctx context.Context, in *EthAddress, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.Blacklist/Add", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blacklistClient) Remove(ctx context.Context, in *EthAddress, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := grpc.Invoke(ctx, "/sonm.Blacklist/Remove", in, out, c.cc, opts...)
@@ -1202,6 +2178,9 @@ func (c *blacklistClient) Remove(ctx context.Context, in *EthAddress, opts ...gr
 type BlacklistServer interface {
 	// List addresses into given blacklist
 	List(context.Context, *EthAddress) (*BlacklistReply, error)
+	// Add adds given address to blacklist. Only blacklist masters are
+	// allowed to do this, others have to close a deal with blacklisting.
+	Add(context.Context, *EthAddress) (*Empty, error)
 	// Remove removes given address from blacklist
 	Remove(context.Context, *EthAddress) (*Empty, error)
 }
@@ -1228,6 +2207,24 @@ func _Blacklist_List_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blacklist_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlacklistServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Blacklist/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlacklistServer).Add(ctx, req.(*EthAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blacklist_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthAddress)
 	if err := dec(in); err != nil {
@@ -1254,6 +2251,10 @@ var _Blacklist_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Blacklist_List_Handler,
 		},
+		{
+			MethodName: "Add",
+			Handler:    _Blacklist_Add_Handler,
+		},
 		{
 			MethodName: "Remove",
 			Handler:    _Blacklist_Remove_Handler,
@@ -1424,6 +2425,120 @@ var _TaskManagement_PullTaskCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.PullTaskRequest"),
 }
 
+var _TaskManagement_EventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Make the Events method call, input-type: sonm.TaskEventsRequest output-type: sonm.TaskEvent",
+	RunE: grpccmd.RunE(
+		"Events",
+		"sonm.TaskEventsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_EventsCmd_gen = &cobra.Command{
+	Use:   "events-gen",
+	Short: "Generate JSON for method call of Events (input-type: sonm.TaskEventsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TaskEventsRequest"),
+}
+
+var _TaskManagement_StartGroupCmd = &cobra.Command{
+	Use:   "startGroup",
+	Short: "Make the StartGroup method call, input-type: sonm.StartTaskGroupRequest output-type: sonm.StartTaskGroupReply",
+	RunE: grpccmd.RunE(
+		"StartGroup",
+		"sonm.StartTaskGroupRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_StartGroupCmd_gen = &cobra.Command{
+	Use:   "startGroup-gen",
+	Short: "Generate JSON for method call of StartGroup (input-type: sonm.StartTaskGroupRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.StartTaskGroupRequest"),
+}
+
+var _TaskManagement_GroupStatusCmd = &cobra.Command{
+	Use:   "groupStatus",
+	Short: "Make the GroupStatus method call, input-type: sonm.TaskID output-type: sonm.TaskGroupStatusReply",
+	RunE: grpccmd.RunE(
+		"GroupStatus",
+		"sonm.TaskID",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_GroupStatusCmd_gen = &cobra.Command{
+	Use:   "groupStatus-gen",
+	Short: "Generate JSON for method call of GroupStatus (input-type: sonm.TaskID)",
+	RunE:  grpccmd.TypeToJson("sonm.TaskID"),
+}
+
+var _TaskManagement_StopGroupCmd = &cobra.Command{
+	Use:   "stopGroup",
+	Short: "Make the StopGroup method call, input-type: sonm.TaskID output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"StopGroup",
+		"sonm.TaskID",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_StopGroupCmd_gen = &cobra.Command{
+	Use:   "stopGroup-gen",
+	Short: "Generate JSON for method call of StopGroup (input-type: sonm.TaskID)",
+	RunE:  grpccmd.TypeToJson("sonm.TaskID"),
+}
+
+var _TaskManagement_DeployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Make the Deploy method call, input-type: sonm.TaskDeploymentRequest output-type: sonm.TaskDeployment",
+	RunE: grpccmd.RunE(
+		"Deploy",
+		"sonm.TaskDeploymentRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_DeployCmd_gen = &cobra.Command{
+	Use:   "deploy-gen",
+	Short: "Generate JSON for method call of Deploy (input-type: sonm.TaskDeploymentRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TaskDeploymentRequest"),
+}
+
+var _TaskManagement_DeploymentsCmd = &cobra.Command{
+	Use:   "deployments",
+	Short: "Make the Deployments method call, input-type: sonm.Empty output-type: sonm.TaskDeploymentsReply",
+	RunE: grpccmd.RunE(
+		"Deployments",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_DeploymentsCmd_gen = &cobra.Command{
+	Use:   "deployments-gen",
+	Short: "Generate JSON for method call of Deployments (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_TaskManagementCmd)
@@ -1444,6 +2559,18 @@ func init() {
 		_TaskManagement_StopCmd_gen,
 		_TaskManagement_PullTaskCmd,
 		_TaskManagement_PullTaskCmd_gen,
+		_TaskManagement_EventsCmd,
+		_TaskManagement_EventsCmd_gen,
+		_TaskManagement_StartGroupCmd,
+		_TaskManagement_StartGroupCmd_gen,
+		_TaskManagement_GroupStatusCmd,
+		_TaskManagement_GroupStatusCmd_gen,
+		_TaskManagement_StopGroupCmd,
+		_TaskManagement_StopGroupCmd_gen,
+		_TaskManagement_DeployCmd,
+		_TaskManagement_DeployCmd_gen,
+		_TaskManagement_DeploymentsCmd,
+		_TaskManagement_DeploymentsCmd_gen,
 	)
 }
 
@@ -1748,6 +2875,101 @@ var _TokenManagement_BalanceCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.Empty"),
 }
 
+var _TokenManagement_TransferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Make the Transfer method call, input-type: sonm.TokenTransferRequest output-type: sonm.TokenTransaction",
+	RunE: grpccmd.RunE(
+		"Transfer",
+		"sonm.TokenTransferRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_TransferCmd_gen = &cobra.Command{
+	Use:   "transfer-gen",
+	Short: "Generate JSON for method call of Transfer (input-type: sonm.TokenTransferRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TokenTransferRequest"),
+}
+
+var _TokenManagement_MarketAllowanceCmd = &cobra.Command{
+	Use:   "marketAllowance",
+	Short: "Make the MarketAllowance method call, input-type: sonm.Empty output-type: sonm.BigInt",
+	RunE: grpccmd.RunE(
+		"MarketAllowance",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_MarketAllowanceCmd_gen = &cobra.Command{
+	Use:   "marketAllowance-gen",
+	Short: "Generate JSON for method call of MarketAllowance (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
+var _TokenManagement_ApproveMarketCmd = &cobra.Command{
+	Use:   "approveMarket",
+	Short: "Make the ApproveMarket method call, input-type: sonm.BigInt output-type: sonm.TokenTransaction",
+	RunE: grpccmd.RunE(
+		"ApproveMarket",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_ApproveMarketCmd_gen = &cobra.Command{
+	Use:   "approveMarket-gen",
+	Short: "Generate JSON for method call of ApproveMarket (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+var _TokenManagement_DepositCmd = &cobra.Command{
+	Use:   "deposit",
+	Short: "Make the Deposit method call, input-type: sonm.BigInt output-type: sonm.TokenTransaction",
+	RunE: grpccmd.RunE(
+		"Deposit",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_DepositCmd_gen = &cobra.Command{
+	Use:   "deposit-gen",
+	Short: "Generate JSON for method call of Deposit (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+var _TokenManagement_WithdrawCmd = &cobra.Command{
+	Use:   "withdraw",
+	Short: "Make the Withdraw method call, input-type: sonm.BigInt output-type: sonm.TokenTransaction",
+	RunE: grpccmd.RunE(
+		"Withdraw",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_WithdrawCmd_gen = &cobra.Command{
+	Use:   "withdraw-gen",
+	Short: "Generate JSON for method call of Withdraw (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_TokenManagementCmd)
@@ -1756,6 +2978,113 @@ func init() {
 		_TokenManagement_TestTokensCmd_gen,
 		_TokenManagement_BalanceCmd,
 		_TokenManagement_BalanceCmd_gen,
+		_TokenManagement_TransferCmd,
+		_TokenManagement_TransferCmd_gen,
+		_TokenManagement_MarketAllowanceCmd,
+		_TokenManagement_MarketAllowanceCmd_gen,
+		_TokenManagement_ApproveMarketCmd,
+		_TokenManagement_ApproveMarketCmd_gen,
+		_TokenManagement_DepositCmd,
+		_TokenManagement_DepositCmd_gen,
+		_TokenManagement_WithdrawCmd,
+		_TokenManagement_WithdrawCmd_gen,
+	)
+}
+
+// Profiles
+var _ProfilesCmd = &cobra.Command{
+	Use:   "profiles [method]",
+	Short: "Subcommand for the Profiles service.",
+}
+
+var _Profiles_ProfileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Make the Profile method call, input-type: sonm.EthAddress output-type: sonm.ProfileReply",
+	RunE: grpccmd.RunE(
+		"Profile",
+		"sonm.EthAddress",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewProfilesClient(cc)
+		},
+	),
+}
+
+var _Profiles_ProfileCmd_gen = &cobra.Command{
+	Use:   "profile-gen",
+	Short: "Generate JSON for method call of Profile (input-type: sonm.EthAddress)",
+	RunE:  grpccmd.TypeToJson("sonm.EthAddress"),
+}
+
+var _Profiles_ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Make the List method call, input-type: sonm.ProfilesRequest output-type: sonm.ProfilesReply",
+	RunE: grpccmd.RunE(
+		"List",
+		"sonm.ProfilesRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewProfilesClient(cc)
+		},
+	),
+}
+
+var _Profiles_ListCmd_gen = &cobra.Command{
+	Use:   "list-gen",
+	Short: "Generate JSON for method call of List (input-type: sonm.ProfilesRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ProfilesRequest"),
+}
+
+var _Profiles_CreateCertificateCmd = &cobra.Command{
+	Use:   "createCertificate",
+	Short: "Make the CreateCertificate method call, input-type: sonm.CertificateRequest output-type: sonm.BigInt",
+	RunE: grpccmd.RunE(
+		"CreateCertificate",
+		"sonm.CertificateRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewProfilesClient(cc)
+		},
+	),
+}
+
+var _Profiles_CreateCertificateCmd_gen = &cobra.Command{
+	Use:   "createCertificate-gen",
+	Short: "Generate JSON for method call of CreateCertificate (input-type: sonm.CertificateRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CertificateRequest"),
+}
+
+var _Profiles_RemoveCertificateCmd = &cobra.Command{
+	Use:   "removeCertificate",
+	Short: "Make the RemoveCertificate method call, input-type: sonm.BigInt output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"RemoveCertificate",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewProfilesClient(cc)
+		},
+	),
+}
+
+var _Profiles_RemoveCertificateCmd_gen = &cobra.Command{
+	Use:   "removeCertificate-gen",
+	Short: "Generate JSON for method call of RemoveCertificate (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+// Register commands with the root command and service command
+func init() {
+	grpccmd.RegisterServiceCmd(_ProfilesCmd)
+	_ProfilesCmd.AddCommand(
+		_Profiles_ProfileCmd,
+		_Profiles_ProfileCmd_gen,
+		_Profiles_ListCmd,
+		_Profiles_ListCmd_gen,
+		_Profiles_CreateCertificateCmd,
+		_Profiles_CreateCertificateCmd_gen,
+		_Profiles_RemoveCertificateCmd,
+		_Profiles_RemoveCertificateCmd_gen,
 	)
 }
 
@@ -1784,6 +3113,25 @@ var _Blacklist_ListCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.EthAddress"),
 }
 
+var _Blacklist_AddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Make the Add method call, input-type: sonm.EthAddress output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"Add",
+		"sonm.EthAddress",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewBlacklistClient(cc)
+		},
+	),
+}
+
+var _Blacklist_AddCmd_gen = &cobra.Command{
+	Use:   "add-gen",
+	Short: "Generate JSON for method call of Add (input-type: sonm.EthAddress)",
+	RunE:  grpccmd.TypeToJson("sonm.EthAddress"),
+}
+
 var _Blacklist_RemoveCmd = &cobra.Command{
 	Use:   "remove",
 	Short: "Make the Remove method call, input-type: sonm.EthAddress output-type: sonm.Empty",
@@ -1809,6 +3157,8 @@ func init() {
 	_BlacklistCmd.AddCommand(
 		_Blacklist_ListCmd,
 		_Blacklist_ListCmd_gen,
+		_Blacklist_AddCmd,
+		_Blacklist_AddCmd_gen,
 		_Blacklist_RemoveCmd,
 		_Blacklist_RemoveCmd_gen,
 	)
@@ -1816,59 +3166,109 @@ func init() {
 
 // End grpccmd
 
-func init() { proto.RegisterFile("node.proto", fileDescriptor9) }
-
-var fileDescriptor9 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xef, 0x8f, 0x1a, 0x45,
-	0x18, 0x66, 0x39, 0x4a, 0x8f, 0x17, 0x84, 0xbb, 0xa1, 0x2a, 0x6e, 0xaa, 0xb9, 0x8c, 0x46, 0x69,
-	0xac, 0x48, 0xa8, 0xb1, 0xfd, 0xd0, 0x98, 0xf4, 0xa0, 0x46, 0x4c, 0xab, 0xcd, 0x42, 0x52, 0xfb,
-	0x71, 0x8e, 0x9d, 0x2e, 0x13, 0x96, 0x99, 0x75, 0x67, 0xb8, 0xa6, 0xff, 0x94, 0x89, 0xdf, 0xfc,
-	0xf3, 0xcc, 0xec, 0xcc, 0xc0, 0xec, 0x82, 0xb1, 0x1f, 0xf7, 0x79, 0x9e, 0xf7, 0xf7, 0xbc, 0xef,
-	0x02, 0x70, 0x11, 0xd3, 0x51, 0x96, 0x0b, 0x25, 0x50, 0x43, 0x0a, 0xbe, 0x0d, 0x3b, 0x37, 0x2c,
-	0x61, 0x5c, 0x19, 0x2c, 0xec, 0xad, 0x04, 0x57, 0x84, 0x71, 0x9a, 0x5b, 0xa0, 0x15, 0xbf, 0x5b,
-	0x3b, 0x8e, 0x71, 0x6d, 0xc1, 0x19, 0xb1, 0xc0, 0xe5, 0x96, 0xe4, 0x1b, 0xaa, 0xb2, 0x94, 0xac,
-	0xac, 0xcf, 0xb0, 0xf3, 0x4e, 0xe4, 0x1b, 0x67, 0x8c, 0xff, 0x00, 0xf4, 0xab, 0x60, 0xfc, 0x37,
-	0xaa, 0x34, 0x1c, 0xd1, 0x3f, 0x77, 0x54, 0x2a, 0xf4, 0x15, 0x34, 0x15, 0x91, 0x9b, 0xf9, 0x6c,
-	0x10, 0x5c, 0x05, 0xc3, 0xf6, 0xa4, 0x33, 0xd2, 0x6e, 0x47, 0xcb, 0x02, 0x8b, 0x2c, 0x87, 0xee,
-	0x43, 0xcb, 0xda, 0xcd, 0x67, 0x83, 0xfa, 0x55, 0x30, 0x6c, 0x45, 0x07, 0x00, 0x3f, 0x86, 0x9e,
-	0xd6, 0xbf, 0x60, 0x52, 0x79, 0x6e, 0x63, 0x4a, 0xd2, 0xaa, 0xdb, 0x6b, 0x96, 0xcc, 0xb9, 0x8a,
-	0x2c, 0x87, 0xdf, 0xc0, 0xe5, 0x8c, 0x92, 0xf4, 0x67, 0xc6, 0x99, 0x5c, 0x3b, 0xd3, 0xfb, 0x50,
-	0x67, 0xf1, 0x49, 0xb3, 0x3a, 0x8b, 0xd1, 0xd7, 0xd0, 0x25, 0x71, 0xbc, 0x14, 0xd7, 0x29, 0x59,
-	0x6d, 0x52, 0x26, 0x55, 0x91, 0xce, 0x79, 0x54, 0x41, 0xf1, 0x43, 0x00, 0xed, 0x5a, 0x46, 0x34,
-	0x4b, 0xdf, 0xa3, 0x2f, 0xa0, 0xa1, 0x43, 0x0e, 0x82, 0xab, 0xb3, 0x61, 0x7b, 0x02, 0xc6, 0xab,
-	0xe6, 0xa3, 0x02, 0xc7, 0x6f, 0xa0, 0xf7, 0x7b, 0x46, 0x79, 0x81, 0xd8, 0x34, 0x30, 0xdc, 0xb9,
-	0x61, 0xf1, 0x7f, 0x14, 0x60, 0x28, 0xad, 0x31, 0xbd, 0xab, 0x9f, 0xd2, 0x14, 0x14, 0x66, 0xd0,
-	0x7f, 0x5d, 0x8c, 0x21, 0xa2, 0x5b, 0x71, 0x4b, 0x9d, 0xfb, 0x21, 0x34, 0xb7, 0x44, 0x2a, 0x9a,
-	0x5b, 0xff, 0x17, 0xc6, 0xf6, 0xb9, 0x5a, 0x3f, 0x8b, 0xe3, 0x9c, 0x4a, 0x19, 0x59, 0x5e, 0x2b,
-	0xcd, 0x1c, 0x6d, 0x94, 0x13, 0x4a, 0xc3, 0xe3, 0xa7, 0xd0, 0x33, 0xa1, 0xcc, 0x24, 0x74, 0xe1,
-	0x0f, 0xe0, 0xae, 0x21, 0xa5, 0xad, 0xbd, 0x67, 0x6b, 0x7f, 0xfd, 0x8b, 0xcd, 0xca, 0xf1, 0x98,
-	0x43, 0xe7, 0x9a, 0xa4, 0x84, 0xaf, 0xa8, 0x31, 0x1d, 0x41, 0x3b, 0x65, 0xb7, 0xd4, 0x62, 0x27,
-	0xdb, 0xe0, 0x0b, 0xb4, 0x5e, 0xb2, 0x78, 0xaf, 0x3f, 0xd5, 0x12, 0x5f, 0x30, 0xf9, 0xeb, 0x0c,
-	0xba, 0xfa, 0xd9, 0xbc, 0x24, 0x9c, 0x24, 0x74, 0x4b, 0xb9, 0x42, 0x3f, 0x40, 0x43, 0xa7, 0x8e,
-	0x3e, 0x3e, 0x3c, 0x42, 0xef, 0x51, 0x85, 0xfd, 0x2a, 0x9c, 0xa5, 0xef, 0x71, 0x0d, 0x7d, 0x07,
-	0xe7, 0xaf, 0x76, 0x72, 0xad, 0x61, 0xd4, 0x36, 0x92, 0xe9, 0x7a, 0xc7, 0x37, 0x61, 0xd7, 0x7c,
-	0xbc, 0xca, 0x45, 0xa2, 0xfb, 0x84, 0x6b, 0xc3, 0x60, 0x1c, 0xa0, 0xc7, 0x70, 0x67, 0xa1, 0x48,
-	0xae, 0xd0, 0x27, 0x86, 0x2e, 0x3e, 0xb4, 0xb1, 0x0b, 0x73, 0xef, 0x08, 0x37, 0x71, 0x9e, 0x42,
-	0xdb, 0x5b, 0x20, 0x34, 0x30, 0xb2, 0xe3, 0x9d, 0x0a, 0x2f, 0x0d, 0x63, 0xd1, 0x45, 0x46, 0x57,
-	0xb8, 0x86, 0xbe, 0x87, 0xe6, 0x42, 0x11, 0xb5, 0x93, 0xa8, 0xb4, 0x62, 0xa1, 0x57, 0xab, 0xe1,
-	0x5d, 0xb8, 0x1f, 0xa1, 0xf1, 0x42, 0x24, 0xb2, 0xd4, 0x0c, 0x91, 0xc8, 0x53, 0xcd, 0x10, 0x89,
-	0x2c, 0x2a, 0xc6, 0xb5, 0x71, 0x80, 0xbe, 0x84, 0xc6, 0x42, 0x89, 0xac, 0x12, 0xc6, 0x36, 0xe6,
-	0xf9, 0x36, 0x53, 0xda, 0xf9, 0x44, 0xf7, 0x2c, 0x4d, 0x8b, 0x9e, 0xd9, 0x00, 0xee, 0xdb, 0x05,
-	0xf0, 0x5b, 0xa9, 0x1d, 0x4f, 0xfe, 0x3e, 0x83, 0xae, 0xde, 0x10, 0x6f, 0x60, 0xdf, 0xd8, 0x81,
-	0x39, 0xad, 0xd8, 0x71, 0x15, 0x5e, 0x1c, 0xd6, 0x6b, 0x5f, 0xcc, 0x83, 0x7d, 0xf5, 0xe7, 0x86,
-	0x9d, 0xcf, 0x5c, 0x05, 0x5a, 0x37, 0xe7, 0x6f, 0x85, 0x93, 0x8e, 0xa1, 0x69, 0x0e, 0x02, 0xfa,
-	0xf4, 0x20, 0x28, 0x9d, 0x88, 0x6a, 0x31, 0xdf, 0x42, 0x43, 0x6f, 0xaf, 0x2b, 0xa4, 0xb2, 0xc9,
-	0xa1, 0xb7, 0xee, 0xb8, 0x86, 0xa6, 0x80, 0xa6, 0x6b, 0xc2, 0x13, 0xb7, 0x89, 0xb2, 0x28, 0xa0,
-	0xf4, 0x4e, 0xc3, 0xcf, 0x0f, 0x16, 0x65, 0xad, 0xcb, 0xf1, 0x27, 0xe8, 0x4f, 0x73, 0x4a, 0x14,
-	0x2d, 0xd1, 0x7e, 0xc2, 0x25, 0x22, 0x2c, 0xb9, 0xc7, 0x35, 0xf4, 0x08, 0xee, 0x3d, 0xcb, 0xb2,
-	0x5c, 0xdc, 0x56, 0x1c, 0x94, 0xd3, 0x38, 0x9a, 0x59, 0x7f, 0xaa, 0x37, 0x27, 0xfd, 0x70, 0x9b,
-	0xc9, 0x3f, 0x01, 0x5c, 0xbc, 0x2c, 0xee, 0x88, 0x37, 0xb5, 0x27, 0xd0, 0x36, 0xcb, 0x6f, 0x6a,
-	0x3f, 0x3a, 0x28, 0xee, 0x4d, 0x56, 0x8e, 0x49, 0x31, 0x9b, 0x8f, 0x0c, 0x38, 0x15, 0xfc, 0x2d,
-	0xcb, 0xb7, 0x27, 0x6c, 0x2b, 0x49, 0x3f, 0x81, 0x8e, 0x7f, 0xfe, 0xd0, 0x67, 0xbe, 0xeb, 0xd2,
-	0x49, 0xac, 0xa6, 0xce, 0xa0, 0xb7, 0x14, 0x1b, 0xca, 0xbd, 0xc4, 0x87, 0x00, 0x4b, 0x2a, 0x55,
-	0x01, 0x4b, 0xe4, 0xeb, 0xab, 0x61, 0x1f, 0xc2, 0x5d, 0x77, 0x97, 0x4a, 0x32, 0x64, 0x9b, 0xe5,
-	0x1d, 0x3a, 0x5c, 0x9b, 0xac, 0xa1, 0xb5, 0xff, 0x73, 0xa0, 0xb1, 0x7d, 0xd3, 0xc7, 0xa5, 0xd9,
-	0xc3, 0xb0, 0x97, 0x7a, 0x8f, 0xdb, 0x56, 0xf7, 0x7f, 0xed, 0xb8, 0x69, 0x16, 0xff, 0xe2, 0x47,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x56, 0x58, 0x75, 0xfb, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("node.proto", fileDescriptor10) }
+
+var fileDescriptor10 = []byte{
+	// 1616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0xe6, 0x52, 0x14, 0x7f, 0x0e, 0x29, 0x91, 0x1a, 0x29, 0x0e, 0xc3, 0xa4, 0x01, 0xb1, 0x0d,
+	0x1c, 0xda, 0x49, 0x54, 0x95, 0x4a, 0x63, 0xb5, 0x88, 0x8b, 0xd2, 0x22, 0x6d, 0xb3, 0x90, 0x64,
+	0x61, 0x45, 0xc0, 0xf5, 0xe5, 0x88, 0x3b, 0x22, 0x17, 0x5c, 0xee, 0x6c, 0x77, 0x86, 0x12, 0x04,
+	0xf4, 0x11, 0x7a, 0xd1, 0x37, 0xe8, 0x13, 0xf4, 0xa2, 0x77, 0x05, 0xfa, 0x3a, 0x7d, 0x8b, 0xde,
+	0x04, 0xf3, 0xc7, 0xfd, 0xe1, 0xca, 0xd6, 0x1d, 0xf7, 0x9c, 0xef, 0xcc, 0x39, 0xe7, 0x9b, 0xf3,
+	0x33, 0x12, 0x40, 0x40, 0x5d, 0x72, 0x18, 0x46, 0x94, 0x53, 0x54, 0x62, 0x34, 0x58, 0x76, 0x1a,
+	0xd7, 0xde, 0xcc, 0x0b, 0xb8, 0x92, 0x75, 0x9a, 0x53, 0x1a, 0x70, 0xec, 0x05, 0x24, 0xd2, 0x82,
+	0x9a, 0x7b, 0x37, 0x37, 0x3a, 0x2f, 0x10, 0x16, 0x81, 0x87, 0xb5, 0x60, 0x6f, 0x89, 0xa3, 0x05,
+	0xe1, 0xa1, 0x8f, 0xa7, 0xc4, 0x60, 0xb8, 0xb7, 0x24, 0x8c, 0xe3, 0x65, 0xa8, 0x05, 0x8d, 0x3b,
+	0x1a, 0x2d, 0xcc, 0x69, 0xf6, 0x5f, 0x00, 0xfd, 0x99, 0x7a, 0xc1, 0x05, 0xe1, 0x42, 0xec, 0x90,
+	0xbf, 0xae, 0x08, 0xe3, 0xe8, 0x1b, 0x28, 0x73, 0xcc, 0x16, 0xe3, 0x61, 0xdb, 0xea, 0x5a, 0xbd,
+	0x7a, 0xbf, 0x71, 0x28, 0xfc, 0x1c, 0x4e, 0xa4, 0xcc, 0xd1, 0x3a, 0xf4, 0x15, 0xd4, 0xb4, 0xdd,
+	0x78, 0xd8, 0x2e, 0x76, 0xad, 0x5e, 0xcd, 0x89, 0x05, 0xf6, 0x0b, 0x68, 0x0a, 0xfc, 0x99, 0xc7,
+	0x78, 0xe2, 0x58, 0x97, 0x60, 0x3f, 0x7b, 0xec, 0x2b, 0x6f, 0x36, 0x0e, 0xb8, 0xa3, 0x75, 0xf6,
+	0xbf, 0x2c, 0xf8, 0x4c, 0x58, 0x0e, 0x49, 0xe8, 0xd3, 0xfb, 0x25, 0x09, 0xd6, 0xf6, 0x4f, 0xa1,
+	0x42, 0x23, 0x97, 0x44, 0x0f, 0x1c, 0x60, 0x94, 0xc8, 0x86, 0x12, 0x0b, 0xc9, 0x54, 0xc6, 0x54,
+	0xef, 0xef, 0xc6, 0xc1, 0x5f, 0x85, 0x64, 0xea, 0x48, 0x1d, 0x7a, 0x0e, 0x55, 0x97, 0x60, 0xd7,
+	0xf7, 0x02, 0xd2, 0xde, 0x4a, 0xe2, 0x86, 0xab, 0x08, 0x73, 0x8f, 0x06, 0xce, 0x5a, 0x2f, 0x12,
+	0x5d, 0x10, 0x12, 0x0e, 0x7c, 0xef, 0x96, 0xb4, 0x4b, 0x5d, 0xab, 0x57, 0x75, 0x62, 0x81, 0xfd,
+	0xcf, 0x6d, 0xd8, 0x4d, 0xc7, 0x2b, 0x0c, 0xae, 0x3d, 0xf7, 0xd2, 0xc7, 0x81, 0x0e, 0xb5, 0xe6,
+	0xc4, 0x82, 0x04, 0x0d, 0xc5, 0x87, 0x69, 0x58, 0x27, 0xb1, 0xf5, 0xc8, 0x24, 0x4a, 0x9f, 0x48,
+	0xe2, 0x18, 0xca, 0x8c, 0x63, 0xbe, 0x62, 0xed, 0xed, 0xae, 0xd5, 0xdb, 0xed, 0x7f, 0x19, 0x9f,
+	0x18, 0x47, 0x7e, 0x78, 0x25, 0x21, 0x8e, 0x86, 0xa2, 0x27, 0xeb, 0x42, 0x28, 0xcb, 0x2c, 0xf4,
+	0x17, 0xea, 0x40, 0x15, 0x73, 0x4e, 0x96, 0x21, 0x67, 0xed, 0x4a, 0xd7, 0xea, 0x95, 0x9c, 0xf5,
+	0xb7, 0x48, 0xde, 0xc7, 0x8c, 0x8f, 0xa2, 0x88, 0x46, 0xed, 0xaa, 0x4a, 0x7e, 0x2d, 0x40, 0xc7,
+	0xd0, 0x10, 0x09, 0xbe, 0x0b, 0x49, 0x40, 0xdc, 0x01, 0x6f, 0xd7, 0x64, 0xd8, 0x4d, 0x1d, 0x8c,
+	0xa9, 0x55, 0x27, 0x05, 0x42, 0x3f, 0x40, 0x6d, 0x15, 0xba, 0x98, 0x4b, 0x0b, 0xc8, 0xb7, 0x88,
+	0x11, 0xe9, 0xfb, 0xaa, 0x67, 0xee, 0x0b, 0x7d, 0x0d, 0xb0, 0xf4, 0x66, 0x8a, 0x1f, 0xd6, 0x6e,
+	0xc8, 0xe8, 0x13, 0x12, 0xf4, 0x23, 0xec, 0x86, 0x11, 0xb9, 0xf5, 0xe8, 0x8a, 0x0d, 0xd5, 0x35,
+	0xed, 0xe4, 0x5c, 0x53, 0x06, 0x83, 0x9e, 0xc6, 0x56, 0xaa, 0x4d, 0xda, 0xbb, 0x32, 0xf5, 0x8c,
+	0x14, 0x7d, 0x03, 0x3b, 0x11, 0x61, 0x9c, 0x46, 0xc4, 0x1d, 0x2f, 0xf1, 0x8c, 0xb4, 0x9b, 0x12,
+	0x96, 0x16, 0xda, 0x67, 0x50, 0x56, 0x37, 0x81, 0xea, 0x50, 0xb9, 0x1c, 0x5d, 0x0c, 0xc7, 0x17,
+	0x6f, 0x5a, 0x05, 0xb4, 0x03, 0xb5, 0xe1, 0xe8, 0xf2, 0xec, 0xdd, 0x07, 0xf1, 0x69, 0x09, 0xdd,
+	0xd5, 0x64, 0xe0, 0x4c, 0x46, 0xc3, 0x56, 0x11, 0x01, 0x94, 0x5f, 0x0f, 0xc6, 0x67, 0xa3, 0x61,
+	0x6b, 0x4b, 0xe0, 0xce, 0xc7, 0x6f, 0x9c, 0xc1, 0x44, 0xe0, 0x4a, 0xf6, 0x7f, 0x2d, 0x38, 0x48,
+	0xdf, 0x33, 0x73, 0x48, 0xe8, 0xdf, 0xa3, 0x73, 0xa8, 0xbb, 0xb1, 0xac, 0x6d, 0x75, 0xb7, 0x7a,
+	0xf5, 0xfe, 0x77, 0x79, 0x85, 0xa1, 0x0c, 0x0e, 0x13, 0x82, 0x51, 0xc0, 0xa3, 0x7b, 0x27, 0x69,
+	0xdf, 0x99, 0x40, 0x2b, 0x0b, 0x40, 0x2d, 0xd8, 0x5a, 0x90, 0x7b, 0xdd, 0x04, 0xe2, 0x27, 0x7a,
+	0x0e, 0xdb, 0xb7, 0xd8, 0x5f, 0x11, 0x5d, 0xfd, 0x07, 0x79, 0xee, 0x1c, 0x05, 0xf9, 0x43, 0xf1,
+	0xc4, 0xb2, 0x3f, 0xc0, 0x9e, 0xe0, 0xf8, 0xb5, 0x17, 0x78, 0x6c, 0x6e, 0x46, 0xc1, 0x57, 0x50,
+	0xf4, 0xdc, 0xdc, 0x29, 0x50, 0xf4, 0x5c, 0x71, 0x19, 0xd8, 0x75, 0x27, 0xf4, 0x95, 0x8f, 0xa7,
+	0x0b, 0xdf, 0x63, 0x5c, 0xfa, 0xaa, 0x3a, 0x19, 0xa9, 0xfd, 0x3d, 0x80, 0x38, 0x5a, 0xb3, 0xf1,
+	0x35, 0x94, 0x44, 0xd5, 0x69, 0x1a, 0x40, 0x77, 0x12, 0xc1, 0xbe, 0x23, 0xe5, 0xf6, 0x07, 0x68,
+	0x8a, 0x8a, 0x94, 0x12, 0x1d, 0x86, 0x0d, 0xdb, 0xd7, 0x9e, 0xfb, 0xc0, 0x3c, 0x52, 0x2a, 0x81,
+	0x51, 0x05, 0x91, 0xd7, 0xed, 0x4a, 0x65, 0x7b, 0xb0, 0xff, 0x5e, 0x8e, 0x65, 0x87, 0x2c, 0xe9,
+	0x2d, 0x31, 0xc7, 0xf7, 0xa0, 0xbc, 0xc4, 0x8c, 0x93, 0x48, 0x9f, 0xdf, 0x52, 0xb6, 0x23, 0x3e,
+	0x1f, 0xb8, 0x6e, 0x44, 0x18, 0x73, 0xb4, 0x5e, 0x20, 0xd5, 0x5c, 0x6f, 0x17, 0x1f, 0x42, 0x2a,
+	0xbd, 0xfd, 0x33, 0x34, 0x95, 0x2b, 0x35, 0x99, 0x45, 0xe2, 0xcf, 0xa0, 0xa2, 0x94, 0xa6, 0x04,
+	0x74, 0x73, 0x0d, 0xdf, 0xbf, 0xd5, 0x51, 0x19, 0xbd, 0x1d, 0x40, 0xe3, 0x15, 0xf6, 0x71, 0x30,
+	0x25, 0xca, 0xf4, 0x10, 0xea, 0xa2, 0xa9, 0xb4, 0x2c, 0x97, 0x86, 0x24, 0x40, 0xe0, 0x99, 0xe7,
+	0xae, 0xf1, 0x79, 0x94, 0x24, 0x01, 0xf6, 0xdf, 0xe0, 0x60, 0x42, 0x17, 0x24, 0x98, 0x44, 0x38,
+	0x60, 0x37, 0x24, 0x32, 0xcc, 0x74, 0xa1, 0xc8, 0xe9, 0x83, 0xac, 0x14, 0x39, 0x15, 0x53, 0x16,
+	0x2f, 0xe9, 0x2a, 0xe0, 0xf9, 0x53, 0x56, 0xe9, 0xc4, 0xa8, 0x10, 0xee, 0xa6, 0x73, 0xec, 0x05,
+	0x72, 0xd4, 0x56, 0x9d, 0x58, 0x60, 0xbf, 0x85, 0x56, 0xec, 0x1d, 0x4f, 0xc5, 0x7c, 0x40, 0x08,
+	0x4a, 0x73, 0xcc, 0xe6, 0xba, 0xa2, 0xe5, 0x6f, 0xd4, 0x85, 0xfa, 0xb5, 0x4f, 0xa7, 0x8b, 0x8b,
+	0xd5, 0xf2, 0x5a, 0x5f, 0x41, 0xc9, 0x49, 0x8a, 0x04, 0x6f, 0x97, 0x11, 0xbd, 0xf1, 0x7c, 0xcd,
+	0xdb, 0xb7, 0x50, 0x09, 0xd5, 0xb7, 0x4e, 0x62, 0x47, 0x85, 0x67, 0x40, 0x46, 0x8b, 0x7e, 0x07,
+	0x8d, 0x29, 0x89, 0xb8, 0x77, 0xe3, 0x4d, 0x31, 0x27, 0xac, 0x5d, 0x94, 0x17, 0xb4, 0xa7, 0xd0,
+	0xa7, 0xb1, 0xc6, 0x49, 0xc1, 0x6c, 0x0e, 0x28, 0xa9, 0xd4, 0xac, 0x3d, 0x87, 0x0a, 0xbd, 0x0b,
+	0x12, 0x0b, 0x74, 0x93, 0x3a, 0x03, 0x10, 0xcc, 0x60, 0xce, 0x23, 0xef, 0x7a, 0xc5, 0x89, 0xce,
+	0x28, 0x16, 0xa0, 0x03, 0xd3, 0xc4, 0x82, 0xb3, 0x86, 0x6e, 0xd7, 0xfe, 0xdf, 0xcb, 0x6a, 0x15,
+	0x9e, 0xe3, 0x00, 0xcf, 0x88, 0x5c, 0x85, 0x3f, 0x42, 0x49, 0x14, 0x1a, 0xfa, 0x2c, 0x6e, 0xf3,
+	0xc4, 0x93, 0xa0, 0xb3, 0x9f, 0x15, 0x87, 0xfe, 0xbd, 0x5d, 0x40, 0x3f, 0x40, 0xf5, 0x72, 0xc5,
+	0xe6, 0x42, 0x8c, 0xea, 0x3a, 0xd7, 0xf9, 0x2a, 0x58, 0x74, 0x76, 0xd7, 0x34, 0xcd, 0x44, 0xb8,
+	0x76, 0xa1, 0x67, 0x1d, 0x59, 0xe8, 0x05, 0x6c, 0x5f, 0x71, 0x1c, 0x71, 0xf4, 0x44, 0xa9, 0xe5,
+	0x87, 0x30, 0x36, 0x6e, 0x0e, 0x36, 0xe4, 0xca, 0xcf, 0xcf, 0x50, 0x4f, 0x3c, 0x7f, 0x50, 0x5b,
+	0xc1, 0x36, 0x5f, 0x44, 0x1d, 0x4d, 0xb8, 0x96, 0x8a, 0x15, 0x6c, 0x17, 0xd0, 0x6f, 0xd6, 0x53,
+	0x3a, 0xf5, 0x40, 0xea, 0x24, 0x72, 0xd5, 0xfb, 0x54, 0xbb, 0xfb, 0x09, 0x4a, 0x67, 0x74, 0xc6,
+	0x52, 0x64, 0xd0, 0x19, 0xcb, 0x23, 0x83, 0xce, 0x98, 0xcc, 0xd8, 0x2e, 0x1c, 0x59, 0xe8, 0xd7,
+	0x50, 0xba, 0xe2, 0x34, 0xcc, 0xb8, 0xd1, 0xc4, 0x8c, 0x96, 0x21, 0x17, 0x87, 0xf7, 0x05, 0x67,
+	0xbe, 0x2f, 0x39, 0xd3, 0x0e, 0xcc, 0xb7, 0x71, 0x90, 0xa4, 0x52, 0x1e, 0xfc, 0x13, 0x94, 0x47,
+	0xb7, 0x24, 0xe0, 0x0c, 0x7d, 0x1e, 0x1f, 0xad, 0x24, 0xc6, 0xa6, 0x99, 0x51, 0x48, 0xbb, 0xd7,
+	0x00, 0x92, 0xcb, 0x37, 0x11, 0x5d, 0x85, 0xe8, 0xcb, 0x0c, 0xbb, 0x52, 0x6a, 0xec, 0xbf, 0xc8,
+	0x57, 0x2a, 0x42, 0x7e, 0x0f, 0x75, 0xf9, 0x9d, 0x4b, 0x63, 0x27, 0xfe, 0x4a, 0x80, 0x8c, 0x69,
+	0x0f, 0x6a, 0x82, 0x13, 0x15, 0xc1, 0x47, 0x89, 0x79, 0x09, 0x65, 0xb5, 0x59, 0x50, 0xee, 0x9b,
+	0x27, 0x53, 0x23, 0x69, 0xa5, 0x5d, 0x40, 0x27, 0x50, 0x8f, 0xbf, 0x19, 0x4a, 0x1e, 0x9e, 0x0c,
+	0x31, 0xbb, 0x2b, 0xed, 0x42, 0xff, 0xdf, 0x5b, 0xb0, 0x2b, 0xb6, 0x45, 0xa2, 0x1d, 0xbe, 0xd5,
+	0xed, 0x60, 0x6e, 0x42, 0x0c, 0xa1, 0x4e, 0x2b, 0x5e, 0x35, 0xeb, 0xf4, 0x9e, 0xad, 0x6b, 0xab,
+	0xaa, 0xb4, 0xe3, 0x61, 0x67, 0x3f, 0xc6, 0x8d, 0x83, 0x1b, 0x6a, 0xa0, 0x47, 0x50, 0x56, 0xcb,
+	0x11, 0x7d, 0x1e, 0x03, 0x52, 0xeb, 0x32, 0xcb, 0xc8, 0x77, 0x50, 0x12, 0x9b, 0xcc, 0x94, 0x49,
+	0x66, 0xab, 0x75, 0x12, 0xab, 0xcf, 0x2e, 0xa0, 0x53, 0x40, 0xa7, 0x73, 0x1c, 0xcc, 0xcc, 0x14,
+	0x61, 0x32, 0x81, 0xd4, 0x38, 0xed, 0xfc, 0x2a, 0xb6, 0x48, 0x63, 0x4d, 0x8c, 0x7f, 0x84, 0xfd,
+	0xd3, 0x88, 0x60, 0x4e, 0x52, 0xea, 0x64, 0xc0, 0x29, 0x45, 0x27, 0x75, 0xbc, 0x5d, 0x40, 0xc7,
+	0x70, 0x30, 0x08, 0xc3, 0x88, 0xde, 0x66, 0x0e, 0x48, 0x87, 0xb1, 0xd1, 0x11, 0xfb, 0xa7, 0x62,
+	0x8b, 0xf8, 0x8f, 0xb7, 0xe9, 0xff, 0xc7, 0x82, 0xd6, 0xb9, 0xdc, 0xa9, 0x89, 0x5b, 0x3b, 0x81,
+	0xba, 0x5a, 0x84, 0x2a, 0xf7, 0x8d, 0xa9, 0x69, 0x3a, 0x3e, 0xb3, 0x58, 0xe5, 0xdd, 0xec, 0x28,
+	0xe1, 0x29, 0x0d, 0x6e, 0xbc, 0x68, 0x99, 0x63, 0x9b, 0x09, 0xfa, 0x04, 0x1a, 0xc9, 0xa7, 0x00,
+	0xfa, 0x22, 0x79, 0x74, 0xea, 0x79, 0x90, 0x0d, 0xfd, 0xff, 0x45, 0x68, 0xca, 0x75, 0x95, 0x88,
+	0xbc, 0x07, 0x30, 0x21, 0x8c, 0x4b, 0x71, 0xa6, 0x76, 0x33, 0x7e, 0xbf, 0x87, 0x8a, 0x59, 0xd2,
+	0x29, 0x18, 0xd2, 0x6c, 0x25, 0xb6, 0xbe, 0x5d, 0x40, 0x7f, 0x82, 0xaa, 0x59, 0xc9, 0xc8, 0x34,
+	0x41, 0xce, 0x9e, 0xee, 0x3c, 0xc9, 0xea, 0xd4, 0x16, 0xb5, 0x0b, 0xe8, 0x10, 0x9a, 0xe7, 0xf2,
+	0xaf, 0xd5, 0x81, 0xef, 0xd3, 0xbb, 0x4d, 0xbf, 0xd9, 0x0a, 0x78, 0x01, 0x3b, 0xba, 0x02, 0x94,
+	0x59, 0xe6, 0x1a, 0x1f, 0x76, 0xf4, 0x5b, 0xa8, 0x0c, 0x49, 0x48, 0x99, 0xf7, 0x78, 0x93, 0x3e,
+	0x54, 0xdf, 0x7b, 0x7c, 0xee, 0x46, 0xf8, 0xee, 0xb1, 0x36, 0xfd, 0xff, 0x59, 0x50, 0xd5, 0xdb,
+	0x9b, 0x09, 0x9f, 0xfa, 0x77, 0xce, 0x85, 0xa3, 0xf4, 0xaa, 0xd7, 0x8c, 0x66, 0x16, 0xa5, 0x39,
+	0x2a, 0xb3, 0x1b, 0x62, 0xb1, 0xb2, 0x7a, 0x09, 0x7b, 0xba, 0xaf, 0xe2, 0x6d, 0x6f, 0xd6, 0xd8,
+	0xe6, 0x03, 0x60, 0x83, 0xd4, 0x23, 0xd8, 0x53, 0x25, 0x95, 0x34, 0xff, 0x68, 0x7f, 0xfc, 0xc3,
+	0x82, 0xda, 0xfa, 0x01, 0x8d, 0x8e, 0x74, 0xd0, 0x9b, 0x49, 0xea, 0x69, 0xba, 0x86, 0x9a, 0x80,
+	0x9f, 0xc2, 0xd6, 0xc0, 0x75, 0x3f, 0xdd, 0x06, 0xcf, 0xa0, 0xac, 0x1b, 0xe0, 0x53, 0xd0, 0xeb,
+	0xb2, 0xfc, 0x57, 0xc6, 0xf1, 0x2f, 0x03, 0x00, 0x23, 0x9d, 0xe5, 0xb9, 0x4b, 0x11, 0x00, 0x00,
 }
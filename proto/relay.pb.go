@@ -47,7 +47,7 @@ var PeerType_value = map[string]int32{
 func (x PeerType) String() string {
 	return proto.EnumName(PeerType_name, int32(x))
 }
-func (PeerType) EnumDescriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
+func (PeerType) EnumDescriptor() ([]byte, []int) { return fileDescriptor11, []int{0} }
 
 type HandshakeRequest struct {
 	// PeerType describes a peer's source.
@@ -75,7 +75,7 @@ type HandshakeRequest struct {
 func (m *HandshakeRequest) Reset()                    { *m = HandshakeRequest{} }
 func (m *HandshakeRequest) String() string            { return proto.CompactTextString(m) }
 func (*HandshakeRequest) ProtoMessage()               {}
-func (*HandshakeRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
+func (*HandshakeRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{0} }
 
 func (m *HandshakeRequest) GetPeerType() PeerType {
 	if m != nil {
@@ -113,7 +113,7 @@ type DiscoverResponse struct {
 func (m *DiscoverResponse) Reset()                    { *m = DiscoverResponse{} }
 func (m *DiscoverResponse) String() string            { return proto.CompactTextString(m) }
 func (*DiscoverResponse) ProtoMessage()               {}
-func (*DiscoverResponse) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{1} }
+func (*DiscoverResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{1} }
 
 func (m *DiscoverResponse) GetAddr() string {
 	if m != nil {
@@ -133,7 +133,7 @@ type HandshakeResponse struct {
 func (m *HandshakeResponse) Reset()                    { *m = HandshakeResponse{} }
 func (m *HandshakeResponse) String() string            { return proto.CompactTextString(m) }
 func (*HandshakeResponse) ProtoMessage()               {}
-func (*HandshakeResponse) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{2} }
+func (*HandshakeResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{2} }
 
 func (m *HandshakeResponse) GetError() int32 {
 	if m != nil {
@@ -156,7 +156,7 @@ type RelayClusterReply struct {
 func (m *RelayClusterReply) Reset()                    { *m = RelayClusterReply{} }
 func (m *RelayClusterReply) String() string            { return proto.CompactTextString(m) }
 func (*RelayClusterReply) ProtoMessage()               {}
-func (*RelayClusterReply) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{3} }
+func (*RelayClusterReply) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{3} }
 
 func (m *RelayClusterReply) GetMembers() []string {
 	if m != nil {
@@ -173,7 +173,7 @@ type RelayMetrics struct {
 func (m *RelayMetrics) Reset()                    { *m = RelayMetrics{} }
 func (m *RelayMetrics) String() string            { return proto.CompactTextString(m) }
 func (*RelayMetrics) ProtoMessage()               {}
-func (*RelayMetrics) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{4} }
+func (*RelayMetrics) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{4} }
 
 func (m *RelayMetrics) GetConnCurrent() uint64 {
 	if m != nil {
@@ -197,7 +197,7 @@ type NetMetrics struct {
 func (m *NetMetrics) Reset()                    { *m = NetMetrics{} }
 func (m *NetMetrics) String() string            { return proto.CompactTextString(m) }
 func (*NetMetrics) ProtoMessage()               {}
-func (*NetMetrics) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{5} }
+func (*NetMetrics) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{5} }
 
 func (m *NetMetrics) GetTxBytes() uint64 {
 	if m != nil {
@@ -388,9 +388,9 @@ func init() {
 
 // End grpccmd
 
-func init() { proto.RegisterFile("relay.proto", fileDescriptor10) }
+func init() { proto.RegisterFile("relay.proto", fileDescriptor11) }
 
-var fileDescriptor10 = []byte{
+var fileDescriptor11 = []byte{
 	// 438 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
 	0x10, 0xcd, 0xda, 0x49, 0x93, 0x8c, 0xa3, 0xe2, 0xae, 0x90, 0xb0, 0xc2, 0xc5, 0xf2, 0xa1, 0xb2,
@@ -39,7 +39,7 @@ type ConnectRequest struct {
 func (m *ConnectRequest) Reset()                    { *m = ConnectRequest{} }
 func (m *ConnectRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConnectRequest) ProtoMessage()               {}
-func (*ConnectRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{0} }
+func (*ConnectRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{0} }
 
 func (m *ConnectRequest) GetID() string {
 	if m != nil {
@@ -72,7 +72,7 @@ type PublishRequest struct {
 func (m *PublishRequest) Reset()                    { *m = PublishRequest{} }
 func (m *PublishRequest) String() string            { return proto.CompactTextString(m) }
 func (*PublishRequest) ProtoMessage()               {}
-func (*PublishRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{1} }
+func (*PublishRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{1} }
 
 func (m *PublishRequest) GetProtocol() string {
 	if m != nil {
@@ -102,7 +102,7 @@ type RendezvousReply struct {
 func (m *RendezvousReply) Reset()                    { *m = RendezvousReply{} }
 func (m *RendezvousReply) String() string            { return proto.CompactTextString(m) }
 func (*RendezvousReply) ProtoMessage()               {}
-func (*RendezvousReply) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{2} }
+func (*RendezvousReply) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{2} }
 
 func (m *RendezvousReply) GetPublicAddr() *Addr {
 	if m != nil {
@@ -126,7 +126,7 @@ type RendezvousState struct {
 func (m *RendezvousState) Reset()                    { *m = RendezvousState{} }
 func (m *RendezvousState) String() string            { return proto.CompactTextString(m) }
 func (*RendezvousState) ProtoMessage()               {}
-func (*RendezvousState) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{3} }
+func (*RendezvousState) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{3} }
 
 func (m *RendezvousState) GetState() map[string]*RendezvousMeeting {
 	if m != nil {
@@ -144,7 +144,7 @@ type RendezvousMeeting struct {
 func (m *RendezvousMeeting) Reset()                    { *m = RendezvousMeeting{} }
 func (m *RendezvousMeeting) String() string            { return proto.CompactTextString(m) }
 func (*RendezvousMeeting) ProtoMessage()               {}
-func (*RendezvousMeeting) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{4} }
+func (*RendezvousMeeting) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{4} }
 
 func (m *RendezvousMeeting) GetClients() map[string]*RendezvousReply {
 	if m != nil {
@@ -167,7 +167,7 @@ type ResolveMetaReply struct {
 func (m *ResolveMetaReply) Reset()                    { *m = ResolveMetaReply{} }
 func (m *ResolveMetaReply) String() string            { return proto.CompactTextString(m) }
 func (*ResolveMetaReply) ProtoMessage()               {}
-func (*ResolveMetaReply) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{5} }
+func (*ResolveMetaReply) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{5} }
 
 func (m *ResolveMetaReply) GetIDs() []string {
 	if m != nil {
@@ -480,9 +480,9 @@ func init() {
 
 // End grpccmd
 
-func init() { proto.RegisterFile("rendezvous.proto", fileDescriptor11) }
+func init() { proto.RegisterFile("rendezvous.proto", fileDescriptor12) }
 
-var fileDescriptor11 = []byte{
+var fileDescriptor12 = []byte{
 	// 453 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6a, 0xdb, 0x40,
 	0x10, 0xb6, 0xe4, 0xa4, 0x89, 0xc7, 0xc1, 0x51, 0x97, 0xfe, 0x08, 0x9d, 0x8c, 0xc8, 0x21, 0xf4,
@@ -7,3 +7,11 @@ import "time"
 func (m Timestamp) Unix() time.Time {
 	return time.Unix(m.Seconds, int64(m.Nanos)).In(time.UTC)
 }
+
+// NewTimestamp constructs a new Timestamp from the given time.
+func NewTimestamp(t time.Time) *Timestamp {
+	return &Timestamp{
+		Seconds: t.Unix(),
+		Nanos:   int32(t.Nanosecond()),
+	}
+}
@@ -56,7 +56,36 @@ var TaskStatusReply_Status_value = map[string]int32{
 func (x TaskStatusReply_Status) String() string {
 	return proto.EnumName(TaskStatusReply_Status_name, int32(x))
 }
-func (TaskStatusReply_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor14, []int{10, 0} }
+func (TaskStatusReply_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor15, []int{15, 0} }
+
+type TaskStatusReply_Health int32
+
+const (
+	// NO_HEALTHCHECK means that there is no health check configured.
+	TaskStatusReply_NO_HEALTHCHECK TaskStatusReply_Health = 0
+	// HEALTH_STARTING means that the container is initializing.
+	TaskStatusReply_HEALTH_STARTING TaskStatusReply_Health = 1
+	TaskStatusReply_HEALTHY         TaskStatusReply_Health = 2
+	TaskStatusReply_UNHEALTHY       TaskStatusReply_Health = 3
+)
+
+var TaskStatusReply_Health_name = map[int32]string{
+	0: "NO_HEALTHCHECK",
+	1: "HEALTH_STARTING",
+	2: "HEALTHY",
+	3: "UNHEALTHY",
+}
+var TaskStatusReply_Health_value = map[string]int32{
+	"NO_HEALTHCHECK":  0,
+	"HEALTH_STARTING": 1,
+	"HEALTHY":         2,
+	"UNHEALTHY":       3,
+}
+
+func (x TaskStatusReply_Health) String() string {
+	return proto.EnumName(TaskStatusReply_Health_name, int32(x))
+}
+func (TaskStatusReply_Health) EnumDescriptor() ([]byte, []int) { return fileDescriptor15, []int{15, 1} }
 
 type TaskSpec struct {
 	// Container describes container settings.
@@ -68,7 +97,7 @@ type TaskSpec struct {
 func (m *TaskSpec) Reset()                    { *m = TaskSpec{} }
 func (m *TaskSpec) String() string            { return proto.CompactTextString(m) }
 func (*TaskSpec) ProtoMessage()               {}
-func (*TaskSpec) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{0} }
+func (*TaskSpec) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{0} }
 
 func (m *TaskSpec) GetContainer() *Container {
 	if m != nil {
@@ -96,12 +125,17 @@ type StartTaskRequest struct {
 	// started.
 	DealID *BigInt   `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
 	Spec   *TaskSpec `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	// TaskID optionally specifies the UUID the started task is identified by.
+	// Starting a task with the ID of the task already started for the same
+	// deal returns that task instead of starting a new one, which makes
+	// retrying the request safe.
+	TaskID string `protobuf:"bytes,3,opt,name=taskID" json:"taskID,omitempty"`
 }
 
 func (m *StartTaskRequest) Reset()                    { *m = StartTaskRequest{} }
 func (m *StartTaskRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartTaskRequest) ProtoMessage()               {}
-func (*StartTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{1} }
+func (*StartTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{1} }
 
 func (m *StartTaskRequest) GetDealID() *BigInt {
 	if m != nil {
@@ -117,6 +151,99 @@ func (m *StartTaskRequest) GetSpec() *TaskSpec {
 	return nil
 }
 
+func (m *StartTaskRequest) GetTaskID() string {
+	if m != nil {
+		return m.TaskID
+	}
+	return ""
+}
+
+// TaskGroupSpec describes a group of containers that are started, stopped
+// and monitored together.
+type TaskGroupSpec struct {
+	// Tasks describes the group members. The first task is the main one,
+	// others are sidecars that share its network namespace, i.e. they can
+	// reach each other via "localhost". Sidecars can't specify exposed
+	// ports, networks and hostname. Resources of all tasks are summed up
+	// and must fit the deal.
+	Tasks []*TaskSpec `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+	// Volumes describes names of volumes shared between tasks of the group.
+	// They can be mounted into containers using the regular "mounts"
+	// syntax, i.e. "name:/path:rw". Volumes are removed with the deal.
+	// Their total size is limited by the storage bought in the deal.
+	Volumes []string `protobuf:"bytes,2,rep,name=volumes" json:"volumes,omitempty"`
+}
+
+func (m *TaskGroupSpec) Reset()                    { *m = TaskGroupSpec{} }
+func (m *TaskGroupSpec) String() string            { return proto.CompactTextString(m) }
+func (*TaskGroupSpec) ProtoMessage()               {}
+func (*TaskGroupSpec) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{2} }
+
+func (m *TaskGroupSpec) GetTasks() []*TaskSpec {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *TaskGroupSpec) GetVolumes() []string {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type StartTaskGroupRequest struct {
+	DealID *BigInt        `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
+	Spec   *TaskGroupSpec `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+}
+
+func (m *StartTaskGroupRequest) Reset()                    { *m = StartTaskGroupRequest{} }
+func (m *StartTaskGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartTaskGroupRequest) ProtoMessage()               {}
+func (*StartTaskGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{3} }
+
+func (m *StartTaskGroupRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *StartTaskGroupRequest) GetSpec() *TaskGroupSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type StartTaskGroupReply struct {
+	// ID is a task group ID.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Tasks contains replies for started tasks in the same order as in the
+	// spec.
+	Tasks []*StartTaskReply `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+}
+
+func (m *StartTaskGroupReply) Reset()                    { *m = StartTaskGroupReply{} }
+func (m *StartTaskGroupReply) String() string            { return proto.CompactTextString(m) }
+func (*StartTaskGroupReply) ProtoMessage()               {}
+func (*StartTaskGroupReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{4} }
+
+func (m *StartTaskGroupReply) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StartTaskGroupReply) GetTasks() []*StartTaskReply {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
 type WorkerJoinNetworkRequest struct {
 	TaskID    string `protobuf:"bytes,1,opt,name=taskID" json:"taskID,omitempty"`
 	NetworkID string `protobuf:"bytes,2,opt,name=networkID" json:"networkID,omitempty"`
@@ -125,7 +252,7 @@ type WorkerJoinNetworkRequest struct {
 func (m *WorkerJoinNetworkRequest) Reset()                    { *m = WorkerJoinNetworkRequest{} }
 func (m *WorkerJoinNetworkRequest) String() string            { return proto.CompactTextString(m) }
 func (*WorkerJoinNetworkRequest) ProtoMessage()               {}
-func (*WorkerJoinNetworkRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{2} }
+func (*WorkerJoinNetworkRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{5} }
 
 func (m *WorkerJoinNetworkRequest) GetTaskID() string {
 	if m != nil {
@@ -150,7 +277,7 @@ type StartTaskReply struct {
 func (m *StartTaskReply) Reset()                    { *m = StartTaskReply{} }
 func (m *StartTaskReply) String() string            { return proto.CompactTextString(m) }
 func (*StartTaskReply) ProtoMessage()               {}
-func (*StartTaskReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{3} }
+func (*StartTaskReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{6} }
 
 func (m *StartTaskReply) GetId() string {
 	if m != nil {
@@ -186,7 +313,7 @@ type StatusReply struct {
 func (m *StatusReply) Reset()                    { *m = StatusReply{} }
 func (m *StatusReply) String() string            { return proto.CompactTextString(m) }
 func (*StatusReply) ProtoMessage()               {}
-func (*StatusReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{4} }
+func (*StatusReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{7} }
 
 func (m *StatusReply) GetUptime() uint64 {
 	if m != nil {
@@ -237,6 +364,83 @@ func (m *StatusReply) GetRendezvousStatus() string {
 	return ""
 }
 
+type MaintenanceRequest struct {
+	// SpotGracePeriod is the time spot deals are allowed to run after the
+	// maintenance has started.
+	SpotGracePeriod *Duration `protobuf:"bytes,1,opt,name=spotGracePeriod" json:"spotGracePeriod,omitempty"`
+}
+
+func (m *MaintenanceRequest) Reset()                    { *m = MaintenanceRequest{} }
+func (m *MaintenanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*MaintenanceRequest) ProtoMessage()               {}
+func (*MaintenanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{8} }
+
+func (m *MaintenanceRequest) GetSpotGracePeriod() *Duration {
+	if m != nil {
+		return m.SpotGracePeriod
+	}
+	return nil
+}
+
+type MaintenanceStatusReply struct {
+	Enabled         bool       `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	Since           *Timestamp `protobuf:"bytes,2,opt,name=since" json:"since,omitempty"`
+	SpotGracePeriod *Duration  `protobuf:"bytes,3,opt,name=spotGracePeriod" json:"spotGracePeriod,omitempty"`
+	// Orders are the IDs of orders left to cancel.
+	Orders []*BigInt `protobuf:"bytes,4,rep,name=orders" json:"orders,omitempty"`
+	// Deals are the IDs of deals left to finish.
+	Deals []*BigInt `protobuf:"bytes,5,rep,name=deals" json:"deals,omitempty"`
+	// Drained is true when there are no orders and deals left.
+	Drained bool `protobuf:"varint,6,opt,name=drained" json:"drained,omitempty"`
+}
+
+func (m *MaintenanceStatusReply) Reset()                    { *m = MaintenanceStatusReply{} }
+func (m *MaintenanceStatusReply) String() string            { return proto.CompactTextString(m) }
+func (*MaintenanceStatusReply) ProtoMessage()               {}
+func (*MaintenanceStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{9} }
+
+func (m *MaintenanceStatusReply) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MaintenanceStatusReply) GetSince() *Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *MaintenanceStatusReply) GetSpotGracePeriod() *Duration {
+	if m != nil {
+		return m.SpotGracePeriod
+	}
+	return nil
+}
+
+func (m *MaintenanceStatusReply) GetOrders() []*BigInt {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *MaintenanceStatusReply) GetDeals() []*BigInt {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+func (m *MaintenanceStatusReply) GetDrained() bool {
+	if m != nil {
+		return m.Drained
+	}
+	return false
+}
+
 type AskPlansReply struct {
 	AskPlans map[string]*AskPlan `protobuf:"bytes,1,rep,name=askPlans" json:"askPlans,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -244,7 +448,7 @@ type AskPlansReply struct {
 func (m *AskPlansReply) Reset()                    { *m = AskPlansReply{} }
 func (m *AskPlansReply) String() string            { return proto.CompactTextString(m) }
 func (*AskPlansReply) ProtoMessage()               {}
-func (*AskPlansReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{5} }
+func (*AskPlansReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{10} }
 
 func (m *AskPlansReply) GetAskPlans() map[string]*AskPlan {
 	if m != nil {
@@ -260,7 +464,7 @@ type TaskListReply struct {
 func (m *TaskListReply) Reset()                    { *m = TaskListReply{} }
 func (m *TaskListReply) String() string            { return proto.CompactTextString(m) }
 func (*TaskListReply) ProtoMessage()               {}
-func (*TaskListReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{6} }
+func (*TaskListReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{11} }
 
 func (m *TaskListReply) GetInfo() map[string]*TaskStatusReply {
 	if m != nil {
@@ -280,7 +484,7 @@ type DevicesReply struct {
 func (m *DevicesReply) Reset()                    { *m = DevicesReply{} }
 func (m *DevicesReply) String() string            { return proto.CompactTextString(m) }
 func (*DevicesReply) ProtoMessage()               {}
-func (*DevicesReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{7} }
+func (*DevicesReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{12} }
 
 func (m *DevicesReply) GetCPU() *CPU {
 	if m != nil {
@@ -325,7 +529,7 @@ type PullTaskRequest struct {
 func (m *PullTaskRequest) Reset()                    { *m = PullTaskRequest{} }
 func (m *PullTaskRequest) String() string            { return proto.CompactTextString(m) }
 func (*PullTaskRequest) ProtoMessage()               {}
-func (*PullTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{8} }
+func (*PullTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{13} }
 
 func (m *PullTaskRequest) GetDealId() string {
 	if m != nil {
@@ -355,7 +559,7 @@ type DealInfoReply struct {
 func (m *DealInfoReply) Reset()                    { *m = DealInfoReply{} }
 func (m *DealInfoReply) String() string            { return proto.CompactTextString(m) }
 func (*DealInfoReply) ProtoMessage()               {}
-func (*DealInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{9} }
+func (*DealInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{14} }
 
 func (m *DealInfoReply) GetDeal() *Deal {
 	if m != nil {
@@ -392,12 +596,13 @@ type TaskStatusReply struct {
 	Uptime             uint64                 `protobuf:"varint,4,opt,name=uptime" json:"uptime,omitempty"`
 	Usage              *ResourceUsage         `protobuf:"bytes,5,opt,name=usage" json:"usage,omitempty"`
 	AllocatedResources *AskPlanResources      `protobuf:"bytes,6,opt,name=allocatedResources" json:"allocatedResources,omitempty"`
+	Health             TaskStatusReply_Health `protobuf:"varint,7,opt,name=health,enum=sonm.TaskStatusReply_Health" json:"health,omitempty"`
 }
 
 func (m *TaskStatusReply) Reset()                    { *m = TaskStatusReply{} }
 func (m *TaskStatusReply) String() string            { return proto.CompactTextString(m) }
 func (*TaskStatusReply) ProtoMessage()               {}
-func (*TaskStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{10} }
+func (*TaskStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{15} }
 
 func (m *TaskStatusReply) GetStatus() TaskStatusReply_Status {
 	if m != nil {
@@ -441,19 +646,161 @@ func (m *TaskStatusReply) GetAllocatedResources() *AskPlanResources {
 	return nil
 }
 
+func (m *TaskStatusReply) GetHealth() TaskStatusReply_Health {
+	if m != nil {
+		return m.Health
+	}
+	return TaskStatusReply_NO_HEALTHCHECK
+}
+
+type TaskGroupStatusReply struct {
+	// Status is the aggregated group status: broken if any task is broken,
+	// finished when all tasks are finished, running when all tasks are
+	// running and the least progressed status otherwise.
+	Status TaskStatusReply_Status `protobuf:"varint,1,opt,name=status,enum=sonm.TaskStatusReply_Status" json:"status,omitempty"`
+	// Tasks contains statuses of group members, members whose status can
+	// not be retrieved are reported as unknown.
+	Tasks map[string]*TaskStatusReply `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Errors describes why statuses of some members can not be retrieved.
+	Errors map[string]string `protobuf:"bytes,3,rep,name=errors" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *TaskGroupStatusReply) Reset()                    { *m = TaskGroupStatusReply{} }
+func (m *TaskGroupStatusReply) String() string            { return proto.CompactTextString(m) }
+func (*TaskGroupStatusReply) ProtoMessage()               {}
+func (*TaskGroupStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{16} }
+
+func (m *TaskGroupStatusReply) GetStatus() TaskStatusReply_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusReply_UNKNOWN
+}
+
+func (m *TaskGroupStatusReply) GetTasks() map[string]*TaskStatusReply {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *TaskGroupStatusReply) GetErrors() map[string]string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type TaskEventsRequest struct {
+	// DealID restricts events to tasks of the given deal. All tasks are
+	// observed when omitted, which requires management permissions.
+	DealID *BigInt `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
+}
+
+func (m *TaskEventsRequest) Reset()                    { *m = TaskEventsRequest{} }
+func (m *TaskEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*TaskEventsRequest) ProtoMessage()               {}
+func (*TaskEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{17} }
+
+func (m *TaskEventsRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+type TaskEvent struct {
+	// ID is a task ID.
+	Id     string                 `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	DealID *BigInt                `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	Status TaskStatusReply_Status `protobuf:"varint,3,opt,name=status,enum=sonm.TaskStatusReply_Status" json:"status,omitempty"`
+	// ExitCode of the container's main process. Set only for events caused
+	// by the container exit.
+	ExitCode int64 `protobuf:"varint,4,opt,name=exitCode" json:"exitCode,omitempty"`
+	// OOMKilled is set when the container was killed due to out of memory.
+	OOMKilled bool `protobuf:"varint,5,opt,name=OOMKilled" json:"OOMKilled,omitempty"`
+	// Restarted is set when the container was restarted according to its
+	// restart policy after the exit.
+	Restarted bool       `protobuf:"varint,6,opt,name=restarted" json:"restarted,omitempty"`
+	Timestamp *Timestamp `protobuf:"bytes,7,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *TaskEvent) Reset()                    { *m = TaskEvent{} }
+func (m *TaskEvent) String() string            { return proto.CompactTextString(m) }
+func (*TaskEvent) ProtoMessage()               {}
+func (*TaskEvent) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{18} }
+
+func (m *TaskEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TaskEvent) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *TaskEvent) GetStatus() TaskStatusReply_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusReply_UNKNOWN
+}
+
+func (m *TaskEvent) GetExitCode() int64 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *TaskEvent) GetOOMKilled() bool {
+	if m != nil {
+		return m.OOMKilled
+	}
+	return false
+}
+
+func (m *TaskEvent) GetRestarted() bool {
+	if m != nil {
+		return m.Restarted
+	}
+	return false
+}
+
+func (m *TaskEvent) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskSpec)(nil), "sonm.TaskSpec")
 	proto.RegisterType((*StartTaskRequest)(nil), "sonm.StartTaskRequest")
+	proto.RegisterType((*TaskGroupSpec)(nil), "sonm.TaskGroupSpec")
+	proto.RegisterType((*StartTaskGroupRequest)(nil), "sonm.StartTaskGroupRequest")
+	proto.RegisterType((*StartTaskGroupReply)(nil), "sonm.StartTaskGroupReply")
 	proto.RegisterType((*WorkerJoinNetworkRequest)(nil), "sonm.WorkerJoinNetworkRequest")
 	proto.RegisterType((*StartTaskReply)(nil), "sonm.StartTaskReply")
 	proto.RegisterType((*StatusReply)(nil), "sonm.StatusReply")
+	proto.RegisterType((*MaintenanceRequest)(nil), "sonm.MaintenanceRequest")
+	proto.RegisterType((*MaintenanceStatusReply)(nil), "sonm.MaintenanceStatusReply")
 	proto.RegisterType((*AskPlansReply)(nil), "sonm.AskPlansReply")
 	proto.RegisterType((*TaskListReply)(nil), "sonm.TaskListReply")
 	proto.RegisterType((*DevicesReply)(nil), "sonm.DevicesReply")
 	proto.RegisterType((*PullTaskRequest)(nil), "sonm.PullTaskRequest")
 	proto.RegisterType((*DealInfoReply)(nil), "sonm.DealInfoReply")
 	proto.RegisterType((*TaskStatusReply)(nil), "sonm.TaskStatusReply")
+	proto.RegisterType((*TaskGroupStatusReply)(nil), "sonm.TaskGroupStatusReply")
+	proto.RegisterType((*TaskEventsRequest)(nil), "sonm.TaskEventsRequest")
+	proto.RegisterType((*TaskEvent)(nil), "sonm.TaskEvent")
 	proto.RegisterEnum("sonm.TaskStatusReply_Status", TaskStatusReply_Status_name, TaskStatusReply_Status_value)
+	proto.RegisterEnum("sonm.TaskStatusReply_Health", TaskStatusReply_Health_name, TaskStatusReply_Health_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -487,6 +834,14 @@ type WorkerManagementClient interface {
 	RemoveAskPlan(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
 	// PurgeAskPlans removes all ask-plans
 	PurgeAskPlans(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// StartMaintenance drains the worker keeping its ask plans: no new
+	// orders are placed, unmatched orders are cancelled and running deals
+	// are allowed to reach their end.
+	StartMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*Empty, error)
+	// MaintenanceStatus reports the drain progress.
+	MaintenanceStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MaintenanceStatusReply, error)
+	// StopMaintenance resumes placing orders for ask plans.
+	StopMaintenance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type workerManagementClient struct {
@@ -569,6 +924,33 @@ func (c *workerManagementClient) PurgeAskPlans(ctx context.Context, in *Empty, o
 	return out, nil
 }
 
+func (c *workerManagementClient) StartMaintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/StartMaintenance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) MaintenanceStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MaintenanceStatusReply, error) {
+	out := new(MaintenanceStatusReply)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/MaintenanceStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) StopMaintenance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/StopMaintenance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for WorkerManagement service
 
 type WorkerManagementServer interface {
@@ -592,6 +974,14 @@ type WorkerManagementServer interface {
 	RemoveAskPlan(context.Context, *ID) (*Empty, error)
 	// PurgeAskPlans removes all ask-plans
 	PurgeAskPlans(context.Context, *Empty) (*Empty, error)
+	// StartMaintenance drains the worker keeping its ask plans: no new
+	// orders are placed, unmatched orders are cancelled and running deals
+	// are allowed to reach their end.
+	StartMaintenance(context.Context, *MaintenanceRequest) (*Empty, error)
+	// MaintenanceStatus reports the drain progress.
+	MaintenanceStatus(context.Context, *Empty) (*MaintenanceStatusReply, error)
+	// StopMaintenance resumes placing orders for ask plans.
+	StopMaintenance(context.Context, *Empty) (*Empty, error)
 }
 
 func RegisterWorkerManagementServer(s *grpc.Server, srv WorkerManagementServer) {
@@ -742,6 +1132,60 @@ func _WorkerManagement_PurgeAskPlans_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerManagement_StartMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).StartMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/StartMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).StartMaintenance(ctx, req.(*MaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_MaintenanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).MaintenanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/MaintenanceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).MaintenanceStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_StopMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).StopMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/StopMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).StopMaintenance(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.WorkerManagement",
 	HandlerType: (*WorkerManagementServer)(nil),
@@ -778,6 +1222,18 @@ var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeAskPlans",
 			Handler:    _WorkerManagement_PurgeAskPlans_Handler,
 		},
+		{
+			MethodName: "StartMaintenance",
+			Handler:    _WorkerManagement_StartMaintenance_Handler,
+		},
+		{
+			MethodName: "MaintenanceStatus",
+			Handler:    _WorkerManagement_MaintenanceStatus_Handler,
+		},
+		{
+			MethodName: "StopMaintenance",
+			Handler:    _WorkerManagement_StopMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "worker.proto",
@@ -792,8 +1248,16 @@ type WorkerClient interface {
 	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error)
 	StopTask(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
 	TaskStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskStatusReply, error)
+	// StartTaskGroup starts a group of containers within the deal as a
+	// single unit.
+	StartTaskGroup(ctx context.Context, in *StartTaskGroupRequest, opts ...grpc.CallOption) (*StartTaskGroupReply, error)
+	// StopTaskGroup stops all tasks of the group.
+	StopTaskGroup(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+	TaskGroupStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskGroupStatusReply, error)
 	JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error)
 	TaskLogs(ctx context.Context, in *TaskLogsRequest, opts ...grpc.CallOption) (Worker_TaskLogsClient, error)
+	// TaskEvents streams task lifecycle events as they happen.
+	TaskEvents(ctx context.Context, in *TaskEventsRequest, opts ...grpc.CallOption) (Worker_TaskEventsClient, error)
 	// Note: currently used for testing pusposes.
 	GetDealInfo(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealInfoReply, error)
 }
@@ -896,6 +1360,33 @@ func (c *workerClient) TaskStatus(ctx context.Context, in *ID, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *workerClient) StartTaskGroup(ctx context.Context, in *StartTaskGroupRequest, opts ...grpc.CallOption) (*StartTaskGroupReply, error) {
+	out := new(StartTaskGroupReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/StartTaskGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) StopTaskGroup(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.Worker/StopTaskGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) TaskGroupStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskGroupStatusReply, error) {
+	out := new(TaskGroupStatusReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/TaskGroupStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerClient) JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error) {
 	out := new(NetworkSpec)
 	err := grpc.Invoke(ctx, "/sonm.Worker/JoinNetwork", in, out, c.cc, opts...)
@@ -937,6 +1428,38 @@ func (x *workerTaskLogsClient) Recv() (*TaskLogsChunk, error) {
 	return m, nil
 }
 
+func (c *workerClient) TaskEvents(ctx context.Context, in *TaskEventsRequest, opts ...grpc.CallOption) (Worker_TaskEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[3], c.cc, "/sonm.Worker/TaskEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerTaskEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_TaskEventsClient interface {
+	Recv() (*TaskEvent, error)
+	grpc.ClientStream
+}
+
+type workerTaskEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerTaskEventsClient) Recv() (*TaskEvent, error) {
+	m := new(TaskEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *workerClient) GetDealInfo(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealInfoReply, error) {
 	out := new(DealInfoReply)
 	err := grpc.Invoke(ctx, "/sonm.Worker/GetDealInfo", in, out, c.cc, opts...)
@@ -955,8 +1478,16 @@ type WorkerServer interface {
 	StartTask(context.Context, *StartTaskRequest) (*StartTaskReply, error)
 	StopTask(context.Context, *ID) (*Empty, error)
 	TaskStatus(context.Context, *ID) (*TaskStatusReply, error)
+	// StartTaskGroup starts a group of containers within the deal as a
+	// single unit.
+	StartTaskGroup(context.Context, *StartTaskGroupRequest) (*StartTaskGroupReply, error)
+	// StopTaskGroup stops all tasks of the group.
+	StopTaskGroup(context.Context, *ID) (*Empty, error)
+	TaskGroupStatus(context.Context, *ID) (*TaskGroupStatusReply, error)
 	JoinNetwork(context.Context, *WorkerJoinNetworkRequest) (*NetworkSpec, error)
 	TaskLogs(*TaskLogsRequest, Worker_TaskLogsServer) error
+	// TaskEvents streams task lifecycle events as they happen.
+	TaskEvents(*TaskEventsRequest, Worker_TaskEventsServer) error
 	// Note: currently used for testing pusposes.
 	GetDealInfo(context.Context, *ID) (*DealInfoReply, error)
 }
@@ -1066,6 +1597,60 @@ func _Worker_TaskStatus_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_StartTaskGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StartTaskGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/StartTaskGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StartTaskGroup(ctx, req.(*StartTaskGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_StopTaskGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StopTaskGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/StopTaskGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StopTaskGroup(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_TaskGroupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TaskGroupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/TaskGroupStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TaskGroupStatus(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Worker_JoinNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WorkerJoinNetworkRequest)
 	if err := dec(in); err != nil {
@@ -1105,6 +1690,27 @@ func (x *workerTaskLogsServer) Send(m *TaskLogsChunk) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Worker_TaskEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TaskEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).TaskEvents(m, &workerTaskEventsServer{stream})
+}
+
+type Worker_TaskEventsServer interface {
+	Send(*TaskEvent) error
+	grpc.ServerStream
+}
+
+type workerTaskEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerTaskEventsServer) Send(m *TaskEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Worker_GetDealInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -1139,6 +1745,18 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TaskStatus",
 			Handler:    _Worker_TaskStatus_Handler,
 		},
+		{
+			MethodName: "StartTaskGroup",
+			Handler:    _Worker_StartTaskGroup_Handler,
+		},
+		{
+			MethodName: "StopTaskGroup",
+			Handler:    _Worker_StopTaskGroup_Handler,
+		},
+		{
+			MethodName: "TaskGroupStatus",
+			Handler:    _Worker_TaskGroupStatus_Handler,
+		},
 		{
 			MethodName: "JoinNetwork",
 			Handler:    _Worker_JoinNetwork_Handler,
@@ -1165,6 +1783,11 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Worker_TaskLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TaskEvents",
+			Handler:       _Worker_TaskEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "worker.proto",
 }
@@ -1330,6 +1953,63 @@ var _WorkerManagement_PurgeAskPlansCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.Empty"),
 }
 
+var _WorkerManagement_StartMaintenanceCmd = &cobra.Command{
+	Use:   "startMaintenance",
+	Short: "Make the StartMaintenance method call, input-type: sonm.MaintenanceRequest output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"StartMaintenance",
+		"sonm.MaintenanceRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerManagementClient(cc)
+		},
+	),
+}
+
+var _WorkerManagement_StartMaintenanceCmd_gen = &cobra.Command{
+	Use:   "startMaintenance-gen",
+	Short: "Generate JSON for method call of StartMaintenance (input-type: sonm.MaintenanceRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.MaintenanceRequest"),
+}
+
+var _WorkerManagement_MaintenanceStatusCmd = &cobra.Command{
+	Use:   "maintenanceStatus",
+	Short: "Make the MaintenanceStatus method call, input-type: sonm.Empty output-type: sonm.MaintenanceStatusReply",
+	RunE: grpccmd.RunE(
+		"MaintenanceStatus",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerManagementClient(cc)
+		},
+	),
+}
+
+var _WorkerManagement_MaintenanceStatusCmd_gen = &cobra.Command{
+	Use:   "maintenanceStatus-gen",
+	Short: "Generate JSON for method call of MaintenanceStatus (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
+var _WorkerManagement_StopMaintenanceCmd = &cobra.Command{
+	Use:   "stopMaintenance",
+	Short: "Make the StopMaintenance method call, input-type: sonm.Empty output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"StopMaintenance",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerManagementClient(cc)
+		},
+	),
+}
+
+var _WorkerManagement_StopMaintenanceCmd_gen = &cobra.Command{
+	Use:   "stopMaintenance-gen",
+	Short: "Generate JSON for method call of StopMaintenance (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_WorkerManagementCmd)
@@ -1350,6 +2030,12 @@ func init() {
 		_WorkerManagement_RemoveAskPlanCmd_gen,
 		_WorkerManagement_PurgeAskPlansCmd,
 		_WorkerManagement_PurgeAskPlansCmd_gen,
+		_WorkerManagement_StartMaintenanceCmd,
+		_WorkerManagement_StartMaintenanceCmd_gen,
+		_WorkerManagement_MaintenanceStatusCmd,
+		_WorkerManagement_MaintenanceStatusCmd_gen,
+		_WorkerManagement_StopMaintenanceCmd,
+		_WorkerManagement_StopMaintenanceCmd_gen,
 	)
 }
 
@@ -1454,6 +2140,63 @@ var _Worker_TaskStatusCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.ID"),
 }
 
+var _Worker_StartTaskGroupCmd = &cobra.Command{
+	Use:   "startTaskGroup",
+	Short: "Make the StartTaskGroup method call, input-type: sonm.StartTaskGroupRequest output-type: sonm.StartTaskGroupReply",
+	RunE: grpccmd.RunE(
+		"StartTaskGroup",
+		"sonm.StartTaskGroupRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_StartTaskGroupCmd_gen = &cobra.Command{
+	Use:   "startTaskGroup-gen",
+	Short: "Generate JSON for method call of StartTaskGroup (input-type: sonm.StartTaskGroupRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.StartTaskGroupRequest"),
+}
+
+var _Worker_StopTaskGroupCmd = &cobra.Command{
+	Use:   "stopTaskGroup",
+	Short: "Make the StopTaskGroup method call, input-type: sonm.ID output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"StopTaskGroup",
+		"sonm.ID",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_StopTaskGroupCmd_gen = &cobra.Command{
+	Use:   "stopTaskGroup-gen",
+	Short: "Generate JSON for method call of StopTaskGroup (input-type: sonm.ID)",
+	RunE:  grpccmd.TypeToJson("sonm.ID"),
+}
+
+var _Worker_TaskGroupStatusCmd = &cobra.Command{
+	Use:   "taskGroupStatus",
+	Short: "Make the TaskGroupStatus method call, input-type: sonm.ID output-type: sonm.TaskGroupStatusReply",
+	RunE: grpccmd.RunE(
+		"TaskGroupStatus",
+		"sonm.ID",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_TaskGroupStatusCmd_gen = &cobra.Command{
+	Use:   "taskGroupStatus-gen",
+	Short: "Generate JSON for method call of TaskGroupStatus (input-type: sonm.ID)",
+	RunE:  grpccmd.TypeToJson("sonm.ID"),
+}
+
 var _Worker_JoinNetworkCmd = &cobra.Command{
 	Use:   "joinNetwork",
 	Short: "Make the JoinNetwork method call, input-type: sonm.WorkerJoinNetworkRequest output-type: sonm.NetworkSpec",
@@ -1492,6 +2235,25 @@ var _Worker_TaskLogsCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.TaskLogsRequest"),
 }
 
+var _Worker_TaskEventsCmd = &cobra.Command{
+	Use:   "taskEvents",
+	Short: "Make the TaskEvents method call, input-type: sonm.TaskEventsRequest output-type: sonm.TaskEvent",
+	RunE: grpccmd.RunE(
+		"TaskEvents",
+		"sonm.TaskEventsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_TaskEventsCmd_gen = &cobra.Command{
+	Use:   "taskEvents-gen",
+	Short: "Generate JSON for method call of TaskEvents (input-type: sonm.TaskEventsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TaskEventsRequest"),
+}
+
 var _Worker_GetDealInfoCmd = &cobra.Command{
 	Use:   "getDealInfo",
 	Short: "Make the GetDealInfo method call, input-type: sonm.ID output-type: sonm.DealInfoReply",
@@ -1525,10 +2287,18 @@ func init() {
 		_Worker_StopTaskCmd_gen,
 		_Worker_TaskStatusCmd,
 		_Worker_TaskStatusCmd_gen,
+		_Worker_StartTaskGroupCmd,
+		_Worker_StartTaskGroupCmd_gen,
+		_Worker_StopTaskGroupCmd,
+		_Worker_StopTaskGroupCmd_gen,
+		_Worker_TaskGroupStatusCmd,
+		_Worker_TaskGroupStatusCmd_gen,
 		_Worker_JoinNetworkCmd,
 		_Worker_JoinNetworkCmd_gen,
 		_Worker_TaskLogsCmd,
 		_Worker_TaskLogsCmd_gen,
+		_Worker_TaskEventsCmd,
+		_Worker_TaskEventsCmd_gen,
 		_Worker_GetDealInfoCmd,
 		_Worker_GetDealInfoCmd_gen,
 	)
@@ -1536,86 +2306,119 @@ func init() {
 
 // End grpccmd
 
-func init() { proto.RegisterFile("worker.proto", fileDescriptor14) }
-
-var fileDescriptor14 = []byte{
-	// 1240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xdf, 0xb5, 0x1d, 0x7f, 0x1c, 0xdb, 0x89, 0x3b, 0xf9, 0xff, 0x23, 0x6b, 0x69, 0xab, 0xb0,
-	0x05, 0x11, 0x52, 0x6a, 0x8a, 0xe9, 0x05, 0x6a, 0x41, 0xc2, 0xb5, 0xd3, 0xd4, 0x6d, 0xe3, 0x2c,
-	0x63, 0xac, 0x70, 0x81, 0x84, 0xa6, 0xf6, 0xc4, 0x5d, 0x79, 0x3d, 0xbb, 0xec, 0xcc, 0x06, 0x85,
-	0x57, 0xe0, 0x8e, 0x4b, 0x78, 0x07, 0xee, 0x79, 0x05, 0xee, 0xb9, 0xe4, 0x55, 0x10, 0x9a, 0x9d,
-	0xd9, 0xaf, 0xd4, 0x91, 0x40, 0xca, 0xdd, 0x9e, 0x73, 0x7e, 0xe7, 0xcc, 0xd9, 0xf3, 0xf1, 0x9b,
-	0x81, 0xd6, 0x0f, 0x7e, 0xb8, 0xa2, 0x61, 0x2f, 0x08, 0x7d, 0xe1, 0xa3, 0x0a, 0xf7, 0xd9, 0xda,
-	0xda, 0x26, 0x7c, 0xf5, 0x5d, 0xe0, 0x11, 0xa6, 0xb4, 0x16, 0x9a, 0x93, 0x80, 0xbc, 0x76, 0x3d,
-	0x57, 0xb8, 0x94, 0x6b, 0xdd, 0xce, 0xdc, 0x67, 0x82, 0xb8, 0x2c, 0x71, 0xb5, 0x5a, 0xaf, 0xdd,
-	0xa5, 0xcb, 0x44, 0x62, 0x76, 0x99, 0x0c, 0xc5, 0x5c, 0xa2, 0x15, 0xb7, 0xd6, 0x24, 0x5c, 0x51,
-	0x11, 0x78, 0x64, 0x4e, 0xb5, 0xaa, 0xc1, 0xa8, 0x86, 0xdb, 0xbf, 0x9a, 0x50, 0xff, 0x9a, 0xf0,
-	0xd5, 0x34, 0xa0, 0x73, 0xf4, 0x00, 0x1a, 0x69, 0xf0, 0xae, 0xb9, 0x6f, 0x1e, 0x34, 0xfb, 0x3b,
-	0x3d, 0x19, 0xad, 0x37, 0x4c, 0xd4, 0x38, 0x43, 0xa0, 0x43, 0xa8, 0x87, 0x74, 0xe9, 0x72, 0x11,
-	0x5e, 0x76, 0x4b, 0x31, 0x7a, 0x5b, 0xa1, 0xb1, 0xd6, 0xe2, 0xd4, 0x8e, 0x1e, 0x41, 0x23, 0xa4,
-	0xdc, 0x8f, 0xc2, 0x39, 0xe5, 0xdd, 0x72, 0x0c, 0xde, 0x53, 0xe0, 0x01, 0x5f, 0x39, 0x1e, 0x61,
-	0x38, 0xb1, 0xe2, 0x0c, 0x68, 0x7f, 0x0b, 0x9d, 0xa9, 0x20, 0xa1, 0x90, 0x19, 0x62, 0xfa, 0x7d,
-	0x44, 0xb9, 0x40, 0xef, 0x41, 0x75, 0x41, 0x89, 0x37, 0x1e, 0xe9, 0x0c, 0x5b, 0x2a, 0xcc, 0x53,
-	0x77, 0x39, 0x66, 0x02, 0x6b, 0x1b, 0xb2, 0xa1, 0xc2, 0x03, 0x3a, 0x2f, 0xe6, 0x95, 0xfc, 0x28,
-	0x8e, 0x6d, 0xb6, 0x03, 0xdd, 0xb3, 0xb8, 0x07, 0x2f, 0x7c, 0x97, 0x4d, 0xa8, 0x90, 0x0d, 0x49,
-	0x4e, 0xd9, 0x83, 0xaa, 0x20, 0x7c, 0xa5, 0x4f, 0x69, 0x60, 0x2d, 0xa1, 0xdb, 0x20, 0x8b, 0x27,
-	0x91, 0xe3, 0x51, 0x1c, 0xbc, 0x81, 0x33, 0x85, 0xfd, 0x87, 0x09, 0xdb, 0xb9, 0x84, 0x03, 0xef,
-	0x12, 0x6d, 0x43, 0xc9, 0x5d, 0xe8, 0x20, 0x25, 0x77, 0x81, 0x9e, 0x40, 0x2d, 0xf0, 0x43, 0x71,
-	0x42, 0x82, 0x6e, 0x69, 0xbf, 0x7c, 0xd0, 0xec, 0xbf, 0xab, 0x72, 0x2b, 0xba, 0xf5, 0x1c, 0x85,
-	0x39, 0x62, 0xb2, 0x8c, 0x89, 0x07, 0xba, 0x0b, 0x90, 0x1e, 0x26, 0xcb, 0x58, 0x3e, 0x68, 0xe0,
-	0x9c, 0xc6, 0x7a, 0x09, 0xad, 0xbc, 0x23, 0xea, 0x40, 0x79, 0x45, 0x2f, 0xf5, 0xe9, 0xf2, 0x13,
-	0xbd, 0x0f, 0x5b, 0x17, 0xc4, 0x8b, 0xa8, 0x2e, 0x8c, 0x6e, 0xef, 0x11, 0x5b, 0x04, 0xbe, 0xcb,
-	0x04, 0xc7, 0xca, 0xfa, 0xb8, 0xf4, 0x99, 0x69, 0xff, 0x65, 0x42, 0x73, 0x2a, 0x88, 0x88, 0xb8,
-	0xfa, 0x93, 0x3d, 0xa8, 0x46, 0x81, 0x70, 0xd7, 0x34, 0x8e, 0x57, 0xc1, 0x5a, 0x42, 0x5d, 0xa8,
-	0x5d, 0xd0, 0x90, 0xbb, 0x3e, 0xd3, 0x05, 0x49, 0x44, 0x64, 0x41, 0x3d, 0xf0, 0x88, 0x38, 0xf7,
-	0xc3, 0x75, 0xdc, 0xf3, 0x06, 0x4e, 0x65, 0xe9, 0x45, 0xc5, 0x9b, 0xc1, 0x62, 0x11, 0x76, 0x2b,
-	0xca, 0x4b, 0x8b, 0xb2, 0xc4, 0xb2, 0xd8, 0x43, 0x3f, 0x62, 0xa2, 0xbb, 0xb5, 0x6f, 0x1e, 0xb4,
-	0x71, 0xa6, 0x90, 0xd6, 0xd1, 0xd9, 0x73, 0x95, 0x57, 0xb7, 0xaa, 0x1a, 0x90, 0x2a, 0xd0, 0x21,
-	0x74, 0x42, 0xca, 0x16, 0xf4, 0xc7, 0x0b, 0x3f, 0xe2, 0x1a, 0x54, 0x8b, 0x41, 0x6f, 0xe9, 0xed,
-	0x5f, 0x4c, 0x68, 0xeb, 0xe1, 0xd3, 0x7f, 0xf8, 0x05, 0xd4, 0x89, 0x56, 0x74, 0xcd, 0x7c, 0x73,
-	0x0a, 0xb0, 0x54, 0x52, 0xcd, 0x49, 0x5d, 0xac, 0x17, 0x59, 0xbc, 0xeb, 0xca, 0x7f, 0xaf, 0x58,
-	0xfe, 0x76, 0x71, 0x05, 0x72, 0xc5, 0xff, 0xd9, 0x84, 0xb6, 0x9c, 0x86, 0x57, 0x2e, 0x17, 0x2a,
-	0xb9, 0x4f, 0xa0, 0xe2, 0xb2, 0x73, 0x5f, 0x27, 0x76, 0x27, 0x9b, 0xe8, 0x14, 0xd2, 0x1b, 0xb3,
-	0x73, 0x5f, 0x25, 0x15, 0x43, 0xad, 0x09, 0x34, 0x52, 0xd5, 0x86, 0x64, 0xee, 0x17, 0x93, 0xf9,
-	0x7f, 0x6e, 0x49, 0xb2, 0xb6, 0xe7, 0x93, 0xfa, 0xdd, 0x84, 0xd6, 0x88, 0x5e, 0xb8, 0x72, 0x4b,
-	0xe3, 0x9c, 0xde, 0x81, 0xf2, 0xd0, 0x99, 0xe9, 0x45, 0x6c, 0x68, 0xaa, 0x70, 0x66, 0x58, 0x6a,
-	0xd1, 0x1d, 0xa8, 0x1c, 0x3b, 0x33, 0xae, 0xc7, 0x5c, 0x5b, 0x8f, 0x9d, 0x19, 0x8e, 0xd5, 0xd2,
-	0x17, 0x0f, 0x4e, 0x34, 0x17, 0x68, 0x2b, 0x1e, 0x9c, 0x60, 0xa9, 0x45, 0x1f, 0x40, 0x4d, 0x8f,
-	0x75, 0x3c, 0x1d, 0x69, 0xa5, 0x92, 0x2d, 0x4d, 0xac, 0x12, 0xc8, 0x85, 0x1f, 0x92, 0x25, 0x8d,
-	0x47, 0x25, 0x05, 0x4e, 0x95, 0x12, 0x27, 0x56, 0x7b, 0x00, 0x3b, 0x4e, 0xe4, 0x79, 0x79, 0x26,
-	0xd9, 0xd3, 0x4c, 0x92, 0xac, 0xa7, 0x96, 0xd2, 0xdd, 0x5f, 0xe8, 0x79, 0xd6, 0x92, 0xfd, 0x53,
-	0x19, 0xda, 0x23, 0x09, 0x61, 0xe7, 0xbe, 0xfa, 0xff, 0xbb, 0x50, 0x91, 0x3e, 0xba, 0x00, 0xa0,
-	0x8e, 0x96, 0x10, 0x1c, 0xeb, 0xd1, 0x63, 0xa8, 0x85, 0x11, 0x63, 0x2e, 0x5b, 0xea, 0x2a, 0xec,
-	0x67, 0x90, 0x34, 0x4a, 0x0f, 0x2b, 0x88, 0xde, 0x75, 0xed, 0x80, 0xbe, 0x94, 0x64, 0xbc, 0x0e,
-	0x3c, 0x2a, 0xe8, 0x22, 0x5e, 0xf5, 0x66, 0xdf, 0xde, 0xe4, 0x3d, 0x4c, 0x40, 0xca, 0x3f, 0x73,
-	0x2a, 0x72, 0x6e, 0xe5, 0x5f, 0x72, 0xae, 0xf5, 0x15, 0xb4, 0xf2, 0x09, 0xdd, 0xc0, 0xdc, 0x58,
-	0x53, 0xd8, 0x2e, 0x66, 0x79, 0x13, 0xc3, 0xf8, 0x67, 0x19, 0x76, 0xae, 0x98, 0xd1, 0x23, 0xa8,
-	0x72, 0xb5, 0xf4, 0x32, 0xf2, 0x76, 0xff, 0xf6, 0xc6, 0x28, 0x3d, 0xfd, 0xad, 0xb1, 0x92, 0x52,
-	0xdc, 0x35, 0x59, 0xd2, 0x09, 0x59, 0xd3, 0x84, 0xd3, 0x53, 0x05, 0xfa, 0x3c, 0x23, 0xec, 0x42,
-	0x17, 0xae, 0x06, 0xdd, 0xcc, 0xd8, 0x19, 0x69, 0x56, 0x0a, 0xa4, 0xf9, 0x21, 0x6c, 0x45, 0x3c,
-	0x9b, 0xda, 0xdd, 0xe4, 0xe2, 0x54, 0x5d, 0x98, 0x49, 0x13, 0x56, 0x08, 0xf4, 0x0c, 0x10, 0xf1,
-	0x3c, 0x7f, 0x4e, 0x04, 0x5d, 0xa4, 0x1d, 0x8b, 0xa9, 0xef, 0xfa, 0x7e, 0x6e, 0xf0, 0xb8, 0xd9,
-	0xcb, 0xe1, 0x1b, 0xa8, 0x6a, 0xca, 0x6d, 0x42, 0x6d, 0x36, 0x79, 0x39, 0x39, 0x3d, 0x9b, 0x74,
-	0x0c, 0xd4, 0x82, 0xfa, 0xd4, 0x39, 0x3d, 0x7d, 0x35, 0x9e, 0x1c, 0x77, 0x4c, 0x25, 0x0d, 0xce,
-	0x26, 0x52, 0x2a, 0x49, 0x20, 0x9e, 0x4d, 0x62, 0xa1, 0x2c, 0x4d, 0xcf, 0xc6, 0x93, 0xf1, 0xf4,
-	0xf9, 0xd1, 0xa8, 0x53, 0x41, 0x00, 0xd5, 0xa7, 0xf8, 0xf4, 0xe5, 0xd1, 0xa4, 0xb3, 0xd5, 0xff,
-	0xbb, 0x04, 0x1d, 0x75, 0x2d, 0x9f, 0x10, 0x46, 0x96, 0x74, 0x4d, 0x99, 0x40, 0x87, 0xd9, 0x71,
-	0x3a, 0xa9, 0x75, 0x20, 0x2e, 0xad, 0x5b, 0xe9, 0xdd, 0x99, 0xb4, 0xc1, 0x36, 0xd0, 0x47, 0x50,
-	0xd3, 0x24, 0x55, 0x04, 0xa3, 0x64, 0x7b, 0x32, 0x02, 0xb3, 0x0d, 0xf4, 0x10, 0x9a, 0xcf, 0x42,
-	0x4a, 0xff, 0x83, 0xc7, 0x7d, 0xd8, 0x92, 0xbd, 0xbf, 0x82, 0xdd, 0xdd, 0x40, 0xc8, 0xb6, 0x81,
-	0x7a, 0x50, 0x4f, 0xee, 0x84, 0x8d, 0xf8, 0xc2, 0xcd, 0x62, 0x1b, 0xe8, 0x10, 0xda, 0xc3, 0x90,
-	0x12, 0x41, 0xb5, 0x01, 0x15, 0xaf, 0x08, 0xab, 0xae, 0xc4, 0xf1, 0xc8, 0x36, 0xd0, 0x01, 0xb4,
-	0x31, 0x5d, 0xfb, 0x17, 0x29, 0x36, 0x35, 0x5a, 0xf9, 0xa3, 0xe2, 0x94, 0xdb, 0x4e, 0x14, 0x2e,
-	0xe9, 0xe6, 0x54, 0x8a, 0xe0, 0xfe, 0x6f, 0x65, 0xa8, 0xaa, 0x06, 0xa0, 0x07, 0x50, 0x77, 0x22,
-	0xfe, 0x46, 0xfe, 0x54, 0xe2, 0x32, 0x7c, 0x13, 0xb1, 0x95, 0xa5, 0x1f, 0x54, 0x4e, 0xe8, 0x2f,
-	0x43, 0xca, 0xb9, 0x6d, 0x1c, 0x98, 0x0f, 0x4d, 0xd4, 0x97, 0x70, 0xc5, 0xb1, 0x48, 0x2f, 0xf0,
-	0x15, 0xce, 0xb5, 0xf2, 0x51, 0x6c, 0xe3, 0xa1, 0x89, 0x9e, 0x40, 0x23, 0x7d, 0xfa, 0xa0, 0xbd,
-	0xb7, 0xde, 0x42, 0xca, 0xeb, 0x7f, 0x9b, 0xde, 0x48, 0xb6, 0x81, 0xee, 0x41, 0x7d, 0x2a, 0xfc,
-	0x20, 0xf6, 0xbd, 0xf6, 0xe7, 0x3f, 0x06, 0xc8, 0x76, 0x35, 0x07, 0xdb, 0x4c, 0x31, 0xb6, 0x81,
-	0x9e, 0x42, 0x33, 0xf7, 0x22, 0x44, 0x77, 0x15, 0xee, 0xba, 0xa7, 0x62, 0x32, 0x84, 0x5a, 0x2b,
-	0xdf, 0x97, 0xb6, 0x81, 0x1e, 0xab, 0x67, 0xf5, 0x2b, 0x7f, 0xc9, 0x51, 0xee, 0x20, 0x29, 0x27,
-	0x7e, 0xbb, 0x45, 0x75, 0x56, 0x92, 0x1e, 0x34, 0x8f, 0xa9, 0x48, 0x58, 0x3e, 0x97, 0xf1, 0xee,
-	0x06, 0xfe, 0xb7, 0x8d, 0xd7, 0xd5, 0xf8, 0x29, 0xff, 0xe9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xb5, 0x1a, 0x4f, 0x4b, 0x52, 0x0c, 0x00, 0x00,
+func init() { proto.RegisterFile("worker.proto", fileDescriptor15) }
+
+var fileDescriptor15 = []byte{
+	// 1762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0x1b, 0xc7,
+	0x11, 0xc6, 0xe2, 0x1f, 0x0d, 0x82, 0x80, 0x86, 0x32, 0xb3, 0x59, 0xcb, 0x2a, 0x66, 0x6d, 0xc7,
+	0x0c, 0x1d, 0x21, 0x0a, 0xe3, 0x83, 0x25, 0x25, 0x29, 0x53, 0x00, 0x45, 0x42, 0x14, 0x01, 0x78,
+	0x40, 0x96, 0x92, 0x93, 0x6a, 0x04, 0x0c, 0xc1, 0x2d, 0x2c, 0x76, 0x37, 0x33, 0x03, 0x26, 0xcc,
+	0x2b, 0xe4, 0x96, 0x63, 0xfc, 0x0a, 0x79, 0x81, 0xbc, 0x42, 0xee, 0xb9, 0xa4, 0x2a, 0xcf, 0x91,
+	0x5b, 0x2a, 0x35, 0x3b, 0xb3, 0x7f, 0xc0, 0x52, 0x65, 0x95, 0x75, 0x43, 0x77, 0x7f, 0xdd, 0xd3,
+	0xdd, 0xd3, 0xd3, 0xdd, 0x0b, 0xd8, 0xfa, 0xa3, 0xcf, 0x16, 0x94, 0x75, 0x03, 0xe6, 0x0b, 0x1f,
+	0x95, 0xb9, 0xef, 0x2d, 0xad, 0x6d, 0xc2, 0x17, 0x6f, 0x02, 0x97, 0x78, 0x8a, 0x6b, 0xa1, 0x29,
+	0x09, 0xc8, 0x5b, 0xc7, 0x75, 0x84, 0x43, 0xb9, 0xe6, 0xb5, 0xa7, 0xbe, 0x27, 0x88, 0xe3, 0x45,
+	0xaa, 0xd6, 0xd6, 0x5b, 0x67, 0xee, 0x78, 0x22, 0x12, 0x3b, 0x9e, 0x34, 0xe5, 0x39, 0x44, 0x33,
+	0xee, 0x2d, 0x09, 0x5b, 0x50, 0x11, 0xb8, 0x64, 0x4a, 0x35, 0xab, 0xe1, 0xd1, 0x18, 0x2e, 0x9c,
+	0x25, 0xe5, 0x82, 0x2c, 0x03, 0xc5, 0xb0, 0xbf, 0x33, 0xa0, 0x7e, 0x41, 0xf8, 0x62, 0x12, 0xd0,
+	0x29, 0x7a, 0x04, 0x8d, 0xf8, 0x34, 0xd3, 0xd8, 0x33, 0xf6, 0x9b, 0x87, 0xed, 0xae, 0x34, 0xdf,
+	0xed, 0x45, 0x6c, 0x9c, 0x20, 0xd0, 0x01, 0xd4, 0x19, 0x9d, 0x3b, 0x5c, 0xb0, 0x5b, 0xb3, 0x18,
+	0xa2, 0xb7, 0x15, 0x1a, 0x6b, 0x2e, 0x8e, 0xe5, 0xe8, 0x2b, 0x68, 0x30, 0xca, 0xfd, 0x15, 0x9b,
+	0x52, 0x6e, 0x96, 0x42, 0xf0, 0xae, 0x02, 0x1f, 0xf1, 0xc5, 0xd8, 0x25, 0x1e, 0x8e, 0xa4, 0x38,
+	0x01, 0xda, 0x02, 0x3a, 0x13, 0x41, 0x98, 0x90, 0x1e, 0x62, 0xfa, 0x87, 0x15, 0xe5, 0x02, 0x7d,
+	0x06, 0xd5, 0x19, 0x25, 0xee, 0xa0, 0xaf, 0x3d, 0xdc, 0x52, 0x66, 0x9e, 0x3b, 0xf3, 0x81, 0x27,
+	0xb0, 0x96, 0x21, 0x1b, 0xca, 0x3c, 0xa0, 0xd3, 0xac, 0x5f, 0x51, 0xa0, 0x38, 0x94, 0xa1, 0x5d,
+	0xa8, 0x0a, 0xc2, 0x17, 0x83, 0x7e, 0xe8, 0x50, 0x03, 0x6b, 0xca, 0x1e, 0x41, 0x4b, 0x22, 0x4f,
+	0x98, 0xbf, 0x0a, 0xc2, 0xbc, 0x7c, 0x06, 0x15, 0x29, 0xe2, 0xa6, 0xb1, 0x57, 0xca, 0xb1, 0xa6,
+	0x84, 0xc8, 0x84, 0xda, 0x8d, 0xef, 0xae, 0x96, 0x94, 0x9b, 0xc5, 0xbd, 0xd2, 0x7e, 0x03, 0x47,
+	0xa4, 0x7d, 0x05, 0x1f, 0xc5, 0x61, 0x84, 0x56, 0xdf, 0x2f, 0x96, 0x2f, 0x32, 0xb1, 0xec, 0x24,
+	0xa7, 0xc7, 0x1e, 0xaa, 0x80, 0xec, 0x6f, 0x61, 0x67, 0xfd, 0x9c, 0xc0, 0xbd, 0x45, 0xdb, 0x50,
+	0x74, 0x66, 0xe1, 0x09, 0x0d, 0x5c, 0x74, 0x66, 0xe8, 0x20, 0x0a, 0xa7, 0x18, 0x86, 0x73, 0x5f,
+	0x19, 0x4c, 0x25, 0x3a, 0x70, 0x6f, 0x75, 0x50, 0xf6, 0x18, 0xcc, 0xd7, 0x61, 0xe1, 0xbe, 0xf4,
+	0x1d, 0x6f, 0x48, 0x85, 0xac, 0xe2, 0xc8, 0xfb, 0x24, 0x7f, 0x46, 0x3a, 0x7f, 0xe8, 0x01, 0x34,
+	0x3c, 0x85, 0x1c, 0xf4, 0x43, 0xa7, 0x1b, 0x38, 0x61, 0xd8, 0xff, 0x34, 0x60, 0x3b, 0x7b, 0xd6,
+	0x86, 0x83, 0xcf, 0xa0, 0x16, 0xf8, 0x4c, 0x9c, 0x93, 0x40, 0xbb, 0xf8, 0x93, 0x3c, 0x17, 0xbb,
+	0x63, 0x85, 0x39, 0xf6, 0x64, 0xa9, 0x45, 0x1a, 0xe8, 0x21, 0x40, 0x7c, 0x98, 0x2c, 0x35, 0x79,
+	0x13, 0x29, 0x8e, 0x75, 0x06, 0x5b, 0x69, 0x45, 0xd4, 0x81, 0xd2, 0x82, 0xde, 0xea, 0xd3, 0xe5,
+	0x4f, 0xf4, 0x39, 0x54, 0x6e, 0x88, 0xbb, 0xa2, 0x66, 0x31, 0xfd, 0x04, 0x8e, 0xbd, 0x59, 0xe0,
+	0x3b, 0x9e, 0xe0, 0x58, 0x49, 0x9f, 0x16, 0xbf, 0x36, 0xec, 0xff, 0x18, 0xd0, 0x9c, 0x08, 0x22,
+	0x56, 0x5c, 0x45, 0xb2, 0x0b, 0xd5, 0x55, 0x20, 0xdf, 0x58, 0x68, 0xaf, 0x8c, 0x35, 0x15, 0xd6,
+	0x06, 0x65, 0xdc, 0xf1, 0x3d, 0x9d, 0x90, 0x88, 0x44, 0x16, 0xd4, 0x03, 0x97, 0x88, 0x2b, 0x9f,
+	0x2d, 0x75, 0x19, 0xc6, 0xb4, 0xd4, 0xa2, 0xe2, 0xfa, 0x68, 0x36, 0x63, 0x66, 0x59, 0x69, 0x69,
+	0x52, 0xa6, 0x58, 0x26, 0xbb, 0xe7, 0xaf, 0x3c, 0x61, 0x56, 0xf6, 0x8c, 0xfd, 0x16, 0x4e, 0x18,
+	0x52, 0xda, 0x7f, 0x7d, 0xaa, 0xfc, 0x32, 0xab, 0xea, 0x02, 0x62, 0x06, 0x3a, 0x80, 0x0e, 0xa3,
+	0xde, 0x8c, 0xfe, 0xf9, 0xc6, 0x5f, 0x71, 0x0d, 0xaa, 0x85, 0xa0, 0x0d, 0xbe, 0x3d, 0x04, 0x74,
+	0x4e, 0x1c, 0x4f, 0x50, 0x8f, 0x78, 0x53, 0x1a, 0x5d, 0xfc, 0xd7, 0xd0, 0xe6, 0x81, 0x2f, 0x4e,
+	0x18, 0x99, 0xd2, 0x31, 0x65, 0x8e, 0x3f, 0xd3, 0xf5, 0xab, 0x5f, 0x46, 0x7f, 0xc5, 0x88, 0x70,
+	0x7c, 0x0f, 0xaf, 0xc3, 0xec, 0xff, 0x1a, 0xb0, 0x9b, 0x32, 0x98, 0x4e, 0x9d, 0x0c, 0xd6, 0x23,
+	0x6f, 0x5d, 0xaa, 0x8c, 0xd5, 0x71, 0x44, 0xca, 0xfb, 0xe0, 0x8e, 0x37, 0x5d, 0xbb, 0x8f, 0x8b,
+	0xa8, 0x93, 0x61, 0x25, 0xcd, 0xf3, 0xaa, 0xf4, 0xbd, 0xbc, 0x92, 0xcf, 0xd0, 0x67, 0x33, 0xca,
+	0xb8, 0x59, 0xde, 0x2b, 0x6d, 0x3e, 0x43, 0x25, 0x43, 0x36, 0x54, 0xe4, 0x83, 0xe4, 0x66, 0x25,
+	0x07, 0xa4, 0x44, 0x32, 0x88, 0x19, 0x93, 0xdd, 0x71, 0x16, 0xe6, 0xbd, 0x8e, 0x23, 0xd2, 0xfe,
+	0x9b, 0x01, 0x2d, 0xdd, 0xea, 0x74, 0xc0, 0xbf, 0x81, 0x3a, 0xd1, 0x0c, 0xd3, 0x48, 0x97, 0x79,
+	0x06, 0x16, 0x53, 0xaa, 0xcc, 0x63, 0x15, 0xeb, 0x25, 0xb4, 0x32, 0xa2, 0x9c, 0x42, 0xfe, 0x34,
+	0x5b, 0xc8, 0xad, 0x6c, 0xc3, 0x4d, 0x95, 0xf1, 0x5f, 0x0d, 0xd5, 0xf2, 0x5e, 0x39, 0x5c, 0x28,
+	0xe7, 0x7e, 0x09, 0x65, 0xc7, 0xbb, 0xf2, 0xb5, 0x63, 0x9f, 0x24, 0x3d, 0x27, 0x86, 0x74, 0x07,
+	0xde, 0x95, 0xaf, 0x9c, 0x0a, 0xa1, 0xd6, 0x10, 0x1a, 0x31, 0x2b, 0xc7, 0x99, 0x2f, 0xb3, 0xce,
+	0x7c, 0x94, 0x6a, 0xa2, 0x49, 0x15, 0xa4, 0x9d, 0xfa, 0x87, 0x01, 0x5b, 0x7d, 0x7a, 0xe3, 0x4c,
+	0xa9, 0x92, 0xa1, 0x8f, 0xa1, 0xd4, 0x1b, 0x5f, 0xea, 0x52, 0x6b, 0xe8, 0xc1, 0x34, 0xbe, 0xc4,
+	0x92, 0x8b, 0x3e, 0x81, 0xf2, 0xc9, 0xf8, 0x32, 0xea, 0x69, 0x5a, 0x7a, 0x32, 0xbe, 0xc4, 0x21,
+	0x5b, 0xea, 0xe2, 0xa3, 0x73, 0x5d, 0x10, 0x5a, 0x8a, 0x8f, 0xce, 0xb1, 0xe4, 0xa2, 0x2f, 0xa0,
+	0xa6, 0x1b, 0x84, 0x59, 0x4e, 0x67, 0x2a, 0xea, 0x77, 0x91, 0x54, 0x02, 0xb9, 0xf0, 0x19, 0x99,
+	0x53, 0xb3, 0x92, 0x06, 0x4e, 0x14, 0x13, 0x47, 0x52, 0xfb, 0x08, 0xda, 0xe3, 0x95, 0xeb, 0xa6,
+	0xe7, 0xd6, 0xae, 0xee, 0xf5, 0x51, 0xa3, 0xd3, 0x54, 0xdc, 0x45, 0x67, 0xba, 0x33, 0x68, 0xca,
+	0xfe, 0x4b, 0x09, 0x5a, 0x7d, 0x09, 0xf1, 0xae, 0x7c, 0x15, 0xff, 0x43, 0x28, 0x4b, 0x1d, 0x9d,
+	0x00, 0xd0, 0x55, 0x4d, 0x89, 0x8b, 0x43, 0x3e, 0x7a, 0x0a, 0x35, 0xb6, 0xf2, 0x3c, 0xc7, 0x9b,
+	0xeb, 0x2c, 0xec, 0x25, 0x90, 0xd8, 0x4a, 0x17, 0x2b, 0x88, 0xee, 0x9a, 0x5a, 0x01, 0x7d, 0x23,
+	0x47, 0xff, 0x32, 0x70, 0xa9, 0xa0, 0xb3, 0xb0, 0x69, 0x36, 0x0f, 0xed, 0x3c, 0xed, 0x5e, 0x04,
+	0x52, 0xfa, 0x89, 0x52, 0x76, 0xc2, 0x97, 0xbf, 0xe7, 0x84, 0xb7, 0xbe, 0x85, 0xad, 0xb4, 0x43,
+	0x1f, 0xa0, 0x6e, 0xac, 0x09, 0x6c, 0x67, 0xbd, 0xfc, 0x10, 0xc5, 0xf8, 0xef, 0x32, 0xb4, 0xd7,
+	0xc4, 0xe8, 0x2b, 0xa8, 0xf2, 0x90, 0x0c, 0x2d, 0x6f, 0x1f, 0x3e, 0xc8, 0xb5, 0xd2, 0xd5, 0xbf,
+	0x35, 0x56, 0x36, 0x67, 0x67, 0x49, 0xe6, 0x74, 0x48, 0x96, 0x34, 0x9a, 0x8e, 0x31, 0x03, 0xfd,
+	0x3a, 0x19, 0x7d, 0x99, 0x5b, 0x58, 0x37, 0x9a, 0x3f, 0xfb, 0x92, 0xf1, 0x53, 0xce, 0x8c, 0x9f,
+	0x9f, 0x41, 0x65, 0xc5, 0x93, 0xaa, 0xdd, 0x89, 0xd6, 0x34, 0x75, 0x0b, 0x97, 0x52, 0x84, 0x15,
+	0x02, 0xbd, 0x00, 0x44, 0x5c, 0xd7, 0x9f, 0x12, 0x41, 0x67, 0xf1, 0x8d, 0x99, 0xd5, 0x77, 0xde,
+	0x67, 0x8e, 0x86, 0x4c, 0xce, 0x35, 0x25, 0xae, 0xb8, 0x36, 0x6b, 0xef, 0x4a, 0xce, 0x69, 0x88,
+	0xc1, 0x1a, 0xfb, 0x61, 0x87, 0xf3, 0xef, 0xa0, 0xaa, 0x47, 0x5e, 0x13, 0x6a, 0x97, 0xc3, 0xb3,
+	0xe1, 0xe8, 0xf5, 0xb0, 0x53, 0x40, 0x5b, 0x50, 0x9f, 0x8c, 0x47, 0xa3, 0x57, 0x83, 0xe1, 0x49,
+	0xc7, 0x50, 0xd4, 0xd1, 0xeb, 0xa1, 0xa4, 0x8a, 0x12, 0x88, 0x2f, 0x87, 0x21, 0x51, 0x92, 0xa2,
+	0x17, 0x83, 0xe1, 0x60, 0x72, 0x7a, 0xdc, 0xef, 0x94, 0x11, 0x40, 0xf5, 0x39, 0x1e, 0x9d, 0x1d,
+	0x0f, 0x3b, 0x15, 0xfb, 0x1c, 0xaa, 0xca, 0x71, 0x84, 0x60, 0x7b, 0x38, 0x7a, 0x73, 0x7a, 0x7c,
+	0xf4, 0xea, 0xe2, 0xb4, 0x77, 0x7a, 0xdc, 0x3b, 0xeb, 0x14, 0xd0, 0x0e, 0xb4, 0x15, 0xe3, 0xcd,
+	0xe4, 0xe2, 0x08, 0x5f, 0xa8, 0x73, 0x9a, 0x50, 0x53, 0xcc, 0xdf, 0x77, 0x8a, 0xa8, 0x05, 0x8d,
+	0xcb, 0x61, 0x44, 0x96, 0xec, 0x7f, 0x15, 0xe1, 0x7e, 0xb2, 0xcf, 0xfd, 0xe0, 0x0a, 0x7b, 0x96,
+	0xdd, 0xef, 0x3e, 0x5f, 0x5f, 0x18, 0x53, 0x9a, 0x92, 0xa9, 0x27, 0x8b, 0xd2, 0x41, 0xbf, 0x85,
+	0x2a, 0x65, 0xcc, 0x67, 0x5c, 0xd7, 0xdf, 0x4f, 0xdf, 0xa1, 0x7d, 0x1c, 0x02, 0x95, 0xba, 0xd6,
+	0xb2, 0x46, 0x00, 0x89, 0xd1, 0x0f, 0xf1, 0x9c, 0x9f, 0x40, 0x33, 0x75, 0x4e, 0x8e, 0xc5, 0xfb,
+	0x69, 0x8b, 0x8d, 0x74, 0x01, 0x3c, 0x81, 0x7b, 0xd2, 0xf0, 0xf1, 0x0d, 0x95, 0x95, 0xf1, 0x3e,
+	0x3b, 0xb7, 0xfd, 0x3f, 0x03, 0x1a, 0xb1, 0xee, 0xc6, 0x82, 0x9a, 0xd8, 0x28, 0xde, 0x6d, 0x23,
+	0x75, 0x7b, 0xa5, 0xf7, 0xb8, 0x3d, 0x0b, 0xea, 0xf4, 0x4f, 0x8e, 0xe8, 0xf9, 0x33, 0xf5, 0x8a,
+	0x4b, 0x38, 0xa6, 0x65, 0xef, 0x18, 0x8d, 0xce, 0xcf, 0x1c, 0x57, 0x6e, 0x49, 0x95, 0x70, 0xc1,
+	0x48, 0x18, 0x52, 0xca, 0x28, 0x17, 0x84, 0x89, 0x78, 0xfd, 0x48, 0x18, 0xf2, 0xe3, 0x2e, 0xfe,
+	0xf8, 0x33, 0x6b, 0xe9, 0xc7, 0x93, 0x6c, 0x52, 0x09, 0xe2, 0xf0, 0xef, 0x65, 0xe8, 0xa8, 0xcd,
+	0xff, 0x9c, 0x78, 0x64, 0x4e, 0x97, 0x32, 0x0f, 0x07, 0xc9, 0x8b, 0xd2, 0xef, 0x6e, 0x19, 0x88,
+	0x5b, 0xeb, 0x5e, 0xbc, 0x9e, 0x47, 0x41, 0xd9, 0x05, 0xf4, 0x73, 0xa8, 0xe9, 0xe9, 0x9d, 0x05,
+	0xa3, 0x68, 0xac, 0x24, 0x93, 0xdd, 0x2e, 0xa0, 0xc7, 0xd0, 0x7c, 0xc1, 0x28, 0x7d, 0x0f, 0x8d,
+	0x2f, 0xa1, 0x12, 0x16, 0x5a, 0x16, 0xbb, 0x93, 0xb3, 0xa9, 0xd8, 0x05, 0xd4, 0x85, 0x7a, 0xb4,
+	0x2c, 0xe5, 0xe2, 0x33, 0x2b, 0x97, 0x5d, 0x40, 0x07, 0xd0, 0xea, 0x31, 0x4a, 0x04, 0xd5, 0x02,
+	0x94, 0xdd, 0x9d, 0xac, 0xba, 0x22, 0x07, 0x7d, 0xbb, 0x80, 0xf6, 0xa1, 0x85, 0xe9, 0xd2, 0xbf,
+	0x89, 0xb1, 0xb1, 0xd0, 0x4a, 0x1f, 0x15, 0xba, 0xdc, 0x1a, 0xaf, 0xd8, 0x9c, 0xe6, 0xbb, 0xb2,
+	0x06, 0x7e, 0xa6, 0xbf, 0x7d, 0x53, 0xeb, 0x32, 0x32, 0x15, 0x64, 0x73, 0x25, 0x5f, 0x57, 0xfe,
+	0x06, 0xee, 0x6d, 0xac, 0xd9, 0xd9, 0xd3, 0x1e, 0x6c, 0x98, 0xca, 0x5e, 0xdf, 0x23, 0x68, 0x4f,
+	0x84, 0x1f, 0xa4, 0x4f, 0x7f, 0x87, 0xb7, 0x87, 0xdf, 0x55, 0xa0, 0xaa, 0xca, 0x05, 0x3d, 0x82,
+	0xfa, 0x78, 0xc5, 0xaf, 0xe5, 0x15, 0x44, 0x2a, 0xbd, 0xeb, 0x95, 0xb7, 0xb0, 0xf4, 0x1e, 0x3e,
+	0x66, 0xfe, 0x9c, 0x51, 0xce, 0xed, 0xc2, 0xbe, 0xf1, 0xd8, 0x40, 0x87, 0x12, 0xae, 0x56, 0x25,
+	0xa4, 0xbb, 0xc1, 0xda, 0xea, 0x64, 0xa5, 0xad, 0xd8, 0x85, 0xc7, 0x06, 0x7a, 0x06, 0x8d, 0xf8,
+	0x5b, 0x10, 0xed, 0x6e, 0x7c, 0x1c, 0x2a, 0xad, 0xdc, 0xef, 0x5a, 0xbb, 0x80, 0x3e, 0x85, 0xba,
+	0x8c, 0x2c, 0xd4, 0xbd, 0xf3, 0xaa, 0x7e, 0xa1, 0xda, 0x98, 0xce, 0x5c, 0x02, 0xcb, 0xef, 0x57,
+	0x76, 0x01, 0xbd, 0x4c, 0x7d, 0xd5, 0x86, 0x8d, 0x12, 0x7d, 0xbc, 0x76, 0x7e, 0xfa, 0xcb, 0xdf,
+	0xfa, 0x71, 0xbe, 0x50, 0xd9, 0xda, 0x87, 0x56, 0xe4, 0xa1, 0x32, 0x75, 0xa7, 0x9b, 0x4f, 0xa0,
+	0x1d, 0xa3, 0x36, 0x7c, 0xb5, 0xee, 0x6e, 0xdd, 0x76, 0x01, 0x3d, 0x87, 0x66, 0xea, 0x9b, 0x1e,
+	0x3d, 0x54, 0xe0, 0xbb, 0x3e, 0xf6, 0xa3, 0x37, 0xae, 0xb9, 0xf2, 0x4f, 0x07, 0xbb, 0x80, 0x9e,
+	0xaa, 0x3f, 0x8f, 0x5e, 0xf9, 0x73, 0x8e, 0x52, 0x99, 0x91, 0x74, 0xa4, 0xb7, 0x93, 0x65, 0x27,
+	0x77, 0xf8, 0x14, 0x20, 0x6e, 0xb0, 0x1c, 0xfd, 0x28, 0x81, 0x65, 0xda, 0xb5, 0xd5, 0x5e, 0x13,
+	0x84, 0xba, 0x5d, 0x68, 0x9e, 0x50, 0x11, 0x6d, 0xa6, 0xa9, 0x90, 0x77, 0x72, 0x76, 0x56, 0xbb,
+	0xf0, 0xb6, 0x1a, 0xfe, 0xd9, 0xf5, 0xab, 0xff, 0x0f, 0x00, 0xcb, 0xcd, 0xd0, 0x9d, 0x85, 0x13,
+	0x00, 0x00,
 }
@@ -16,6 +16,7 @@ type options struct {
 	decoder     Decoder
 	encoder     Encoder
 	interceptor grpc.UnaryServerInterceptor
+	handlers    map[string]http.Handler
 }
 
 func defaultOptions() *options {
@@ -24,6 +25,7 @@ func defaultOptions() *options {
 		listeners: []net.Listener{},
 		decoder:   &nilDecoder{},
 		encoder:   &nilEncoder{},
+		handlers:  map[string]http.Handler{},
 	}
 }
 
@@ -79,3 +81,11 @@ func WithInterceptor(interceptor grpc.UnaryServerInterceptor) Option {
 		o.interceptor = interceptor
 	}
 }
+
+// WithHandler serves the given path with a plain HTTP handler instead of
+// a registered service method.
+func WithHandler(path string, handler http.Handler) Option {
+	return func(o *options) {
+		o.handlers[path] = handler
+	}
+}
@@ -38,6 +38,7 @@ type Server struct {
 	decoder     Decoder
 	encoder     Encoder
 	interceptor grpc.UnaryServerInterceptor
+	handlers    map[string]http.Handler
 }
 
 func NewServer(opts ...Option) (*Server, error) {
@@ -52,6 +53,7 @@ func NewServer(opts ...Option) (*Server, error) {
 		decoder:     o.decoder,
 		encoder:     o.encoder,
 		interceptor: o.interceptor,
+		handlers:    o.handlers,
 	}, nil
 }
 
@@ -106,8 +108,13 @@ func (s *Server) RegisterService(interfacePtr, concretePtr interface{}) error {
 }
 
 func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.RequestURI, "/")
 	s.log.Debugf("serving URI: %s", r.RequestURI)
+	if handler, ok := s.handlers[r.URL.Path]; ok {
+		handler.ServeHTTP(rw, r)
+		return
+	}
+
+	parts := strings.Split(r.RequestURI, "/")
 	if len(parts) < 3 {
 		rw.WriteHeader(http.StatusNotFound)
 		rw.Write([]byte("invalid uri provided"))